@@ -0,0 +1,362 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A cassette is a directory of recorded interactions, one JSON file each,
+// numbered in the order they were made. RecordTransport writes them and
+// ReplayTransport serves them back, so scripts and tests can run against the
+// real Client without reaching the API.
+
+// ErrNoRecording is returned on replay for a request that was never recorded.
+var ErrNoRecording = errors.New("no recorded response")
+
+// Interaction is a recorded request and the response the API gave to it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest holds the parts of a request replay matches on, and its
+// headers for reference.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	// Body holds a JSON body as-is; BodyText holds any other body.
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+// RecordedResponse is the response served on replay.
+type RecordedResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	// Body holds a JSON body as-is; BodyText holds any other body.
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+// RecordTransport passes requests on to Next and saves each exchange to Dir,
+// numbering on from any interactions already there. Secret headers, and
+// secret fields in request bodies, are redacted as they are in traces;
+// response bodies are saved verbatim so they replay faithfully.
+type RecordTransport struct {
+	Dir  string
+	Next http.RoundTripper
+
+	mu   sync.Mutex
+	next int
+}
+
+// NewRecordTransport returns a RecordTransport saving to dir. A nil next uses
+// http.DefaultTransport.
+func NewRecordTransport(dir string, next http.RoundTripper) *RecordTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &RecordTransport{Dir: dir, Next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RecordTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	payload, err := drainBody(&request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := t.Next.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := drainBody(&response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := Interaction{
+		Request: recordRequest(request, payload),
+		Response: RecordedResponse{
+			Status:  response.StatusCode,
+			Headers: redactHeaders(response.Header),
+		},
+	}
+	interaction.Response.Body, interaction.Response.BodyText = splitBody(body)
+
+	if err := t.save(interaction); err != nil {
+		return nil, fmt.Errorf("unable to record %s %s: %w", request.Method, request.URL.Path, err)
+	}
+
+	return response, nil
+}
+
+func (t *RecordTransport) save(interaction Interaction) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := os.MkdirAll(t.Dir, 0o755); err != nil {
+		return err
+	}
+
+	if t.next == 0 {
+		files, err := cassetteFiles(t.Dir)
+		if err != nil {
+			return err
+		}
+		t.next = 1
+		if len(files) > 0 {
+			last, _ := sequence(files[len(files)-1])
+			t.next = last + 1
+		}
+	}
+
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%04d-%s%s.json", t.next, strings.ToLower(interaction.Request.Method), slug(interaction.Request.Path))
+	t.next++
+
+	return os.WriteFile(filepath.Join(t.Dir, name), append(data, '\n'), 0o644)
+}
+
+// ReplayTransport serves the interactions recorded in Dir. A request matches
+// an interaction with the same method, path, query and body; the host is
+// ignored. Identical requests are served their recordings in order, and once
+// those run out the last one is repeated, so polling loops replay as
+// recorded. A request with no recording fails with an error naming it.
+type ReplayTransport struct {
+	Dir string
+
+	once         sync.Once
+	loadErr      error
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewReplayTransport returns a ReplayTransport serving the recordings in dir.
+func NewReplayTransport(dir string) *ReplayTransport {
+	return &ReplayTransport{Dir: dir}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *ReplayTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	t.once.Do(t.load)
+	if t.loadErr != nil {
+		return nil, t.loadErr
+	}
+
+	payload, err := drainBody(&request.Body)
+	if err != nil {
+		return nil, err
+	}
+	key := matchKey(recordRequest(request, payload))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	found := -1
+	for i, interaction := range t.interactions {
+		if matchKey(interaction.Request) != key {
+			continue
+		}
+		found = i
+		if !t.used[i] {
+			break
+		}
+	}
+
+	if found == -1 {
+		target := request.URL.Path
+		if request.URL.RawQuery != "" {
+			target += "?" + request.URL.RawQuery
+		}
+		return nil, fmt.Errorf("%w in %s for %s %s", ErrNoRecording, t.Dir, request.Method, target)
+	}
+	t.used[found] = true
+
+	recorded := t.interactions[found].Response
+	body := []byte(recorded.BodyText)
+	if len(recorded.Body) > 0 {
+		body = recorded.Body
+	}
+
+	headers := recorded.Headers.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+func (t *ReplayTransport) load() {
+	files, err := cassetteFiles(t.Dir)
+	if err != nil {
+		t.loadErr = fmt.Errorf("unable to read recordings: %w", err)
+		return
+	}
+
+	for _, name := range files {
+		data, err := os.ReadFile(filepath.Join(t.Dir, name))
+		if err != nil {
+			t.loadErr = fmt.Errorf("unable to read recordings: %w", err)
+			return
+		}
+
+		var interaction Interaction
+		if err := json.Unmarshal(data, &interaction); err != nil {
+			t.loadErr = fmt.Errorf("unable to parse recording %s: %w", name, err)
+			return
+		}
+		t.interactions = append(t.interactions, interaction)
+	}
+	t.used = make([]bool, len(t.interactions))
+}
+
+// recordRequest captures the parts of a request that are recorded and matched,
+// with its secrets redacted. Replay redacts the request it is matching in the
+// same way, so a recording matches whatever secret is sent.
+func recordRequest(request *http.Request, payload []byte) RecordedRequest {
+	recorded := RecordedRequest{
+		Method:  request.Method,
+		Path:    request.URL.Path,
+		Query:   request.URL.Query().Encode(),
+		Headers: redactHeaders(request.Header),
+	}
+	recorded.Body, recorded.BodyText = splitBody(redactBody(payload))
+	return recorded
+}
+
+// matchKey reduces a request to what replay compares. Query parameters are
+// sorted and JSON bodies compacted with sorted keys, so neither formatting
+// nor ordering stops a recording matching.
+func matchKey(r RecordedRequest) string {
+	query, err := url.ParseQuery(r.Query)
+	if err == nil {
+		r.Query = query.Encode()
+	}
+
+	body := r.BodyText
+	if len(r.Body) > 0 {
+		var v any
+		dec := json.NewDecoder(bytes.NewReader(r.Body))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err == nil {
+			normalised, _ := json.Marshal(v)
+			body = string(normalised)
+		} else {
+			body = string(r.Body)
+		}
+	}
+
+	return strings.Join([]string{r.Method, r.Path, r.Query, body}, "\n")
+}
+
+// splitBody returns a JSON body as raw JSON, or anything else as text.
+func splitBody(body []byte) (json.RawMessage, string) {
+	if len(body) == 0 {
+		return nil, ""
+	}
+	if json.Valid(body) {
+		compact := new(bytes.Buffer)
+		if err := json.Compact(compact, body); err == nil {
+			return compact.Bytes(), ""
+		}
+	}
+	return nil, string(body)
+}
+
+// drainBody reads a request or response body and replaces it with a copy, so
+// it can still be read by whoever is next.
+func drainBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+var cassetteFileName = regexp.MustCompile(`^(\d+)-.*\.json$`)
+
+// cassetteFiles lists the recordings in dir in the order they were made. A
+// missing directory holds no recordings.
+func cassetteFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && cassetteFileName.MatchString(entry.Name()) {
+			files = append(files, entry.Name())
+		}
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		a, _ := sequence(files[i])
+		b, _ := sequence(files[j])
+		return a < b
+	})
+	return files, nil
+}
+
+func sequence(name string) (int, error) {
+	match := cassetteFileName.FindStringSubmatch(name)
+	if match == nil {
+		return 0, fmt.Errorf("%s is not a recording", name)
+	}
+	return strconv.Atoi(match[1])
+}
+
+var nonSlug = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slug turns a path into something readable in a file name.
+func slug(path string) string {
+	s := strings.Trim(nonSlug.ReplaceAllString(strings.ToLower(path), "-"), "-")
+	if len(s) > 60 {
+		s = strings.TrimRight(s[:60], "-")
+	}
+	if s == "" {
+		return ""
+	}
+	return "-" + s
+}
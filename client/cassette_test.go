@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordThenReplay(t *testing.T) {
+	dir := t.TempDir()
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"path":"` + r.URL.Path + `","sent":` + string(body) + `}`))
+	}))
+	defer server.Close()
+
+	recorder := Client{
+		Client:  &http.Client{Transport: NewRecordTransport(dir, nil)},
+		BaseURL: server.URL,
+		Token:   "secret-token",
+	}
+
+	var recorded map[string]any
+	_, err := recorder.Execute(context.Background(), http.MethodPost, "/api/v1/studies/?b=2&a=1", map[string]any{"name": "x", "reward": 100}, &recorded)
+	require.NoError(t, err)
+	require.Equal(t, 1, hits)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, "0001-post-api-v1-studies.json", files[0].Name())
+
+	data, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "secret-token")
+
+	replayer := Client{
+		Client:  &http.Client{Transport: NewReplayTransport(dir)},
+		BaseURL: "https://api.example.com",
+		Token:   "other-token",
+	}
+
+	// The query and body are matched regardless of order.
+	var replayed map[string]any
+	_, err = replayer.Execute(context.Background(), http.MethodPost, "/api/v1/studies/?a=1&b=2", map[string]any{"reward": 100, "name": "x"}, &replayed)
+	require.NoError(t, err)
+	require.Equal(t, recorded, replayed)
+	require.Equal(t, 1, hits, "replay should not reach the server")
+}
+
+func TestRecordRedactsRequestSecrets(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"secret-1"}`))
+	}))
+	defer server.Close()
+
+	recorder := Client{
+		Client:  &http.Client{Transport: NewRecordTransport(dir, nil)},
+		BaseURL: server.URL,
+		Token:   "secret-token",
+	}
+
+	payload := map[string]any{"workspace_id": "ws-1", "credentials": map[string]any{"username": "u"}, "webhook_secret": "hunter2"}
+	_, err := recorder.Execute(context.Background(), http.MethodPost, "/api/v1/hooks/secrets/", payload, nil)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "0001-post-api-v1-hooks-secrets.json"))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "secret-token")
+	require.NotContains(t, string(data), "hunter2")
+	require.NotContains(t, string(data), `"username"`)
+	require.Contains(t, string(data), `"Authorization": [`)
+	require.Contains(t, string(data), `"ws-1"`)
+
+	replayer := Client{
+		Client:  &http.Client{Transport: NewReplayTransport(dir)},
+		BaseURL: "https://api.example.com",
+		Token:   "other-token",
+	}
+
+	payload["webhook_secret"] = "another secret"
+	var replayed map[string]any
+	_, err = replayer.Execute(context.Background(), http.MethodPost, "/api/v1/hooks/secrets/", payload, &replayed)
+	require.NoError(t, err)
+	require.Equal(t, "secret-1", replayed["id"])
+}
+
+func TestReplayFailsOnUnmatchedRequest(t *testing.T) {
+	dir := t.TempDir()
+	writeRecording(t, dir, "0001-get-api-v1-users-me.json", `{
+  "request": {"method": "GET", "path": "/api/v1/users/me"},
+  "response": {"status": 200, "body": {"id": "u-1"}}
+}`)
+
+	c := Client{
+		Client:  &http.Client{Transport: NewReplayTransport(dir)},
+		BaseURL: "https://api.example.com",
+		Token:   "token",
+		Retry:   RetryPolicy{MaxRetries: 3},
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodGet, "/api/v1/studies/?limit=1", nil, nil)
+
+	require.ErrorIs(t, err, ErrNoRecording)
+	require.Contains(t, err.Error(), "no recorded response in "+dir+" for GET /api/v1/studies/?limit=1")
+}
+
+func TestReplayServesRepeatedRequestsInOrder(t *testing.T) {
+	dir := t.TempDir()
+	for i, status := range []string{"PROCESSING", "PROCESSING", "COMPLETE"} {
+		writeRecording(t, dir, fmt.Sprintf("%04d-get-status.json", i+1), `{
+  "request": {"method": "GET", "path": "/status"},
+  "response": {"status": 200, "body": {"status": "`+status+`"}}
+}`)
+	}
+
+	c := Client{
+		Client:  &http.Client{Transport: NewReplayTransport(dir)},
+		BaseURL: "https://api.example.com",
+		Token:   "token",
+	}
+
+	var got []string
+	for range 4 {
+		var response struct {
+			Status string `json:"status"`
+		}
+		_, err := c.Execute(context.Background(), http.MethodGet, "/status", nil, &response)
+		require.NoError(t, err)
+		got = append(got, response.Status)
+	}
+
+	require.Equal(t, []string{"PROCESSING", "PROCESSING", "COMPLETE", "COMPLETE"}, got)
+}
+
+func TestReplayServesRecordedErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeRecording(t, dir, "0001-get-missing.json", `{
+  "request": {"method": "GET", "path": "/missing"},
+  "response": {"status": 404, "headers": {"X-Request-Id": ["req-1"]}, "body": {"detail": "Not found."}}
+}`)
+
+	c := Client{
+		Client:  &http.Client{Transport: NewReplayTransport(dir)},
+		BaseURL: "https://api.example.com",
+		Token:   "token",
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodGet, "/missing", nil, nil)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "req-1", apiErr.RequestID)
+}
+
+func TestRecordContinuesNumbering(t *testing.T) {
+	dir := t.TempDir()
+	writeRecording(t, dir, "0007-get-status.json", `{"request": {"method": "GET", "path": "/status"}, "response": {"status": 200}}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := Client{
+		Client:  &http.Client{Transport: NewRecordTransport(dir, nil)},
+		BaseURL: server.URL,
+		Token:   "token",
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodDelete, "/api/v1/hooks/h-1/", nil, nil)
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "0008-delete-api-v1-hooks-h-1.json"))
+	require.NoError(t, err)
+}
+
+func writeRecording(t *testing.T, dir, name, contents string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644))
+}
@@ -16,6 +16,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"time"
 	"unicode/utf8"
 
 	"github.com/prolific-oss/cli/agentenv"
@@ -34,110 +35,110 @@ const DefaultRecordLimit = 200
 
 // API represents what is allowed to be called on the Prolific client.
 type API interface {
-	GetMe() (*MeResponse, error)
-
-	CreateStudy(model.CreateStudy) (*model.Study, error)
-	DuplicateStudy(ID string) (*model.Study, error)
-	GetStudies(status, projectID string) (*ListStudiesResponse, error)
-	GetStudy(ID string) (*model.Study, error)
-	GetSubmissions(ID string, limit, offset int) (*ListSubmissionsResponse, error)
-	RequestSubmissionReturn(ID string, reasons []string) (*RequestSubmissionReturnResponse, error)
-	TransitionSubmission(ID string, payload TransitionSubmissionPayload) (*TransitionSubmissionResponse, error)
-	BulkApproveSubmissions(payload BulkApproveSubmissionsPayload) error
-	TransitionStudy(ID, action string) (*TransitionStudyResponse, error)
-	UpdateStudy(ID string, study any) (*model.Study, error)
-	GetStudySubmissionCounts(ID string) (*model.SubmissionCounts, error)
-	GetStudyCredentialsUsageReportCSV(ID string) (string, error)
-	ExportDemographics(ID string) (string, error)
-	TestStudy(ID string) (*TestStudyResponse, error)
-	CreateCredentialPool(credentials string, workspaceID string) (*CredentialPoolResponse, error)
-	UpdateCredentialPool(credentialPoolID string, credentials string) (*CredentialPoolResponse, error)
-	ListCredentialPools(workspaceID string) (*ListCredentialPoolsResponse, error)
-
-	GetCampaigns(workspaceID string, limit, offset int) (*ListCampaignsResponse, error)
-
-	GetCollections(workspaceID string, limit, offset int) (*ListCollectionsResponse, error)
-	GetCollection(ID string) (*model.Collection, error)
-	InitiateCollectionExport(collectionID string) (*CollectionExportResponse, error)
-	GetCollectionExportStatus(collectionID, exportID string) (*CollectionExportResponse, error)
-	UpdateCollection(ID string, collection model.UpdateCollection) (*model.Collection, error)
-
-	GetHooks(workspaceID string, enabled bool, limit, offset int) (*ListHooksResponse, error)
-	GetHookEventTypes() (*ListHookEventTypesResponse, error)
-	GetHookSecrets(workspaceID string) (*ListSecretsResponse, error)
-	CreateHookSecret(payload CreateSecretPayload) (*model.Secret, error)
-	GetEvents(subscriptionID string, limit, offset int) (*ListHookEventsResponse, error)
-	CreateHookSubscription(payload CreateHookPayload) (*model.Hook, string, error)
-	ConfirmHookSubscription(subscriptionID, secret string) (*model.Hook, error)
-	UpdateHookSubscription(subscriptionID string, payload UpdateHookPayload) (*model.Hook, error)
-	DeleteHookSubscription(subscriptionID string) error
-
-	GetWorkspaces(limit, offset int) (*ListWorkspacesResponse, error)
-	GetWorkspaceBalance(workspaceID string) (*WorkspaceBalanceResponse, error)
-	CreateWorkspace(workspace model.Workspace) (*CreateWorkspacesResponse, error)
-
-	CreateInvitation(invitation model.CreateInvitation) (*CreateInvitationResponse, error)
-
-	GetProjects(workspaceID string, limit, offset int) (*ListProjectsResponse, error)
-	CreateProject(workspaceID string, project model.Project) (*CreateProjectResponse, error)
-	GetProject(ID string) (*model.Project, error)
-
-	GetParticipantGroups(workspaceID string, limit, offset int) (*ListParticipantGroupsResponse, error)
-	GetParticipantGroup(groupID string) (*ViewParticipantGroupResponse, error)
-	CreateParticipantGroup(group model.CreateParticipantGroup) (*CreateParticipantGroupResponse, error)
-	RemoveParticipantGroupMembers(groupID string, participantIDs []string) (*ViewParticipantGroupResponse, error)
-
-	CreateTestParticipant(email string) (*CreateTestParticipantResponse, error)
-
-	GetFilters() (*ListFiltersResponse, error)
-
-	GetRewardRecommendations(workspaceID, currency string, screenerIDs []string) (*RewardRecommendationsResponse, error)
-
-	GetFilterSets(workspaceID string, limit, offset int) (*ListFilterSetsResponse, error)
-	GetFilterSet(ID string) (*model.FilterSet, error)
-	CreateFilterSet(filterSet model.CreateFilterSet) (*CreateFilterSetResponse, error)
-
-	GetSurveys(researcherID string, limit, offset int) (*ListSurveysResponse, error)
-	GetSurvey(ID string) (*model.Survey, error)
-	CreateSurvey(survey model.CreateSurvey) (*CreateSurveyResponse, error)
-	DeleteSurvey(ID string) error
-
-	GetSurveyResponses(surveyID string, limit, offset int) (*ListSurveyResponsesResponse, error)
-	GetSurveyResponse(surveyID, responseID string) (*model.SurveyResponse, error)
-	CreateSurveyResponse(surveyID string, response model.CreateSurveyResponseRequest) (*CreateSurveyResponseResponse, error)
-	DeleteSurveyResponse(surveyID, responseID string) error
-	DeleteAllSurveyResponses(surveyID string) error
-	GetSurveyResponseSummary(surveyID string) (*model.SurveySummary, error)
-
-	GetMessages(userID *string, createdAfter *string) (*ListMessagesResponse, error)
-	SendMessage(body, recipientID, studyID string) error
-	GetUnreadMessages() (*ListUnreadMessagesResponse, error)
-	BulkSendMessage(ids []string, body, studyID string) error
-	SendGroupMessage(participantGroupID, body string, studyID *string) error
-
-	CreateBonusPayments(payload CreateBonusPaymentsPayload) (*CreateBonusPaymentsResponse, error)
-	PayBonusPayments(id string) error
-
-	CreateAITaskBuilderBatch(params CreateBatchParams) (*CreateAITaskBuilderBatchResponse, error)
-	CreateAITaskBuilderInstructions(batchID string, instructions CreateAITaskBuilderInstructionsPayload) (*CreateAITaskBuilderInstructionsResponse, error)
-	SetupAITaskBuilderBatch(batchID, datasetID string, tasksPerGroup int) (*SetupAITaskBuilderBatchResponse, error)
-	CreateAITaskBuilderDataset(workspaceID string, payload CreateAITaskBuilderDatasetPayload) (*CreateAITaskBuilderDatasetResponse, error)
-	CreateAITaskBuilderCollection(payload model.CreateAITaskBuilderCollection) (*CreateAITaskBuilderCollectionResponse, error)
-	GetAITaskBuilderBatch(batchID string) (*GetAITaskBuilderBatchResponse, error)
-	GetAITaskBuilderDataset(datasetID string) (*GetAITaskBuilderDatasetResponse, error)
-	UpdateAITaskBuilderBatch(params UpdateBatchParams) (*UpdateAITaskBuilderBatchResponse, error)
-	GetAITaskBuilderBatchStatus(batchID string) (*GetAITaskBuilderBatchStatusResponse, error)
-	GetAITaskBuilderBatches(workspaceID string) (*GetAITaskBuilderBatchesResponse, error)
-	GetAITaskBuilderResponses(batchID string) (*GetAITaskBuilderResponsesResponse, error)
-	GetAITaskBuilderTasks(batchID string) (*GetAITaskBuilderTasksResponse, error)
-	GetAITaskBuilderTaskGroups(batchID string) (*GetAITaskBuilderTaskGroupsResponse, error)
-	InitiateBatchExport(batchID string) (*BatchExportResponse, error)
-	GetBatchExportStatus(batchID, exportID string) (*BatchExportResponse, error)
-	SyncAITaskBuilderBatch(batchID string) (*AITaskBuilderBatchSyncResponse, error)
-	GetAITaskBuilderBatchSyncStatus(batchID, syncID string) (*AITaskBuilderBatchSyncResponse, error)
-	GetAITaskBuilderDatasetStatus(datasetID string) (*GetAITaskBuilderDatasetStatusResponse, error)
-	GetAITaskBuilderDatasetUploadURL(datasetID, fileName string) (*GetAITaskBuilderDatasetUploadURLResponse, error)
-	GetAITaskBuilderDatasetImportStatus(datasetID, importID string) (*GetAITaskBuilderDatasetImportStatusResponse, error)
+	GetMe(ctx context.Context) (*MeResponse, error)
+
+	CreateStudy(ctx context.Context, study model.CreateStudy) (*model.Study, error)
+	DuplicateStudy(ctx context.Context, ID string) (*model.Study, error)
+	GetStudies(ctx context.Context, status, projectID string, limit, offset int) (*ListStudiesResponse, error)
+	GetStudy(ctx context.Context, ID string) (*model.Study, error)
+	GetSubmissions(ctx context.Context, ID string, limit, offset int) (*ListSubmissionsResponse, error)
+	RequestSubmissionReturn(ctx context.Context, ID string, reasons []string) (*RequestSubmissionReturnResponse, error)
+	TransitionSubmission(ctx context.Context, ID string, payload TransitionSubmissionPayload) (*TransitionSubmissionResponse, error)
+	BulkApproveSubmissions(ctx context.Context, payload BulkApproveSubmissionsPayload) error
+	TransitionStudy(ctx context.Context, ID, action string) (*TransitionStudyResponse, error)
+	UpdateStudy(ctx context.Context, ID string, study any) (*model.Study, error)
+	GetStudySubmissionCounts(ctx context.Context, ID string) (*model.SubmissionCounts, error)
+	GetStudyCredentialsUsageReportCSV(ctx context.Context, ID string) (string, error)
+	ExportDemographics(ctx context.Context, ID string) (string, error)
+	TestStudy(ctx context.Context, ID string) (*TestStudyResponse, error)
+	CreateCredentialPool(ctx context.Context, credentials string, workspaceID string) (*CredentialPoolResponse, error)
+	UpdateCredentialPool(ctx context.Context, credentialPoolID string, credentials string) (*CredentialPoolResponse, error)
+	ListCredentialPools(ctx context.Context, workspaceID string) (*ListCredentialPoolsResponse, error)
+
+	GetCampaigns(ctx context.Context, workspaceID string, limit, offset int) (*ListCampaignsResponse, error)
+
+	GetCollections(ctx context.Context, workspaceID string, limit, offset int) (*ListCollectionsResponse, error)
+	GetCollection(ctx context.Context, ID string) (*model.Collection, error)
+	InitiateCollectionExport(ctx context.Context, collectionID string) (*CollectionExportResponse, error)
+	GetCollectionExportStatus(ctx context.Context, collectionID, exportID string) (*CollectionExportResponse, error)
+	UpdateCollection(ctx context.Context, ID string, collection model.UpdateCollection) (*model.Collection, error)
+
+	GetHooks(ctx context.Context, workspaceID string, enabled bool, limit, offset int) (*ListHooksResponse, error)
+	GetHookEventTypes(ctx context.Context) (*ListHookEventTypesResponse, error)
+	GetHookSecrets(ctx context.Context, workspaceID string) (*ListSecretsResponse, error)
+	CreateHookSecret(ctx context.Context, payload CreateSecretPayload) (*model.Secret, error)
+	GetEvents(ctx context.Context, subscriptionID string, limit, offset int) (*ListHookEventsResponse, error)
+	CreateHookSubscription(ctx context.Context, payload CreateHookPayload) (*model.Hook, string, error)
+	ConfirmHookSubscription(ctx context.Context, subscriptionID, secret string) (*model.Hook, error)
+	UpdateHookSubscription(ctx context.Context, subscriptionID string, payload UpdateHookPayload) (*model.Hook, error)
+	DeleteHookSubscription(ctx context.Context, subscriptionID string) error
+
+	GetWorkspaces(ctx context.Context, limit, offset int) (*ListWorkspacesResponse, error)
+	GetWorkspaceBalance(ctx context.Context, workspaceID string) (*WorkspaceBalanceResponse, error)
+	CreateWorkspace(ctx context.Context, workspace model.Workspace) (*CreateWorkspacesResponse, error)
+
+	CreateInvitation(ctx context.Context, invitation model.CreateInvitation) (*CreateInvitationResponse, error)
+
+	GetProjects(ctx context.Context, workspaceID string, limit, offset int) (*ListProjectsResponse, error)
+	CreateProject(ctx context.Context, workspaceID string, project model.Project) (*CreateProjectResponse, error)
+	GetProject(ctx context.Context, ID string) (*model.Project, error)
+
+	GetParticipantGroups(ctx context.Context, workspaceID string, limit, offset int) (*ListParticipantGroupsResponse, error)
+	GetParticipantGroup(ctx context.Context, groupID string) (*ViewParticipantGroupResponse, error)
+	CreateParticipantGroup(ctx context.Context, group model.CreateParticipantGroup) (*CreateParticipantGroupResponse, error)
+	RemoveParticipantGroupMembers(ctx context.Context, groupID string, participantIDs []string) (*ViewParticipantGroupResponse, error)
+
+	CreateTestParticipant(ctx context.Context, email string) (*CreateTestParticipantResponse, error)
+
+	GetFilters(ctx context.Context) (*ListFiltersResponse, error)
+
+	GetRewardRecommendations(ctx context.Context, workspaceID, currency string, screenerIDs []string) (*RewardRecommendationsResponse, error)
+
+	GetFilterSets(ctx context.Context, workspaceID string, limit, offset int) (*ListFilterSetsResponse, error)
+	GetFilterSet(ctx context.Context, ID string) (*model.FilterSet, error)
+	CreateFilterSet(ctx context.Context, filterSet model.CreateFilterSet) (*CreateFilterSetResponse, error)
+
+	GetSurveys(ctx context.Context, researcherID string, limit, offset int) (*ListSurveysResponse, error)
+	GetSurvey(ctx context.Context, ID string) (*model.Survey, error)
+	CreateSurvey(ctx context.Context, survey model.CreateSurvey) (*CreateSurveyResponse, error)
+	DeleteSurvey(ctx context.Context, ID string) error
+
+	GetSurveyResponses(ctx context.Context, surveyID string, limit, offset int) (*ListSurveyResponsesResponse, error)
+	GetSurveyResponse(ctx context.Context, surveyID, responseID string) (*model.SurveyResponse, error)
+	CreateSurveyResponse(ctx context.Context, surveyID string, response model.CreateSurveyResponseRequest) (*CreateSurveyResponseResponse, error)
+	DeleteSurveyResponse(ctx context.Context, surveyID, responseID string) error
+	DeleteAllSurveyResponses(ctx context.Context, surveyID string) error
+	GetSurveyResponseSummary(ctx context.Context, surveyID string) (*model.SurveySummary, error)
+
+	GetMessages(ctx context.Context, userID *string, createdAfter *string) (*ListMessagesResponse, error)
+	SendMessage(ctx context.Context, body, recipientID, studyID string) error
+	GetUnreadMessages(ctx context.Context) (*ListUnreadMessagesResponse, error)
+	BulkSendMessage(ctx context.Context, ids []string, body, studyID string) error
+	SendGroupMessage(ctx context.Context, participantGroupID, body string, studyID *string) error
+
+	CreateBonusPayments(ctx context.Context, payload CreateBonusPaymentsPayload) (*CreateBonusPaymentsResponse, error)
+	PayBonusPayments(ctx context.Context, id string) error
+
+	CreateAITaskBuilderBatch(ctx context.Context, params CreateBatchParams) (*CreateAITaskBuilderBatchResponse, error)
+	CreateAITaskBuilderInstructions(ctx context.Context, batchID string, instructions CreateAITaskBuilderInstructionsPayload) (*CreateAITaskBuilderInstructionsResponse, error)
+	SetupAITaskBuilderBatch(ctx context.Context, batchID, datasetID string, tasksPerGroup int) (*SetupAITaskBuilderBatchResponse, error)
+	CreateAITaskBuilderDataset(ctx context.Context, workspaceID string, payload CreateAITaskBuilderDatasetPayload) (*CreateAITaskBuilderDatasetResponse, error)
+	CreateAITaskBuilderCollection(ctx context.Context, payload model.CreateAITaskBuilderCollection) (*CreateAITaskBuilderCollectionResponse, error)
+	GetAITaskBuilderBatch(ctx context.Context, batchID string) (*GetAITaskBuilderBatchResponse, error)
+	GetAITaskBuilderDataset(ctx context.Context, datasetID string) (*GetAITaskBuilderDatasetResponse, error)
+	UpdateAITaskBuilderBatch(ctx context.Context, params UpdateBatchParams) (*UpdateAITaskBuilderBatchResponse, error)
+	GetAITaskBuilderBatchStatus(ctx context.Context, batchID string) (*GetAITaskBuilderBatchStatusResponse, error)
+	GetAITaskBuilderBatches(ctx context.Context, workspaceID string, limit, offset int) (*GetAITaskBuilderBatchesResponse, error)
+	GetAITaskBuilderResponses(ctx context.Context, batchID string) (*GetAITaskBuilderResponsesResponse, error)
+	GetAITaskBuilderTasks(ctx context.Context, batchID string) (*GetAITaskBuilderTasksResponse, error)
+	GetAITaskBuilderTaskGroups(ctx context.Context, batchID string) (*GetAITaskBuilderTaskGroupsResponse, error)
+	InitiateBatchExport(ctx context.Context, batchID string) (*BatchExportResponse, error)
+	GetBatchExportStatus(ctx context.Context, batchID, exportID string) (*BatchExportResponse, error)
+	SyncAITaskBuilderBatch(ctx context.Context, batchID string) (*AITaskBuilderBatchSyncResponse, error)
+	GetAITaskBuilderBatchSyncStatus(ctx context.Context, batchID, syncID string) (*AITaskBuilderBatchSyncResponse, error)
+	GetAITaskBuilderDatasetStatus(ctx context.Context, datasetID string) (*GetAITaskBuilderDatasetStatusResponse, error)
+	GetAITaskBuilderDatasetUploadURL(ctx context.Context, datasetID, fileName string) (*GetAITaskBuilderDatasetUploadURLResponse, error)
+	GetAITaskBuilderDatasetImportStatus(ctx context.Context, datasetID, importID string) (*GetAITaskBuilderDatasetImportStatusResponse, error)
 }
 
 // UnrecognizedAPIError is returned by Execute when the response body does not match any known
@@ -181,6 +182,21 @@ type Client struct {
 	Token   string
 	Debug   bool
 	Skill   string
+	// DebugOutput receives the Debug output; nil means stderr.
+	DebugOutput io.Writer
+	// TraceFile, when set, is a HAR file every request and response is
+	// recorded to, with secrets redacted.
+	TraceFile string
+	// Timeout bounds each individual request; zero means no per-request
+	// limit beyond whatever the caller's context imposes.
+	Timeout time.Duration
+	// Retry decides how failed requests are retried; the zero value never
+	// retries.
+	Retry RetryPolicy
+	// TokenSource supplies the token when Token is empty. It is only called
+	// once a request needs a token, so a passphrase prompt doesn't get in
+	// the way of commands that never reach the API.
+	TokenSource func() (string, error)
 }
 
 // cliVersionPrefix is computed once since the CLI version can't change during
@@ -216,90 +232,181 @@ func New() Client {
 	viper.SetDefault("PROLIFIC_URL", config.GetAPIURL())
 
 	client := Client{
-		Client:  http.DefaultClient,
-		Token:   viper.GetString("PROLIFIC_TOKEN"),
-		BaseURL: strings.TrimRight(viper.GetString("PROLIFIC_URL"), "/"),
-		Debug:   viper.GetBool("PROLIFIC_DEBUG"),
+		Client:    http.DefaultClient,
+		Token:     viper.GetString("PROLIFIC_TOKEN"),
+		BaseURL:   strings.TrimRight(viper.GetString("PROLIFIC_URL"), "/"),
+		Debug:     viper.GetBool("PROLIFIC_DEBUG"),
+		TraceFile: viper.GetString("PROLIFIC_TRACE_FILE"),
+		Timeout:   viper.GetDuration("PROLIFIC_TIMEOUT"),
+		Retry:     NewRetryPolicy(),
+	}
+
+	// Replaying needs no token, as nothing reaches the API.
+	if dir := viper.GetString("PROLIFIC_REPLAY"); dir != "" {
+		client.Client = &http.Client{Transport: NewReplayTransport(dir)}
+		if client.Token == "" {
+			client.Token = "replay"
+		}
+	} else if dir := viper.GetString("PROLIFIC_RECORD"); dir != "" {
+		client.Client = &http.Client{Transport: NewRecordTransport(dir, nil)}
 	}
 
 	return client
 }
 
-// Execute runs an HTTP request.
-func (c *Client) Execute(method, url string, body any, response any) (*http.Response, error) {
-	if c.Token == "" {
-		return nil, errors.New("PROLIFIC_TOKEN not set")
+// WithToken returns a copy of the client that authenticates with token, e.g.
+// to check a token before it is stored.
+func (c *Client) WithToken(token string) API {
+	clone := *c
+	clone.Token = token
+	clone.TokenSource = nil
+	return &clone
+}
+
+// token returns the token to authenticate with, falling back to TokenSource
+// when no token was configured.
+func (c *Client) token() (string, error) {
+	if c.Token != "" || c.TokenSource == nil {
+		return c.Token, nil
 	}
+	return c.TokenSource()
+}
 
-	var buf io.ReadWriter
+// Execute runs an HTTP request. The request is abandoned as soon as ctx is
+// cancelled, or once Timeout elapses if one is configured. Idempotent methods
+// are retried according to the Retry policy.
+func (c *Client) Execute(ctx context.Context, method, url string, body any, response any) (*http.Response, error) {
+	return c.execute(ctx, method, url, body, response, isIdempotent(method))
+}
+
+// execute is Execute with the decision on whether to retry made by the
+// caller, so ExecuteBuilder can opt non-idempotent requests in.
+func (c *Client) execute(ctx context.Context, method, url string, body any, response any, retryable bool) (*http.Response, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, ErrTokenNotSet
+	}
+
+	// Encode once up front so every attempt sends an identical body.
+	var payload []byte
 	if body != nil {
-		buf = new(bytes.Buffer)
+		buf := new(bytes.Buffer)
 		enc := json.NewEncoder(buf)
 		enc.SetEscapeHTML(false)
 		err := enc.Encode(body)
 		if err != nil {
 			return nil, err
 		}
+		payload = buf.Bytes()
+	}
+
+	maxAttempts := 1
+	if retryable && c.Retry.MaxRetries > 0 {
+		maxAttempts += c.Retry.MaxRetries
 	}
 
-	request, err := http.NewRequestWithContext(context.Background(), method, c.BaseURL+url, buf)
+	var httpResponse *http.Response
+	var responseBody []byte
+	for attempt := 1; ; attempt++ {
+		httpResponse, responseBody, err = c.send(ctx, method, url, token, payload)
+
+		if attempt >= maxAttempts || ctx.Err() != nil {
+			break
+		}
+		if err == nil && !isRetryableStatus(httpResponse.StatusCode) {
+			break
+		}
+		if errors.Is(err, ErrNoRecording) {
+			break
+		}
+
+		wait := c.Retry.delay(attempt, httpResponse)
+		if c.Debug {
+			reason := ""
+			if err != nil {
+				reason = err.Error()
+			} else {
+				reason = httpResponse.Status
+			}
+			c.debugf("Retrying %s %s in %s (attempt %d of %d): %s\n", method, url, wait.Round(time.Millisecond), attempt+1, maxAttempts, reason)
+		}
+		if sleepErr := Sleep(ctx, wait); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	request.Header.Set("Content-Type", "application/json")
-	request.Header.Set("User-Agent", c.userAgent())
-	request.Header.Set("Authorization", fmt.Sprintf("Token %s", c.Token))
+	if httpResponse.StatusCode >= 400 {
+		return nil, newAPIError(httpResponse, responseBody)
+	}
 
-	if c.Debug {
-		fmt.Println(request)
+	if response != nil {
+		if err := json.NewDecoder(io.NopCloser(bytes.NewBuffer(responseBody))).Decode(response); err != nil {
+			return nil, fmt.Errorf("decoding JSON response from %s failed: %w", httpResponse.Request.URL, err)
+		}
 	}
 
-	httpResponse, err := c.Client.Do(request)
-	if err != nil {
-		return nil, err
+	return httpResponse, nil
+}
+
+// send makes a single attempt at a request, returning the response with its
+// body already read so the caller can inspect it and the connection is
+// released before any retry.
+func (c *Client) send(ctx context.Context, method, url, token string, payload []byte) (*http.Response, []byte, error) {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
 	}
-	defer httpResponse.Body.Close()
 
-	responseBody, _ := io.ReadAll(httpResponse.Body)
-	httpResponse.Body = io.NopCloser(bytes.NewBuffer(responseBody))
+	var buf io.Reader
+	if payload != nil {
+		buf = bytes.NewReader(payload)
+	}
 
-	if c.Debug {
-		fmt.Println(string(responseBody))
+	request, err := http.NewRequestWithContext(ctx, method, c.BaseURL+url, buf)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if httpResponse.StatusCode >= 400 {
-		// Try the nested error format first
-		var apiError JSONAPIError
-		if err := json.NewDecoder(io.NopCloser(bytes.NewBuffer(responseBody))).Decode(&apiError); err == nil && apiError.Error.Detail != nil {
-			return nil, fmt.Errorf("request failed: %s", truncateErrorDetail(fmt.Sprintf("%v", apiError.Error.Detail)))
-		}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", c.userAgent())
+	request.Header.Set("Authorization", fmt.Sprintf("Token %s", token))
 
-		// Try the simple error format
-		var simpleError SimpleAPIError
-		if err := json.NewDecoder(io.NopCloser(bytes.NewBuffer(responseBody))).Decode(&simpleError); err == nil && simpleError.Detail != "" {
-			return nil, fmt.Errorf("request failed: %s - %s", simpleError.Message, truncateErrorDetail(simpleError.Detail))
-		}
+	started := time.Now()
+	httpResponse, err := c.Client.Do(request)
+	var responseBody []byte
+	if err == nil {
+		defer httpResponse.Body.Close()
 
-		// If both fail, return a typed error so callers can inspect the raw body
-		return nil, &UnrecognizedAPIError{StatusCode: httpResponse.StatusCode, Body: responseBody}
+		responseBody, _ = io.ReadAll(httpResponse.Body)
+		httpResponse.Body = io.NopCloser(bytes.NewBuffer(responseBody))
 	}
+	elapsed := time.Since(started)
 
-	if response != nil {
-		if err := json.NewDecoder(io.NopCloser(bytes.NewBuffer(responseBody))).Decode(response); err != nil {
-			return nil, fmt.Errorf("decoding JSON response from %s failed: %v", request.URL, err)
-		}
+	c.debugExchange(request, payload, httpResponse, responseBody, elapsed, err)
+	if c.TraceFile != "" {
+		traceTo(c.TraceFile).record(request, payload, httpResponse, responseBody, started, elapsed, err)
 	}
 
-	return httpResponse, nil
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return httpResponse, responseBody, nil
 }
 
 // CreateStudy is responsible for hitting the Prolific API to create a study.
-func (c *Client) CreateStudy(study model.CreateStudy) (*model.Study, error) {
+func (c *Client) CreateStudy(ctx context.Context, study model.CreateStudy) (*model.Study, error) {
 	var response model.Study
 
 	url := "/api/v1/studies/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(study).
 		Status(http.StatusCreated).
@@ -313,11 +420,11 @@ func (c *Client) CreateStudy(study model.CreateStudy) (*model.Study, error) {
 }
 
 // GetMe will return your user account details.
-func (c *Client) GetMe() (*MeResponse, error) {
+func (c *Client) GetMe(ctx context.Context) (*MeResponse, error) {
 	var response MeResponse
 
 	url := "/api/v1/users/me"
-	if _, err := c.ExecuteBuilder().Get(url, &response); err != nil {
+	if _, err := c.ExecuteBuilder(ctx).Get(url, &response); err != nil {
 		return nil, err
 	}
 
@@ -325,11 +432,11 @@ func (c *Client) GetMe() (*MeResponse, error) {
 }
 
 // DuplicateStudy will duplicate an existing study.
-func (c *Client) DuplicateStudy(ID string) (*model.Study, error) {
+func (c *Client) DuplicateStudy(ctx context.Context, ID string) (*model.Study, error) {
 	var response model.Study
 
 	url := fmt.Sprintf("/api/v1/studies/%s/clone/", ID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Status(http.StatusOK).
 		Decode(&response).
@@ -341,10 +448,10 @@ func (c *Client) DuplicateStudy(ID string) (*model.Study, error) {
 	return &response, nil
 }
 
-// GetStudies will return you a list of Study objects.
-func (c *Client) GetStudies(status, projectID string) (*ListStudiesResponse, error) {
+// GetStudies will return you a list of Study objects. A limit of 0 sends no
+// paging parameters, returning the studies as the endpoint does by default.
+func (c *Client) GetStudies(ctx context.Context, status, projectID string, limit, offset int) (*ListStudiesResponse, error) {
 	var response ListStudiesResponse
-	var url string
 
 	// Validate status if it's not "all" or empty
 	if status != "" && status != model.StatusAll {
@@ -363,22 +470,24 @@ func (c *Client) GetStudies(status, projectID string) (*ListStudiesResponse, err
 		}
 	}
 
+	var params []string
+	if statusFragment != "" {
+		params = append(params, statusFragment)
+	}
+	if paging := pageQuery(limit, offset); paging != "" {
+		params = append(params, paging)
+	}
+
 	// Build URL based on whether projectID is provided
+	url := "/api/v1/studies/"
 	if projectID != "" {
-		if statusFragment != "" {
-			url = fmt.Sprintf("/api/v1/projects/%s/studies/?%s", projectID, statusFragment)
-		} else {
-			url = fmt.Sprintf("/api/v1/projects/%s/studies/", projectID)
-		}
-	} else {
-		if statusFragment != "" {
-			url = fmt.Sprintf("/api/v1/studies/?%s", statusFragment)
-		} else {
-			url = "/api/v1/studies/"
-		}
+		url = fmt.Sprintf("/api/v1/projects/%s/studies/", projectID)
+	}
+	if len(params) > 0 {
+		url += "?" + strings.Join(params, "&")
 	}
 
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -386,12 +495,21 @@ func (c *Client) GetStudies(status, projectID string) (*ListStudiesResponse, err
 	return &response, nil
 }
 
+// pageQuery returns the limit and offset query parameters, or nothing when
+// limit is 0 so that endpoints which were never paged keep their default.
+func pageQuery(limit, offset int) string {
+	if limit == 0 {
+		return ""
+	}
+	return fmt.Sprintf("limit=%v&offset=%v", limit, offset)
+}
+
 // GetStudy will return a single study
-func (c *Client) GetStudy(ID string) (*model.Study, error) {
+func (c *Client) GetStudy(ctx context.Context, ID string) (*model.Study, error) {
 	var response model.Study
 
 	url := fmt.Sprintf("/api/v1/studies/%s", ID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -400,11 +518,11 @@ func (c *Client) GetStudy(ID string) (*model.Study, error) {
 }
 
 // GetStudySubmissionCounts returns submission counts grouped by status for a study.
-func (c *Client) GetStudySubmissionCounts(ID string) (*model.SubmissionCounts, error) {
+func (c *Client) GetStudySubmissionCounts(ctx context.Context, ID string) (*model.SubmissionCounts, error) {
 	var response model.SubmissionCounts
 
 	url := fmt.Sprintf("/api/v1/studies/%s/submissions/counts/", ID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -413,11 +531,11 @@ func (c *Client) GetStudySubmissionCounts(ID string) (*model.SubmissionCounts, e
 }
 
 // GetSubmissions will return submission data for a given study.
-func (c *Client) GetSubmissions(ID string, limit, offset int) (*ListSubmissionsResponse, error) {
+func (c *Client) GetSubmissions(ctx context.Context, ID string, limit, offset int) (*ListSubmissionsResponse, error) {
 	var response ListSubmissionsResponse
 
 	url := fmt.Sprintf("/api/v1/studies/%s/submissions/?limit=%v&offset=%v", ID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -426,7 +544,7 @@ func (c *Client) GetSubmissions(ID string, limit, offset int) (*ListSubmissionsR
 }
 
 // RequestSubmissionReturn requests a participant to return a submission.
-func (c *Client) RequestSubmissionReturn(ID string, reasons []string) (*RequestSubmissionReturnResponse, error) {
+func (c *Client) RequestSubmissionReturn(ctx context.Context, ID string, reasons []string) (*RequestSubmissionReturnResponse, error) {
 	var response RequestSubmissionReturnResponse
 
 	payload := RequestSubmissionReturnPayload{
@@ -434,7 +552,7 @@ func (c *Client) RequestSubmissionReturn(ID string, reasons []string) (*RequestS
 	}
 
 	url := fmt.Sprintf("/api/v1/submissions/%s/request-return/", ID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Decode(&response).
@@ -447,11 +565,11 @@ func (c *Client) RequestSubmissionReturn(ID string, reasons []string) (*RequestS
 }
 
 // TransitionSubmission will transition a submission to a new state.
-func (c *Client) TransitionSubmission(ID string, payload TransitionSubmissionPayload) (*TransitionSubmissionResponse, error) {
+func (c *Client) TransitionSubmission(ctx context.Context, ID string, payload TransitionSubmissionPayload) (*TransitionSubmissionResponse, error) {
 	var response TransitionSubmissionResponse
 
 	url := fmt.Sprintf("/api/v1/submissions/%s/transition/", ID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Decode(&response).
@@ -464,9 +582,9 @@ func (c *Client) TransitionSubmission(ID string, payload TransitionSubmissionPay
 }
 
 // BulkApproveSubmissions will bulk approve multiple submissions.
-func (c *Client) BulkApproveSubmissions(payload BulkApproveSubmissionsPayload) error {
+func (c *Client) BulkApproveSubmissions(ctx context.Context, payload BulkApproveSubmissionsPayload) error {
 	url := "/api/v1/submissions/bulk-approve/"
-	_, err := c.ExecuteBuilder().PostRequest(url).Body(payload).Execute()
+	_, err := c.ExecuteBuilder(ctx).PostRequest(url).Body(payload).Execute()
 	if err != nil {
 		return err
 	}
@@ -475,7 +593,7 @@ func (c *Client) BulkApproveSubmissions(payload BulkApproveSubmissionsPayload) e
 }
 
 // TransitionStudy will move the study status to a desired state.
-func (c *Client) TransitionStudy(ID, action string) (*TransitionStudyResponse, error) {
+func (c *Client) TransitionStudy(ctx context.Context, ID, action string) (*TransitionStudyResponse, error) {
 	var response TransitionStudyResponse
 
 	transition := struct {
@@ -485,7 +603,7 @@ func (c *Client) TransitionStudy(ID, action string) (*TransitionStudyResponse, e
 	}
 
 	url := fmt.Sprintf("/api/v1/studies/%s/transition/", ID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(transition).
 		Decode(&response).
@@ -498,11 +616,11 @@ func (c *Client) TransitionStudy(ID, action string) (*TransitionStudyResponse, e
 }
 
 // GetCampaigns will return you a list of Campaign objects.
-func (c *Client) GetCampaigns(workspaceID string, limit, offset int) (*ListCampaignsResponse, error) {
+func (c *Client) GetCampaigns(ctx context.Context, workspaceID string, limit, offset int) (*ListCampaignsResponse, error) {
 	var response ListCampaignsResponse
 
 	url := fmt.Sprintf("/api/v1/campaigns/?workspace_id=%s&limit=%v&offset=%v", workspaceID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -511,11 +629,11 @@ func (c *Client) GetCampaigns(workspaceID string, limit, offset int) (*ListCampa
 }
 
 // GetCollections will return a list of Collection objects for a workspace.
-func (c *Client) GetCollections(workspaceID string, limit, offset int) (*ListCollectionsResponse, error) {
+func (c *Client) GetCollections(ctx context.Context, workspaceID string, limit, offset int) (*ListCollectionsResponse, error) {
 	var response ListCollectionsResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/collections?workspace_id=%s&limit=%v&offset=%v", workspaceID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -524,11 +642,11 @@ func (c *Client) GetCollections(workspaceID string, limit, offset int) (*ListCol
 }
 
 // GetCollection will return a single Collection by ID.
-func (c *Client) GetCollection(ID string) (*model.Collection, error) {
+func (c *Client) GetCollection(ctx context.Context, ID string) (*model.Collection, error) {
 	var response model.Collection
 
 	url := fmt.Sprintf("/api/v1/data-collection/collections/%s", ID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -539,11 +657,12 @@ func (c *Client) GetCollection(ID string) (*model.Collection, error) {
 // InitiateCollectionExport starts a collection export job via POST.
 // Returns "generating" + ExportID (202) if a new job was enqueued,
 // or "complete" + URL immediately (200) if a valid export already exists.
-func (c *Client) InitiateCollectionExport(collectionID string) (*CollectionExportResponse, error) {
+func (c *Client) InitiateCollectionExport(ctx context.Context, collectionID string) (*CollectionExportResponse, error) {
 	var response CollectionExportResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/collections/%s/export", collectionID)
-	_, err := c.ExecuteBuilder().PostRequest(url).Decode(&response).Execute()
+	// Starting an export is safe to repeat; see ExecuteBuilder.Retryable.
+	_, err := c.ExecuteBuilder(ctx).PostRequest(url).Retryable().Decode(&response).Execute()
 	if err != nil {
 		return nil, err
 	}
@@ -553,11 +672,11 @@ func (c *Client) InitiateCollectionExport(collectionID string) (*CollectionExpor
 
 // GetCollectionExportStatus polls the status of an in-progress export job.
 // Returns "generating", "complete" (with URL), or "failed".
-func (c *Client) GetCollectionExportStatus(collectionID, exportID string) (*CollectionExportResponse, error) {
+func (c *Client) GetCollectionExportStatus(ctx context.Context, collectionID, exportID string) (*CollectionExportResponse, error) {
 	var response CollectionExportResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/collections/%s/export/%s", collectionID, exportID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -566,11 +685,11 @@ func (c *Client) GetCollectionExportStatus(collectionID, exportID string) (*Coll
 }
 
 // UpdateStudy is responsible for updating the Study with a PATCH request.
-func (c *Client) UpdateStudy(ID string, study any) (*model.Study, error) {
+func (c *Client) UpdateStudy(ctx context.Context, ID string, study any) (*model.Study, error) {
 	var response model.Study
 
 	url := fmt.Sprintf("/api/v1/studies/%s/", ID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PatchRequest(url).
 		Body(study).
 		Status(http.StatusOK).
@@ -584,9 +703,9 @@ func (c *Client) UpdateStudy(ID string, study any) (*model.Study, error) {
 }
 
 // GetStudyCredentialsUsageReportCSV will return the credentials usage report for a study as CSV.
-func (c *Client) GetStudyCredentialsUsageReportCSV(ID string) (string, error) {
+func (c *Client) GetStudyCredentialsUsageReportCSV(ctx context.Context, ID string) (string, error) {
 	endpointURL := fmt.Sprintf("/api/v1/studies/%s/credentials/report/", ID)
-	httpResponse, err := c.ExecuteBuilder().GetRequest(endpointURL).Execute()
+	httpResponse, err := c.ExecuteBuilder(ctx).GetRequest(endpointURL).Execute()
 	if err != nil {
 		return "", err
 	}
@@ -600,9 +719,9 @@ func (c *Client) GetStudyCredentialsUsageReportCSV(ID string) (string, error) {
 }
 
 // ExportDemographics triggers a demographic data export for all submissions in a study.
-func (c *Client) ExportDemographics(ID string) (string, error) {
+func (c *Client) ExportDemographics(ctx context.Context, ID string) (string, error) {
 	url := fmt.Sprintf("/api/v1/studies/%s/demographic-export/", ID)
-	httpResponse, err := c.ExecuteBuilder().PostRequest(url).Execute()
+	httpResponse, err := c.ExecuteBuilder(ctx).PostRequest(url).Execute()
 	if err != nil {
 		return "", err
 	}
@@ -616,11 +735,11 @@ func (c *Client) ExportDemographics(ID string) (string, error) {
 }
 
 // TestStudy creates a test run of a study to validate configuration before going live.
-func (c *Client) TestStudy(ID string) (*TestStudyResponse, error) {
+func (c *Client) TestStudy(ctx context.Context, ID string) (*TestStudyResponse, error) {
 	var response TestStudyResponse
 
 	url := fmt.Sprintf("/api/v1/studies/%s/test-study/", ID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Status(http.StatusOK).
 		Decode(&response).
@@ -633,7 +752,7 @@ func (c *Client) TestStudy(ID string) (*TestStudyResponse, error) {
 }
 
 // GetHooks will return the subscriptions to event types for current user.
-func (c *Client) GetHooks(workspaceID string, enabled bool, limit, offset int) (*ListHooksResponse, error) {
+func (c *Client) GetHooks(ctx context.Context, workspaceID string, enabled bool, limit, offset int) (*ListHooksResponse, error) {
 	var response ListHooksResponse
 
 	url := fmt.Sprintf(
@@ -643,7 +762,7 @@ func (c *Client) GetHooks(workspaceID string, enabled bool, limit, offset int) (
 		limit,
 		offset,
 	)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -653,11 +772,11 @@ func (c *Client) GetHooks(workspaceID string, enabled bool, limit, offset int) (
 
 // GetHookEventTypes will return all of the event types you can subscribe a
 // hook for.
-func (c *Client) GetHookEventTypes() (*ListHookEventTypesResponse, error) {
+func (c *Client) GetHookEventTypes(ctx context.Context) (*ListHookEventTypesResponse, error) {
 	var response ListHookEventTypesResponse
 
 	url := "/api/v1/hooks/event-types/"
-	if _, err := c.ExecuteBuilder().Get(url, &response); err != nil {
+	if _, err := c.ExecuteBuilder(ctx).Get(url, &response); err != nil {
 		return nil, err
 	}
 
@@ -665,11 +784,11 @@ func (c *Client) GetHookEventTypes() (*ListHookEventTypesResponse, error) {
 }
 
 // GetHookSecrets will return the secrets for a Workspace
-func (c *Client) GetHookSecrets(workspaceID string) (*ListSecretsResponse, error) {
+func (c *Client) GetHookSecrets(ctx context.Context, workspaceID string) (*ListSecretsResponse, error) {
 	var response ListSecretsResponse
 
 	url := fmt.Sprintf("/api/v1/hooks/secrets/?workspace_id=%s", workspaceID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -678,11 +797,11 @@ func (c *Client) GetHookSecrets(workspaceID string) (*ListSecretsResponse, error
 }
 
 // CreateHookSecret will create (or replace) a secret for a Workspace.
-func (c *Client) CreateHookSecret(payload CreateSecretPayload) (*model.Secret, error) {
+func (c *Client) CreateHookSecret(ctx context.Context, payload CreateSecretPayload) (*model.Secret, error) {
 	var response model.Secret
 
 	const url = "/api/v1/hooks/secrets/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusCreated).
@@ -696,11 +815,11 @@ func (c *Client) CreateHookSecret(payload CreateSecretPayload) (*model.Secret, e
 }
 
 // GetEvents will return events created for a subscription
-func (c *Client) GetEvents(subscriptionID string, limit, offset int) (*ListHookEventsResponse, error) {
+func (c *Client) GetEvents(ctx context.Context, subscriptionID string, limit, offset int) (*ListHookEventsResponse, error) {
 	var response ListHookEventsResponse
 
 	url := fmt.Sprintf("/api/v1/hooks/subscriptions/%s/events/?limit=%v&offset=%v", subscriptionID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -710,11 +829,11 @@ func (c *Client) GetEvents(subscriptionID string, limit, offset int) (*ListHookE
 
 // CreateHookSubscription will create a new hook subscription for a workspace.
 // It returns the hook, the X-Hook-Secret header value needed to confirm the subscription, and any error.
-func (c *Client) CreateHookSubscription(payload CreateHookPayload) (*model.Hook, string, error) {
+func (c *Client) CreateHookSubscription(ctx context.Context, payload CreateHookPayload) (*model.Hook, string, error) {
 	var response model.Hook
 
 	url := "/api/v1/hooks/subscriptions/"
-	httpResponse, err := c.ExecuteBuilder().
+	httpResponse, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusCreated).
@@ -730,7 +849,7 @@ func (c *Client) CreateHookSubscription(payload CreateHookPayload) (*model.Hook,
 
 // ConfirmHookSubscription confirms a webhook subscription using the secret
 // returned in the X-Hook-Secret header when the subscription was created.
-func (c *Client) ConfirmHookSubscription(subscriptionID, secret string) (*model.Hook, error) {
+func (c *Client) ConfirmHookSubscription(ctx context.Context, subscriptionID, secret string) (*model.Hook, error) {
 	var response model.Hook
 
 	payload := struct {
@@ -740,7 +859,7 @@ func (c *Client) ConfirmHookSubscription(subscriptionID, secret string) (*model.
 	}
 
 	url := fmt.Sprintf("/api/v1/hooks/subscriptions/%s/", subscriptionID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusOK).
@@ -754,11 +873,11 @@ func (c *Client) ConfirmHookSubscription(subscriptionID, secret string) (*model.
 }
 
 // UpdateHookSubscription updates an existing webhook subscription.
-func (c *Client) UpdateHookSubscription(subscriptionID string, payload UpdateHookPayload) (*model.Hook, error) {
+func (c *Client) UpdateHookSubscription(ctx context.Context, subscriptionID string, payload UpdateHookPayload) (*model.Hook, error) {
 	var response model.Hook
 
 	url := fmt.Sprintf("/api/v1/hooks/subscriptions/%s/", subscriptionID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PatchRequest(url).
 		Body(payload).
 		Status(http.StatusOK).
@@ -772,9 +891,9 @@ func (c *Client) UpdateHookSubscription(subscriptionID string, payload UpdateHoo
 }
 
 // DeleteHookSubscription deletes a hook subscription by ID.
-func (c *Client) DeleteHookSubscription(subscriptionID string) error {
+func (c *Client) DeleteHookSubscription(ctx context.Context, subscriptionID string) error {
 	url := fmt.Sprintf("/api/v1/hooks/subscriptions/%s/", subscriptionID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		DeleteRequest(url).
 		Status(http.StatusNoContent).
 		Execute()
@@ -785,11 +904,11 @@ func (c *Client) DeleteHookSubscription(subscriptionID string) error {
 	return nil
 }
 
-func (c *Client) GetWorkspaces(limit, offset int) (*ListWorkspacesResponse, error) {
+func (c *Client) GetWorkspaces(ctx context.Context, limit, offset int) (*ListWorkspacesResponse, error) {
 	var response ListWorkspacesResponse
 
 	url := fmt.Sprintf("/api/v1/workspaces/?limit=%v&offset=%v", limit, offset)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -798,11 +917,11 @@ func (c *Client) GetWorkspaces(limit, offset int) (*ListWorkspacesResponse, erro
 }
 
 // GetWorkspaceBalance will return the balance for a workspace.
-func (c *Client) GetWorkspaceBalance(workspaceID string) (*WorkspaceBalanceResponse, error) {
+func (c *Client) GetWorkspaceBalance(ctx context.Context, workspaceID string) (*WorkspaceBalanceResponse, error) {
 	var response WorkspaceBalanceResponse
 
 	url := fmt.Sprintf("/api/v1/workspaces/%s/balance/", workspaceID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -811,11 +930,11 @@ func (c *Client) GetWorkspaceBalance(workspaceID string) (*WorkspaceBalanceRespo
 }
 
 // CreateWorkspace will create you a workspace
-func (c *Client) CreateWorkspace(workspace model.Workspace) (*CreateWorkspacesResponse, error) {
+func (c *Client) CreateWorkspace(ctx context.Context, workspace model.Workspace) (*CreateWorkspacesResponse, error) {
 	var response CreateWorkspacesResponse
 
 	url := "/api/v1/workspaces/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(workspace).
 		Status(http.StatusCreated).
@@ -829,11 +948,11 @@ func (c *Client) CreateWorkspace(workspace model.Workspace) (*CreateWorkspacesRe
 }
 
 // CreateInvitation will create invitations for the given email addresses
-func (c *Client) CreateInvitation(invitation model.CreateInvitation) (*CreateInvitationResponse, error) {
+func (c *Client) CreateInvitation(ctx context.Context, invitation model.CreateInvitation) (*CreateInvitationResponse, error) {
 	var response CreateInvitationResponse
 
 	url := "/api/v1/invitations/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(invitation).
 		Status(http.StatusCreated).
@@ -847,11 +966,11 @@ func (c *Client) CreateInvitation(invitation model.CreateInvitation) (*CreateInv
 }
 
 // GetProjects will return the projects for the given workspace ID
-func (c *Client) GetProjects(workspaceID string, limit, offset int) (*ListProjectsResponse, error) {
+func (c *Client) GetProjects(ctx context.Context, workspaceID string, limit, offset int) (*ListProjectsResponse, error) {
 	var response ListProjectsResponse
 
 	url := fmt.Sprintf("/api/v1/workspaces/%s/projects/?limit=%v&offset=%v", workspaceID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -860,11 +979,11 @@ func (c *Client) GetProjects(workspaceID string, limit, offset int) (*ListProjec
 }
 
 // GetProject will return the project for the given project ID
-func (c *Client) GetProject(ID string) (*model.Project, error) {
+func (c *Client) GetProject(ctx context.Context, ID string) (*model.Project, error) {
 	var response model.Project
 
 	url := fmt.Sprintf("/api/v1/projects/%s/", ID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -873,11 +992,11 @@ func (c *Client) GetProject(ID string) (*model.Project, error) {
 }
 
 // CreateProject will create you a project
-func (c *Client) CreateProject(workspaceID string, project model.Project) (*CreateProjectResponse, error) {
+func (c *Client) CreateProject(ctx context.Context, workspaceID string, project model.Project) (*CreateProjectResponse, error) {
 	var response CreateProjectResponse
 
 	url := fmt.Sprintf("/api/v1/workspaces/%s/projects/", workspaceID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(project).
 		Decode(&response).
@@ -890,11 +1009,11 @@ func (c *Client) CreateProject(workspaceID string, project model.Project) (*Crea
 }
 
 // GetParticipantGroups will return all the participant groups you have access to for a given WorkspaceID
-func (c *Client) GetParticipantGroups(workspaceID string, limit, offset int) (*ListParticipantGroupsResponse, error) {
+func (c *Client) GetParticipantGroups(ctx context.Context, workspaceID string, limit, offset int) (*ListParticipantGroupsResponse, error) {
 	var response ListParticipantGroupsResponse
 
 	url := fmt.Sprintf("/api/v1/participant-groups/?workspace_id=%s&limit=%v&offset=%v", workspaceID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -903,11 +1022,11 @@ func (c *Client) GetParticipantGroups(workspaceID string, limit, offset int) (*L
 }
 
 // GetParticipantGroup will return the membership in the group
-func (c *Client) GetParticipantGroup(groupID string) (*ViewParticipantGroupResponse, error) {
+func (c *Client) GetParticipantGroup(ctx context.Context, groupID string) (*ViewParticipantGroupResponse, error) {
 	var response ViewParticipantGroupResponse
 
 	url := fmt.Sprintf("/api/v1/participant-groups/%s/participants/", groupID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -916,11 +1035,11 @@ func (c *Client) GetParticipantGroup(groupID string) (*ViewParticipantGroupRespo
 }
 
 // CreateParticipantGroup will create a new participant group
-func (c *Client) CreateParticipantGroup(group model.CreateParticipantGroup) (*CreateParticipantGroupResponse, error) {
+func (c *Client) CreateParticipantGroup(ctx context.Context, group model.CreateParticipantGroup) (*CreateParticipantGroupResponse, error) {
 	var response CreateParticipantGroupResponse
 
 	url := "/api/v1/participant-groups/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(group).
 		Decode(&response).
@@ -932,14 +1051,14 @@ func (c *Client) CreateParticipantGroup(group model.CreateParticipantGroup) (*Cr
 	return &response, nil
 }
 
-func (c *Client) RemoveParticipantGroupMembers(groupID string, participantIDs []string) (*ViewParticipantGroupResponse, error) {
+func (c *Client) RemoveParticipantGroupMembers(ctx context.Context, groupID string, participantIDs []string) (*ViewParticipantGroupResponse, error) {
 	payload := RemoveParticipantGroupMembersPayload{
 		ParticipantIDs: participantIDs,
 	}
 	var response ViewParticipantGroupResponse
 
 	url := fmt.Sprintf("/api/v1/participant-groups/%s/participants/", groupID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		DeleteRequest(url).
 		Body(payload).
 		Status(http.StatusOK).
@@ -952,14 +1071,14 @@ func (c *Client) RemoveParticipantGroupMembers(groupID string, participantIDs []
 	return &response, nil
 }
 
-func (c *Client) AddParticipantGroupMembers(groupID string, participantIDs []string) (*ViewParticipantGroupResponse, error) {
+func (c *Client) AddParticipantGroupMembers(ctx context.Context, groupID string, participantIDs []string) (*ViewParticipantGroupResponse, error) {
 	payload := AddParticipantGroupMembersPayload{
 		ParticipantIDs: participantIDs,
 	}
 	var response ViewParticipantGroupResponse
 
 	url := fmt.Sprintf("/api/v1/participant-groups/%s/participants/", groupID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusOK).
@@ -973,7 +1092,7 @@ func (c *Client) AddParticipantGroupMembers(groupID string, participantIDs []str
 }
 
 // CreateTestParticipant creates a test participant for the researcher with the given email.
-func (c *Client) CreateTestParticipant(email string) (*CreateTestParticipantResponse, error) {
+func (c *Client) CreateTestParticipant(ctx context.Context, email string) (*CreateTestParticipantResponse, error) {
 	var response CreateTestParticipantResponse
 
 	payload := struct {
@@ -981,7 +1100,7 @@ func (c *Client) CreateTestParticipant(email string) (*CreateTestParticipantResp
 	}{Email: email}
 
 	url := "/api/v1/researchers/participants/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusCreated).
@@ -994,11 +1113,11 @@ func (c *Client) CreateTestParticipant(email string) (*CreateTestParticipantResp
 	return &response, nil
 }
 
-func (c *Client) GetFilters() (*ListFiltersResponse, error) {
+func (c *Client) GetFilters(ctx context.Context) (*ListFiltersResponse, error) {
 	var response ListFiltersResponse
 
 	url := "/api/v1/filters/"
-	if _, err := c.ExecuteBuilder().Get(url, &response); err != nil {
+	if _, err := c.ExecuteBuilder(ctx).Get(url, &response); err != nil {
 		return nil, err
 	}
 
@@ -1008,7 +1127,7 @@ func (c *Client) GetFilters() (*ListFiltersResponse, error) {
 // GetRewardRecommendations will return Prolific's recommended reward-per-hour
 // rates for a workspace and currency, optionally scoped to a set of screener
 // filter IDs.
-func (c *Client) GetRewardRecommendations(workspaceID, currency string, screenerIDs []string) (*RewardRecommendationsResponse, error) {
+func (c *Client) GetRewardRecommendations(ctx context.Context, workspaceID, currency string, screenerIDs []string) (*RewardRecommendationsResponse, error) {
 	var response RewardRecommendationsResponse
 
 	query := url.Values{}
@@ -1019,7 +1138,7 @@ func (c *Client) GetRewardRecommendations(workspaceID, currency string, screener
 	}
 
 	requestURL := "/api/v1/reward-recommendations/?" + query.Encode()
-	_, err := c.ExecuteBuilder().GetInto(requestURL, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(requestURL, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1028,11 +1147,11 @@ func (c *Client) GetRewardRecommendations(workspaceID, currency string, screener
 }
 
 // GetFilterSets will return the filter sets in a workspace
-func (c *Client) GetFilterSets(workspaceID string, limit, offset int) (*ListFilterSetsResponse, error) {
+func (c *Client) GetFilterSets(ctx context.Context, workspaceID string, limit, offset int) (*ListFilterSetsResponse, error) {
 	var response ListFilterSetsResponse
 
 	url := fmt.Sprintf("/api/v1/filter-sets/?workspace_id=%s&limit=%v&offset=%v", workspaceID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1041,11 +1160,11 @@ func (c *Client) GetFilterSets(workspaceID string, limit, offset int) (*ListFilt
 }
 
 // GetFilterSet will return the filter set for the given filter set ID
-func (c *Client) GetFilterSet(ID string) (*model.FilterSet, error) {
+func (c *Client) GetFilterSet(ctx context.Context, ID string) (*model.FilterSet, error) {
 	var response model.FilterSet
 
 	url := fmt.Sprintf("/api/v1/filter-sets/%s/", ID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1054,11 +1173,11 @@ func (c *Client) GetFilterSet(ID string) (*model.FilterSet, error) {
 }
 
 // CreateFilterSet will create a new filter set
-func (c *Client) CreateFilterSet(filterSet model.CreateFilterSet) (*CreateFilterSetResponse, error) {
+func (c *Client) CreateFilterSet(ctx context.Context, filterSet model.CreateFilterSet) (*CreateFilterSetResponse, error) {
 	var response CreateFilterSetResponse
 
 	url := "/api/v1/filter-sets/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(filterSet).
 		Decode(&response).
@@ -1071,11 +1190,11 @@ func (c *Client) CreateFilterSet(filterSet model.CreateFilterSet) (*CreateFilter
 }
 
 // GetSurveys will return the surveys for a researcher
-func (c *Client) GetSurveys(researcherID string, limit, offset int) (*ListSurveysResponse, error) {
+func (c *Client) GetSurveys(ctx context.Context, researcherID string, limit, offset int) (*ListSurveysResponse, error) {
 	var response ListSurveysResponse
 
 	url := fmt.Sprintf("/api/v1/surveys/?researcher_id=%s&limit=%v&offset=%v", researcherID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1084,11 +1203,11 @@ func (c *Client) GetSurveys(researcherID string, limit, offset int) (*ListSurvey
 }
 
 // GetSurvey will return the survey for the given survey ID
-func (c *Client) GetSurvey(ID string) (*model.Survey, error) {
+func (c *Client) GetSurvey(ctx context.Context, ID string) (*model.Survey, error) {
 	var response model.Survey
 
 	url := fmt.Sprintf("/api/v1/surveys/%s/", ID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1097,11 +1216,11 @@ func (c *Client) GetSurvey(ID string) (*model.Survey, error) {
 }
 
 // CreateSurvey will create a new survey
-func (c *Client) CreateSurvey(survey model.CreateSurvey) (*CreateSurveyResponse, error) {
+func (c *Client) CreateSurvey(ctx context.Context, survey model.CreateSurvey) (*CreateSurveyResponse, error) {
 	var response CreateSurveyResponse
 
 	url := "/api/v1/surveys/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(survey).
 		Decode(&response).
@@ -1114,9 +1233,9 @@ func (c *Client) CreateSurvey(survey model.CreateSurvey) (*CreateSurveyResponse,
 }
 
 // DeleteSurvey will delete the survey with the given ID
-func (c *Client) DeleteSurvey(ID string) error {
+func (c *Client) DeleteSurvey(ctx context.Context, ID string) error {
 	url := fmt.Sprintf("/api/v1/surveys/%s/", ID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		DeleteRequest(url).
 		Status(http.StatusNoContent).
 		Execute()
@@ -1128,11 +1247,11 @@ func (c *Client) DeleteSurvey(ID string) error {
 }
 
 // GetSurveyResponses will return all responses for a survey
-func (c *Client) GetSurveyResponses(surveyID string, limit, offset int) (*ListSurveyResponsesResponse, error) {
+func (c *Client) GetSurveyResponses(ctx context.Context, surveyID string, limit, offset int) (*ListSurveyResponsesResponse, error) {
 	var response ListSurveyResponsesResponse
 
 	url := fmt.Sprintf("/api/v1/surveys/%s/responses/?limit=%v&offset=%v", surveyID, limit, offset)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1141,11 +1260,11 @@ func (c *Client) GetSurveyResponses(surveyID string, limit, offset int) (*ListSu
 }
 
 // GetSurveyResponse will return a single survey response
-func (c *Client) GetSurveyResponse(surveyID, responseID string) (*model.SurveyResponse, error) {
+func (c *Client) GetSurveyResponse(ctx context.Context, surveyID, responseID string) (*model.SurveyResponse, error) {
 	var response model.SurveyResponse
 
 	url := fmt.Sprintf("/api/v1/surveys/%s/responses/%s", surveyID, responseID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1154,11 +1273,11 @@ func (c *Client) GetSurveyResponse(surveyID, responseID string) (*model.SurveyRe
 }
 
 // CreateSurveyResponse will create a new survey response
-func (c *Client) CreateSurveyResponse(surveyID string, surveyResponse model.CreateSurveyResponseRequest) (*CreateSurveyResponseResponse, error) {
+func (c *Client) CreateSurveyResponse(ctx context.Context, surveyID string, surveyResponse model.CreateSurveyResponseRequest) (*CreateSurveyResponseResponse, error) {
 	var response CreateSurveyResponseResponse
 
 	url := fmt.Sprintf("/api/v1/surveys/%s/responses/", surveyID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(surveyResponse).
 		Decode(&response).
@@ -1171,9 +1290,9 @@ func (c *Client) CreateSurveyResponse(surveyID string, surveyResponse model.Crea
 }
 
 // DeleteSurveyResponse will delete a single survey response
-func (c *Client) DeleteSurveyResponse(surveyID, responseID string) error {
+func (c *Client) DeleteSurveyResponse(ctx context.Context, surveyID, responseID string) error {
 	url := fmt.Sprintf("/api/v1/surveys/%s/responses/%s", surveyID, responseID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		DeleteRequest(url).
 		Status(http.StatusNoContent).
 		Execute()
@@ -1185,9 +1304,9 @@ func (c *Client) DeleteSurveyResponse(surveyID, responseID string) error {
 }
 
 // DeleteAllSurveyResponses will delete all responses for a survey
-func (c *Client) DeleteAllSurveyResponses(surveyID string) error {
+func (c *Client) DeleteAllSurveyResponses(ctx context.Context, surveyID string) error {
 	url := fmt.Sprintf("/api/v1/surveys/%s/responses/", surveyID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		DeleteRequest(url).
 		Status(http.StatusNoContent).
 		Execute()
@@ -1199,11 +1318,11 @@ func (c *Client) DeleteAllSurveyResponses(surveyID string) error {
 }
 
 // GetSurveyResponseSummary will return the response summary for a survey
-func (c *Client) GetSurveyResponseSummary(surveyID string) (*model.SurveySummary, error) {
+func (c *Client) GetSurveyResponseSummary(ctx context.Context, surveyID string) (*model.SurveySummary, error) {
 	var response model.SurveySummary
 
 	url := fmt.Sprintf("/api/v1/surveys/%s/responses/summary/", surveyID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1212,11 +1331,11 @@ func (c *Client) GetSurveyResponseSummary(surveyID string) (*model.SurveySummary
 }
 
 // UpdateCollection will update a collection with the given ID
-func (c *Client) UpdateCollection(ID string, collection model.UpdateCollection) (*model.Collection, error) {
+func (c *Client) UpdateCollection(ctx context.Context, ID string, collection model.UpdateCollection) (*model.Collection, error) {
 	var response model.Collection
 
 	url := fmt.Sprintf("/api/v1/data-collection/collections/%s/", ID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PutRequest(url).
 		Body(collection).
 		Decode(&response).
@@ -1229,7 +1348,7 @@ func (c *Client) UpdateCollection(ID string, collection model.UpdateCollection)
 }
 
 // GetMessages will return the messages for the authenticated user
-func (c *Client) GetMessages(userID *string, createdAfter *string) (*ListMessagesResponse, error) {
+func (c *Client) GetMessages(ctx context.Context, userID *string, createdAfter *string) (*ListMessagesResponse, error) {
 	var response ListMessagesResponse
 
 	if userID == nil && createdAfter == nil {
@@ -1249,7 +1368,7 @@ func (c *Client) GetMessages(userID *string, createdAfter *string) (*ListMessage
 
 	url := baseURL + "?" + params.Encode()
 
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1258,7 +1377,7 @@ func (c *Client) GetMessages(userID *string, createdAfter *string) (*ListMessage
 }
 
 // SendMessage will send a message
-func (c *Client) SendMessage(body string, recipientID string, studyID string) error {
+func (c *Client) SendMessage(ctx context.Context, body string, recipientID string, studyID string) error {
 	payload := SendMessagePayload{
 		RecipientID: recipientID,
 		StudyID:     studyID,
@@ -1266,7 +1385,7 @@ func (c *Client) SendMessage(body string, recipientID string, studyID string) er
 	}
 
 	url := "/api/v1/messages/"
-	_, err := c.ExecuteBuilder().PostRequest(url).Body(payload).Execute()
+	_, err := c.ExecuteBuilder(ctx).PostRequest(url).Body(payload).Execute()
 	if err != nil {
 		return err
 	}
@@ -1275,12 +1394,12 @@ func (c *Client) SendMessage(body string, recipientID string, studyID string) er
 }
 
 // GetUnreadMessages will return the unread messages for the authenticated user
-func (c *Client) GetUnreadMessages() (*ListUnreadMessagesResponse, error) {
+func (c *Client) GetUnreadMessages(ctx context.Context) (*ListUnreadMessagesResponse, error) {
 	var response ListUnreadMessagesResponse
 
 	url := "/api/v1/messages/unread/"
 
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1289,7 +1408,7 @@ func (c *Client) GetUnreadMessages() (*ListUnreadMessagesResponse, error) {
 }
 
 // BulkSendMessage will send a message to multiple participants
-func (c *Client) BulkSendMessage(ids []string, body, studyID string) error {
+func (c *Client) BulkSendMessage(ctx context.Context, ids []string, body, studyID string) error {
 	payload := BulkSendMessagePayload{
 		IDs:     ids,
 		Body:    body,
@@ -1297,7 +1416,7 @@ func (c *Client) BulkSendMessage(ids []string, body, studyID string) error {
 	}
 
 	url := "/api/v1/messages/bulk/"
-	_, err := c.ExecuteBuilder().PostRequest(url).Body(payload).Execute()
+	_, err := c.ExecuteBuilder(ctx).PostRequest(url).Body(payload).Execute()
 	if err != nil {
 		return err
 	}
@@ -1306,7 +1425,7 @@ func (c *Client) BulkSendMessage(ids []string, body, studyID string) error {
 }
 
 // SendGroupMessage will send a message to a participant group
-func (c *Client) SendGroupMessage(participantGroupID, body string, studyID *string) error {
+func (c *Client) SendGroupMessage(ctx context.Context, participantGroupID, body string, studyID *string) error {
 	payload := SendGroupMessagePayload{
 		ParticipantGroupID: participantGroupID,
 		Body:               body,
@@ -1317,7 +1436,7 @@ func (c *Client) SendGroupMessage(participantGroupID, body string, studyID *stri
 	}
 
 	url := "/api/v1/messages/participant-group/"
-	_, err := c.ExecuteBuilder().PostRequest(url).Body(payload).Execute()
+	_, err := c.ExecuteBuilder(ctx).PostRequest(url).Body(payload).Execute()
 	if err != nil {
 		return err
 	}
@@ -1326,11 +1445,11 @@ func (c *Client) SendGroupMessage(participantGroupID, body string, studyID *stri
 }
 
 // CreateBonusPayments creates bonus payment records for participants in a study.
-func (c *Client) CreateBonusPayments(payload CreateBonusPaymentsPayload) (*CreateBonusPaymentsResponse, error) {
+func (c *Client) CreateBonusPayments(ctx context.Context, payload CreateBonusPaymentsPayload) (*CreateBonusPaymentsResponse, error) {
 	var response CreateBonusPaymentsResponse
 
 	url := "/api/v1/submissions/bonus-payments/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusCreated).
@@ -1344,9 +1463,9 @@ func (c *Client) CreateBonusPayments(payload CreateBonusPaymentsPayload) (*Creat
 }
 
 // PayBonusPayments triggers asynchronous payment of previously created bonus records.
-func (c *Client) PayBonusPayments(id string) error {
+func (c *Client) PayBonusPayments(ctx context.Context, id string) error {
 	url := fmt.Sprintf("/api/v1/bulk-bonus-payments/%s/pay/", id)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Status(http.StatusAccepted).
 		Execute()
@@ -1358,7 +1477,7 @@ func (c *Client) PayBonusPayments(id string) error {
 }
 
 // UpdateAITaskBuilderBatch will update an AI Task Builder batch.
-func (c *Client) UpdateAITaskBuilderBatch(params UpdateBatchParams) (*UpdateAITaskBuilderBatchResponse, error) {
+func (c *Client) UpdateAITaskBuilderBatch(ctx context.Context, params UpdateBatchParams) (*UpdateAITaskBuilderBatchResponse, error) {
 	var response UpdateAITaskBuilderBatchResponse
 
 	payload := UpdateAITaskBuilderBatchPayload{
@@ -1370,15 +1489,15 @@ func (c *Client) UpdateAITaskBuilderBatch(params UpdateBatchParams) (*UpdateAITa
 	}
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s", params.BatchID)
-	httpResponse, err := c.ExecuteBuilder().
+	httpResponse, err := c.ExecuteBuilder(ctx).
 		PatchRequest(url).
 		Body(payload).
 		Decode(&response).
 		Execute()
 	if err != nil {
-		var apiErr *UnrecognizedAPIError
-		if errors.As(err, &apiErr) {
-			return nil, fmt.Errorf("unable to update batch: %s", formatBatchErrorBody(apiErr.Body))
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.unrecognized != nil {
+			return nil, apiErr.withMessage(fmt.Sprintf("unable to update batch: %s", formatBatchErrorBody(apiErr.Body)))
 		}
 		return nil, err
 	}
@@ -1423,11 +1542,11 @@ func formatBatchErrorBody(body []byte) string {
 }
 
 // GetAITaskBuilderBatch will return details of an AI Task Builder batch.
-func (c *Client) GetAITaskBuilderBatch(batchID string) (*GetAITaskBuilderBatchResponse, error) {
+func (c *Client) GetAITaskBuilderBatch(ctx context.Context, batchID string) (*GetAITaskBuilderBatchResponse, error) {
 	var response GetAITaskBuilderBatchResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s", batchID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1436,23 +1555,27 @@ func (c *Client) GetAITaskBuilderBatch(batchID string) (*GetAITaskBuilderBatchRe
 }
 
 // GetAITaskBuilderBatchStatus will return the status of an AI Task Builder batch.
-func (c *Client) GetAITaskBuilderBatchStatus(batchID string) (*GetAITaskBuilderBatchStatusResponse, error) {
+func (c *Client) GetAITaskBuilderBatchStatus(ctx context.Context, batchID string) (*GetAITaskBuilderBatchStatusResponse, error) {
 	var response GetAITaskBuilderBatchStatusResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/status", batchID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
 	return &response, nil
 }
 
-// GetAITaskBuilderBatches will return the batches for a given workspace.
-func (c *Client) GetAITaskBuilderBatches(workspaceID string) (*GetAITaskBuilderBatchesResponse, error) {
+// GetAITaskBuilderBatches will return the batches for a given workspace. A
+// limit of 0 sends no paging parameters, as for GetStudies.
+func (c *Client) GetAITaskBuilderBatches(ctx context.Context, workspaceID string, limit, offset int) (*GetAITaskBuilderBatchesResponse, error) {
 	var response GetAITaskBuilderBatchesResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/?workspace_id=%s", workspaceID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	if paging := pageQuery(limit, offset); paging != "" {
+		url += "&" + paging
+	}
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1460,11 +1583,11 @@ func (c *Client) GetAITaskBuilderBatches(workspaceID string) (*GetAITaskBuilderB
 }
 
 // GetAITaskBuilderResponses will return the responses for an AI Task Builder batch.
-func (c *Client) GetAITaskBuilderResponses(batchID string) (*GetAITaskBuilderResponsesResponse, error) {
+func (c *Client) GetAITaskBuilderResponses(ctx context.Context, batchID string) (*GetAITaskBuilderResponsesResponse, error) {
 	var response GetAITaskBuilderResponsesResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/responses", batchID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1472,11 +1595,11 @@ func (c *Client) GetAITaskBuilderResponses(batchID string) (*GetAITaskBuilderRes
 }
 
 // GetAITaskBuilderTasks will return the tasks for an AI Task Builder batch.
-func (c *Client) GetAITaskBuilderTasks(batchID string) (*GetAITaskBuilderTasksResponse, error) {
+func (c *Client) GetAITaskBuilderTasks(ctx context.Context, batchID string) (*GetAITaskBuilderTasksResponse, error) {
 	var response GetAITaskBuilderTasksResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/tasks", batchID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1484,11 +1607,11 @@ func (c *Client) GetAITaskBuilderTasks(batchID string) (*GetAITaskBuilderTasksRe
 }
 
 // GetAITaskBuilderTaskGroups will return the task group IDs for an AI Task Builder batch.
-func (c *Client) GetAITaskBuilderTaskGroups(batchID string) (*GetAITaskBuilderTaskGroupsResponse, error) {
+func (c *Client) GetAITaskBuilderTaskGroups(ctx context.Context, batchID string) (*GetAITaskBuilderTaskGroupsResponse, error) {
 	var response GetAITaskBuilderTaskGroupsResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/task-groups", batchID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1498,12 +1621,14 @@ func (c *Client) GetAITaskBuilderTaskGroups(batchID string) (*GetAITaskBuilderTa
 // InitiateBatchExport starts a batch export job via POST.
 // Returns "generating" + ExportID (202) if a new job was enqueued,
 // or "complete" + URL immediately (200) if a valid export already exists.
-func (c *Client) InitiateBatchExport(batchID string) (*BatchExportResponse, error) {
+func (c *Client) InitiateBatchExport(ctx context.Context, batchID string) (*BatchExportResponse, error) {
 	var response BatchExportResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/export", batchID)
-	_, err := c.ExecuteBuilder().
+	// Starting an export is safe to repeat; see ExecuteBuilder.Retryable.
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
+		Retryable().
 		Status(http.StatusOK, http.StatusAccepted).
 		Decode(&response).
 		Execute()
@@ -1516,11 +1641,11 @@ func (c *Client) InitiateBatchExport(batchID string) (*BatchExportResponse, erro
 
 // GetBatchExportStatus polls the status of an in-progress batch export job.
 // Returns "generating", "complete" (with URL), or "failed".
-func (c *Client) GetBatchExportStatus(batchID, exportID string) (*BatchExportResponse, error) {
+func (c *Client) GetBatchExportStatus(ctx context.Context, batchID, exportID string) (*BatchExportResponse, error) {
 	var response BatchExportResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/export/%s", batchID, exportID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1531,11 +1656,11 @@ func (c *Client) GetBatchExportStatus(batchID, exportID string) (*BatchExportRes
 // SyncAITaskBuilderBatch starts an async sync job that extends a batch with tasks
 // created from datapoints appended to its dataset since setup or the last sync.
 // Returns the created job (status "queued") including its sync_id.
-func (c *Client) SyncAITaskBuilderBatch(batchID string) (*AITaskBuilderBatchSyncResponse, error) {
+func (c *Client) SyncAITaskBuilderBatch(ctx context.Context, batchID string) (*AITaskBuilderBatchSyncResponse, error) {
 	var response AITaskBuilderBatchSyncResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/sync", batchID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Status(http.StatusOK, http.StatusAccepted).
 		Decode(&response).
@@ -1550,11 +1675,11 @@ func (c *Client) SyncAITaskBuilderBatch(batchID string) (*AITaskBuilderBatchSync
 // GetAITaskBuilderBatchSyncStatus polls the status of an in-progress batch sync
 // job. Returns "queued", "processing", "complete" (with outcome counts), or
 // "failed" (with a reason).
-func (c *Client) GetAITaskBuilderBatchSyncStatus(batchID, syncID string) (*AITaskBuilderBatchSyncResponse, error) {
+func (c *Client) GetAITaskBuilderBatchSyncStatus(ctx context.Context, batchID, syncID string) (*AITaskBuilderBatchSyncResponse, error) {
 	var response AITaskBuilderBatchSyncResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/syncs/%s", batchID, syncID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1563,11 +1688,11 @@ func (c *Client) GetAITaskBuilderBatchSyncStatus(batchID, syncID string) (*AITas
 }
 
 // GetAITaskBuilderDataset will return an AI Task Builder dataset by ID.
-func (c *Client) GetAITaskBuilderDataset(datasetID string) (*GetAITaskBuilderDatasetResponse, error) {
+func (c *Client) GetAITaskBuilderDataset(ctx context.Context, datasetID string) (*GetAITaskBuilderDatasetResponse, error) {
 	var response GetAITaskBuilderDatasetResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/datasets/%s", datasetID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1575,11 +1700,11 @@ func (c *Client) GetAITaskBuilderDataset(datasetID string) (*GetAITaskBuilderDat
 }
 
 // GetAITaskBuilderDatasetStatus will return the status of an AI Task Builder dataset.
-func (c *Client) GetAITaskBuilderDatasetStatus(datasetID string) (*GetAITaskBuilderDatasetStatusResponse, error) {
+func (c *Client) GetAITaskBuilderDatasetStatus(ctx context.Context, datasetID string) (*GetAITaskBuilderDatasetStatusResponse, error) {
 	var response GetAITaskBuilderDatasetStatusResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/datasets/%s/status", datasetID)
-	_, err := c.ExecuteBuilder().GetInto(url, &response)
+	_, err := c.ExecuteBuilder(ctx).GetInto(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1587,11 +1712,11 @@ func (c *Client) GetAITaskBuilderDatasetStatus(datasetID string) (*GetAITaskBuil
 }
 
 // GetAITaskBuilderDatasetUploadURL will get an upload URL for an AI Task Builder dataset.
-func (c *Client) GetAITaskBuilderDatasetUploadURL(datasetID, fileName string) (*GetAITaskBuilderDatasetUploadURLResponse, error) {
+func (c *Client) GetAITaskBuilderDatasetUploadURL(ctx context.Context, datasetID, fileName string) (*GetAITaskBuilderDatasetUploadURLResponse, error) {
 	var response GetAITaskBuilderDatasetUploadURLResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/datasets/%s/upload-url/%s", datasetID, fileName)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		GetRequest(url).
 		Status(http.StatusCreated).
 		Decode(&response).
@@ -1604,11 +1729,11 @@ func (c *Client) GetAITaskBuilderDatasetUploadURL(datasetID, fileName string) (*
 }
 
 // GetAITaskBuilderDatasetImportStatus returns the status of a dataset import job.
-func (c *Client) GetAITaskBuilderDatasetImportStatus(datasetID, importID string) (*GetAITaskBuilderDatasetImportStatusResponse, error) {
+func (c *Client) GetAITaskBuilderDatasetImportStatus(ctx context.Context, datasetID, importID string) (*GetAITaskBuilderDatasetImportStatusResponse, error) {
 	var response GetAITaskBuilderDatasetImportStatusResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/datasets/%s/imports/%s", datasetID, importID)
-	_, err := c.ExecuteBuilder().Get(url, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(url, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -1617,7 +1742,7 @@ func (c *Client) GetAITaskBuilderDatasetImportStatus(datasetID, importID string)
 }
 
 // CreateAITaskBuilderBatch will create an AI Task Builder batch.
-func (c *Client) CreateAITaskBuilderBatch(params CreateBatchParams) (*CreateAITaskBuilderBatchResponse, error) {
+func (c *Client) CreateAITaskBuilderBatch(ctx context.Context, params CreateBatchParams) (*CreateAITaskBuilderBatchResponse, error) {
 	var response CreateAITaskBuilderBatchResponse
 
 	payload := CreateAITaskBuilderBatchPayload{
@@ -1634,15 +1759,15 @@ func (c *Client) CreateAITaskBuilderBatch(params CreateBatchParams) (*CreateAITa
 	}
 
 	url := "/api/v1/data-collection/batches"
-	httpResponse, err := c.ExecuteBuilder().
+	httpResponse, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Decode(&response).
 		Execute()
 	if err != nil {
-		var apiErr *UnrecognizedAPIError
-		if errors.As(err, &apiErr) {
-			return nil, fmt.Errorf("unable to create batch: %s", formatBatchErrorBody(apiErr.Body))
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.unrecognized != nil {
+			return nil, apiErr.withMessage(fmt.Sprintf("unable to create batch: %s", formatBatchErrorBody(apiErr.Body)))
 		}
 		return nil, err
 	}
@@ -1656,11 +1781,11 @@ func (c *Client) CreateAITaskBuilderBatch(params CreateBatchParams) (*CreateAITa
 }
 
 // CreateAITaskBuilderInstructions will create instructions for an AI Task Builder batch.
-func (c *Client) CreateAITaskBuilderInstructions(batchID string, instructions CreateAITaskBuilderInstructionsPayload) (*CreateAITaskBuilderInstructionsResponse, error) {
+func (c *Client) CreateAITaskBuilderInstructions(ctx context.Context, batchID string, instructions CreateAITaskBuilderInstructionsPayload) (*CreateAITaskBuilderInstructionsResponse, error) {
 	var response CreateAITaskBuilderInstructionsResponse
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/instructions", batchID)
-	httpResponse, err := c.ExecuteBuilder().
+	httpResponse, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(instructions).
 		Status(http.StatusCreated).
@@ -1683,7 +1808,7 @@ func (c *Client) CreateAITaskBuilderInstructions(batchID string, instructions Cr
 }
 
 // SetupAITaskBuilderBatch will setup an AI Task Builder batch.
-func (c *Client) SetupAITaskBuilderBatch(batchID, datasetID string, tasksPerGroup int) (*SetupAITaskBuilderBatchResponse, error) {
+func (c *Client) SetupAITaskBuilderBatch(ctx context.Context, batchID, datasetID string, tasksPerGroup int) (*SetupAITaskBuilderBatchResponse, error) {
 	var response SetupAITaskBuilderBatchResponse
 
 	payload := SetupAITaskBuilderBatchPayload{
@@ -1692,7 +1817,7 @@ func (c *Client) SetupAITaskBuilderBatch(batchID, datasetID string, tasksPerGrou
 	}
 
 	url := fmt.Sprintf("/api/v1/data-collection/batches/%s/setup", batchID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusAccepted).
@@ -1706,14 +1831,14 @@ func (c *Client) SetupAITaskBuilderBatch(batchID, datasetID string, tasksPerGrou
 
 // CreateAITaskBuilderDataset will create a new AI Task Builder dataset.
 // The workspaceID parameter specifies which workspace the dataset belongs to.
-func (c *Client) CreateAITaskBuilderDataset(workspaceID string, payload CreateAITaskBuilderDatasetPayload) (*CreateAITaskBuilderDatasetResponse, error) {
+func (c *Client) CreateAITaskBuilderDataset(ctx context.Context, workspaceID string, payload CreateAITaskBuilderDatasetPayload) (*CreateAITaskBuilderDatasetResponse, error) {
 	var response CreateAITaskBuilderDatasetResponse
 
 	// Ensure workspace_id in payload matches the parameter
 	payload.WorkspaceID = workspaceID
 
 	url := "/api/v1/data-collection/datasets"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusCreated).
@@ -1727,11 +1852,11 @@ func (c *Client) CreateAITaskBuilderDataset(workspaceID string, payload CreateAI
 }
 
 // CreateAITaskBuilderCollection creates a new AI Task Builder collection.
-func (c *Client) CreateAITaskBuilderCollection(payload model.CreateAITaskBuilderCollection) (*CreateAITaskBuilderCollectionResponse, error) {
+func (c *Client) CreateAITaskBuilderCollection(ctx context.Context, payload model.CreateAITaskBuilderCollection) (*CreateAITaskBuilderCollectionResponse, error) {
 	var response CreateAITaskBuilderCollectionResponse
 
 	url := "/api/v1/data-collection/collections"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(url).
 		Body(payload).
 		Status(http.StatusCreated).
@@ -1746,7 +1871,7 @@ func (c *Client) CreateAITaskBuilderCollection(payload model.CreateAITaskBuilder
 
 // CreateCredentialPool creates a new credential pool with the provided credentials.
 // credentials should be a comma-separated string with newlines between entries.
-func (c *Client) CreateCredentialPool(credentials string, workspaceID string) (*CredentialPoolResponse, error) {
+func (c *Client) CreateCredentialPool(ctx context.Context, credentials string, workspaceID string) (*CredentialPoolResponse, error) {
 	var response CredentialPoolResponse
 
 	payload := CredentialPoolPayload{
@@ -1755,7 +1880,7 @@ func (c *Client) CreateCredentialPool(credentials string, workspaceID string) (*
 	}
 
 	endpointURL := "/api/v1/credentials/"
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PostRequest(endpointURL).
 		Body(payload).
 		Status(http.StatusCreated).
@@ -1770,7 +1895,7 @@ func (c *Client) CreateCredentialPool(credentials string, workspaceID string) (*
 
 // UpdateCredentialPool updates an existing credential pool with new credentials.
 // credentials should be a comma-separated string with newlines between entries.
-func (c *Client) UpdateCredentialPool(credentialPoolID string, credentials string) (*CredentialPoolResponse, error) {
+func (c *Client) UpdateCredentialPool(ctx context.Context, credentialPoolID string, credentials string) (*CredentialPoolResponse, error) {
 	var response CredentialPoolResponse
 
 	payload := UpdateCredentialPoolPayload{
@@ -1778,7 +1903,7 @@ func (c *Client) UpdateCredentialPool(credentialPoolID string, credentials strin
 	}
 
 	endpointURL := fmt.Sprintf("/api/v1/credentials/%s/", credentialPoolID)
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(ctx).
 		PatchRequest(endpointURL).
 		Body(payload).
 		Status(http.StatusOK).
@@ -1792,11 +1917,11 @@ func (c *Client) UpdateCredentialPool(credentialPoolID string, credentials strin
 }
 
 // ListCredentialPools retrieves a list of credential pools for a specific workspace.
-func (c *Client) ListCredentialPools(workspaceID string) (*ListCredentialPoolsResponse, error) {
+func (c *Client) ListCredentialPools(ctx context.Context, workspaceID string) (*ListCredentialPoolsResponse, error) {
 	var response ListCredentialPoolsResponse
 
 	endpointURL := fmt.Sprintf("/api/v1/credentials/?workspace_id=%s", workspaceID)
-	_, err := c.ExecuteBuilder().Get(endpointURL, &response)
+	_, err := c.ExecuteBuilder(ctx).Get(endpointURL, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -2,15 +2,18 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/prolific-oss/cli/model"
 	"github.com/prolific-oss/cli/version"
 )
 
@@ -128,7 +131,7 @@ func TestExecuteTruncatesOversizedErrorDetail(t *testing.T) {
 
 			c := Client{Client: server.Client(), BaseURL: server.URL, Token: "test-token"}
 
-			_, err := c.Execute(http.MethodGet, "/", nil, nil)
+			_, err := c.Execute(context.Background(), http.MethodGet, "/", nil, nil)
 			if err == nil {
 				t.Fatalf("expected an error, got nil")
 			}
@@ -191,7 +194,7 @@ func TestUnrecognizedAPIErrorBodyRemainsIntactAfterTruncation(t *testing.T) {
 
 	c := Client{Client: server.Client(), BaseURL: server.URL, Token: "test-token"}
 
-	_, execErr := c.Execute(http.MethodGet, "/", nil, nil)
+	_, execErr := c.Execute(context.Background(), http.MethodGet, "/", nil, nil)
 	if execErr == nil {
 		t.Fatalf("expected an error, got nil")
 	}
@@ -270,7 +273,7 @@ func TestUpdateAITaskBuilderBatch(t *testing.T) {
 				Token:   "test-token",
 			}
 
-			resp, err := c.UpdateAITaskBuilderBatch(UpdateBatchParams{BatchID: "batch-1", Name: "updated"})
+			resp, err := c.UpdateAITaskBuilderBatch(context.Background(), UpdateBatchParams{BatchID: "batch-1", Name: "updated"})
 
 			if tt.wantOK {
 				if err != nil {
@@ -343,7 +346,7 @@ func TestCreateAITaskBuilderBatch(t *testing.T) {
 				Token:   "test-token",
 			}
 
-			resp, err := c.CreateAITaskBuilderBatch(CreateBatchParams{
+			resp, err := c.CreateAITaskBuilderBatch(context.Background(), CreateBatchParams{
 				Name: "batch", WorkspaceID: "ws-1", DatasetID: "ds-1",
 				TaskName: "task", TaskIntroduction: "intro", TaskSteps: "steps",
 			})
@@ -392,7 +395,7 @@ func TestGetParticipantGroupsSendsWorkspaceIDQueryParam(t *testing.T) {
 		Token:   "test-token",
 	}
 
-	_, err := c.GetParticipantGroups("ws-id", 10, 0)
+	_, err := c.GetParticipantGroups(context.Background(), "ws-id", 10, 0)
 	if err != nil {
 		t.Fatalf("GetParticipantGroups returned error: %v", err)
 	}
@@ -417,6 +420,37 @@ func TestGetParticipantGroupsSendsWorkspaceIDQueryParam(t *testing.T) {
 	}
 }
 
+func TestGetStudiesOnlySendsPagingWhenLimited(t *testing.T) {
+	var gotQueries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQueries = append(gotQueries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(ListStudiesResponse{}); err != nil {
+			t.Logf("failed to encode response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	c := Client{
+		Client:  server.Client(),
+		BaseURL: server.URL,
+		Token:   "test-token",
+	}
+
+	if _, err := c.GetStudies(context.Background(), model.StatusAll, "", 0, 0); err != nil {
+		t.Fatalf("GetStudies returned error: %v", err)
+	}
+	if _, err := c.GetStudies(context.Background(), model.StatusActive, "", 10, 20); err != nil {
+		t.Fatalf("GetStudies returned error: %v", err)
+	}
+
+	expected := []string{"", "active=1&limit=10&offset=20"}
+	if !reflect.DeepEqual(gotQueries, expected) {
+		t.Errorf("queries = %q, want %q", gotQueries, expected)
+	}
+}
+
 func TestComposeUserAgent(t *testing.T) {
 	knownVars := []string{"CLAUDECODE", "ANTIGRAVITY_AGENT", "AI_AGENT", "LLM_AGENT"}
 
@@ -485,7 +519,7 @@ func TestExecuteSetsSkillInUserAgent(t *testing.T) {
 		Skill:   "cli-command-create",
 	}
 
-	if _, err := c.Execute(http.MethodGet, "/studies", nil, nil); err != nil {
+	if _, err := c.Execute(context.Background(), http.MethodGet, "/studies", nil, nil); err != nil {
 		t.Fatalf("Execute returned error: %v", err)
 	}
 
@@ -514,7 +548,7 @@ func TestExecuteSetsAgentInUserAgent(t *testing.T) {
 		Token:   "test-token",
 	}
 
-	if _, err := c.Execute(http.MethodGet, "/studies", nil, nil); err != nil {
+	if _, err := c.Execute(context.Background(), http.MethodGet, "/studies", nil, nil); err != nil {
 		t.Fatalf("Execute returned error: %v", err)
 	}
 
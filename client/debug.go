@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+)
+
+// debugOutput is where PROLIFIC_DEBUG output goes, stderr unless overridden,
+// so it never mixes with a command's --json or --csv output.
+func (c *Client) debugOutput() io.Writer {
+	if c.DebugOutput != nil {
+		return c.DebugOutput
+	}
+	return os.Stderr
+}
+
+func (c *Client) debugf(format string, args ...any) {
+	if !c.Debug {
+		return
+	}
+	fmt.Fprintf(c.debugOutput(), format, args...)
+}
+
+// debugExchange prints a request and its response with secrets redacted.
+// response is nil if the request failed before one arrived.
+func (c *Client) debugExchange(request *http.Request, payload []byte, response *http.Response, body []byte, elapsed time.Duration, err error) {
+	if !c.Debug {
+		return
+	}
+
+	w := c.debugOutput()
+	fmt.Fprintf(w, "> %s %s\n", request.Method, request.URL)
+	writeDebugHeaders(w, "> ", request.Header)
+	if len(payload) > 0 {
+		fmt.Fprintf(w, "%s\n", redactBody(payload))
+	}
+
+	if err != nil {
+		fmt.Fprintf(w, "< failed after %s: %s\n\n", elapsed.Round(time.Millisecond), err)
+		return
+	}
+
+	fmt.Fprintf(w, "< %s %s in %s\n", response.Proto, response.Status, elapsed.Round(time.Millisecond))
+	writeDebugHeaders(w, "< ", response.Header)
+	if len(body) > 0 {
+		fmt.Fprintf(w, "%s\n", redactBody(body))
+	}
+	fmt.Fprintln(w)
+}
+
+func writeDebugHeaders(w io.Writer, prefix string, h http.Header) {
+	h = redactHeaders(h)
+
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range h[name] {
+			fmt.Fprintf(w, "%s%s: %s\n", prefix, name, value)
+		}
+	}
+}
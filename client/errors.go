@@ -0,0 +1,151 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrTokenNotSet is returned by Execute when no API token is configured.
+var ErrTokenNotSet = errors.New("PROLIFIC_TOKEN not set")
+
+// APIError is returned by Execute whenever the API responds with a 4xx or 5xx
+// status. Commands can inspect it with errors.As rather than matching on the
+// message text.
+type APIError struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// ErrorCode is the API's own error code, when the body carries one.
+	ErrorCode int
+	// Title is the API's short summary of the error, when provided.
+	Title string
+	// Detail is the human readable explanation of the error.
+	Detail string
+	// FieldErrors holds validation messages keyed by the offending field.
+	FieldErrors map[string][]string
+	// RequestID identifies the request in the API's logs, for support.
+	RequestID string
+	// Body is the raw response body, untruncated.
+	Body []byte
+
+	message      string
+	unrecognized *UnrecognizedAPIError
+}
+
+func (e *APIError) Error() string {
+	if e.message == "" {
+		return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, truncateErrorDetail(e.Detail))
+	}
+	return e.message
+}
+
+// Unwrap exposes the UnrecognizedAPIError for bodies that matched no known
+// error format, so callers that inspect the raw body keep working.
+func (e *APIError) Unwrap() error {
+	if e.unrecognized == nil {
+		return nil
+	}
+	return e.unrecognized
+}
+
+// IsFeatureNotEnabled reports whether the API refused the request because the
+// account does not have access to the feature yet. Gated features answer with
+// a 403 or 404, so the status decides first and the error's title second; the
+// wording of the detail is only consulted when the title says nothing.
+func (e *APIError) IsFeatureNotEnabled() bool {
+	if e.StatusCode != http.StatusForbidden && e.StatusCode != http.StatusNotFound {
+		return false
+	}
+
+	if title := normaliseTitle(e.Title); title != "" && title != "forbidden" && title != "notfound" {
+		return title == "featurenotenabled"
+	}
+
+	detail := strings.ToLower(e.Detail)
+	return strings.Contains(detail, "permission") && strings.Contains(detail, "feature")
+}
+
+// normaliseTitle lowercases an error title and drops everything but letters,
+// so "Feature not enabled", "FEATURE_NOT_ENABLED" and "FeatureNotEnabled"
+// compare equal.
+func normaliseTitle(title string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r
+		}
+		if r >= 'A' && r <= 'Z' {
+			return r + ('a' - 'A')
+		}
+		return -1
+	}, title)
+}
+
+// withMessage returns a copy of the error that reads as msg, for callers that
+// reword an error but still want it classified by status.
+func (e *APIError) withMessage(msg string) *APIError {
+	clone := *e
+	clone.message = msg
+	return &clone
+}
+
+// newAPIError builds an APIError from a failed response, recognising the two
+// error body formats the API uses and falling back to UnrecognizedAPIError
+// for anything else.
+func newAPIError(response *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		RequestID:  response.Header.Get("X-Request-Id"),
+		Body:       body,
+	}
+
+	// Try the nested error format first
+	var nested JSONAPIError
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&nested); err == nil && nested.Error.Detail != nil {
+		apiErr.ErrorCode = nested.Error.ErrorCode
+		apiErr.Title = nested.Error.Title
+		apiErr.Detail = fmt.Sprintf("%v", nested.Error.Detail)
+		apiErr.FieldErrors = fieldErrors(nested.Error.Detail)
+		apiErr.message = fmt.Sprintf("request failed: %s", truncateErrorDetail(apiErr.Detail))
+		return apiErr
+	}
+
+	// Try the simple error format
+	var simple SimpleAPIError
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&simple); err == nil && simple.Detail != "" {
+		apiErr.Title = simple.Message
+		apiErr.Detail = simple.Detail
+		apiErr.message = fmt.Sprintf("request failed: %s - %s", simple.Message, truncateErrorDetail(simple.Detail))
+		return apiErr
+	}
+
+	// If both fail, keep the raw body available for callers to format
+	apiErr.unrecognized = &UnrecognizedAPIError{StatusCode: response.StatusCode, Body: body}
+	apiErr.message = apiErr.unrecognized.Error()
+	return apiErr
+}
+
+// fieldErrors pulls per-field validation messages out of an error detail
+// shaped like {"field": ["message", ...]}. Other shapes yield nil.
+func fieldErrors(detail any) map[string][]string {
+	fields, ok := detail.(map[string]any)
+	if !ok || len(fields) == 0 {
+		return nil
+	}
+
+	result := make(map[string][]string, len(fields))
+	for field, value := range fields {
+		switch v := value.(type) {
+		case []any:
+			for _, msg := range v {
+				result[field] = append(result[field], fmt.Sprintf("%v", msg))
+			}
+		default:
+			result[field] = []string{fmt.Sprintf("%v", v)}
+		}
+	}
+
+	return result
+}
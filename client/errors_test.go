@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func executeAgainst(t *testing.T, status int, header http.Header, body string) error {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	c := Client{Client: http.DefaultClient, BaseURL: srv.URL, Token: "fake-token"}
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", nil)
+	return err
+}
+
+func TestAPIErrorFromNestedFormat(t *testing.T) {
+	err := executeAgainst(t, http.StatusBadRequest, http.Header{"X-Request-Id": []string{"req-123"}},
+		`{"error":{"status":400,"title":"Invalid request","error_code":140001,"detail":{"name":["This field is required."],"reward":["Must be positive.","Too small."]}}}`)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	require.Equal(t, 140001, apiErr.ErrorCode)
+	require.Equal(t, "Invalid request", apiErr.Title)
+	require.Equal(t, "req-123", apiErr.RequestID)
+	require.Equal(t, map[string][]string{
+		"name":   {"This field is required."},
+		"reward": {"Must be positive.", "Too small."},
+	}, apiErr.FieldErrors)
+	require.Contains(t, err.Error(), "request failed: map[")
+
+	var unrecognized *UnrecognizedAPIError
+	require.False(t, errors.As(err, &unrecognized))
+}
+
+func TestAPIErrorFromSimpleFormat(t *testing.T) {
+	err := executeAgainst(t, http.StatusNotFound, nil, `{"message":"Not found","detail":"No study matches the given query."}`)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "Not found", apiErr.Title)
+	require.Equal(t, "No study matches the given query.", apiErr.Detail)
+	require.Nil(t, apiErr.FieldErrors)
+	require.Contains(t, err.Error(), "request failed: Not found - No study matches the given query.")
+}
+
+func TestAPIErrorFromUnrecognizedFormat(t *testing.T) {
+	err := executeAgainst(t, http.StatusTooManyRequests, nil, `slow down`)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	require.Equal(t, []byte("slow down"), apiErr.Body)
+
+	var unrecognized *UnrecognizedAPIError
+	require.ErrorAs(t, err, &unrecognized)
+	require.Equal(t, http.StatusTooManyRequests, unrecognized.StatusCode)
+	require.Contains(t, err.Error(), "request failed with status 429: slow down")
+}
+
+func TestAPIErrorIsFeatureNotEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      APIError
+		expected bool
+	}{
+		{
+			name:     "title names the feature gate",
+			err:      APIError{StatusCode: http.StatusForbidden, Title: "Feature not enabled", Detail: "Ask us about early access."},
+			expected: true,
+		},
+		{
+			name:     "title in constant form",
+			err:      APIError{StatusCode: http.StatusNotFound, Title: "FEATURE_NOT_ENABLED"},
+			expected: true,
+		},
+		{
+			name:     "specific title other than the gate wins over the detail",
+			err:      APIError{StatusCode: http.StatusForbidden, Title: "Workspace suspended", Detail: "You do not have permission to use this feature."},
+			expected: false,
+		},
+		{
+			name:     "generic title falls back to the detail",
+			err:      APIError{StatusCode: http.StatusForbidden, Title: "Forbidden", Detail: "You do not currently have permission to access this feature."},
+			expected: true,
+		},
+		{
+			name:     "no title falls back to the detail",
+			err:      APIError{StatusCode: http.StatusNotFound, Detail: "You do not currently have permission to access this feature."},
+			expected: true,
+		},
+		{
+			name:     "permission error for an action",
+			err:      APIError{StatusCode: http.StatusForbidden, Detail: "You do not have permission to perform this action."},
+			expected: false,
+		},
+		{
+			name:     "other statuses are never a feature gate",
+			err:      APIError{StatusCode: http.StatusBadRequest, Title: "Feature not enabled", Detail: "You do not currently have permission to access this feature."},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.err.IsFeatureNotEnabled())
+		})
+	}
+}
+
+func TestExecuteWithoutTokenReturnsErrTokenNotSet(t *testing.T) {
+	c := Client{Client: http.DefaultClient, BaseURL: "http://localhost"}
+
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", nil)
+
+	require.ErrorIs(t, err, ErrTokenNotSet)
+}
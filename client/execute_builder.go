@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -8,15 +9,19 @@ import (
 
 type ExecuteBuilder struct {
 	client           *Client
+	ctx              context.Context
 	method           string
 	url              string
 	body             any
 	response         any
 	expectedStatuses []int
+	retryable        bool
 }
 
-func (c *Client) ExecuteBuilder() *ExecuteBuilder {
-	return &ExecuteBuilder{client: c}
+// ExecuteBuilder returns a builder whose requests are all bound to ctx, so
+// cancelling ctx aborts whichever request is in flight.
+func (c *Client) ExecuteBuilder(ctx context.Context) *ExecuteBuilder {
+	return &ExecuteBuilder{client: c, ctx: ctx}
 }
 
 func (b *ExecuteBuilder) Get(url string, response any) (*http.Response, error) {
@@ -60,6 +65,7 @@ func (b *ExecuteBuilder) newRequest(method, url string) *ExecuteBuilder {
 	b.body = nil
 	b.response = nil
 	b.expectedStatuses = nil
+	b.retryable = false
 	return b
 }
 
@@ -78,8 +84,18 @@ func (b *ExecuteBuilder) Status(statuses ...int) *ExecuteBuilder {
 	return b
 }
 
+// Retryable opts the request into the client's retry policy even though its
+// method is not idempotent. Only use it for requests that are safe to repeat,
+// such as a POST the server deduplicates, or one that starts an export, where
+// a repeat at worst starts a duplicate export job.
+func (b *ExecuteBuilder) Retryable() *ExecuteBuilder {
+	b.retryable = true
+	return b
+}
+
 func (b *ExecuteBuilder) Execute() (*http.Response, error) {
-	httpResponse, err := b.client.Execute(b.method, b.url, b.body, b.response)
+	retryable := b.retryable || isIdempotent(b.method)
+	httpResponse, err := b.client.execute(b.ctx, b.method, b.url, b.body, b.response, retryable)
 
 	if err != nil {
 		return nil, fmt.Errorf("unable to fulfil request %s: %w", b.url, err)
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -32,7 +33,7 @@ func TestExecuteBuilderGet(t *testing.T) {
 	}
 
 	var resp testResponse
-	_, err := c.ExecuteBuilder().Get("/some-path", &resp)
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", &resp)
 	require.NoError(t, err)
 	require.Equal(t, "123", resp.ID)
 	require.Equal(t, "test", resp.Name)
@@ -58,7 +59,7 @@ func TestExecuteBuilderGetIntoAcceptsAnySuccessStatus(t *testing.T) {
 	}
 
 	var resp testResponse
-	httpResponse, err := c.ExecuteBuilder().GetInto("/some-path", &resp)
+	httpResponse, err := c.ExecuteBuilder(context.Background()).GetInto("/some-path", &resp)
 
 	require.NoError(t, err)
 	require.Equal(t, http.StatusAccepted, httpResponse.StatusCode)
@@ -81,7 +82,7 @@ func TestExecuteBuilderGetReturnsErrorOnHTTPFailure(t *testing.T) {
 	}
 
 	var resp testResponse
-	_, err := c.ExecuteBuilder().Get("/some-path", &resp)
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", &resp)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unable to fulfil request")
 }
@@ -106,7 +107,7 @@ func TestExecuteBuilderGetReturnsErrorOnUnexpectedSuccessStatus(t *testing.T) {
 	}
 
 	var resp testResponse
-	_, err := c.ExecuteBuilder().Get("/some-path", &resp)
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", &resp)
 
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unexpected status code 202")
@@ -129,7 +130,7 @@ func TestExecuteBuilderExecuteAcceptsAConfiguredStatus(t *testing.T) {
 	}
 
 	var resp testResponse
-	httpResponse, err := c.ExecuteBuilder().
+	httpResponse, err := c.ExecuteBuilder(context.Background()).
 		GetRequest("/some-path").
 		Status(http.StatusCreated).
 		Decode(&resp).
@@ -160,7 +161,7 @@ func TestExecuteBuilderExecuteWithoutStatusAcceptsAnySuccess(t *testing.T) {
 	}
 
 	var resp testResponse
-	httpResponse, err := c.ExecuteBuilder().
+	httpResponse, err := c.ExecuteBuilder(context.Background()).
 		GetRequest("/some-path").
 		Decode(&resp).
 		Execute()
@@ -191,7 +192,7 @@ func TestExecuteBuilderPostRequestSendsBodyAndMethod(t *testing.T) {
 	}
 
 	var resp testResponse
-	httpResponse, err := c.ExecuteBuilder().
+	httpResponse, err := c.ExecuteBuilder(context.Background()).
 		PostRequest("/some-path").
 		Body(testResponse{ID: "123", Name: "test"}).
 		Status(http.StatusCreated).
@@ -218,7 +219,7 @@ func TestExecuteBuilderPatchRequestUsesPatchMethod(t *testing.T) {
 		Token:   "fake-token",
 	}
 
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(context.Background()).
 		PatchRequest("/some-path").
 		Body(testResponse{ID: "123"}).
 		Status(http.StatusOK).
@@ -242,7 +243,7 @@ func TestExecuteBuilderDeleteRequestUsesDeleteMethod(t *testing.T) {
 		Token:   "fake-token",
 	}
 
-	_, err := c.ExecuteBuilder().
+	_, err := c.ExecuteBuilder(context.Background()).
 		DeleteRequest("/some-path").
 		Status(http.StatusNoContent).
 		Execute()
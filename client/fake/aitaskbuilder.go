@@ -0,0 +1,634 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// Batch sync statuses as the API reports them.
+const (
+	syncQueued     = "queued"
+	syncProcessing = "processing"
+	syncComplete   = "complete"
+)
+
+type dataset struct {
+	client.GetAITaskBuilderDatasetResponse
+}
+
+type batch struct {
+	model.AITaskBuilderBatch
+	datasetID    string
+	instructions []model.Instruction
+	tasks        []string
+	groups       []string
+	responses    []model.AITaskBuilderResponse
+	// processed counts the dataset's datapoints that have been made into
+	// tasks, so a sync only adds tasks for those appended since.
+	processed int
+}
+
+type batchSync struct {
+	client.AITaskBuilderBatchSyncResponse
+	batchID string
+}
+
+// AddDatapoints adds n datapoints to the dataset, as a completed import
+// would, and marks it ready.
+func (c *Client) AddDatapoints(datasetID string, n int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.findDataset(datasetID)
+	if !ok {
+		return notFound("dataset", datasetID)
+	}
+	d.TotalDatapointCount += n
+	d.Status = model.DatasetStatusReady
+
+	return nil
+}
+
+// AddAITaskBuilderResponse seeds a participant's response to the batch. It
+// is given an ID if it has none.
+func (c *Client) AddAITaskBuilderResponse(batchID string, response model.AITaskBuilderResponse) (model.AITaskBuilderResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return response, notFound("batch", batchID)
+	}
+	if response.ID == "" {
+		response.ID = c.newID()
+	}
+	if response.CreatedAt.IsZero() {
+		response.CreatedAt = c.now()
+	}
+	response.BatchID = batchID
+	b.responses = append(b.responses, response)
+
+	return response, nil
+}
+
+// receiveUpload records the number of datapoints in the file uploaded for
+// the import.
+func (c *Client) receiveUpload(datasetID, importID string, datapoints int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.findDataset(datasetID)
+	if !ok {
+		return notFound("dataset", datasetID)
+	}
+	if !slices.ContainsFunc(d.Imports, func(job model.DatasetImportJob) bool { return job.ImportID == importID }) {
+		return notFound("import", importID)
+	}
+	c.uploads[importID] = datapoints
+
+	return nil
+}
+
+// CreateAITaskBuilderDataset stores an empty dataset in the workspace.
+func (c *Client) CreateAITaskBuilderDataset(ctx context.Context, workspaceID string, payload client.CreateAITaskBuilderDatasetPayload) (*client.CreateAITaskBuilderDatasetResponse, error) {
+	if payload.Name == "" {
+		return nil, required("name")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(workspaceID); !ok {
+		return nil, notFound("workspace", workspaceID)
+	}
+
+	d := &dataset{client.GetAITaskBuilderDatasetResponse{
+		ID:            c.newID(),
+		Name:          payload.Name,
+		CreatedAt:     c.timestamp(),
+		CreatedBy:     c.me.ID,
+		WorkspaceID:   workspaceID,
+		SchemaVersion: 1,
+		Status:        model.DatasetStatusUninitialised,
+		Schema:        payload.Schema,
+		Imports:       []model.DatasetImportJob{},
+	}}
+	c.datasets = append(c.datasets, d)
+
+	return &client.CreateAITaskBuilderDatasetResponse{
+		ID:                  d.ID,
+		Name:                d.Name,
+		CreatedAt:           d.CreatedAt,
+		CreatedBy:           d.CreatedBy,
+		Status:              d.Status,
+		TotalDatapointCount: d.TotalDatapointCount,
+		WorkspaceID:         d.WorkspaceID,
+	}, nil
+}
+
+// GetAITaskBuilderDataset returns the dataset and its imports.
+func (c *Client) GetAITaskBuilderDataset(ctx context.Context, datasetID string) (*client.GetAITaskBuilderDatasetResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.findDataset(datasetID)
+	if !ok {
+		return nil, notFound("dataset", datasetID)
+	}
+
+	response := d.GetAITaskBuilderDatasetResponse
+	response.Imports = slices.Clone(d.Imports)
+	return &response, nil
+}
+
+// GetAITaskBuilderDatasetStatus returns the dataset's status.
+func (c *Client) GetAITaskBuilderDatasetStatus(ctx context.Context, datasetID string) (*client.GetAITaskBuilderDatasetStatusResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.findDataset(datasetID)
+	if !ok {
+		return nil, notFound("dataset", datasetID)
+	}
+
+	return &client.GetAITaskBuilderDatasetStatusResponse{Status: d.Status}, nil
+}
+
+// GetAITaskBuilderDatasetUploadURL starts an import of fileName into the
+// dataset. The fake doesn't wait for the file to be uploaded: the import is
+// queued straight away, and advances each time its status is polled.
+func (c *Client) GetAITaskBuilderDatasetUploadURL(ctx context.Context, datasetID, fileName string) (*client.GetAITaskBuilderDatasetUploadURLResponse, error) {
+	if fileName == "" {
+		return nil, required("file_name")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.findDataset(datasetID)
+	if !ok {
+		return nil, notFound("dataset", datasetID)
+	}
+
+	format := strings.TrimPrefix(path.Ext(fileName), ".")
+	contentType := "text/csv"
+	switch model.DatasetImportFormat(format) {
+	case model.DatasetImportFormatCSV:
+	case model.DatasetImportFormatJSONL:
+		contentType = "application/x-ndjson"
+	default:
+		return nil, badRequest("%s is not a CSV or JSONL file", fileName)
+	}
+
+	job := model.DatasetImportJob{
+		DatasetID: datasetID,
+		ImportID:  c.newID(),
+		Type:      format,
+		Filename:  fileName,
+		CreatedAt: c.timestamp(),
+		UpdatedAt: c.timestamp(),
+		Status:    model.DatasetImportJobStatusQueued,
+	}
+	d.Imports = append(d.Imports, job)
+
+	fileKey := fmt.Sprintf("datasets/%s/imports/%s/%s", datasetID, job.ImportID, fileName)
+	return &client.GetAITaskBuilderDatasetUploadURLResponse{
+		DatasetID:   datasetID,
+		ImportID:    job.ImportID,
+		UploadURL:   strings.TrimRight(c.UploadBaseURL, "/") + "/" + fileKey,
+		HTTPMethod:  "PUT",
+		ContentType: contentType,
+		ExpiresAt:   c.now().Add(time.Hour).Format(time.RFC3339),
+		FileKey:     fileKey,
+	}, nil
+}
+
+// GetAITaskBuilderDatasetImportStatus advances the import a step, from
+// queued to processing to complete, and returns it. On completing it adds
+// the uploaded file's datapoints to the dataset, or DatapointsPerImport if
+// the fake never received the file, and marks it ready.
+func (c *Client) GetAITaskBuilderDatasetImportStatus(ctx context.Context, datasetID, importID string) (*client.GetAITaskBuilderDatasetImportStatusResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	d, ok := c.findDataset(datasetID)
+	if !ok {
+		return nil, notFound("dataset", datasetID)
+	}
+	i := slices.IndexFunc(d.Imports, func(job model.DatasetImportJob) bool { return job.ImportID == importID })
+	if i == -1 {
+		return nil, notFound("import", importID)
+	}
+
+	job := &d.Imports[i]
+	switch job.Status {
+	case model.DatasetImportJobStatusUninitialised, model.DatasetImportJobStatusQueued:
+		job.Status = model.DatasetImportJobStatusProcessing
+		job.ProcessingStartedAt = c.timestamp()
+		d.Status = model.DatasetStatusProcessing
+	case model.DatasetImportJobStatusProcessing:
+		accepted, none := c.DatapointsPerImport, 0
+		if n, ok := c.uploads[importID]; ok {
+			accepted = n
+		}
+		job.Status = model.DatasetImportJobStatusComplete
+		job.AcceptedCount = &accepted
+		job.WrittenCount = &accepted
+		job.DuplicateCount = &none
+		job.RejectedCount = &none
+		d.TotalDatapointCount += accepted
+		d.Status = model.DatasetStatusReady
+	}
+	job.UpdatedAt = c.timestamp()
+
+	return &client.GetAITaskBuilderDatasetImportStatusResponse{DatasetImportJob: *job}, nil
+}
+
+// CreateAITaskBuilderBatch stores a batch over the dataset. As with the API,
+// a batch over a ready dataset starts being set up straight away, one task
+// per group; otherwise it is uninitialised until SetupAITaskBuilderBatch.
+// Batch items aren't modelled.
+func (c *Client) CreateAITaskBuilderBatch(ctx context.Context, params client.CreateBatchParams) (*client.CreateAITaskBuilderBatchResponse, error) {
+	if params.Name == "" {
+		return nil, required("name")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(params.WorkspaceID); !ok {
+		return nil, notFound("workspace", params.WorkspaceID)
+	}
+	d, ok := c.findDataset(params.DatasetID)
+	if !ok {
+		return nil, notFound("dataset", params.DatasetID)
+	}
+
+	b := &batch{
+		AITaskBuilderBatch: model.AITaskBuilderBatch{
+			ID:            c.newID(),
+			CreatedAt:     c.now(),
+			CreatedBy:     c.me.ID,
+			Datasets:      []model.Dataset{d.summary()},
+			Name:          params.Name,
+			Status:        model.AITaskBuilderBatchStatusUninitialised,
+			WorkspaceID:   params.WorkspaceID,
+			SchemaVersion: 1,
+			TaskDetails: model.TaskDetails{
+				TaskName:         params.TaskName,
+				TaskIntroduction: params.TaskIntroduction,
+				TaskSteps:        params.TaskSteps,
+			},
+			AutoSyncEnabled: params.AutoSync,
+		},
+		datasetID: params.DatasetID,
+	}
+	if d.Status == model.DatasetStatusReady {
+		b.Status = model.AITaskBuilderBatchStatusProcessing
+		b.TasksPerGroup = 1
+	}
+	c.batches = append(c.batches, b)
+
+	return &client.CreateAITaskBuilderBatchResponse{
+		ID:              b.ID,
+		CreatedAt:       b.CreatedAt.Format(timeFormat),
+		CreatedBy:       b.CreatedBy,
+		Name:            b.Name,
+		Status:          string(b.Status),
+		WorkspaceID:     b.WorkspaceID,
+		AutoSyncEnabled: b.AutoSyncEnabled,
+		Datasets:        slices.Clone(b.Datasets),
+		TaskDetails:     b.TaskDetails,
+	}, nil
+}
+
+// CreateAITaskBuilderInstructions adds the instructions to the batch.
+func (c *Client) CreateAITaskBuilderInstructions(ctx context.Context, batchID string, payload client.CreateAITaskBuilderInstructionsPayload) (*client.CreateAITaskBuilderInstructionsResponse, error) {
+	if len(payload.Instructions) == 0 {
+		return nil, required("instructions")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return nil, notFound("batch", batchID)
+	}
+
+	response := client.CreateAITaskBuilderInstructionsResponse{}
+	for _, instruction := range payload.Instructions {
+		// The request and response share their JSON names.
+		var created model.Instruction
+		if err := convert(instruction, &created); err != nil {
+			return nil, err
+		}
+		created.ID = c.newID()
+		created.BatchID = batchID
+		created.CreatedAt = c.timestamp()
+		response = append(response, created)
+	}
+	b.instructions = append(b.instructions, response...)
+	b.TotalInstructionCount = len(b.instructions)
+
+	return &response, nil
+}
+
+// SetupAITaskBuilderBatch starts making tasks afresh from the dataset, which
+// must be ready. The batch stays processing until its status is next polled.
+func (c *Client) SetupAITaskBuilderBatch(ctx context.Context, batchID, datasetID string, tasksPerGroup int) (*client.SetupAITaskBuilderBatchResponse, error) {
+	if tasksPerGroup < 1 {
+		return nil, badRequest("tasks_per_group must be at least 1")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return nil, notFound("batch", batchID)
+	}
+	d, ok := c.findDataset(datasetID)
+	if !ok {
+		return nil, notFound("dataset", datasetID)
+	}
+	if d.Status != model.DatasetStatusReady {
+		return nil, badRequest("dataset %s is not ready; it is %s", datasetID, d.Status)
+	}
+
+	b.datasetID = datasetID
+	b.Datasets = []model.Dataset{d.summary()}
+	b.TasksPerGroup = tasksPerGroup
+	b.Status = model.AITaskBuilderBatchStatusProcessing
+	b.tasks, b.groups, b.processed = nil, nil, 0
+
+	return &client.SetupAITaskBuilderBatchResponse{}, nil
+}
+
+// GetAITaskBuilderBatch returns the batch.
+func (c *Client) GetAITaskBuilderBatch(ctx context.Context, batchID string) (*client.GetAITaskBuilderBatchResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return nil, notFound("batch", batchID)
+	}
+
+	return &client.GetAITaskBuilderBatchResponse{AITaskBuilderBatch: b.view()}, nil
+}
+
+// GetAITaskBuilderBatchStatus returns the batch's status. A batch being set
+// up becomes ready, with a task for each of its dataset's datapoints.
+func (c *Client) GetAITaskBuilderBatchStatus(ctx context.Context, batchID string) (*client.GetAITaskBuilderBatchStatusResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return nil, notFound("batch", batchID)
+	}
+
+	if b.Status == model.AITaskBuilderBatchStatusProcessing {
+		c.extendBatch(b)
+		b.Status = model.AITaskBuilderBatchStatusReady
+	}
+
+	return &client.GetAITaskBuilderBatchStatusResponse{
+		AITaskBuilderBatchStatus: model.AITaskBuilderBatchStatus{Status: b.Status},
+	}, nil
+}
+
+// UpdateAITaskBuilderBatch applies the fields set in params. Batch items
+// aren't modelled.
+func (c *Client) UpdateAITaskBuilderBatch(ctx context.Context, params client.UpdateBatchParams) (*client.UpdateAITaskBuilderBatchResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(params.BatchID)
+	if !ok {
+		return nil, notFound("batch", params.BatchID)
+	}
+
+	if params.DatasetID != "" {
+		d, ok := c.findDataset(params.DatasetID)
+		if !ok {
+			return nil, notFound("dataset", params.DatasetID)
+		}
+		b.datasetID = params.DatasetID
+		b.Datasets = []model.Dataset{d.summary()}
+	}
+	if params.Name != "" {
+		b.Name = params.Name
+	}
+	if params.TaskDetails != nil {
+		b.TaskDetails = model.TaskDetails(*params.TaskDetails)
+	}
+	if params.AutoSync != nil {
+		b.AutoSyncEnabled = *params.AutoSync
+	}
+
+	return &client.UpdateAITaskBuilderBatchResponse{AITaskBuilderBatch: b.view()}, nil
+}
+
+// GetAITaskBuilderBatches lists the workspace's batches in the order they
+// were created.
+func (c *Client) GetAITaskBuilderBatches(ctx context.Context, workspaceID string, limit, offset int) (*client.GetAITaskBuilderBatchesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.AITaskBuilderBatch{}
+	for _, b := range c.batches {
+		if b.WorkspaceID == workspaceID {
+			results = append(results, b.view())
+		}
+	}
+
+	return &client.GetAITaskBuilderBatchesResponse{Results: page(results, limit, offset)}, nil
+}
+
+// GetAITaskBuilderResponses lists the responses seeded with
+// AddAITaskBuilderResponse.
+func (c *Client) GetAITaskBuilderResponses(ctx context.Context, batchID string) (*client.GetAITaskBuilderResponsesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return nil, notFound("batch", batchID)
+	}
+
+	results := slices.Clone(b.responses)
+	if results == nil {
+		results = []model.AITaskBuilderResponse{}
+	}
+
+	return &client.GetAITaskBuilderResponsesResponse{
+		Results: results,
+		Meta:    client.ResponseMeta{Count: len(results)},
+	}, nil
+}
+
+// GetAITaskBuilderTasks lists the IDs of the batch's tasks.
+func (c *Client) GetAITaskBuilderTasks(ctx context.Context, batchID string) (*client.GetAITaskBuilderTasksResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return nil, notFound("batch", batchID)
+	}
+
+	tasks := client.GetAITaskBuilderTasksResponse(slices.Clone(b.tasks))
+	return &tasks, nil
+}
+
+// GetAITaskBuilderTaskGroups lists the IDs of the batch's task groups.
+func (c *Client) GetAITaskBuilderTaskGroups(ctx context.Context, batchID string) (*client.GetAITaskBuilderTaskGroupsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return nil, notFound("batch", batchID)
+	}
+
+	groups := client.GetAITaskBuilderTaskGroupsResponse(slices.Clone(b.groups))
+	return &groups, nil
+}
+
+// InitiateBatchExport starts an export of the batch's responses, or returns
+// the last one if it has completed.
+func (c *Client) InitiateBatchExport(ctx context.Context, batchID string) (*client.BatchExportResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findBatch(batchID); !ok {
+		return nil, notFound("batch", batchID)
+	}
+
+	response := c.startExport(c.batchExports, batchID, "batches").response()
+	return &response, nil
+}
+
+// GetBatchExportStatus reports the export as complete.
+func (c *Client) GetBatchExportStatus(ctx context.Context, batchID, exportID string) (*client.BatchExportResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.batchExports[exportID]
+	if !ok || e.resourceID != batchID {
+		return nil, notFound("export", exportID)
+	}
+
+	c.completeExport(e)
+	response := e.response()
+	return &response, nil
+}
+
+// SyncAITaskBuilderBatch queues a sync of a ready batch, which adds tasks
+// for datapoints appended to its dataset since it was set up or last
+// synced.
+func (c *Client) SyncAITaskBuilderBatch(ctx context.Context, batchID string) (*client.AITaskBuilderBatchSyncResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.findBatch(batchID)
+	if !ok {
+		return nil, notFound("batch", batchID)
+	}
+	if b.Status != model.AITaskBuilderBatchStatusReady {
+		return nil, badRequest("batch %s has not been set up", batchID)
+	}
+
+	s := &batchSync{
+		AITaskBuilderBatchSyncResponse: client.AITaskBuilderBatchSyncResponse{Status: syncQueued, SyncID: c.newID()},
+		batchID:                        batchID,
+	}
+	c.syncs[s.SyncID] = s
+
+	response := s.AITaskBuilderBatchSyncResponse
+	return &response, nil
+}
+
+// GetAITaskBuilderBatchSyncStatus advances the sync a step, from queued to
+// processing to complete, and returns it.
+func (c *Client) GetAITaskBuilderBatchSyncStatus(ctx context.Context, batchID, syncID string) (*client.AITaskBuilderBatchSyncResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.syncs[syncID]
+	if !ok || s.batchID != batchID {
+		return nil, notFound("sync", syncID)
+	}
+
+	switch s.Status {
+	case syncQueued:
+		s.Status = syncProcessing
+	case syncProcessing:
+		b, _ := c.findBatch(batchID)
+		s.DatapointsProcessed, s.TasksCreated, s.GroupsCreated = c.extendBatch(b)
+		s.Status = syncComplete
+	}
+
+	response := s.AITaskBuilderBatchSyncResponse
+	return &response, nil
+}
+
+func (c *Client) findDataset(ID string) (*dataset, bool) {
+	return find(c.datasets, func(d *dataset) bool { return d.ID == ID })
+}
+
+func (c *Client) findBatch(ID string) (*batch, bool) {
+	return find(c.batches, func(b *batch) bool { return b.ID == ID })
+}
+
+// extendBatch makes a task for each of the dataset's datapoints not yet
+// processed, grouping them TasksPerGroup at a time. It returns how many
+// datapoints, tasks and groups it made.
+func (c *Client) extendBatch(b *batch) (datapoints, tasks, groups int) {
+	d, ok := c.findDataset(b.datasetID)
+	if !ok {
+		return 0, 0, 0
+	}
+
+	datapoints = d.TotalDatapointCount - b.processed
+	for i := range datapoints {
+		if i%b.TasksPerGroup == 0 {
+			b.groups = append(b.groups, c.newID())
+			groups++
+		}
+		b.tasks = append(b.tasks, c.newID())
+	}
+	b.processed = d.TotalDatapointCount
+	b.TotalTaskCount = len(b.tasks)
+
+	return datapoints, datapoints, groups
+}
+
+// view returns a copy of the batch that doesn't share its datasets with the
+// store.
+func (b *batch) view() model.AITaskBuilderBatch {
+	view := b.AITaskBuilderBatch
+	view.Datasets = slices.Clone(b.Datasets)
+	return view
+}
+
+func (d *dataset) summary() model.Dataset {
+	return model.Dataset{
+		ID:                  d.ID,
+		Name:                d.Name,
+		CreatedAt:           d.CreatedAt,
+		CreatedBy:           d.CreatedBy,
+		Status:              d.Status,
+		TotalDatapointCount: d.TotalDatapointCount,
+		WorkspaceID:         d.WorkspaceID,
+	}
+}
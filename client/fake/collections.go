@@ -0,0 +1,192 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// Export statuses as the API reports them.
+const (
+	exportGenerating = "generating"
+	exportComplete   = "complete"
+)
+
+type collection struct {
+	model.Collection
+	workspaceID string
+}
+
+// export is a collection or batch export job. It completes the first time
+// its status is polled.
+type export struct {
+	ID         string
+	resourceID string
+	status     string
+	url        string
+	expiresAt  string
+}
+
+// CreateAITaskBuilderCollection stores the collection in the workspace.
+func (c *Client) CreateAITaskBuilderCollection(ctx context.Context, payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
+	if payload.Name == "" {
+		return nil, required("name")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(payload.WorkspaceID); !ok {
+		return nil, notFound("workspace", payload.WorkspaceID)
+	}
+
+	coll := &collection{
+		Collection: model.Collection{
+			ID:          c.newID(),
+			Name:        payload.Name,
+			CreatedAt:   c.now(),
+			CreatedBy:   c.me.ID,
+			ItemCount:   len(payload.CollectionItems),
+			TaskDetails: payload.TaskDetails,
+		},
+		workspaceID: payload.WorkspaceID,
+	}
+	c.collections = append(c.collections, coll)
+
+	return &client.CreateAITaskBuilderCollectionResponse{
+		ID:              coll.ID,
+		Name:            coll.Name,
+		WorkspaceID:     coll.workspaceID,
+		SchemaVersion:   1,
+		CreatedBy:       coll.CreatedBy,
+		TaskDetails:     coll.TaskDetails,
+		CollectionItems: payload.CollectionItems,
+	}, nil
+}
+
+// GetCollections lists the workspace's collections in the order they were
+// created.
+func (c *Client) GetCollections(ctx context.Context, workspaceID string, limit, offset int) (*client.ListCollectionsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.Collection{}
+	for _, coll := range c.collections {
+		if coll.workspaceID == workspaceID {
+			results = append(results, coll.Collection)
+		}
+	}
+
+	return &client.ListCollectionsResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// GetCollection returns the collection.
+func (c *Client) GetCollection(ctx context.Context, ID string) (*model.Collection, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	coll, ok := c.findCollection(ID)
+	if !ok {
+		return nil, notFound("collection", ID)
+	}
+
+	result := coll.Collection
+	return &result, nil
+}
+
+// UpdateCollection replaces the collection's name, task details and pages.
+func (c *Client) UpdateCollection(ctx context.Context, ID string, update model.UpdateCollection) (*model.Collection, error) {
+	if update.Name == "" {
+		return nil, required("name")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	coll, ok := c.findCollection(ID)
+	if !ok {
+		return nil, notFound("collection", ID)
+	}
+
+	coll.Name = update.Name
+	coll.TaskDetails = update.TaskDetails
+	coll.ItemCount = len(update.CollectionItems)
+
+	result := coll.Collection
+	return &result, nil
+}
+
+// InitiateCollectionExport starts an export of the collection's responses,
+// or returns the last one if it has completed.
+func (c *Client) InitiateCollectionExport(ctx context.Context, collectionID string) (*client.CollectionExportResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findCollection(collectionID); !ok {
+		return nil, notFound("collection", collectionID)
+	}
+
+	e := c.startExport(c.collectionExports, collectionID, "collections")
+	response := client.CollectionExportResponse(e.response())
+	return &response, nil
+}
+
+// GetCollectionExportStatus reports the export as complete.
+func (c *Client) GetCollectionExportStatus(ctx context.Context, collectionID, exportID string) (*client.CollectionExportResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.collectionExports[exportID]
+	if !ok || e.resourceID != collectionID {
+		return nil, notFound("export", exportID)
+	}
+
+	c.completeExport(e)
+	response := client.CollectionExportResponse(e.response())
+	return &response, nil
+}
+
+func (c *Client) findCollection(ID string) (*collection, bool) {
+	return find(c.collections, func(coll *collection) bool { return coll.ID == ID })
+}
+
+// startExport returns the resource's completed export if it has one, and
+// otherwise starts a new one.
+func (c *Client) startExport(exports map[string]*export, resourceID, kind string) *export {
+	for _, e := range exports {
+		if e.resourceID == resourceID && e.status == exportComplete {
+			return e
+		}
+	}
+
+	e := &export{ID: c.newID(), resourceID: resourceID, status: exportGenerating}
+	e.url = fmt.Sprintf("https://exports.example.com/%s/%s/%s.zip", kind, resourceID, e.ID)
+	exports[e.ID] = e
+	return e
+}
+
+// completeExport finishes the export, leaving its link valid for an hour.
+func (c *Client) completeExport(e *export) {
+	if e.status == exportComplete {
+		return
+	}
+	e.status = exportComplete
+	e.expiresAt = c.now().Add(time.Hour).Format(time.RFC3339)
+}
+
+// response reports the export the way the API does: one still generating
+// has no URL yet.
+func (e *export) response() client.BatchExportResponse {
+	response := client.BatchExportResponse{Status: e.status, ExportID: e.ID}
+	if e.status == exportComplete {
+		response.URL = e.url
+		response.ExpiresAt = e.expiresAt
+	}
+	return response
+}
@@ -0,0 +1,106 @@
+package fake
+
+import (
+	"context"
+	"strings"
+
+	"github.com/prolific-oss/cli/client"
+)
+
+type credentialPool struct {
+	ID          string
+	workspaceID string
+	// credentials holds one "username,password" entry per participant.
+	credentials []string
+}
+
+// CreateCredentialPool stores the credentials, one comma separated entry
+// per line, as a pool in the workspace.
+func (c *Client) CreateCredentialPool(ctx context.Context, credentials string, workspaceID string) (*client.CredentialPoolResponse, error) {
+	entries, err := parseCredentials(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(workspaceID); !ok {
+		return nil, notFound("workspace", workspaceID)
+	}
+
+	pool := &credentialPool{ID: c.newID(), workspaceID: workspaceID, credentials: entries}
+	c.pools = append(c.pools, pool)
+
+	return &client.CredentialPoolResponse{CredentialPoolID: pool.ID}, nil
+}
+
+// UpdateCredentialPool replaces the pool's credentials.
+func (c *Client) UpdateCredentialPool(ctx context.Context, credentialPoolID string, credentials string) (*client.CredentialPoolResponse, error) {
+	entries, err := parseCredentials(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pool, ok := c.findPool(credentialPoolID)
+	if !ok {
+		return nil, notFound("credential pool", credentialPoolID)
+	}
+	pool.credentials = entries
+
+	return &client.CredentialPoolResponse{CredentialPoolID: pool.ID}, nil
+}
+
+// ListCredentialPools lists the workspace's pools. A credential is taken by
+// each submission to a study using the pool.
+func (c *Client) ListCredentialPools(ctx context.Context, workspaceID string) (*client.ListCredentialPoolsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	response := &client.ListCredentialPoolsResponse{CredentialPools: []client.CredentialPoolSummary{}}
+	for _, pool := range c.pools {
+		if pool.workspaceID != workspaceID {
+			continue
+		}
+
+		taken := 0
+		for _, study := range c.studies {
+			if study.CredentialPoolID == pool.ID {
+				taken += len(c.studySubmissions(study.ID))
+			}
+		}
+
+		response.CredentialPools = append(response.CredentialPools, client.CredentialPoolSummary{
+			CredentialPoolID:     pool.ID,
+			TotalCredentials:     len(pool.credentials),
+			AvailableCredentials: max(len(pool.credentials)-taken, 0),
+		})
+	}
+
+	return response, nil
+}
+
+func (c *Client) findPool(ID string) (*credentialPool, bool) {
+	return find(c.pools, func(p *credentialPool) bool { return p.ID == ID })
+}
+
+func parseCredentials(credentials string) ([]string, error) {
+	var entries []string
+	for line := range strings.SplitSeq(credentials, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !strings.Contains(line, ",") {
+			return nil, badRequest("invalid credential %q: expected username,password", line)
+		}
+		entries = append(entries, line)
+	}
+	if len(entries) == 0 {
+		return nil, required("credentials")
+	}
+	return entries, nil
+}
@@ -0,0 +1,210 @@
+// Package fake provides an in-memory implementation of client.API for tests.
+//
+// Unlike the generated mock, which answers each call with whatever a test
+// told it to expect, the fake keeps state: a study created through it is then
+// listed by GetStudies, transitioning it changes its status, and so on. That
+// makes it suited to testing workflows that make several calls, such as
+// `prolific study create --publish`, without scripting each response.
+//
+// Resources the CLI can't create, such as submissions, campaigns and inbound
+// messages, are seeded with the Add methods. Long running jobs (dataset
+// imports, batch setup, exports and syncs) advance one step each time their
+// status is polled, so polling loops run to completion.
+//
+// The fake answers the way the API does where that matters to callers:
+// unknown IDs fail with a 404 *client.APIError and invalid transitions with a
+// 400, so exit codes and error handling can be tested too. It is safe for
+// concurrent use.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+var _ client.API = (*Client)(nil)
+
+// Client is a stateful, in-memory client.API.
+type Client struct {
+	// Now returns the time stamped on anything created. It defaults to
+	// time.Now; set it for stable output.
+	Now func() time.Time
+	// DatapointsPerImport is how many datapoints a dataset import adds once
+	// complete when its file was uploaded somewhere other than Handler. It
+	// defaults to 10.
+	DatapointsPerImport int
+	// UploadBaseURL is where dataset upload URLs point. Point it at the
+	// server running Handler, with the path "/uploads", for the server to
+	// receive uploads.
+	UploadBaseURL string
+
+	mu  sync.Mutex
+	seq int
+
+	me client.MeResponse
+
+	studies       []*model.Study
+	studyProjects map[string]string
+	submissions   []*submission
+	bonuses       []*bonus
+
+	workspaces []*model.Workspace
+	balances   map[string]client.WorkspaceBalanceResponse
+	projects   []*model.Project
+	campaigns  []*campaign
+
+	hooks      []*hook
+	hookEvents map[string][]model.HookEvent
+	eventTypes []model.HookEventType
+	secrets    []*model.Secret
+
+	groups       []*participantGroup
+	participants []string
+	filters      []model.Filter
+	filterSets   []*model.FilterSet
+
+	surveys   []*model.Survey
+	responses map[string][]*model.SurveyResponse
+
+	messages []*message
+
+	pools []*credentialPool
+
+	collections       []*collection
+	collectionExports map[string]*export
+
+	datasets     []*dataset
+	uploads      map[string]int
+	batches      []*batch
+	batchExports map[string]*export
+	syncs        map[string]*batchSync
+}
+
+// New returns an empty fake, signed in as a placeholder researcher that
+// SetMe can replace.
+func New() *Client {
+	return &Client{
+		Now:                 time.Now,
+		DatapointsPerImport: 10,
+		UploadBaseURL:       "https://uploads.example.com",
+		me: client.MeResponse{
+			ID:           "researcher",
+			Email:        "researcher@example.com",
+			FirstName:    "Fake",
+			LastName:     "Researcher",
+			Name:         "Fake Researcher",
+			Username:     "researcher@example.com",
+			UserType:     "researcher",
+			CurrencyCode: model.DefaultCurrency,
+		},
+		studyProjects:     map[string]string{},
+		balances:          map[string]client.WorkspaceBalanceResponse{},
+		hookEvents:        map[string][]model.HookEvent{},
+		eventTypes:        defaultEventTypes(),
+		responses:         map[string][]*model.SurveyResponse{},
+		collectionExports: map[string]*export{},
+		uploads:           map[string]int{},
+		batchExports:      map[string]*export{},
+		syncs:             map[string]*batchSync{},
+	}
+}
+
+// SetMe replaces the account GetMe returns. Its ID is used as the creator of
+// anything made through the fake.
+func (c *Client) SetMe(me client.MeResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.me = me
+}
+
+// GetMe returns the account set with SetMe.
+func (c *Client) GetMe(ctx context.Context) (*client.MeResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	me := c.me
+	return &me, nil
+}
+
+// newID returns the next ID, shaped like the API's 24 character hex IDs.
+// IDs are sequential so tests can predict them.
+func (c *Client) newID() string {
+	c.seq++
+	return fmt.Sprintf("%024x", c.seq)
+}
+
+func (c *Client) now() time.Time {
+	return c.Now().UTC()
+}
+
+// timeFormat is how the API formats the times it returns as strings.
+const timeFormat = "2006-01-02T15:04:05.000000Z"
+
+func (c *Client) timestamp() string {
+	return c.now().Format(timeFormat)
+}
+
+// notFound is the error the API gives for an unknown ID.
+func notFound(kind, id string) error {
+	return &client.APIError{
+		StatusCode: http.StatusNotFound,
+		Title:      "Not found",
+		Detail:     fmt.Sprintf("%s %s not found", kind, id),
+	}
+}
+
+// badRequest is the error the API gives for a request it won't act on.
+func badRequest(format string, args ...any) error {
+	return &client.APIError{
+		StatusCode: http.StatusBadRequest,
+		Title:      "Bad request",
+		Detail:     fmt.Sprintf(format, args...),
+	}
+}
+
+// required is the error the API gives when a required field is missing.
+func required(field string) error {
+	return &client.APIError{
+		StatusCode:  http.StatusBadRequest,
+		Title:       "Bad request",
+		Detail:      fmt.Sprintf("%s: This field is required.", field),
+		FieldErrors: map[string][]string{field: {"This field is required."}},
+	}
+}
+
+// page applies limit and offset the way the API does. A limit of zero or
+// less returns everything from offset on.
+func page[T any](items []T, limit, offset int) []T {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(items) {
+		return []T{}
+	}
+	items = items[offset:]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+func meta(count int) *client.JSONAPIMeta {
+	m := &client.JSONAPIMeta{}
+	m.Meta.Count = count
+	return m
+}
+
+// find returns the first item for which match is true.
+func find[T any](items []*T, match func(*T) bool) (*T, bool) {
+	for _, item := range items {
+		if match(item) {
+			return item, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,172 @@
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/client/fake"
+	"github.com/prolific-oss/cli/model"
+	"github.com/stretchr/testify/require"
+)
+
+// requireStatus checks err is an APIError with the given status, and returns
+// it for any further checks.
+func requireStatus(t *testing.T, err error, status int) *client.APIError {
+	t.Helper()
+
+	var apiErr *client.APIError
+	require.True(t, errors.As(err, &apiErr), "expected an APIError, got %v", err)
+	require.Equal(t, status, apiErr.StatusCode)
+	return apiErr
+}
+
+func TestCreatedStudiesAreListed(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+
+	study, err := f.CreateStudy(ctx, model.CreateStudy{Name: "Eggs", TotalAvailablePlaces: 10, Reward: 400})
+	require.NoError(t, err)
+	require.Equal(t, "UNPUBLISHED", study.Status)
+	require.Equal(t, 4000.0, study.TotalCost)
+
+	studies, err := f.GetStudies(ctx, model.StatusAll, "", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	require.NoError(t, err)
+	require.Len(t, studies.Results, 1)
+	require.Equal(t, study.ID, studies.Results[0].ID)
+}
+
+func TestTransitionStudy(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+
+	study, err := f.CreateStudy(ctx, model.CreateStudy{Name: "Eggs", TotalAvailablePlaces: 1})
+	require.NoError(t, err)
+
+	_, err = f.TransitionStudy(ctx, study.ID, model.TransitionStudyPause)
+	requireStatus(t, err, http.StatusBadRequest)
+
+	_, err = f.TransitionStudy(ctx, study.ID, model.TransitionStudyPublish)
+	require.NoError(t, err)
+
+	active, err := f.GetStudies(ctx, model.StatusActive, "", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	require.NoError(t, err)
+	require.Len(t, active.Results, 1)
+
+	_, err = f.TransitionStudy(ctx, study.ID, model.TransitionStudyStop)
+	require.NoError(t, err)
+
+	stopped, err := f.GetStudy(ctx, study.ID)
+	require.NoError(t, err)
+	require.Equal(t, "COMPLETED", stopped.Status)
+}
+
+func TestUnknownIDsAreNotFound(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+
+	_, err := f.GetStudy(ctx, "missing")
+	requireStatus(t, err, http.StatusNotFound)
+
+	_, err = f.GetAITaskBuilderBatch(ctx, "missing")
+	requireStatus(t, err, http.StatusNotFound)
+}
+
+func TestSubmissionsAndBonuses(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+
+	study := f.AddStudy(model.Study{Name: "Eggs", Status: "ACTIVE", TotalAvailablePlaces: 2})
+	first := f.AddSubmission(study.ID, model.Submission{ParticipantID: "p1"})
+	f.AddSubmission(study.ID, model.Submission{ParticipantID: "p2"})
+
+	_, err := f.TransitionSubmission(ctx, first.ID, client.TransitionSubmissionPayload{Action: "APPROVE"})
+	requireStatus(t, err, http.StatusBadRequest)
+
+	_, err = f.TransitionSubmission(ctx, first.ID, client.TransitionSubmissionPayload{Action: "COMPLETE", CompletionCode: "C1"})
+	require.NoError(t, err)
+	_, err = f.TransitionSubmission(ctx, first.ID, client.TransitionSubmissionPayload{Action: "APPROVE"})
+	require.NoError(t, err)
+
+	counts, err := f.GetStudySubmissionCounts(ctx, study.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, counts.Approved)
+	require.Equal(t, 1, counts.Active)
+
+	for range 2 {
+		bonus, err := f.CreateBonusPayments(ctx, client.CreateBonusPaymentsPayload{StudyID: study.ID, CSVBonuses: "p1,1.50"})
+		require.NoError(t, err)
+		require.Equal(t, 150.0, bonus.TotalAmount)
+		require.NoError(t, f.PayBonusPayments(ctx, bonus.ID))
+
+		err = f.PayBonusPayments(ctx, bonus.ID)
+		requireStatus(t, err, http.StatusBadRequest)
+	}
+
+	submissions, err := f.GetSubmissions(ctx, study.ID, client.DefaultRecordLimit, client.DefaultRecordOffset)
+	require.NoError(t, err)
+	require.Equal(t, []any{150.0, 150.0}, submissions.Results[0].BonusPayments)
+}
+
+func TestDatasetImportAndBatchSetup(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+	f.DatapointsPerImport = 5
+
+	workspace, err := f.CreateWorkspace(ctx, model.Workspace{Title: "Lab"})
+	require.NoError(t, err)
+
+	dataset, err := f.CreateAITaskBuilderDataset(ctx, workspace.ID, client.CreateAITaskBuilderDatasetPayload{Name: "Images"})
+	require.NoError(t, err)
+
+	batch, err := f.CreateAITaskBuilderBatch(ctx, client.CreateBatchParams{Name: "Labels", WorkspaceID: workspace.ID, DatasetID: dataset.ID})
+	require.NoError(t, err)
+
+	_, err = f.SetupAITaskBuilderBatch(ctx, batch.ID, dataset.ID, 2)
+	requireStatus(t, err, http.StatusBadRequest)
+
+	upload, err := f.GetAITaskBuilderDatasetUploadURL(ctx, dataset.ID, "images.csv")
+	require.NoError(t, err)
+
+	var statuses []model.DatasetImportJobStatus
+	for range 3 {
+		job, err := f.GetAITaskBuilderDatasetImportStatus(ctx, dataset.ID, upload.ImportID)
+		require.NoError(t, err)
+		statuses = append(statuses, job.Status)
+	}
+	require.Equal(t, []model.DatasetImportJobStatus{
+		model.DatasetImportJobStatusProcessing,
+		model.DatasetImportJobStatusComplete,
+		model.DatasetImportJobStatusComplete,
+	}, statuses)
+
+	status, err := f.GetAITaskBuilderDatasetStatus(ctx, dataset.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.DatasetStatusReady, status.Status)
+
+	_, err = f.SetupAITaskBuilderBatch(ctx, batch.ID, dataset.ID, 2)
+	require.NoError(t, err)
+	batchStatus, err := f.GetAITaskBuilderBatchStatus(ctx, batch.ID)
+	require.NoError(t, err)
+	require.Equal(t, model.AITaskBuilderBatchStatusReady, batchStatus.Status)
+
+	groups, err := f.GetAITaskBuilderTaskGroups(ctx, batch.ID)
+	require.NoError(t, err)
+	require.Len(t, *groups, 3)
+
+	require.NoError(t, f.AddDatapoints(dataset.ID, 2))
+	sync, err := f.SyncAITaskBuilderBatch(ctx, batch.ID)
+	require.NoError(t, err)
+	for sync.Status != "complete" {
+		sync, err = f.GetAITaskBuilderBatchSyncStatus(ctx, batch.ID, sync.SyncID)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, sync.TasksCreated)
+	require.Equal(t, 1, sync.GroupsCreated)
+
+	tasks, err := f.GetAITaskBuilderTasks(ctx, batch.ID)
+	require.NoError(t, err)
+	require.Len(t, *tasks, 7)
+}
@@ -0,0 +1,98 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// Fixtures is data to seed a fake with, as read from a JSON file by
+// LoadFixtures. Each resource is seeded with the matching Add method, so IDs
+// left out are generated.
+type Fixtures struct {
+	// Me replaces the placeholder current user.
+	Me         *client.MeResponse `json:"me,omitempty"`
+	Workspaces []model.Workspace  `json:"workspaces,omitempty"`
+	// Projects name their workspace in the "workspace" field.
+	Projects    []model.Project     `json:"projects,omitempty"`
+	Studies     []StudyFixture      `json:"studies,omitempty"`
+	Submissions []SubmissionFixture `json:"submissions,omitempty"`
+	Messages    []model.Message     `json:"messages,omitempty"`
+}
+
+// StudyFixture is a study and, optionally, the project it belongs to.
+type StudyFixture struct {
+	ProjectID string `json:"project_id,omitempty"`
+	model.Study
+}
+
+// SubmissionFixture is a submission and the study it was made to.
+type SubmissionFixture struct {
+	StudyID string `json:"study_id"`
+	model.Submission
+}
+
+// LoadFixtures reads fixtures from a JSON file.
+func LoadFixtures(path string) (Fixtures, error) {
+	var f Fixtures
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return f, err
+	}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return f, fmt.Errorf("unable to parse fixtures %s: %w", path, err)
+	}
+
+	return f, nil
+}
+
+// Seed adds the fixtures to the fake. Projects, studies and submissions must
+// refer to a workspace, project or study that exists by then.
+func (c *Client) Seed(f Fixtures) error {
+	if f.Me != nil {
+		c.SetMe(*f.Me)
+	}
+	for _, w := range f.Workspaces {
+		c.AddWorkspace(w)
+	}
+	for _, p := range f.Projects {
+		if !has(c, c.findWorkspace, p.Workspace) {
+			return fmt.Errorf("project %q: workspace %q not found", p.Title, p.Workspace)
+		}
+		c.AddProject(p.Workspace, p)
+	}
+	for _, s := range f.Studies {
+		if s.ProjectID != "" && !has(c, c.findProject, s.ProjectID) {
+			return fmt.Errorf("study %q: project %q not found", s.Name, s.ProjectID)
+		}
+		study := c.AddStudy(s.Study)
+
+		c.mu.Lock()
+		c.studyProjects[study.ID] = s.ProjectID
+		c.mu.Unlock()
+	}
+	for _, s := range f.Submissions {
+		if !has(c, c.findStudy, s.StudyID) {
+			return fmt.Errorf("submission %q: study %q not found", s.ID, s.StudyID)
+		}
+		c.AddSubmission(s.StudyID, s.Submission)
+	}
+	for _, m := range f.Messages {
+		c.AddMessage(m)
+	}
+
+	return nil
+}
+
+// has reports whether lookup finds ID.
+func has[T any](c *Client, lookup func(string) (*T, bool), ID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := lookup(ID)
+	return ok
+}
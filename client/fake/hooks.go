@@ -0,0 +1,227 @@
+package fake
+
+import (
+	"context"
+	"slices"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+type hook struct {
+	model.Hook
+	// secret confirms the subscription, as sent in the X-Hook-Secret header.
+	secret string
+}
+
+func defaultEventTypes() []model.HookEventType {
+	return []model.HookEventType{
+		{EventType: "study.status.change", Description: "Fired when the status of a study changes."},
+		{EventType: "submission.status.change", Description: "Fired when the status of a submission changes."},
+	}
+}
+
+// AddHookEvent seeds an event delivered to the subscription. It is given an
+// ID if it has none.
+func (c *Client) AddHookEvent(subscriptionID string, event model.HookEvent) model.HookEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if event.ID == "" {
+		event.ID = c.newID()
+	}
+	c.hookEvents[subscriptionID] = append(c.hookEvents[subscriptionID], event)
+
+	return event
+}
+
+// GetHooks lists the workspace's subscriptions that are enabled, or that
+// aren't.
+func (c *Client) GetHooks(ctx context.Context, workspaceID string, enabled bool, limit, offset int) (*client.ListHooksResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.Hook{}
+	for _, h := range c.hooks {
+		if h.WorkspaceID == workspaceID && h.IsEnabled == enabled {
+			results = append(results, h.Hook)
+		}
+	}
+
+	return &client.ListHooksResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// GetHookEventTypes lists the event types subscriptions can be made for.
+func (c *Client) GetHookEventTypes(ctx context.Context) (*client.ListHookEventTypesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &client.ListHookEventTypesResponse{Results: slices.Clone(c.eventTypes)}, nil
+}
+
+// GetHookSecrets lists the workspace's secrets.
+func (c *Client) GetHookSecrets(ctx context.Context, workspaceID string) (*client.ListSecretsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.Secret{}
+	for _, s := range c.secrets {
+		if s.WorkspaceID == workspaceID {
+			results = append(results, *s)
+		}
+	}
+
+	return &client.ListSecretsResponse{Results: results}, nil
+}
+
+// CreateHookSecret creates the workspace's secret, replacing any it had.
+func (c *Client) CreateHookSecret(ctx context.Context, payload client.CreateSecretPayload) (*model.Secret, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(payload.WorkspaceID); !ok {
+		return nil, notFound("workspace", payload.WorkspaceID)
+	}
+
+	c.secrets = slices.DeleteFunc(c.secrets, func(s *model.Secret) bool {
+		return s.WorkspaceID == payload.WorkspaceID
+	})
+
+	secret := model.Secret{
+		ID:          c.newID(),
+		Value:       "secret-" + c.newID(),
+		WorkspaceID: payload.WorkspaceID,
+	}
+	c.secrets = append(c.secrets, &secret)
+
+	return &secret, nil
+}
+
+// GetEvents lists the events seeded for the subscription with AddHookEvent.
+func (c *Client) GetEvents(ctx context.Context, subscriptionID string, limit, offset int) (*client.ListHookEventsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findHook(subscriptionID); !ok {
+		return nil, notFound("subscription", subscriptionID)
+	}
+
+	results := slices.Clone(c.hookEvents[subscriptionID])
+	if results == nil {
+		results = []model.HookEvent{}
+	}
+
+	return &client.ListHookEventsResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// CreateHookSubscription creates a disabled subscription, returning the
+// secret ConfirmHookSubscription needs to enable it. The workspace must
+// already have a secret.
+func (c *Client) CreateHookSubscription(ctx context.Context, payload client.CreateHookPayload) (*model.Hook, string, error) {
+	if payload.TargetURL == "" {
+		return nil, "", required("target_url")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(payload.WorkspaceID); !ok {
+		return nil, "", notFound("workspace", payload.WorkspaceID)
+	}
+	if !c.isEventType(payload.EventType) {
+		return nil, "", badRequest("%q is not a valid event type", payload.EventType)
+	}
+	if _, ok := find(c.secrets, func(s *model.Secret) bool { return s.WorkspaceID == payload.WorkspaceID }); !ok {
+		return nil, "", badRequest("workspace %s has no secret; create one first", payload.WorkspaceID)
+	}
+
+	h := &hook{
+		Hook: model.Hook{
+			ID:          c.newID(),
+			EventType:   payload.EventType,
+			TargetURL:   payload.TargetURL,
+			WorkspaceID: payload.WorkspaceID,
+		},
+		secret: "confirm-" + c.newID(),
+	}
+	c.hooks = append(c.hooks, h)
+
+	created := h.Hook
+	return &created, h.secret, nil
+}
+
+// ConfirmHookSubscription enables the subscription if secret is the one
+// CreateHookSubscription returned.
+func (c *Client) ConfirmHookSubscription(ctx context.Context, subscriptionID, secret string) (*model.Hook, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h, ok := c.findHook(subscriptionID)
+	if !ok {
+		return nil, notFound("subscription", subscriptionID)
+	}
+	if secret != h.secret {
+		return nil, badRequest("the secret does not match the subscription")
+	}
+
+	h.IsEnabled = true
+
+	confirmed := h.Hook
+	return &confirmed, nil
+}
+
+// UpdateHookSubscription applies the fields set in payload.
+func (c *Client) UpdateHookSubscription(ctx context.Context, subscriptionID string, payload client.UpdateHookPayload) (*model.Hook, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h, ok := c.findHook(subscriptionID)
+	if !ok {
+		return nil, notFound("subscription", subscriptionID)
+	}
+
+	if payload.EventType != nil {
+		if !c.isEventType(*payload.EventType) {
+			return nil, badRequest("%q is not a valid event type", *payload.EventType)
+		}
+		h.EventType = *payload.EventType
+	}
+	if payload.TargetURL != nil {
+		h.TargetURL = *payload.TargetURL
+	}
+	if payload.IsEnabled != nil {
+		h.IsEnabled = *payload.IsEnabled
+	}
+
+	updated := h.Hook
+	return &updated, nil
+}
+
+// DeleteHookSubscription deletes the subscription and its events.
+func (c *Client) DeleteHookSubscription(ctx context.Context, subscriptionID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findHook(subscriptionID); !ok {
+		return notFound("subscription", subscriptionID)
+	}
+
+	c.hooks = slices.DeleteFunc(c.hooks, func(h *hook) bool { return h.ID == subscriptionID })
+	delete(c.hookEvents, subscriptionID)
+
+	return nil
+}
+
+func (c *Client) findHook(ID string) (*hook, bool) {
+	return find(c.hooks, func(h *hook) bool { return h.ID == ID })
+}
+
+func (c *Client) isEventType(eventType string) bool {
+	return slices.ContainsFunc(c.eventTypes, func(t model.HookEventType) bool { return t.EventType == eventType })
+}
@@ -0,0 +1,171 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+type message struct {
+	model.Message
+	recipientID string
+	// unread is set on messages sent to the current user.
+	unread bool
+}
+
+// AddMessage seeds a message sent to the current user, such as a reply from
+// a participant. It is given an ID and time if it has none, and is listed by
+// GetUnreadMessages.
+func (c *Client) AddMessage(m model.Message) model.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if m.ID == "" {
+		m.ID = c.newID()
+	}
+	if m.DatetimeCreated.IsZero() {
+		m.DatetimeCreated = c.now()
+	}
+	if m.ChannelID == "" {
+		m.ChannelID = m.GetSenderID()
+	}
+	c.messages = append(c.messages, &message{Message: m, recipientID: c.me.ID, unread: true})
+
+	return m
+}
+
+// GetMessages lists the messages sent to or from userID, created after
+// createdAfter (YYYY-MM-DD or RFC 3339), or both. One of them is required.
+func (c *Client) GetMessages(ctx context.Context, userID *string, createdAfter *string) (*client.ListMessagesResponse, error) {
+	if userID == nil && createdAfter == nil {
+		return nil, errors.New("either userID or createdAfter must be provided")
+	}
+
+	var after time.Time
+	if createdAfter != nil {
+		var err error
+		after, err = time.Parse(time.DateOnly, *createdAfter)
+		if err != nil {
+			after, err = time.Parse(time.RFC3339, *createdAfter)
+		}
+		if err != nil {
+			return nil, badRequest("created_after: %q is not a valid date", *createdAfter)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.Message{}
+	for _, m := range c.messages {
+		if userID != nil && m.GetSenderID() != *userID && m.recipientID != *userID {
+			continue
+		}
+		if createdAfter != nil && !m.DatetimeCreated.After(after) {
+			continue
+		}
+		results = append(results, m.Message)
+	}
+
+	return &client.ListMessagesResponse{
+		Results:     results,
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// SendMessage sends a message to the participant.
+func (c *Client) SendMessage(ctx context.Context, body, recipientID, studyID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.send(body, []string{recipientID}, studyID)
+}
+
+// GetUnreadMessages lists the messages seeded with AddMessage.
+func (c *Client) GetUnreadMessages(ctx context.Context) (*client.ListUnreadMessagesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.Message{}
+	for _, m := range c.messages {
+		if m.unread {
+			results = append(results, m.Message)
+		}
+	}
+
+	return &client.ListUnreadMessagesResponse{
+		Results:     results,
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// BulkSendMessage sends the same message to each participant.
+func (c *Client) BulkSendMessage(ctx context.Context, ids []string, body, studyID string) error {
+	if len(ids) == 0 {
+		return required("ids")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.send(body, ids, studyID)
+}
+
+// SendGroupMessage sends the message to each member of the group.
+func (c *Client) SendGroupMessage(ctx context.Context, participantGroupID, body string, studyID *string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, ok := c.findGroup(participantGroupID)
+	if !ok {
+		return notFound("participant group", participantGroupID)
+	}
+
+	var ids []string
+	for _, m := range g.members {
+		ids = append(ids, m.ParticipantID)
+	}
+	if len(ids) == 0 {
+		return badRequest("participant group %s has no members", participantGroupID)
+	}
+
+	study := ""
+	if studyID != nil {
+		study = *studyID
+	}
+	return c.send(body, ids, study)
+}
+
+// send records a message from the current user to each recipient.
+func (c *Client) send(body string, recipientIDs []string, studyID string) error {
+	if body == "" {
+		return required("body")
+	}
+	if studyID != "" {
+		if _, ok := c.findStudy(studyID); !ok {
+			return notFound("study", studyID)
+		}
+	}
+
+	for _, recipientID := range recipientIDs {
+		m := &message{
+			Message: model.Message{
+				ID:              c.newID(),
+				SenderID:        c.me.ID,
+				Body:            body,
+				DatetimeCreated: c.now(),
+				ChannelID:       recipientID,
+			},
+			recipientID: recipientID,
+		}
+		if studyID != "" {
+			m.Data = &model.MessageData{StudyID: studyID}
+		}
+		c.messages = append(c.messages, m)
+	}
+
+	return nil
+}
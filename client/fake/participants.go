@@ -0,0 +1,246 @@
+package fake
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+type participantGroup struct {
+	model.ParticipantGroup
+	members []model.ParticipantGroupMembership
+}
+
+// AddFilter seeds a filter GetFilters lists.
+func (c *Client) AddFilter(filter model.Filter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.filters = append(c.filters, filter)
+}
+
+// GetParticipantGroups lists the workspace's groups in the order they were
+// created.
+func (c *Client) GetParticipantGroups(ctx context.Context, workspaceID string, limit, offset int) (*client.ListParticipantGroupsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.ParticipantGroup{}
+	for _, g := range c.groups {
+		if g.WorkspaceID == workspaceID {
+			results = append(results, g.view())
+		}
+	}
+
+	return &client.ListParticipantGroupsResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// GetParticipantGroup lists the group's members.
+func (c *Client) GetParticipantGroup(ctx context.Context, groupID string) (*client.ViewParticipantGroupResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, ok := c.findGroup(groupID)
+	if !ok {
+		return nil, notFound("participant group", groupID)
+	}
+
+	return g.membership(), nil
+}
+
+// CreateParticipantGroup stores the group with any participants given.
+func (c *Client) CreateParticipantGroup(ctx context.Context, create model.CreateParticipantGroup) (*client.CreateParticipantGroupResponse, error) {
+	if create.Name == "" {
+		return nil, required("name")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(create.WorkspaceID); !ok {
+		return nil, notFound("workspace", create.WorkspaceID)
+	}
+
+	g := &participantGroup{
+		ParticipantGroup: model.ParticipantGroup{
+			ID:          c.newID(),
+			Name:        create.Name,
+			WorkspaceID: create.WorkspaceID,
+			Description: create.Description,
+		},
+	}
+	g.add(create.ParticipantIDs, c.now())
+	c.groups = append(c.groups, g)
+
+	return &client.CreateParticipantGroupResponse{ParticipantGroup: g.view()}, nil
+}
+
+// AddParticipantGroupMembers adds the participants to the group, returning
+// its members. It isn't part of client.API, but mirrors the method on
+// client.Client.
+func (c *Client) AddParticipantGroupMembers(ctx context.Context, groupID string, participantIDs []string) (*client.ViewParticipantGroupResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, ok := c.findGroup(groupID)
+	if !ok {
+		return nil, notFound("participant group", groupID)
+	}
+	g.add(participantIDs, c.now())
+
+	return g.membership(), nil
+}
+
+// RemoveParticipantGroupMembers removes the participants from the group,
+// returning the members left.
+func (c *Client) RemoveParticipantGroupMembers(ctx context.Context, groupID string, participantIDs []string) (*client.ViewParticipantGroupResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, ok := c.findGroup(groupID)
+	if !ok {
+		return nil, notFound("participant group", groupID)
+	}
+	g.members = slices.DeleteFunc(g.members, func(m model.ParticipantGroupMembership) bool {
+		return slices.Contains(participantIDs, m.ParticipantID)
+	})
+
+	return g.membership(), nil
+}
+
+// CreateTestParticipant returns the ID of a new test participant.
+func (c *Client) CreateTestParticipant(ctx context.Context, email string) (*client.CreateTestParticipantResponse, error) {
+	if email == "" {
+		return nil, required("email")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	participantID := c.newID()
+	c.participants = append(c.participants, participantID)
+
+	return &client.CreateTestParticipantResponse{ParticipantID: participantID}, nil
+}
+
+// GetFilters lists the filters seeded with AddFilter.
+func (c *Client) GetFilters(ctx context.Context) (*client.ListFiltersResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := slices.Clone(c.filters)
+	if results == nil {
+		results = []model.Filter{}
+	}
+
+	return &client.ListFiltersResponse{
+		Results:     results,
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// GetRewardRecommendations returns a fixed set of rates, in minor units per
+// hour, in the currency asked for or the account's own.
+func (c *Client) GetRewardRecommendations(ctx context.Context, workspaceID, currency string, screenerIDs []string) (*client.RewardRecommendationsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(workspaceID); !ok {
+		return nil, notFound("workspace", workspaceID)
+	}
+	if currency == "" {
+		currency = c.me.CurrencyCode
+	}
+
+	return &client.RewardRecommendationsResponse{
+		{Currency: currency, MinRewardPerHour: 600, RecommendedRewardPerHour: 900},
+	}, nil
+}
+
+// GetFilterSets lists the workspace's filter sets in the order they were
+// created.
+func (c *Client) GetFilterSets(ctx context.Context, workspaceID string, limit, offset int) (*client.ListFilterSetsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.FilterSet{}
+	for _, fs := range c.filterSets {
+		if fs.WorkspaceID == workspaceID {
+			results = append(results, *fs)
+		}
+	}
+
+	return &client.ListFilterSetsResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// GetFilterSet returns the filter set.
+func (c *Client) GetFilterSet(ctx context.Context, ID string) (*model.FilterSet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fs, ok := find(c.filterSets, func(fs *model.FilterSet) bool { return fs.ID == ID })
+	if !ok {
+		return nil, notFound("filter set", ID)
+	}
+
+	filterSet := *fs
+	return &filterSet, nil
+}
+
+// CreateFilterSet stores the filter set at version 1.
+func (c *Client) CreateFilterSet(ctx context.Context, create model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
+	if create.Name == "" {
+		return nil, required("name")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(create.WorkspaceID); !ok {
+		return nil, notFound("workspace", create.WorkspaceID)
+	}
+
+	fs := &model.FilterSet{
+		ID:             c.newID(),
+		Name:           create.Name,
+		OrganisationID: create.OrganisationID,
+		WorkspaceID:    create.WorkspaceID,
+		Version:        1,
+		Filters:        create.Filters,
+	}
+	c.filterSets = append(c.filterSets, fs)
+
+	return &client.CreateFilterSetResponse{FilterSet: *fs}, nil
+}
+
+func (c *Client) findGroup(ID string) (*participantGroup, bool) {
+	return find(c.groups, func(g *participantGroup) bool { return g.ID == ID })
+}
+
+// add makes the participants members, skipping any that already are.
+func (g *participantGroup) add(participantIDs []string, now time.Time) {
+	for _, ID := range participantIDs {
+		if slices.ContainsFunc(g.members, func(m model.ParticipantGroupMembership) bool { return m.ParticipantID == ID }) {
+			continue
+		}
+		g.members = append(g.members, model.ParticipantGroupMembership{ParticipantID: ID, DatetimeCreated: now})
+	}
+}
+
+func (g *participantGroup) view() model.ParticipantGroup {
+	view := g.ParticipantGroup
+	view.ParticipantCount = len(g.members)
+	return view
+}
+
+func (g *participantGroup) membership() *client.ViewParticipantGroupResponse {
+	return &client.ViewParticipantGroupResponse{Results: slices.Clone(g.members)}
+}
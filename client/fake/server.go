@@ -0,0 +1,631 @@
+package fake
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// Handler serves the fake over HTTP at the API's /api/v1/ routes, so the CLI
+// or any other API client can be pointed at it. Requests need no token.
+//
+// It also accepts dataset uploads at /uploads/, counting the datapoints in
+// each file; set UploadBaseURL to the server's URL followed by "/uploads" for
+// upload URLs to point there.
+func (c *Client) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	// Users
+	mux.HandleFunc("GET /api/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetMe(r.Context()))
+	})
+
+	// Studies
+	mux.HandleFunc("POST /api/v1/studies", func(w http.ResponseWriter, r *http.Request) {
+		var create model.CreateStudy
+		if decode(w, r, &create) {
+			reply(w, http.StatusCreated)(c.CreateStudy(r.Context(), create))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/studies", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetStudies(r.Context(), studyStatus(r), "", limit, offset))
+	})
+	mux.HandleFunc("GET /api/v1/projects/{id}/studies", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetStudies(r.Context(), studyStatus(r), r.PathValue("id"), limit, offset))
+	})
+	mux.HandleFunc("GET /api/v1/studies/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetStudy(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("PATCH /api/v1/studies/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var update map[string]any
+		if decode(w, r, &update) {
+			reply(w, http.StatusOK)(c.UpdateStudy(r.Context(), r.PathValue("id"), update))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/studies/{id}/clone", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.DuplicateStudy(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("POST /api/v1/studies/{id}/transition", func(w http.ResponseWriter, r *http.Request) {
+		var transition struct {
+			Action string `json:"action"`
+		}
+		if decode(w, r, &transition) {
+			reply(w, http.StatusOK)(c.TransitionStudy(r.Context(), r.PathValue("id"), transition.Action))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/studies/{id}/test-study", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.TestStudy(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/studies/{id}/credentials/report", func(w http.ResponseWriter, r *http.Request) {
+		replyCSV(w)(c.GetStudyCredentialsUsageReportCSV(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("POST /api/v1/studies/{id}/demographic-export", func(w http.ResponseWriter, r *http.Request) {
+		replyCSV(w)(c.ExportDemographics(r.Context(), r.PathValue("id")))
+	})
+
+	// Submissions
+	mux.HandleFunc("GET /api/v1/studies/{id}/submissions", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetSubmissions(r.Context(), r.PathValue("id"), limit, offset))
+	})
+	mux.HandleFunc("GET /api/v1/studies/{id}/submissions/counts", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetStudySubmissionCounts(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("POST /api/v1/submissions/{id}/transition", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.TransitionSubmissionPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusOK)(c.TransitionSubmission(r.Context(), r.PathValue("id"), payload))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/submissions/{id}/request-return", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.RequestSubmissionReturnPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusOK)(c.RequestSubmissionReturn(r.Context(), r.PathValue("id"), payload.Reasons))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/submissions/bulk-approve", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.BulkApproveSubmissionsPayload
+		if decode(w, r, &payload) {
+			replyEmpty(w, http.StatusOK, c.BulkApproveSubmissions(r.Context(), payload))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/submissions/bonus-payments", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.CreateBonusPaymentsPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusCreated)(c.CreateBonusPayments(r.Context(), payload))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/bulk-bonus-payments/{id}/pay", func(w http.ResponseWriter, r *http.Request) {
+		replyEmpty(w, http.StatusAccepted, c.PayBonusPayments(r.Context(), r.PathValue("id")))
+	})
+
+	// Workspaces and projects
+	mux.HandleFunc("GET /api/v1/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetWorkspaces(r.Context(), limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		var workspace model.Workspace
+		if decode(w, r, &workspace) {
+			reply(w, http.StatusCreated)(c.CreateWorkspace(r.Context(), workspace))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/workspaces/{id}/balance", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetWorkspaceBalance(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/workspaces/{id}/projects", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetProjects(r.Context(), r.PathValue("id"), limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/workspaces/{id}/projects", func(w http.ResponseWriter, r *http.Request) {
+		var project model.Project
+		if decode(w, r, &project) {
+			reply(w, http.StatusCreated)(c.CreateProject(r.Context(), r.PathValue("id"), project))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/projects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetProject(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("POST /api/v1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		var invitation model.CreateInvitation
+		if decode(w, r, &invitation) {
+			reply(w, http.StatusCreated)(c.CreateInvitation(r.Context(), invitation))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetCampaigns(r.Context(), r.URL.Query().Get("workspace_id"), limit, offset))
+	})
+
+	// Hooks
+	mux.HandleFunc("GET /api/v1/hooks/event-types", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetHookEventTypes(r.Context()))
+	})
+	mux.HandleFunc("GET /api/v1/hooks/secrets", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetHookSecrets(r.Context(), r.URL.Query().Get("workspace_id")))
+	})
+	mux.HandleFunc("POST /api/v1/hooks/secrets", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.CreateSecretPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusCreated)(c.CreateHookSecret(r.Context(), payload))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/hooks/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		enabled, _ := strconv.ParseBool(r.URL.Query().Get("is_enabled"))
+		reply(w, http.StatusOK)(c.GetHooks(r.Context(), r.URL.Query().Get("workspace_id"), enabled, limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/hooks/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.CreateHookPayload
+		if !decode(w, r, &payload) {
+			return
+		}
+		hook, secret, err := c.CreateHookSubscription(r.Context(), payload)
+		if err == nil {
+			w.Header().Set("X-Hook-Secret", secret)
+		}
+		reply(w, http.StatusCreated)(hook, err)
+	})
+	mux.HandleFunc("POST /api/v1/hooks/subscriptions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Secret string `json:"secret"`
+		}
+		if decode(w, r, &payload) {
+			reply(w, http.StatusOK)(c.ConfirmHookSubscription(r.Context(), r.PathValue("id"), payload.Secret))
+		}
+	})
+	mux.HandleFunc("PATCH /api/v1/hooks/subscriptions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.UpdateHookPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusOK)(c.UpdateHookSubscription(r.Context(), r.PathValue("id"), payload))
+		}
+	})
+	mux.HandleFunc("DELETE /api/v1/hooks/subscriptions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		replyEmpty(w, http.StatusNoContent, c.DeleteHookSubscription(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/hooks/subscriptions/{id}/events", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetEvents(r.Context(), r.PathValue("id"), limit, offset))
+	})
+
+	// Participant groups and filters
+	mux.HandleFunc("GET /api/v1/participant-groups", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetParticipantGroups(r.Context(), r.URL.Query().Get("workspace_id"), limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/participant-groups", func(w http.ResponseWriter, r *http.Request) {
+		var group model.CreateParticipantGroup
+		if decode(w, r, &group) {
+			reply(w, http.StatusCreated)(c.CreateParticipantGroup(r.Context(), group))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/participant-groups/{id}/participants", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetParticipantGroup(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("POST /api/v1/participant-groups/{id}/participants", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.AddParticipantGroupMembersPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusOK)(c.AddParticipantGroupMembers(r.Context(), r.PathValue("id"), payload.ParticipantIDs))
+		}
+	})
+	mux.HandleFunc("DELETE /api/v1/participant-groups/{id}/participants", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.RemoveParticipantGroupMembersPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusOK)(c.RemoveParticipantGroupMembers(r.Context(), r.PathValue("id"), payload.ParticipantIDs))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/researchers/participants", func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Email string `json:"email"`
+		}
+		if decode(w, r, &payload) {
+			reply(w, http.StatusCreated)(c.CreateTestParticipant(r.Context(), payload.Email))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/filters", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetFilters(r.Context()))
+	})
+	mux.HandleFunc("GET /api/v1/filter-sets", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetFilterSets(r.Context(), r.URL.Query().Get("workspace_id"), limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/filter-sets", func(w http.ResponseWriter, r *http.Request) {
+		var filterSet model.CreateFilterSet
+		if decode(w, r, &filterSet) {
+			reply(w, http.StatusCreated)(c.CreateFilterSet(r.Context(), filterSet))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/filter-sets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetFilterSet(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/reward-recommendations", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var screenerIDs []string
+		if ids := query.Get("screener_ids"); ids != "" {
+			screenerIDs = strings.Split(ids, ",")
+		}
+		reply(w, http.StatusOK)(c.GetRewardRecommendations(r.Context(), query.Get("workspace_id"), query.Get("currency"), screenerIDs))
+	})
+
+	// Surveys
+	mux.HandleFunc("GET /api/v1/surveys", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetSurveys(r.Context(), r.URL.Query().Get("researcher_id"), limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/surveys", func(w http.ResponseWriter, r *http.Request) {
+		var survey model.CreateSurvey
+		if decode(w, r, &survey) {
+			reply(w, http.StatusCreated)(c.CreateSurvey(r.Context(), survey))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/surveys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetSurvey(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("DELETE /api/v1/surveys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		replyEmpty(w, http.StatusNoContent, c.DeleteSurvey(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/surveys/{id}/responses", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetSurveyResponses(r.Context(), r.PathValue("id"), limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/surveys/{id}/responses", func(w http.ResponseWriter, r *http.Request) {
+		var response model.CreateSurveyResponseRequest
+		if decode(w, r, &response) {
+			reply(w, http.StatusCreated)(c.CreateSurveyResponse(r.Context(), r.PathValue("id"), response))
+		}
+	})
+	mux.HandleFunc("DELETE /api/v1/surveys/{id}/responses", func(w http.ResponseWriter, r *http.Request) {
+		replyEmpty(w, http.StatusNoContent, c.DeleteAllSurveyResponses(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/surveys/{id}/responses/summary", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetSurveyResponseSummary(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/surveys/{id}/responses/{response}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetSurveyResponse(r.Context(), r.PathValue("id"), r.PathValue("response")))
+	})
+	mux.HandleFunc("DELETE /api/v1/surveys/{id}/responses/{response}", func(w http.ResponseWriter, r *http.Request) {
+		replyEmpty(w, http.StatusNoContent, c.DeleteSurveyResponse(r.Context(), r.PathValue("id"), r.PathValue("response")))
+	})
+
+	// Messages
+	mux.HandleFunc("GET /api/v1/messages", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var userID, createdAfter *string
+		if query.Has("user_id") {
+			v := query.Get("user_id")
+			userID = &v
+		}
+		if query.Has("created_after") {
+			v := query.Get("created_after")
+			createdAfter = &v
+		}
+		reply(w, http.StatusOK)(c.GetMessages(r.Context(), userID, createdAfter))
+	})
+	mux.HandleFunc("POST /api/v1/messages", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.SendMessagePayload
+		if decode(w, r, &payload) {
+			replyEmpty(w, http.StatusCreated, c.SendMessage(r.Context(), payload.Body, payload.RecipientID, payload.StudyID))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/messages/unread", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetUnreadMessages(r.Context()))
+	})
+	mux.HandleFunc("POST /api/v1/messages/bulk", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.BulkSendMessagePayload
+		if decode(w, r, &payload) {
+			replyEmpty(w, http.StatusCreated, c.BulkSendMessage(r.Context(), payload.IDs, payload.Body, payload.StudyID))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/messages/participant-group", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.SendGroupMessagePayload
+		if !decode(w, r, &payload) {
+			return
+		}
+		var studyID *string
+		if payload.StudyID != "" {
+			studyID = &payload.StudyID
+		}
+		replyEmpty(w, http.StatusCreated, c.SendGroupMessage(r.Context(), payload.ParticipantGroupID, payload.Body, studyID))
+	})
+
+	// Credential pools
+	mux.HandleFunc("GET /api/v1/credentials", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.ListCredentialPools(r.Context(), r.URL.Query().Get("workspace_id")))
+	})
+	mux.HandleFunc("POST /api/v1/credentials", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.CredentialPoolPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusCreated)(c.CreateCredentialPool(r.Context(), payload.Credentials, payload.WorkspaceID))
+		}
+	})
+	mux.HandleFunc("PATCH /api/v1/credentials/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.UpdateCredentialPoolPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusOK)(c.UpdateCredentialPool(r.Context(), r.PathValue("id"), payload.Credentials))
+		}
+	})
+
+	// AI Task Builder collections
+	mux.HandleFunc("GET /api/v1/data-collection/collections", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetCollections(r.Context(), r.URL.Query().Get("workspace_id"), limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/data-collection/collections", func(w http.ResponseWriter, r *http.Request) {
+		var payload model.CreateAITaskBuilderCollection
+		if decode(w, r, &payload) {
+			reply(w, http.StatusCreated)(c.CreateAITaskBuilderCollection(r.Context(), payload))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/collections/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetCollection(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("PUT /api/v1/data-collection/collections/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var update model.UpdateCollection
+		if decode(w, r, &update) {
+			reply(w, http.StatusOK)(c.UpdateCollection(r.Context(), r.PathValue("id"), update))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/data-collection/collections/{id}/export", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.InitiateCollectionExport(r.Context(), r.PathValue("id"))
+		reply(w, exportStatus(err == nil && response.Status == exportComplete))(response, err)
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/collections/{id}/export/{export}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetCollectionExportStatus(r.Context(), r.PathValue("id"), r.PathValue("export")))
+	})
+
+	// AI Task Builder datasets
+	mux.HandleFunc("POST /api/v1/data-collection/datasets", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.CreateAITaskBuilderDatasetPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusCreated)(c.CreateAITaskBuilderDataset(r.Context(), payload.WorkspaceID, payload))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/datasets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderDataset(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/datasets/{id}/status", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderDatasetStatus(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/datasets/{id}/upload-url/{file}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusCreated)(c.GetAITaskBuilderDatasetUploadURL(r.Context(), r.PathValue("id"), r.PathValue("file")))
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/datasets/{id}/imports/{import}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderDatasetImportStatus(r.Context(), r.PathValue("id"), r.PathValue("import")))
+	})
+	mux.HandleFunc("PUT /uploads/datasets/{id}/imports/{import}/{file}", func(w http.ResponseWriter, r *http.Request) {
+		datapoints, err := countDatapoints(r.Body, path.Ext(r.PathValue("file")))
+		if err != nil {
+			writeError(w, badRequest("unable to read upload: %v", err))
+			return
+		}
+		replyEmpty(w, http.StatusOK, c.receiveUpload(r.PathValue("id"), r.PathValue("import"), datapoints))
+	})
+
+	// AI Task Builder batches
+	mux.HandleFunc("GET /api/v1/data-collection/batches", func(w http.ResponseWriter, r *http.Request) {
+		limit, offset := paging(r)
+		reply(w, http.StatusOK)(c.GetAITaskBuilderBatches(r.Context(), r.URL.Query().Get("workspace_id"), limit, offset))
+	})
+	mux.HandleFunc("POST /api/v1/data-collection/batches", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.CreateAITaskBuilderBatchPayload
+		if !decode(w, r, &payload) {
+			return
+		}
+		reply(w, http.StatusCreated)(c.CreateAITaskBuilderBatch(r.Context(), client.CreateBatchParams{
+			Name:             payload.Name,
+			WorkspaceID:      payload.WorkspaceID,
+			DatasetID:        payload.DatasetID,
+			TaskName:         payload.TaskDetails.TaskName,
+			TaskIntroduction: payload.TaskDetails.TaskIntroduction,
+			TaskSteps:        payload.TaskDetails.TaskSteps,
+			BatchItems:       payload.BatchItems,
+			AutoSync:         payload.AutoSync,
+		}))
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/batches/{id}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderBatch(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("PATCH /api/v1/data-collection/batches/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.UpdateAITaskBuilderBatchPayload
+		if !decode(w, r, &payload) {
+			return
+		}
+		reply(w, http.StatusOK)(c.UpdateAITaskBuilderBatch(r.Context(), client.UpdateBatchParams{
+			BatchID:     r.PathValue("id"),
+			Name:        payload.Name,
+			DatasetID:   payload.DatasetID,
+			TaskDetails: payload.TaskDetails,
+			BatchItems:  payload.BatchItems,
+			AutoSync:    payload.AutoSync,
+		}))
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/batches/{id}/status", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderBatchStatus(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("POST /api/v1/data-collection/batches/{id}/instructions", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.CreateAITaskBuilderInstructionsPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusCreated)(c.CreateAITaskBuilderInstructions(r.Context(), r.PathValue("id"), payload))
+		}
+	})
+	mux.HandleFunc("POST /api/v1/data-collection/batches/{id}/setup", func(w http.ResponseWriter, r *http.Request) {
+		var payload client.SetupAITaskBuilderBatchPayload
+		if decode(w, r, &payload) {
+			reply(w, http.StatusAccepted)(c.SetupAITaskBuilderBatch(r.Context(), r.PathValue("id"), payload.DatasetID, payload.TasksPerGroup))
+		}
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/batches/{id}/responses", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderResponses(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/batches/{id}/tasks", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderTasks(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/batches/{id}/task-groups", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderTaskGroups(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("POST /api/v1/data-collection/batches/{id}/export", func(w http.ResponseWriter, r *http.Request) {
+		response, err := c.InitiateBatchExport(r.Context(), r.PathValue("id"))
+		reply(w, exportStatus(err == nil && response.Status == exportComplete))(response, err)
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/batches/{id}/export/{export}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetBatchExportStatus(r.Context(), r.PathValue("id"), r.PathValue("export")))
+	})
+	mux.HandleFunc("POST /api/v1/data-collection/batches/{id}/sync", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusAccepted)(c.SyncAITaskBuilderBatch(r.Context(), r.PathValue("id")))
+	})
+	mux.HandleFunc("GET /api/v1/data-collection/batches/{id}/syncs/{sync}", func(w http.ResponseWriter, r *http.Request) {
+		reply(w, http.StatusOK)(c.GetAITaskBuilderBatchSyncStatus(r.Context(), r.PathValue("id"), r.PathValue("sync")))
+	})
+
+	// The client is inconsistent about trailing slashes, so routes are
+	// matched without them.
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.URL.Path) > 1 {
+			r.URL.Path = strings.TrimSuffix(r.URL.Path, "/")
+		}
+
+		_, pattern := mux.Handler(r)
+		if pattern == "" {
+			writeError(w, &client.APIError{StatusCode: http.StatusNotFound, Title: "Not found", Detail: fmt.Sprintf("%s %s is not served by the fake", r.Method, r.URL.Path)})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// reply returns a function that writes a call's result as JSON with status,
+// or its error the way the API reports errors.
+func reply(w http.ResponseWriter, status int) func(any, error) {
+	return func(v any, err error) {
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+// replyEmpty writes status with no body, or the error.
+func replyEmpty(w http.ResponseWriter, status int, err error) {
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+// replyCSV returns a function that writes a call's CSV result, or its error.
+func replyCSV(w http.ResponseWriter) func(string, error) {
+	return func(csv string, err error) {
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = io.WriteString(w, csv)
+	}
+}
+
+// writeError writes err in the API's nested error format. Errors other than
+// an APIError come from validating arguments, so are reported as a 400.
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		apiErr = &client.APIError{StatusCode: http.StatusBadRequest, Title: "Bad request", Detail: err.Error()}
+	}
+
+	var detail any = apiErr.Detail
+	if len(apiErr.FieldErrors) > 0 {
+		detail = apiErr.FieldErrors
+	}
+
+	var body client.JSONAPIError
+	body.Error.ErrorCode = apiErr.ErrorCode
+	body.Error.Title = apiErr.Title
+	body.Error.Detail = detail
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.StatusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decode reads the request body into v, replying with a 400 and returning
+// false if it isn't valid JSON.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, badRequest("invalid JSON body: %v", err))
+		return false
+	}
+	return true
+}
+
+// paging reads the limit and offset query parameters, falling back to the
+// client's defaults.
+func paging(r *http.Request) (limit, offset int) {
+	limit, offset = client.DefaultRecordLimit, client.DefaultRecordOffset
+	if v, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil {
+		limit = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil {
+		offset = v
+	}
+	return limit, offset
+}
+
+// studyStatus turns the client's status filter, such as "active=1" or
+// "published=0", back into the status it was built from.
+func studyStatus(r *http.Request) string {
+	query := r.URL.Query()
+	if query.Get("published") == "0" {
+		return model.StatusUnpublished
+	}
+	for _, status := range model.StudyListStatus {
+		if query.Get(status) == "1" {
+			return status
+		}
+	}
+	return model.StatusAll
+}
+
+// exportStatus is the status the API starts an export with: 200 when a
+// completed export is reused, otherwise 202.
+func exportStatus(complete bool) int {
+	if complete {
+		return http.StatusOK
+	}
+	return http.StatusAccepted
+}
+
+// countDatapoints counts the rows of a CSV file after its header, or the
+// non-blank lines of a JSONL file.
+func countDatapoints(r io.Reader, ext string) (int, error) {
+	rows := 0
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) != "" {
+			rows++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	if strings.EqualFold(ext, ".csv") && rows > 0 {
+		rows--
+	}
+	return rows, nil
+}
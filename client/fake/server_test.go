@@ -0,0 +1,93 @@
+package fake_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/client/fake"
+	"github.com/prolific-oss/cli/model"
+	"github.com/stretchr/testify/require"
+)
+
+// serve starts the fake's handler and returns a real client pointed at it.
+func serve(t *testing.T, f *fake.Client) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(f.Handler())
+	t.Cleanup(server.Close)
+	f.UploadBaseURL = server.URL + "/uploads"
+
+	return &client.Client{Client: server.Client(), BaseURL: server.URL, Token: "dev"}
+}
+
+func TestHandlerServesStudyWorkflow(t *testing.T) {
+	ctx := context.Background()
+	c := serve(t, fake.New())
+
+	study, err := c.CreateStudy(ctx, model.CreateStudy{Name: "Eggs", TotalAvailablePlaces: 5, Reward: 100})
+	require.NoError(t, err)
+
+	_, err = c.TransitionStudy(ctx, study.ID, model.TransitionStudyPublish)
+	require.NoError(t, err)
+
+	active, err := c.GetStudies(ctx, model.StatusActive, "", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	require.NoError(t, err)
+	require.Len(t, active.Results, 1)
+	require.Equal(t, study.ID, active.Results[0].ID)
+
+	unpublished, err := c.GetStudies(ctx, model.StatusUnpublished, "", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	require.NoError(t, err)
+	require.Empty(t, unpublished.Results)
+}
+
+func TestHandlerReportsErrorsLikeTheAPI(t *testing.T) {
+	ctx := context.Background()
+	c := serve(t, fake.New())
+
+	_, err := c.GetStudy(ctx, "missing")
+	requireStatus(t, err, http.StatusNotFound)
+
+	_, err = c.CreateStudy(ctx, model.CreateStudy{})
+	requireStatus(t, err, http.StatusBadRequest)
+}
+
+func TestHandlerCountsUploadedDatapoints(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+	c := serve(t, f)
+
+	workspace := f.AddWorkspace(model.Workspace{Title: "Lab"})
+	dataset, err := c.CreateAITaskBuilderDataset(ctx, workspace.ID, client.CreateAITaskBuilderDatasetPayload{Name: "Prompts"})
+	require.NoError(t, err)
+
+	upload, err := c.GetAITaskBuilderDatasetUploadURL(ctx, dataset.ID, "prompts.csv")
+	require.NoError(t, err)
+
+	request, err := http.NewRequestWithContext(ctx, upload.HTTPMethod, upload.UploadURL, strings.NewReader("prompt\none\ntwo\nthree\n"))
+	require.NoError(t, err)
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	response.Body.Close()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	job, err := c.GetAITaskBuilderDatasetImportStatus(ctx, dataset.ID, upload.ImportID)
+	require.NoError(t, err)
+	for job.Status != model.DatasetImportJobStatusComplete {
+		job, err = c.GetAITaskBuilderDatasetImportStatus(ctx, dataset.ID, upload.ImportID)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, *job.AcceptedCount)
+}
+
+func TestSeedRequiresReferencedResources(t *testing.T) {
+	f := fake.New()
+
+	err := f.Seed(fake.Fixtures{
+		Projects: []model.Project{{Title: "Orphan", Workspace: "missing"}},
+	})
+	require.ErrorContains(t, err, `workspace "missing" not found`)
+}
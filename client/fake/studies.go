@@ -0,0 +1,364 @@
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// Study statuses as the API reports them.
+const (
+	studyUnpublished    = "UNPUBLISHED"
+	studyActive         = "ACTIVE"
+	studyPaused         = "PAUSED"
+	studyAwaitingReview = "AWAITING REVIEW"
+	studyCompleted      = "COMPLETED"
+)
+
+// studyTransitions maps each study action to the statuses it can be taken
+// from and the status it leads to.
+var studyTransitions = map[string]struct {
+	from []string
+	to   string
+}{
+	model.TransitionStudyPublish: {from: []string{studyUnpublished}, to: studyActive},
+	model.TransitionStudyPause:   {from: []string{studyActive}, to: studyPaused},
+	model.TransitionStudyStart:   {from: []string{studyPaused}, to: studyActive},
+	model.TransitionStudyStop:    {from: []string{studyActive, studyPaused}, to: studyAwaitingReview},
+}
+
+// AddStudy seeds a study as it would come back from the API, for example
+// one that is already active. It is given an ID if it has none, and is
+// unpublished if it has no status.
+func (c *Client) AddStudy(study model.Study) model.Study {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if study.ID == "" {
+		study.ID = c.newID()
+	}
+	if study.Status == "" {
+		study.Status = studyUnpublished
+	}
+	if study.DateCreated.IsZero() {
+		study.DateCreated = c.now()
+	}
+	c.studies = append(c.studies, &study)
+
+	return c.studyView(&study)
+}
+
+// CreateStudy stores the study as unpublished.
+func (c *Client) CreateStudy(ctx context.Context, create model.CreateStudy) (*model.Study, error) {
+	if create.Name == "" {
+		return nil, required("name")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if create.Project != "" {
+		if _, ok := c.findProject(create.Project); !ok {
+			return nil, notFound("project", create.Project)
+		}
+	}
+	if create.CredentialPoolID != "" {
+		if _, ok := c.findPool(create.CredentialPoolID); !ok {
+			return nil, notFound("credential pool", create.CredentialPoolID)
+		}
+	}
+
+	// The create and read models share their JSON names, so a round trip
+	// carries over every field the two have in common.
+	var study model.Study
+	if err := convert(create, &study); err != nil {
+		return nil, err
+	}
+
+	study.ID = c.newID()
+	study.Status = studyUnpublished
+	study.DateCreated = c.now()
+	study.StudyType = "SINGLE"
+	study.CurrencyCode = c.me.CurrencyCode
+	study.Researcher.ID = c.me.ID
+	study.Researcher.Name = c.me.Name
+	study.Researcher.Email = c.me.Email
+	study.TotalCost = study.Reward * float64(study.TotalAvailablePlaces)
+
+	c.studies = append(c.studies, &study)
+	c.studyProjects[study.ID] = create.Project
+
+	view := c.studyView(&study)
+	return &view, nil
+}
+
+// DuplicateStudy stores an unpublished copy of the study.
+func (c *Client) DuplicateStudy(ctx context.Context, ID string) (*model.Study, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	original, ok := c.findStudy(ID)
+	if !ok {
+		return nil, notFound("study", ID)
+	}
+
+	study := *original
+	study.ID = c.newID()
+	study.Status = studyUnpublished
+	study.DateCreated = c.now()
+	study.PublishedAt = nil
+	study.PlacesTaken = 0
+
+	c.studies = append(c.studies, &study)
+	c.studyProjects[study.ID] = c.studyProjects[ID]
+
+	view := c.studyView(&study)
+	return &view, nil
+}
+
+// GetStudies lists the studies in the order they were created, filtered the
+// way the API filters them.
+func (c *Client) GetStudies(ctx context.Context, status, projectID string, limit, offset int) (*client.ListStudiesResponse, error) {
+	if status != "" && status != model.StatusAll && !slices.Contains(model.StudyListStatus, status) {
+		return nil, fmt.Errorf("%s is not a valid status: %s", status, strings.Join(model.StudyListStatus, ", "))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if projectID != "" {
+		if _, ok := c.findProject(projectID); !ok {
+			return nil, notFound("project", projectID)
+		}
+	}
+
+	results := []model.Study{}
+	for _, study := range c.studies {
+		if projectID != "" && c.studyProjects[study.ID] != projectID {
+			continue
+		}
+		if status != "" && status != model.StatusAll && !strings.EqualFold(study.Status, status) {
+			continue
+		}
+		results = append(results, c.studyView(study))
+	}
+
+	return &client.ListStudiesResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// GetStudy returns the study, with its places taken counted from its
+// submissions.
+func (c *Client) GetStudy(ctx context.Context, ID string) (*model.Study, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	study, ok := c.findStudy(ID)
+	if !ok {
+		return nil, notFound("study", ID)
+	}
+
+	view := c.studyView(study)
+	return &view, nil
+}
+
+// TransitionStudy moves the study to the status the action leads to, failing
+// if the action can't be taken from its current status.
+func (c *Client) TransitionStudy(ctx context.Context, ID, action string) (*client.TransitionStudyResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	study, ok := c.findStudy(ID)
+	if !ok {
+		return nil, notFound("study", ID)
+	}
+
+	transition, ok := studyTransitions[action]
+	if !ok {
+		return nil, badRequest("%q is not a valid action", action)
+	}
+	if !slices.Contains(transition.from, study.Status) {
+		return nil, badRequest("cannot %s a study that is %s", strings.ToLower(action), strings.ToLower(study.Status))
+	}
+
+	study.Status = transition.to
+	if action == model.TransitionStudyPublish {
+		study.PublishedAt = c.timestamp()
+	}
+	if action == model.TransitionStudyStop && !c.hasSubmissionsToReview(ID) {
+		study.Status = studyCompleted
+	}
+
+	return &client.TransitionStudyResponse{
+		ID:                      study.ID,
+		Name:                    study.Name,
+		InternalName:            study.InternalName,
+		Description:             study.Desc,
+		ExternalStudyURL:        study.ExternalStudyURL,
+		TotalAvailablePlaces:    study.TotalAvailablePlaces,
+		EstimatedCompletionTime: study.EstimatedCompletionTime,
+		MaximumAllowedTime:      study.MaximumAllowedTime,
+		Reward:                  int(study.Reward),
+		DeviceCompatibility:     study.DeviceCompatibility,
+		PeripheralRequirements:  study.PeripheralRequirements,
+		Status:                  study.Status,
+	}, nil
+}
+
+// UpdateStudy applies the fields set in study, which is anything that
+// marshals to the study's JSON, such as model.UpdateStudy.
+func (c *Client) UpdateStudy(ctx context.Context, ID string, study any) (*model.Study, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stored, ok := c.findStudy(ID)
+	if !ok {
+		return nil, notFound("study", ID)
+	}
+
+	updated := *stored
+	if err := convert(study, &updated); err != nil {
+		return nil, err
+	}
+	if updated.CredentialPoolID != stored.CredentialPoolID {
+		if _, ok := c.findPool(updated.CredentialPoolID); !ok {
+			return nil, notFound("credential pool", updated.CredentialPoolID)
+		}
+	}
+	updated.TotalCost = updated.Reward * float64(updated.TotalAvailablePlaces)
+	*stored = updated
+
+	view := c.studyView(stored)
+	return &view, nil
+}
+
+// GetStudySubmissionCounts counts the study's submissions by status.
+func (c *Client) GetStudySubmissionCounts(ctx context.Context, ID string) (*model.SubmissionCounts, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findStudy(ID); !ok {
+		return nil, notFound("study", ID)
+	}
+
+	counts := model.SubmissionCounts{}
+	for _, s := range c.studySubmissions(ID) {
+		switch s.Status {
+		case submissionActive:
+			counts.Active++
+		case submissionApproved:
+			counts.Approved++
+		case submissionAwaitingReview:
+			counts.AwaitingReview++
+		case submissionRejected:
+			counts.Rejected++
+		case submissionReserved:
+			counts.Reserved++
+		case submissionReturned:
+			counts.Returned++
+		case submissionTimedOut:
+			counts.TimedOut++
+		case submissionPartiallyApproved:
+			counts.PartiallyApproved++
+		case submissionScreenedOut:
+			counts.ScreenedOut++
+		}
+		counts.Total++
+	}
+
+	return &counts, nil
+}
+
+// GetStudyCredentialsUsageReportCSV reports which credentials the study's
+// participants were given, in submission order.
+func (c *Client) GetStudyCredentialsUsageReportCSV(ctx context.Context, ID string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	study, ok := c.findStudy(ID)
+	if !ok {
+		return "", notFound("study", ID)
+	}
+	pool, ok := c.findPool(study.CredentialPoolID)
+	if !ok {
+		return "", badRequest("Study does not have credentials configured")
+	}
+
+	var report strings.Builder
+	report.WriteString("Participant Id,Submission Id,Username,Status")
+	for i, s := range c.studySubmissions(ID) {
+		if i >= len(pool.credentials) {
+			break
+		}
+		username, _, _ := strings.Cut(pool.credentials[i], ",")
+		fmt.Fprintf(&report, "\n%s,%s,%s,%s", s.ParticipantID, s.ID, username, s.Status)
+	}
+
+	return report.String(), nil
+}
+
+// ExportDemographics returns the study's submissions as CSV.
+func (c *Client) ExportDemographics(ctx context.Context, ID string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findStudy(ID); !ok {
+		return "", notFound("study", ID)
+	}
+
+	var export strings.Builder
+	export.WriteString("Submission id,Participant id,Status\n")
+	for _, s := range c.studySubmissions(ID) {
+		fmt.Fprintf(&export, "%s,%s,%s\n", s.ID, s.ParticipantID, s.Status)
+	}
+
+	return export.String(), nil
+}
+
+// TestStudy returns a preview link for the study.
+func (c *Client) TestStudy(ctx context.Context, ID string) (*client.TestStudyResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findStudy(ID); !ok {
+		return nil, notFound("study", ID)
+	}
+
+	return &client.TestStudyResponse{
+		StudyID:  ID,
+		StudyURL: fmt.Sprintf("https://app.prolific.com/studies/%s/preview", ID),
+	}, nil
+}
+
+func (c *Client) findStudy(ID string) (*model.Study, bool) {
+	return find(c.studies, func(s *model.Study) bool { return s.ID == ID })
+}
+
+// studyView returns a copy of the study as the API would report it.
+func (c *Client) studyView(study *model.Study) model.Study {
+	view := *study
+	view.PlacesTaken = 0
+	for _, s := range c.studySubmissions(study.ID) {
+		if s.takesPlace() {
+			view.PlacesTaken++
+		}
+	}
+	return view
+}
+
+// convert copies from into to by way of JSON, leaving fields of to that from
+// doesn't have untouched.
+func convert(from, to any) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
@@ -0,0 +1,180 @@
+package fake_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/prolific-oss/cli/client/fake"
+	"github.com/prolific-oss/cli/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStudyTransitions(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         string
+		withSubmission bool
+		action         string
+		expected       string
+		expectedErr    int
+	}{
+		{name: "publish an unpublished study", status: "UNPUBLISHED", action: model.TransitionStudyPublish, expected: "ACTIVE"},
+		{name: "pause an active study", status: "ACTIVE", action: model.TransitionStudyPause, expected: "PAUSED"},
+		{name: "start a paused study", status: "PAUSED", action: model.TransitionStudyStart, expected: "ACTIVE"},
+		{name: "stop a study with nothing to review", status: "ACTIVE", action: model.TransitionStudyStop, expected: "COMPLETED"},
+		{name: "stop a study with submissions to review", status: "PAUSED", withSubmission: true, action: model.TransitionStudyStop, expected: "AWAITING REVIEW"},
+		{name: "pause an unpublished study", status: "UNPUBLISHED", action: model.TransitionStudyPause, expectedErr: http.StatusBadRequest},
+		{name: "start an active study", status: "ACTIVE", action: model.TransitionStudyStart, expectedErr: http.StatusBadRequest},
+		{name: "publish a completed study", status: "COMPLETED", action: model.TransitionStudyPublish, expectedErr: http.StatusBadRequest},
+		{name: "stop a completed study", status: "COMPLETED", action: model.TransitionStudyStop, expectedErr: http.StatusBadRequest},
+		{name: "unknown action", status: "ACTIVE", action: "EXPLODE", expectedErr: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := fake.New()
+
+			study := f.AddStudy(model.Study{Name: "Eggs", Status: tt.status, TotalAvailablePlaces: 1})
+			if tt.withSubmission {
+				f.AddSubmission(study.ID, model.Submission{})
+			}
+
+			response, err := f.TransitionStudy(ctx, study.ID, tt.action)
+			stored, getErr := f.GetStudy(ctx, study.ID)
+			require.NoError(t, getErr)
+
+			if tt.expectedErr != 0 {
+				requireStatus(t, err, tt.expectedErr)
+				require.Equal(t, tt.status, stored.Status, "a refused action should leave the study as it was")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, response.Status)
+			require.Equal(t, tt.expected, stored.Status)
+			if tt.action == model.TransitionStudyPublish {
+				require.NotNil(t, stored.PublishedAt)
+			}
+		})
+	}
+}
+
+func TestGetStudiesPaging(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+
+	var IDs []string
+	for range 5 {
+		IDs = append(IDs, f.AddStudy(model.Study{Name: "Eggs"}).ID)
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		offset   int
+		expected []string
+	}{
+		{name: "no limit returns everything", limit: 0, offset: 0, expected: IDs},
+		{name: "limit returns the first studies", limit: 2, offset: 0, expected: IDs[:2]},
+		{name: "offset skips studies", limit: 2, offset: 3, expected: IDs[3:5]},
+		{name: "limit past the end returns the rest", limit: 10, offset: 4, expected: IDs[4:]},
+		{name: "offset past the end returns nothing", limit: 2, offset: 5, expected: []string{}},
+		{name: "negative offset starts at the beginning", limit: 1, offset: -1, expected: IDs[:1]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			studies, err := f.GetStudies(ctx, model.StatusAll, "", tt.limit, tt.offset)
+			require.NoError(t, err)
+
+			got := []string{}
+			for _, s := range studies.Results {
+				got = append(got, s.ID)
+			}
+			require.Equal(t, tt.expected, got)
+			require.Equal(t, 5, studies.Meta.Count, "the count should be of every study, not the page")
+		})
+	}
+}
+
+func TestStudyErrors(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+	study := f.AddStudy(model.Study{Name: "Eggs"})
+
+	tests := []struct {
+		name          string
+		call          func() error
+		status        int
+		detail        string
+		invalidFields []string
+	}{
+		{
+			name:   "get an unknown study",
+			call:   func() error { _, err := f.GetStudy(ctx, "missing"); return err },
+			status: http.StatusNotFound,
+			detail: "study missing not found",
+		},
+		{
+			name:   "transition an unknown study",
+			call:   func() error { _, err := f.TransitionStudy(ctx, "missing", model.TransitionStudyPublish); return err },
+			status: http.StatusNotFound,
+			detail: "study missing not found",
+		},
+		{
+			name:   "update an unknown study",
+			call:   func() error { _, err := f.UpdateStudy(ctx, "missing", model.UpdateStudy{}); return err },
+			status: http.StatusNotFound,
+			detail: "study missing not found",
+		},
+		{
+			name:   "count the submissions of an unknown study",
+			call:   func() error { _, err := f.GetStudySubmissionCounts(ctx, "missing"); return err },
+			status: http.StatusNotFound,
+			detail: "study missing not found",
+		},
+		{
+			name:   "list the studies of an unknown project",
+			call:   func() error { _, err := f.GetStudies(ctx, model.StatusAll, "missing", 0, 0); return err },
+			status: http.StatusNotFound,
+			detail: "project missing not found",
+		},
+		{
+			name: "create a study in an unknown project",
+			call: func() error {
+				_, err := f.CreateStudy(ctx, model.CreateStudy{Name: "Eggs", Project: "missing"})
+				return err
+			},
+			status: http.StatusNotFound,
+			detail: "project missing not found",
+		},
+		{
+			name:          "create a study without a name",
+			call:          func() error { _, err := f.CreateStudy(ctx, model.CreateStudy{}); return err },
+			status:        http.StatusBadRequest,
+			detail:        "name: This field is required.",
+			invalidFields: []string{"name"},
+		},
+		{
+			name:   "use credentials a study doesn't have",
+			call:   func() error { _, err := f.GetStudyCredentialsUsageReportCSV(ctx, study.ID); return err },
+			status: http.StatusBadRequest,
+			detail: "Study does not have credentials configured",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := requireStatus(t, tt.call(), tt.status)
+			require.Equal(t, tt.detail, apiErr.Detail)
+
+			var fields []string
+			for field := range apiErr.FieldErrors {
+				fields = append(fields, field)
+			}
+			require.ElementsMatch(t, tt.invalidFields, fields)
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package fake
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// Submission statuses as the API reports them.
+const (
+	submissionActive            = "ACTIVE"
+	submissionApproved          = "APPROVED"
+	submissionAwaitingReview    = "AWAITING REVIEW"
+	submissionRejected          = "REJECTED"
+	submissionReserved          = "RESERVED"
+	submissionReturned          = "RETURNED"
+	submissionTimedOut          = "TIMED-OUT"
+	submissionPartiallyApproved = "PARTIALLY APPROVED"
+	submissionScreenedOut       = "SCREENED OUT"
+)
+
+// submissionTransitions maps each submission action to the statuses it can
+// be taken from and the status it leads to.
+var submissionTransitions = map[string]struct {
+	from []string
+	to   string
+}{
+	"APPROVE":    {from: []string{submissionAwaitingReview}, to: submissionApproved},
+	"COMPLETE":   {from: []string{submissionActive}, to: submissionAwaitingReview},
+	"REJECT":     {from: []string{submissionAwaitingReview}, to: submissionRejected},
+	"RETURN":     {from: []string{submissionActive, submissionAwaitingReview}, to: submissionReturned},
+	"SCREEN_OUT": {from: []string{submissionActive}, to: submissionScreenedOut},
+	"START":      {from: []string{submissionReserved}, to: submissionActive},
+	"UNREJECT":   {from: []string{submissionRejected}, to: submissionApproved},
+	"UNRETURN":   {from: []string{submissionReturned}, to: submissionAwaitingReview},
+}
+
+type submission struct {
+	model.Submission
+	studyID         string
+	returnRequested *string
+}
+
+// takesPlace reports whether the submission counts towards the study's
+// places taken.
+func (s *submission) takesPlace() bool {
+	return !slices.Contains([]string{submissionReturned, submissionTimedOut, submissionScreenedOut}, s.Status)
+}
+
+type bonus struct {
+	client.CreateBonusPaymentsResponse
+	payments []bonusPayment
+	paid     bool
+}
+
+type bonusPayment struct {
+	submission *submission
+	amount     float64
+}
+
+// AddSubmission seeds a submission to the study, as a participant taking
+// part would. It is given an ID if it has none, and is active if it has no
+// status.
+func (c *Client) AddSubmission(studyID string, s model.Submission) model.Submission {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if s.ID == "" {
+		s.ID = c.newID()
+	}
+	if s.ParticipantID == "" {
+		s.ParticipantID = c.newID()
+	}
+	if s.Status == "" {
+		s.Status = submissionActive
+	}
+	if s.StartedAt.IsZero() {
+		s.StartedAt = c.now()
+	}
+	if s.BonusPayments == nil {
+		s.BonusPayments = []any{}
+	}
+	stored := &submission{Submission: s, studyID: studyID}
+	c.submissions = append(c.submissions, stored)
+
+	return stored.view()
+}
+
+// GetSubmissions lists the study's submissions in the order they were added.
+func (c *Client) GetSubmissions(ctx context.Context, ID string, limit, offset int) (*client.ListSubmissionsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findStudy(ID); !ok {
+		return nil, notFound("study", ID)
+	}
+
+	results := []model.Submission{}
+	for _, s := range c.studySubmissions(ID) {
+		results = append(results, s.view())
+	}
+
+	return &client.ListSubmissionsResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// TransitionSubmission moves the submission to the status the action leads
+// to, failing if the action can't be taken from its current status.
+func (c *Client) TransitionSubmission(ctx context.Context, ID string, payload client.TransitionSubmissionPayload) (*client.TransitionSubmissionResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.findSubmission(ID)
+	if !ok {
+		return nil, notFound("submission", ID)
+	}
+
+	transition, ok := submissionTransitions[payload.Action]
+	if !ok {
+		return nil, badRequest("%q is not a valid action", payload.Action)
+	}
+	if payload.Action == "REJECT" && payload.Message == "" {
+		return nil, required("message")
+	}
+	if payload.Action == "REJECT" && payload.RejectionCategory == "" {
+		return nil, required("rejection_category")
+	}
+	if payload.Action == "COMPLETE" && payload.CompletionCode == "" {
+		return nil, required("completion_code")
+	}
+	if !slices.Contains(transition.from, s.Status) {
+		return nil, badRequest("cannot %s a submission that is %s", strings.ToLower(payload.Action), strings.ToLower(s.Status))
+	}
+
+	s.Status = transition.to
+	if payload.Action == "COMPLETE" {
+		s.StudyCode = payload.CompletionCode
+		s.CompletedAt = c.now()
+		s.IsComplete = true
+	}
+
+	response := &client.TransitionSubmissionResponse{
+		ID:          s.ID,
+		Participant: s.ParticipantID,
+		StartedAt:   s.StartedAt.Format(timeFormat),
+		Status:      s.Status,
+		StudyID:     s.studyID,
+	}
+	if s.IsComplete {
+		completedAt := s.CompletedAt.Format(timeFormat)
+		response.CompletedAt = &completedAt
+		response.EnteredCode = &s.StudyCode
+	}
+
+	return response, nil
+}
+
+// RequestSubmissionReturn records that the participant has been asked to
+// return the submission. Its status doesn't change until they do.
+func (c *Client) RequestSubmissionReturn(ctx context.Context, ID string, reasons []string) (*client.RequestSubmissionReturnResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.findSubmission(ID)
+	if !ok {
+		return nil, notFound("submission", ID)
+	}
+	if len(reasons) == 0 {
+		return nil, required("request_return_reasons")
+	}
+	if s.Status != submissionActive && s.Status != submissionAwaitingReview {
+		return nil, badRequest("cannot request the return of a submission that is %s", strings.ToLower(s.Status))
+	}
+
+	requested := c.timestamp()
+	s.returnRequested = &requested
+
+	return &client.RequestSubmissionReturnResponse{
+		ID:              s.ID,
+		Status:          s.Status,
+		Participant:     s.ParticipantID,
+		ReturnRequested: s.returnRequested,
+	}, nil
+}
+
+// BulkApproveSubmissions approves the submissions given by ID, or given by
+// participant within a study. Every one must be awaiting review, or none are
+// approved.
+func (c *Client) BulkApproveSubmissions(ctx context.Context, payload client.BulkApproveSubmissionsPayload) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var approve []*submission
+	switch {
+	case len(payload.SubmissionIDs) > 0:
+		for _, ID := range payload.SubmissionIDs {
+			s, ok := c.findSubmission(ID)
+			if !ok {
+				return notFound("submission", ID)
+			}
+			approve = append(approve, s)
+		}
+	case payload.StudyID != "" && len(payload.ParticipantIDs) > 0:
+		if _, ok := c.findStudy(payload.StudyID); !ok {
+			return notFound("study", payload.StudyID)
+		}
+		for _, participantID := range payload.ParticipantIDs {
+			s, ok := c.findParticipantSubmission(payload.StudyID, participantID)
+			if !ok {
+				return badRequest("participant %s has no submission in study %s", participantID, payload.StudyID)
+			}
+			approve = append(approve, s)
+		}
+	default:
+		return badRequest("provide submission_ids, or study_id and participant_ids")
+	}
+
+	for _, s := range approve {
+		if s.Status != submissionAwaitingReview {
+			return badRequest("cannot approve submission %s as it is %s", s.ID, strings.ToLower(s.Status))
+		}
+	}
+	for _, s := range approve {
+		s.Status = submissionApproved
+	}
+
+	return nil
+}
+
+// CreateBonusPayments sets up the bonuses in payload.CSVBonuses, each a
+// participant or submission ID and an amount in major units. They are paid,
+// and show on the submissions, once PayBonusPayments is called. The fake
+// charges no fees.
+func (c *Client) CreateBonusPayments(ctx context.Context, payload client.CreateBonusPaymentsPayload) (*client.CreateBonusPaymentsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findStudy(payload.StudyID); !ok {
+		return nil, notFound("study", payload.StudyID)
+	}
+
+	b := &bonus{}
+	for line := range strings.SplitSeq(strings.TrimSpace(payload.CSVBonuses), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		ID, value, ok := strings.Cut(line, ",")
+		if !ok {
+			return nil, badRequest("invalid bonus %q: expected id,amount", line)
+		}
+		ID = strings.TrimSpace(ID)
+		amount, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || amount <= 0 {
+			return nil, badRequest("invalid bonus amount %q", value)
+		}
+
+		s, ok := c.findParticipantSubmission(payload.StudyID, ID)
+		if !ok {
+			s, ok = c.findSubmission(ID)
+		}
+		if !ok || s.studyID != payload.StudyID {
+			return nil, badRequest("%s is not a participant or submission in study %s", ID, payload.StudyID)
+		}
+
+		minor := math.Round(amount * 100)
+		b.payments = append(b.payments, bonusPayment{submission: s, amount: minor})
+		b.Amount += minor
+	}
+	if len(b.payments) == 0 {
+		return nil, required("csv_bonuses")
+	}
+
+	b.ID = c.newID()
+	b.Study = payload.StudyID
+	b.TotalAmount = b.Amount + b.Fees + b.VAT
+	c.bonuses = append(c.bonuses, b)
+
+	response := b.CreateBonusPaymentsResponse
+	return &response, nil
+}
+
+// PayBonusPayments pays the bonuses set up by CreateBonusPayments, adding
+// each to its submission's bonus payments.
+func (c *Client) PayBonusPayments(ctx context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := find(c.bonuses, func(b *bonus) bool { return b.ID == id })
+	if !ok {
+		return notFound("bonus payment", id)
+	}
+	if b.paid {
+		return badRequest("bonus payment %s has already been paid", id)
+	}
+
+	for _, payment := range b.payments {
+		payment.submission.BonusPayments = append(payment.submission.BonusPayments, payment.amount)
+	}
+	b.paid = true
+
+	return nil
+}
+
+func (c *Client) findSubmission(ID string) (*submission, bool) {
+	return find(c.submissions, func(s *submission) bool { return s.ID == ID })
+}
+
+func (c *Client) findParticipantSubmission(studyID, participantID string) (*submission, bool) {
+	return find(c.submissions, func(s *submission) bool {
+		return s.studyID == studyID && s.ParticipantID == participantID
+	})
+}
+
+func (c *Client) studySubmissions(studyID string) []*submission {
+	var submissions []*submission
+	for _, s := range c.submissions {
+		if s.studyID == studyID {
+			submissions = append(submissions, s)
+		}
+	}
+	return submissions
+}
+
+func (c *Client) hasSubmissionsToReview(studyID string) bool {
+	for _, s := range c.studySubmissions(studyID) {
+		if s.Status == submissionActive || s.Status == submissionAwaitingReview {
+			return true
+		}
+	}
+	return false
+}
+
+// view returns a copy of the submission that doesn't share its bonus
+// payments with the store.
+func (s *submission) view() model.Submission {
+	view := s.Submission
+	view.BonusPayments = slices.Clone(s.BonusPayments)
+	return view
+}
@@ -0,0 +1,195 @@
+package fake_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/client/fake"
+	"github.com/prolific-oss/cli/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmissionTransitions(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       string
+		payload      client.TransitionSubmissionPayload
+		expected     string
+		expectedErr  int
+		invalidField string
+	}{
+		{name: "start a reserved submission", status: "RESERVED", payload: client.TransitionSubmissionPayload{Action: "START"}, expected: "ACTIVE"},
+		{name: "complete an active submission", status: "ACTIVE", payload: client.TransitionSubmissionPayload{Action: "COMPLETE", CompletionCode: "C1"}, expected: "AWAITING REVIEW"},
+		{name: "approve a submission awaiting review", status: "AWAITING REVIEW", payload: client.TransitionSubmissionPayload{Action: "APPROVE"}, expected: "APPROVED"},
+		{name: "reject a submission awaiting review", status: "AWAITING REVIEW", payload: client.TransitionSubmissionPayload{Action: "REJECT", Message: "No answers", RejectionCategory: "NO_DATA"}, expected: "REJECTED"},
+		{name: "return an active submission", status: "ACTIVE", payload: client.TransitionSubmissionPayload{Action: "RETURN"}, expected: "RETURNED"},
+		{name: "screen out an active submission", status: "ACTIVE", payload: client.TransitionSubmissionPayload{Action: "SCREEN_OUT"}, expected: "SCREENED OUT"},
+		{name: "unreject a rejected submission", status: "REJECTED", payload: client.TransitionSubmissionPayload{Action: "UNREJECT"}, expected: "APPROVED"},
+		{name: "unreturn a returned submission", status: "RETURNED", payload: client.TransitionSubmissionPayload{Action: "UNRETURN"}, expected: "AWAITING REVIEW"},
+		{name: "approve an active submission", status: "ACTIVE", payload: client.TransitionSubmissionPayload{Action: "APPROVE"}, expectedErr: http.StatusBadRequest},
+		{name: "return an approved submission", status: "APPROVED", payload: client.TransitionSubmissionPayload{Action: "RETURN"}, expectedErr: http.StatusBadRequest},
+		{name: "unknown action", status: "ACTIVE", payload: client.TransitionSubmissionPayload{Action: "EXPLODE"}, expectedErr: http.StatusBadRequest},
+		{name: "complete without a code", status: "ACTIVE", payload: client.TransitionSubmissionPayload{Action: "COMPLETE"}, expectedErr: http.StatusBadRequest, invalidField: "completion_code"},
+		{name: "reject without a message", status: "AWAITING REVIEW", payload: client.TransitionSubmissionPayload{Action: "REJECT", RejectionCategory: "NO_DATA"}, expectedErr: http.StatusBadRequest, invalidField: "message"},
+		{name: "reject without a category", status: "AWAITING REVIEW", payload: client.TransitionSubmissionPayload{Action: "REJECT", Message: "No answers"}, expectedErr: http.StatusBadRequest, invalidField: "rejection_category"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := fake.New()
+
+			study := f.AddStudy(model.Study{Name: "Eggs", Status: "ACTIVE", TotalAvailablePlaces: 1})
+			s := f.AddSubmission(study.ID, model.Submission{Status: tt.status})
+
+			response, err := f.TransitionSubmission(ctx, s.ID, tt.payload)
+			submissions, listErr := f.GetSubmissions(ctx, study.ID, 0, 0)
+			require.NoError(t, listErr)
+			stored := submissions.Results[0]
+
+			if tt.expectedErr != 0 {
+				apiErr := requireStatus(t, err, tt.expectedErr)
+				if tt.invalidField != "" {
+					require.Contains(t, apiErr.FieldErrors, tt.invalidField)
+				}
+				require.Equal(t, tt.status, stored.Status, "a refused action should leave the submission as it was")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, response.Status)
+			require.Equal(t, tt.expected, stored.Status)
+			if tt.payload.Action == "COMPLETE" {
+				require.Equal(t, tt.payload.CompletionCode, stored.StudyCode)
+				require.NotNil(t, response.CompletedAt)
+			}
+		})
+	}
+}
+
+func TestGetSubmissionsPaging(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+
+	study := f.AddStudy(model.Study{Name: "Eggs", Status: "ACTIVE", TotalAvailablePlaces: 3})
+	other := f.AddStudy(model.Study{Name: "Ham", Status: "ACTIVE", TotalAvailablePlaces: 1})
+	var IDs []string
+	for range 3 {
+		IDs = append(IDs, f.AddSubmission(study.ID, model.Submission{}).ID)
+		f.AddSubmission(other.ID, model.Submission{})
+	}
+
+	tests := []struct {
+		name     string
+		limit    int
+		offset   int
+		expected []string
+	}{
+		{name: "no limit returns the study's submissions", limit: 0, offset: 0, expected: IDs},
+		{name: "limit returns the first submissions", limit: 2, offset: 0, expected: IDs[:2]},
+		{name: "offset skips submissions", limit: 1, offset: 1, expected: IDs[1:2]},
+		{name: "limit past the end returns the rest", limit: 5, offset: 2, expected: IDs[2:]},
+		{name: "offset past the end returns nothing", limit: 1, offset: 3, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submissions, err := f.GetSubmissions(ctx, study.ID, tt.limit, tt.offset)
+			require.NoError(t, err)
+
+			got := []string{}
+			for _, s := range submissions.Results {
+				got = append(got, s.ID)
+			}
+			require.Equal(t, tt.expected, got)
+			require.Equal(t, 3, submissions.Meta.Count, "the count should be of every submission in the study, not the page")
+		})
+	}
+}
+
+func TestSubmissionErrors(t *testing.T) {
+	ctx := context.Background()
+	f := fake.New()
+
+	study := f.AddStudy(model.Study{Name: "Eggs", Status: "ACTIVE", TotalAvailablePlaces: 2})
+	awaiting := f.AddSubmission(study.ID, model.Submission{Status: "AWAITING REVIEW"})
+	active := f.AddSubmission(study.ID, model.Submission{})
+
+	tests := []struct {
+		name         string
+		call         func() error
+		status       int
+		detail       string
+		invalidField string
+	}{
+		{
+			name:   "list the submissions of an unknown study",
+			call:   func() error { _, err := f.GetSubmissions(ctx, "missing", 0, 0); return err },
+			status: http.StatusNotFound,
+			detail: "study missing not found",
+		},
+		{
+			name: "transition an unknown submission",
+			call: func() error {
+				_, err := f.TransitionSubmission(ctx, "missing", client.TransitionSubmissionPayload{Action: "APPROVE"})
+				return err
+			},
+			status: http.StatusNotFound,
+			detail: "submission missing not found",
+		},
+		{
+			name:         "request a return without reasons",
+			call:         func() error { _, err := f.RequestSubmissionReturn(ctx, active.ID, nil); return err },
+			status:       http.StatusBadRequest,
+			detail:       "request_return_reasons: This field is required.",
+			invalidField: "request_return_reasons",
+		},
+		{
+			name:   "bulk approve with nothing to approve",
+			call:   func() error { return f.BulkApproveSubmissions(ctx, client.BulkApproveSubmissionsPayload{}) },
+			status: http.StatusBadRequest,
+			detail: "provide submission_ids, or study_id and participant_ids",
+		},
+		{
+			name: "bulk approve a submission that isn't awaiting review",
+			call: func() error {
+				return f.BulkApproveSubmissions(ctx, client.BulkApproveSubmissionsPayload{SubmissionIDs: []string{awaiting.ID, active.ID}})
+			},
+			status: http.StatusBadRequest,
+			detail: "cannot approve submission " + active.ID + " as it is active",
+		},
+		{
+			name:   "pay an unknown bonus",
+			call:   func() error { return f.PayBonusPayments(ctx, "missing") },
+			status: http.StatusNotFound,
+			detail: "bonus payment missing not found",
+		},
+		{
+			name: "bonus a participant outside the study",
+			call: func() error {
+				_, err := f.CreateBonusPayments(ctx, client.CreateBonusPaymentsPayload{StudyID: study.ID, CSVBonuses: "stranger,1.00"})
+				return err
+			},
+			status: http.StatusBadRequest,
+			detail: "stranger is not a participant or submission in study " + study.ID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := requireStatus(t, tt.call(), tt.status)
+			require.Equal(t, tt.detail, apiErr.Detail)
+			if tt.invalidField != "" {
+				require.Contains(t, apiErr.FieldErrors, tt.invalidField)
+			}
+		})
+	}
+
+	// A bulk approval that fails approves none of the submissions.
+	counts, err := f.GetStudySubmissionCounts(ctx, study.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, counts.Approved)
+	require.Equal(t, 1, counts.AwaitingReview)
+}
@@ -0,0 +1,251 @@
+package fake
+
+import (
+	"context"
+	"slices"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// GetSurveys lists the researcher's surveys in the order they were created.
+func (c *Client) GetSurveys(ctx context.Context, researcherID string, limit, offset int) (*client.ListSurveysResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.Survey{}
+	for _, s := range c.surveys {
+		if s.ResearcherID == researcherID {
+			results = append(results, *s)
+		}
+	}
+
+	return &client.ListSurveysResponse{Results: page(results, limit, offset)}, nil
+}
+
+// GetSurvey returns the survey.
+func (c *Client) GetSurvey(ctx context.Context, ID string) (*model.Survey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.findSurvey(ID)
+	if !ok {
+		return nil, notFound("survey", ID)
+	}
+
+	survey := *s
+	return &survey, nil
+}
+
+// CreateSurvey stores the survey, giving its sections, questions and answers
+// IDs where they have none. It belongs to the current user unless another
+// researcher is given.
+func (c *Client) CreateSurvey(ctx context.Context, create model.CreateSurvey) (*client.CreateSurveyResponse, error) {
+	if create.Title == "" {
+		return nil, required("title")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	survey := &model.Survey{
+		ID:           c.newID(),
+		ResearcherID: create.ResearcherID,
+		Title:        create.Title,
+		DateCreated:  c.now(),
+		DateModified: c.now(),
+		Sections:     slices.Clone(create.Sections),
+		Questions:    c.identifyQuestions(create.Questions),
+	}
+	if survey.ResearcherID == "" {
+		survey.ResearcherID = c.me.ID
+	}
+	for i := range survey.Sections {
+		if survey.Sections[i].ID == "" {
+			survey.Sections[i].ID = c.newID()
+		}
+		survey.Sections[i].Questions = c.identifyQuestions(survey.Sections[i].Questions)
+	}
+	c.surveys = append(c.surveys, survey)
+
+	return &client.CreateSurveyResponse{Survey: *survey}, nil
+}
+
+// DeleteSurvey deletes the survey and its responses.
+func (c *Client) DeleteSurvey(ctx context.Context, ID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findSurvey(ID); !ok {
+		return notFound("survey", ID)
+	}
+
+	c.surveys = slices.DeleteFunc(c.surveys, func(s *model.Survey) bool { return s.ID == ID })
+	delete(c.responses, ID)
+
+	return nil
+}
+
+// GetSurveyResponses lists the survey's responses in the order they were
+// made.
+func (c *Client) GetSurveyResponses(ctx context.Context, surveyID string, limit, offset int) (*client.ListSurveyResponsesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findSurvey(surveyID); !ok {
+		return nil, notFound("survey", surveyID)
+	}
+
+	results := []model.SurveyResponse{}
+	for _, r := range c.responses[surveyID] {
+		results = append(results, *r)
+	}
+
+	return &client.ListSurveyResponsesResponse{Results: page(results, limit, offset)}, nil
+}
+
+// GetSurveyResponse returns the response.
+func (c *Client) GetSurveyResponse(ctx context.Context, surveyID, responseID string) (*model.SurveyResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findSurvey(surveyID); !ok {
+		return nil, notFound("survey", surveyID)
+	}
+	r, ok := find(c.responses[surveyID], func(r *model.SurveyResponse) bool { return r.ID == responseID })
+	if !ok {
+		return nil, notFound("survey response", responseID)
+	}
+
+	response := *r
+	return &response, nil
+}
+
+// CreateSurveyResponse stores a participant's response to the survey.
+func (c *Client) CreateSurveyResponse(ctx context.Context, surveyID string, create model.CreateSurveyResponseRequest) (*client.CreateSurveyResponseResponse, error) {
+	if create.ParticipantID == "" {
+		return nil, required("participant_id")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findSurvey(surveyID); !ok {
+		return nil, notFound("survey", surveyID)
+	}
+
+	response := &model.SurveyResponse{
+		ID:            c.newID(),
+		ParticipantID: create.ParticipantID,
+		SubmissionID:  create.SubmissionID,
+		DateCreated:   c.now(),
+		DateModified:  c.now(),
+		Sections:      create.Sections,
+		Questions:     create.Questions,
+	}
+	c.responses[surveyID] = append(c.responses[surveyID], response)
+
+	return &client.CreateSurveyResponseResponse{SurveyResponse: *response}, nil
+}
+
+// DeleteSurveyResponse deletes the response.
+func (c *Client) DeleteSurveyResponse(ctx context.Context, surveyID, responseID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findSurvey(surveyID); !ok {
+		return notFound("survey", surveyID)
+	}
+	if _, ok := find(c.responses[surveyID], func(r *model.SurveyResponse) bool { return r.ID == responseID }); !ok {
+		return notFound("survey response", responseID)
+	}
+
+	c.responses[surveyID] = slices.DeleteFunc(c.responses[surveyID], func(r *model.SurveyResponse) bool {
+		return r.ID == responseID
+	})
+
+	return nil
+}
+
+// DeleteAllSurveyResponses deletes every response to the survey.
+func (c *Client) DeleteAllSurveyResponses(ctx context.Context, surveyID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findSurvey(surveyID); !ok {
+		return notFound("survey", surveyID)
+	}
+	delete(c.responses, surveyID)
+
+	return nil
+}
+
+// GetSurveyResponseSummary counts how often each of the survey's answers was
+// given.
+func (c *Client) GetSurveyResponseSummary(ctx context.Context, surveyID string) (*model.SurveySummary, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	survey, ok := c.findSurvey(surveyID)
+	if !ok {
+		return nil, notFound("survey", surveyID)
+	}
+
+	counts := map[string]int{}
+	for _, r := range c.responses[surveyID] {
+		for _, q := range responseQuestions(r) {
+			for _, a := range q.Answers {
+				counts[q.QuestionID+"\x00"+a.AnswerID]++
+			}
+		}
+	}
+
+	summary := &model.SurveySummary{SurveyID: surveyID, Questions: []model.SurveySummaryQuestion{}}
+	questions := slices.Clone(survey.Questions)
+	for _, section := range survey.Sections {
+		questions = append(questions, section.Questions...)
+	}
+	for _, q := range questions {
+		sq := model.SurveySummaryQuestion{QuestionID: q.ID, Question: q.Title}
+		for _, a := range q.Answers {
+			count := counts[q.ID+"\x00"+a.ID]
+			sq.Answers = append(sq.Answers, model.SurveySummaryAnswer{AnswerID: a.ID, Answer: a.Value, Count: count})
+			sq.TotalAnswers += count
+		}
+		summary.Questions = append(summary.Questions, sq)
+	}
+
+	return summary, nil
+}
+
+func (c *Client) findSurvey(ID string) (*model.Survey, bool) {
+	return find(c.surveys, func(s *model.Survey) bool { return s.ID == ID })
+}
+
+// identifyQuestions returns a copy of the questions with IDs given to any
+// question or answer without one.
+func (c *Client) identifyQuestions(questions []model.SurveyQuestion) []model.SurveyQuestion {
+	questions = slices.Clone(questions)
+	for i := range questions {
+		if questions[i].ID == "" {
+			questions[i].ID = c.newID()
+		}
+		questions[i].Answers = slices.Clone(questions[i].Answers)
+		for j := range questions[i].Answers {
+			if questions[i].Answers[j].ID == "" {
+				questions[i].Answers[j].ID = c.newID()
+			}
+		}
+	}
+	return questions
+}
+
+// responseQuestions returns every question answered in the response, in or
+// out of a section.
+func responseQuestions(r *model.SurveyResponse) []model.SurveyQuestionResponse {
+	questions := slices.Clone(r.Questions)
+	for _, section := range r.Sections {
+		questions = append(questions, section.Questions...)
+	}
+	return questions
+}
@@ -0,0 +1,246 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+type campaign struct {
+	model.Campaign
+	workspaceID string
+}
+
+// AddWorkspace seeds a workspace, such as one a script expects by ID. It is
+// given an ID if it has none, and the current user as its owner if it has no
+// users.
+func (c *Client) AddWorkspace(workspace model.Workspace) model.Workspace {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if workspace.ID == "" {
+		workspace.ID = c.newID()
+	}
+	if len(workspace.Users) == 0 {
+		workspace.Users = []model.User{c.owner()}
+	}
+	c.workspaces = append(c.workspaces, &workspace)
+
+	return workspace
+}
+
+// AddProject seeds a project in the workspace. It is given an ID if it has
+// none, and the current user as its owner if it has no users.
+func (c *Client) AddProject(workspaceID string, project model.Project) model.Project {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if project.ID == "" {
+		project.ID = c.newID()
+	}
+	project.Workspace = workspaceID
+	if len(project.Users) == 0 {
+		project.Owner = c.me.ID
+		project.Users = []model.User{c.owner()}
+	}
+	c.projects = append(c.projects, &project)
+
+	return project
+}
+
+// AddCampaign seeds a campaign to the workspace. It is given an ID if it has
+// none.
+func (c *Client) AddCampaign(workspaceID string, m model.Campaign) model.Campaign {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if m.ID == "" {
+		m.ID = c.newID()
+	}
+	c.campaigns = append(c.campaigns, &campaign{Campaign: m, workspaceID: workspaceID})
+
+	return m
+}
+
+// SetWorkspaceBalance sets the balance GetWorkspaceBalance returns for the
+// workspace. Workspaces otherwise have a zero balance.
+func (c *Client) SetWorkspaceBalance(workspaceID string, balance client.WorkspaceBalanceResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.balances[workspaceID] = balance
+}
+
+// GetWorkspaces lists the workspaces in the order they were created.
+func (c *Client) GetWorkspaces(ctx context.Context, limit, offset int) (*client.ListWorkspacesResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.Workspace{}
+	for _, w := range c.workspaces {
+		results = append(results, *w)
+	}
+
+	return &client.ListWorkspacesResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// GetWorkspaceBalance returns the balance set with SetWorkspaceBalance.
+func (c *Client) GetWorkspaceBalance(ctx context.Context, workspaceID string) (*client.WorkspaceBalanceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(workspaceID); !ok {
+		return nil, notFound("workspace", workspaceID)
+	}
+
+	balance, ok := c.balances[workspaceID]
+	if !ok {
+		balance.CurrencyCode = c.me.CurrencyCode
+	}
+	return &balance, nil
+}
+
+// CreateWorkspace stores the workspace, with the current user as its owner.
+func (c *Client) CreateWorkspace(ctx context.Context, workspace model.Workspace) (*client.CreateWorkspacesResponse, error) {
+	if workspace.Title == "" {
+		return nil, required("title")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	workspace.ID = c.newID()
+	workspace.Users = []model.User{c.owner()}
+	c.workspaces = append(c.workspaces, &workspace)
+
+	return &client.CreateWorkspacesResponse{Workspace: workspace}, nil
+}
+
+// CreateInvitation returns an invitation for each email. The fake doesn't
+// add invitees to the workspace or project.
+func (c *Client) CreateInvitation(ctx context.Context, invitation model.CreateInvitation) (*client.CreateInvitationResponse, error) {
+	if len(invitation.Emails) == 0 {
+		return nil, required("emails")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, isWorkspace := c.findWorkspace(invitation.Association)
+	_, isProject := c.findProject(invitation.Association)
+	if !isWorkspace && !isProject {
+		return nil, notFound("workspace or project", invitation.Association)
+	}
+
+	response := &client.CreateInvitationResponse{}
+	for _, email := range invitation.Emails {
+		token := c.newID()
+		response.Invitations = append(response.Invitations, model.Invitation{
+			Association: invitation.Association,
+			Invitee:     model.Invitee{Email: email},
+			InvitedBy:   c.me.ID,
+			Status:      "INVITED",
+			InviteLink:  "https://app.prolific.com/invite/" + token,
+			Role:        invitation.Role,
+		})
+	}
+
+	return response, nil
+}
+
+// GetCampaigns lists the workspace's campaigns seeded with AddCampaign.
+func (c *Client) GetCampaigns(ctx context.Context, workspaceID string, limit, offset int) (*client.ListCampaignsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	results := []model.Campaign{}
+	for _, cp := range c.campaigns {
+		if cp.workspaceID == workspaceID {
+			results = append(results, cp.Campaign)
+		}
+	}
+
+	return &client.ListCampaignsResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// GetProjects lists the workspace's projects in the order they were created.
+func (c *Client) GetProjects(ctx context.Context, workspaceID string, limit, offset int) (*client.ListProjectsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(workspaceID); !ok {
+		return nil, notFound("workspace", workspaceID)
+	}
+
+	results := []model.Project{}
+	for _, p := range c.projects {
+		if p.Workspace == workspaceID {
+			results = append(results, *p)
+		}
+	}
+
+	return &client.ListProjectsResponse{
+		Results:     page(results, limit, offset),
+		JSONAPIMeta: meta(len(results)),
+	}, nil
+}
+
+// CreateProject stores the project in the workspace, with the current user
+// as its owner.
+func (c *Client) CreateProject(ctx context.Context, workspaceID string, project model.Project) (*client.CreateProjectResponse, error) {
+	if project.Title == "" {
+		return nil, required("title")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.findWorkspace(workspaceID); !ok {
+		return nil, notFound("workspace", workspaceID)
+	}
+
+	project.ID = c.newID()
+	project.Workspace = workspaceID
+	project.Owner = c.me.ID
+	project.Users = []model.User{c.owner()}
+	c.projects = append(c.projects, &project)
+
+	return &client.CreateProjectResponse{Project: project}, nil
+}
+
+// GetProject returns the project.
+func (c *Client) GetProject(ctx context.Context, ID string) (*model.Project, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	project, ok := c.findProject(ID)
+	if !ok {
+		return nil, notFound("project", ID)
+	}
+
+	p := *project
+	return &p, nil
+}
+
+func (c *Client) findWorkspace(ID string) (*model.Workspace, bool) {
+	return find(c.workspaces, func(w *model.Workspace) bool { return w.ID == ID })
+}
+
+func (c *Client) findProject(ID string) (*model.Project, bool) {
+	return find(c.projects, func(p *model.Project) bool { return p.ID == ID })
+}
+
+func (c *Client) owner() model.User {
+	return model.User{
+		ID:    c.me.ID,
+		Name:  c.me.Name,
+		Email: c.me.Email,
+		Roles: []string{"WORKSPACE_ADMIN"},
+	}
+}
@@ -0,0 +1,284 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"reflect"
+
+	"github.com/prolific-oss/cli/model"
+)
+
+// Pageable is implemented by the responses of list endpoints that page with
+// limit and offset, letting Pages, Items and FetchAll walk any of them.
+type Pageable[T any] interface {
+	// PageResults returns the records on this page.
+	PageResults() []T
+	// PageTotal returns the number of records across every page, if the
+	// endpoint reports it.
+	PageTotal() (int, bool)
+	// SetPageResults replaces the records held by the response.
+	SetPageResults([]T)
+}
+
+// PageFetcher requests the page of up to limit records starting at offset,
+// typically by closing over one of the API's list methods.
+type PageFetcher[P any] func(ctx context.Context, limit, offset int) (P, error)
+
+// Pages iterates over every page fetch returns, pageSize records at a time.
+// It stops once the endpoint's reported total is reached or, for endpoints
+// that don't report one, at the first short page. An error is yielded once
+// and ends the iteration, as does a nil page.
+func Pages[T any, P Pageable[T]](ctx context.Context, pageSize int, fetch PageFetcher[P]) iter.Seq2[P, error] {
+	return func(yield func(P, error) bool) {
+		offset := 0
+		for {
+			page, err := fetch(ctx, pageSize, offset)
+			if err != nil {
+				yield(page, err)
+				return
+			}
+			if isNil(page) || !yield(page, nil) {
+				return
+			}
+
+			n := len(page.PageResults())
+			offset += n
+
+			// An endpoint that knows its total can cap the page size below
+			// what we asked for, so keep going until we reach it.
+			if total, ok := page.PageTotal(); ok {
+				if n == 0 || offset >= total {
+					return
+				}
+				continue
+			}
+			if n == 0 || n < pageSize {
+				return
+			}
+		}
+	}
+}
+
+// Items iterates over every record on every page, fetching the next page only
+// when the previous one has been consumed.
+func Items[T any, P Pageable[T]](ctx context.Context, pageSize int, fetch PageFetcher[P]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range Pages(ctx, pageSize, fetch) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.PageResults() {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FetchAll walks every page, DefaultRecordLimit records at a time, and
+// returns the first page's response holding the records from all of them, so
+// callers can render it exactly as they would a single page. It returns an
+// error if fetch returns no page at all.
+func FetchAll[T any, P Pageable[T]](ctx context.Context, fetch PageFetcher[P]) (P, error) {
+	var first P
+	var results []T
+	seen := false
+
+	for page, err := range Pages(ctx, DefaultRecordLimit, fetch) {
+		if err != nil {
+			var zero P
+			return zero, err
+		}
+		if !seen {
+			first = page
+			seen = true
+		}
+		results = append(results, page.PageResults()...)
+	}
+
+	if !seen {
+		var zero P
+		return zero, errors.New("no page of results was returned")
+	}
+
+	first.SetPageResults(results)
+	return first, nil
+}
+
+// isNil reports whether page is a nil pointer, which a fetcher can return
+// alongside a nil error.
+func isNil[P any](page P) bool {
+	v := reflect.ValueOf(page)
+	return !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil())
+}
+
+// metaTotal reads the record count from an optional meta block.
+func metaTotal(meta *JSONAPIMeta) (int, bool) {
+	if meta == nil {
+		return 0, false
+	}
+	return meta.Meta.Count, true
+}
+
+// The list responses below implement Pageable. Those without a meta block
+// don't report a total, so Pages relies on a short page to find the end.
+
+func (r *ListStudiesResponse) PageResults() []model.Study {
+	return r.Results
+}
+
+func (r *ListStudiesResponse) SetPageResults(results []model.Study) {
+	r.Results = results
+}
+
+func (r *ListStudiesResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListSubmissionsResponse) PageResults() []model.Submission {
+	return r.Results
+}
+
+func (r *ListSubmissionsResponse) SetPageResults(results []model.Submission) {
+	r.Results = results
+}
+
+func (r *ListSubmissionsResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListCampaignsResponse) PageResults() []model.Campaign {
+	return r.Results
+}
+
+func (r *ListCampaignsResponse) SetPageResults(results []model.Campaign) {
+	r.Results = results
+}
+
+func (r *ListCampaignsResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListCollectionsResponse) PageResults() []model.Collection {
+	return r.Results
+}
+
+func (r *ListCollectionsResponse) SetPageResults(results []model.Collection) {
+	r.Results = results
+}
+
+func (r *ListCollectionsResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListHooksResponse) PageResults() []model.Hook {
+	return r.Results
+}
+
+func (r *ListHooksResponse) SetPageResults(results []model.Hook) {
+	r.Results = results
+}
+
+func (r *ListHooksResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListHookEventsResponse) PageResults() []model.HookEvent {
+	return r.Results
+}
+
+func (r *ListHookEventsResponse) SetPageResults(results []model.HookEvent) {
+	r.Results = results
+}
+
+func (r *ListHookEventsResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListWorkspacesResponse) PageResults() []model.Workspace {
+	return r.Results
+}
+
+func (r *ListWorkspacesResponse) SetPageResults(results []model.Workspace) {
+	r.Results = results
+}
+
+func (r *ListWorkspacesResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListProjectsResponse) PageResults() []model.Project {
+	return r.Results
+}
+
+func (r *ListProjectsResponse) SetPageResults(results []model.Project) {
+	r.Results = results
+}
+
+func (r *ListProjectsResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListParticipantGroupsResponse) PageResults() []model.ParticipantGroup {
+	return r.Results
+}
+
+func (r *ListParticipantGroupsResponse) SetPageResults(results []model.ParticipantGroup) {
+	r.Results = results
+}
+
+func (r *ListParticipantGroupsResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListFilterSetsResponse) PageResults() []model.FilterSet {
+	return r.Results
+}
+
+func (r *ListFilterSetsResponse) SetPageResults(results []model.FilterSet) {
+	r.Results = results
+}
+
+func (r *ListFilterSetsResponse) PageTotal() (int, bool) {
+	return metaTotal(r.JSONAPIMeta)
+}
+
+func (r *ListSurveysResponse) PageResults() []model.Survey {
+	return r.Results
+}
+
+func (r *ListSurveysResponse) SetPageResults(results []model.Survey) {
+	r.Results = results
+}
+
+func (r *ListSurveysResponse) PageTotal() (int, bool) {
+	return 0, false
+}
+
+func (r *ListSurveyResponsesResponse) PageResults() []model.SurveyResponse {
+	return r.Results
+}
+
+func (r *ListSurveyResponsesResponse) SetPageResults(results []model.SurveyResponse) {
+	r.Results = results
+}
+
+func (r *ListSurveyResponsesResponse) PageTotal() (int, bool) {
+	return 0, false
+}
+
+func (r *GetAITaskBuilderBatchesResponse) PageResults() []model.AITaskBuilderBatch {
+	return r.Results
+}
+
+func (r *GetAITaskBuilderBatchesResponse) SetPageResults(results []model.AITaskBuilderBatch) {
+	r.Results = results
+}
+
+func (r *GetAITaskBuilderBatchesResponse) PageTotal() (int, bool) {
+	return 0, false
+}
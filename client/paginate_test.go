@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prolific-oss/cli/model"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProjects serves pages from a fixed set of projects, recording each
+// offset requested. When reportTotal is set the pages carry a meta count, and
+// maxPage lets a test mimic a server that caps the page size.
+type fakeProjects struct {
+	all         []model.Project
+	reportTotal bool
+	maxPage     int
+	offsets     []int
+}
+
+func newFakeProjects(n int, reportTotal bool) *fakeProjects {
+	f := &fakeProjects{reportTotal: reportTotal}
+	for i := range n {
+		f.all = append(f.all, model.Project{ID: string(rune('a' + i))})
+	}
+	return f
+}
+
+func (f *fakeProjects) fetch(_ context.Context, limit, offset int) (*ListProjectsResponse, error) {
+	f.offsets = append(f.offsets, offset)
+	if f.maxPage > 0 && limit > f.maxPage {
+		limit = f.maxPage
+	}
+	end := min(offset+limit, len(f.all))
+	start := min(offset, end)
+
+	response := &ListProjectsResponse{Results: f.all[start:end]}
+	if f.reportTotal {
+		response.JSONAPIMeta = &JSONAPIMeta{}
+		response.Meta.Count = len(f.all)
+	}
+	return response, nil
+}
+
+func TestPagesStopsAtReportedTotal(t *testing.T) {
+	f := newFakeProjects(5, true)
+
+	pages := 0
+	for _, err := range Pages(context.Background(), 2, f.fetch) {
+		require.NoError(t, err)
+		pages++
+	}
+
+	require.Equal(t, 3, pages)
+	require.Equal(t, []int{0, 2, 4}, f.offsets)
+}
+
+func TestPagesStopsAtShortPageWithoutTotal(t *testing.T) {
+	f := newFakeProjects(4, false)
+
+	for _, err := range Pages(context.Background(), 3, f.fetch) {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []int{0, 3}, f.offsets)
+}
+
+func TestPagesFollowsTotalWhenServerCapsPageSize(t *testing.T) {
+	f := newFakeProjects(5, true)
+	f.maxPage = 2
+
+	var ids []string
+	for item, err := range Items(context.Background(), 10, f.fetch) {
+		require.NoError(t, err)
+		ids = append(ids, item.ID)
+	}
+
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, ids)
+	require.Equal(t, []int{0, 2, 4}, f.offsets)
+}
+
+func TestItemsFetchesNoFurtherThanConsumed(t *testing.T) {
+	f := newFakeProjects(6, true)
+
+	for item, err := range Items(context.Background(), 2, f.fetch) {
+		require.NoError(t, err)
+		if item.ID == "b" {
+			break
+		}
+	}
+
+	require.Equal(t, []int{0}, f.offsets)
+}
+
+func TestItemsYieldsFetchError(t *testing.T) {
+	calls := 0
+	fetch := func(_ context.Context, _, _ int) (*ListProjectsResponse, error) {
+		calls++
+		if calls == 2 {
+			return nil, errors.New("boom")
+		}
+		return &ListProjectsResponse{Results: []model.Project{{ID: "a"}, {ID: "b"}}}, nil
+	}
+
+	var got []string
+	var gotErr error
+	for item, err := range Items(context.Background(), 2, fetch) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, item.ID)
+	}
+
+	require.EqualError(t, gotErr, "boom")
+	require.Equal(t, []string{"a", "b"}, got)
+}
+
+func TestFetchAllMergesEveryPageIntoTheFirstResponse(t *testing.T) {
+	f := newFakeProjects(DefaultRecordLimit+3, true)
+
+	response, err := FetchAll(context.Background(), f.fetch)
+
+	require.NoError(t, err)
+	require.Len(t, response.Results, DefaultRecordLimit+3)
+	require.Equal(t, DefaultRecordLimit+3, response.Meta.Count)
+	require.Equal(t, []int{0, DefaultRecordLimit}, f.offsets)
+}
+
+func TestFetchAllReturnsError(t *testing.T) {
+	fetch := func(_ context.Context, _, _ int) (*ListProjectsResponse, error) {
+		return nil, errors.New("boom")
+	}
+
+	response, err := FetchAll(context.Background(), fetch)
+
+	require.EqualError(t, err, "boom")
+	require.Nil(t, response)
+}
+
+func TestPagesStopsAtANilPage(t *testing.T) {
+	fetch := func(_ context.Context, limit, offset int) (*ListProjectsResponse, error) {
+		return nil, nil
+	}
+
+	pages := 0
+	for _, err := range Pages(context.Background(), 2, fetch) {
+		require.NoError(t, err)
+		pages++
+	}
+
+	require.Equal(t, 0, pages)
+}
+
+func TestFetchAllErrorsWithoutAPage(t *testing.T) {
+	fetch := func(_ context.Context, limit, offset int) (*ListProjectsResponse, error) {
+		return nil, nil
+	}
+
+	response, err := FetchAll(context.Background(), fetch)
+
+	require.Nil(t, response)
+	require.EqualError(t, err, "no page of results was returned")
+}
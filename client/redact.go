@@ -0,0 +1,94 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// redacted stands in for secrets in debug output and traces.
+const redacted = "[REDACTED]"
+
+// secretHeaders never have their values written to debug output or traces.
+var secretHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// secretFields are JSON keys whose values are redacted from bodies, matched
+// case-insensitively either exactly or as a suffix such as "webhook_secret".
+var secretFields = []string{"token", "secret", "password", "credentials", "api_key", "private_key"}
+
+// redactHeaders returns a copy of h with secret values replaced. The scheme
+// of an Authorization header is kept, so it is clear which kind was sent.
+func redactHeaders(h http.Header) http.Header {
+	clone := h.Clone()
+	for _, name := range secretHeaders {
+		values := clone.Values(name)
+		for i, value := range values {
+			scheme, _, found := strings.Cut(value, " ")
+			if found && strings.HasSuffix(name, "Authorization") {
+				values[i] = scheme + " " + redacted
+			} else {
+				values[i] = redacted
+			}
+		}
+	}
+	return clone
+}
+
+// redactBody replaces the values of secret fields in a JSON body. Bodies that
+// aren't JSON, or hold nothing secret, are returned unchanged.
+func redactBody(body []byte) []byte {
+	var doc any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return body
+	}
+
+	if !redactValue(doc) {
+		return body
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return body
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n")
+}
+
+// redactValue redacts secret fields in place, reporting whether it found any.
+func redactValue(v any) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSecretField(key) && value != nil {
+				v[key] = redacted
+				changed = true
+				continue
+			}
+			if redactValue(value) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, value := range v {
+			if redactValue(value) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+func isSecretField(key string) bool {
+	key = strings.ToLower(key)
+	for _, field := range secretFields {
+		if key == field || strings.HasSuffix(key, "_"+field) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Token abc123")
+	h.Set("Cookie", "session=xyz")
+	h.Set("Content-Type", "application/json")
+
+	redactedHeaders := redactHeaders(h)
+
+	require.Equal(t, "Token [REDACTED]", redactedHeaders.Get("Authorization"))
+	require.Equal(t, "[REDACTED]", redactedHeaders.Get("Cookie"))
+	require.Equal(t, "application/json", redactedHeaders.Get("Content-Type"))
+	require.Equal(t, "Token abc123", h.Get("Authorization"), "the original headers should be untouched")
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "secret fields at any depth",
+			body:     `{"id":"1","secret":"s3cr3t","hooks":[{"webhook_secret":"abc","url":"https://example.com"}]}`,
+			expected: `{"hooks":[{"url":"https://example.com","webhook_secret":"[REDACTED]"}],"id":"1","secret":"[REDACTED]"}`,
+		},
+		{
+			name:     "nothing secret is left as sent",
+			body:     `{"name": "Study", "reward": 100}`,
+			expected: `{"name": "Study", "reward": 100}`,
+		},
+		{
+			name:     "non-JSON is left as sent",
+			body:     "id,token\n1,abc",
+			expected: "id,token\n1,abc",
+		},
+		{
+			name:     "large numbers keep their precision",
+			body:     `{"count":12345678901234567890,"token":"abc"}`,
+			expected: `{"count":12345678901234567890,"token":"[REDACTED]"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, string(redactBody([]byte(tt.body))))
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Defaults for RetryPolicy, used when nothing is configured.
+const (
+	DefaultMaxRetries     = 3
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	DefaultRetryMaxDelay  = 30 * time.Second
+)
+
+// RetryPolicy controls how Execute retries requests that fail with a
+// rate limit (429), a transient server error (502, 503, 504) or a network
+// error. Only idempotent methods are retried unless the caller opts a
+// request in via ExecuteBuilder.Retryable.
+type RetryPolicy struct {
+	// MaxRetries is how many times a request is retried after the first
+	// attempt; zero disables retries.
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry, doubling on each
+	// subsequent one.
+	BaseDelay time.Duration
+	// MaxDelay caps any single wait, including one asked for by Retry-After.
+	MaxDelay time.Duration
+}
+
+// NewRetryPolicy builds a RetryPolicy from the PROLIFIC_MAX_RETRIES,
+// PROLIFIC_RETRY_BASE_DELAY and PROLIFIC_RETRY_MAX_DELAY config/env values,
+// falling back to the defaults for any that are unset.
+func NewRetryPolicy() RetryPolicy {
+	viper.SetDefault("PROLIFIC_MAX_RETRIES", DefaultMaxRetries)
+	viper.SetDefault("PROLIFIC_RETRY_BASE_DELAY", DefaultRetryBaseDelay)
+	viper.SetDefault("PROLIFIC_RETRY_MAX_DELAY", DefaultRetryMaxDelay)
+
+	return RetryPolicy{
+		MaxRetries: viper.GetInt("PROLIFIC_MAX_RETRIES"),
+		BaseDelay:  viper.GetDuration("PROLIFIC_RETRY_BASE_DELAY"),
+		MaxDelay:   viper.GetDuration("PROLIFIC_RETRY_MAX_DELAY"),
+	}
+}
+
+// isIdempotent reports whether method can be safely repeated without the
+// caller opting in.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a response status is worth retrying.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// delay returns how long to wait before retry number attempt (starting at 1).
+// A Retry-After header on the response wins over the computed backoff;
+// otherwise the wait is exponential with full jitter so that many clients
+// backing off together don't retry in lockstep.
+func (p RetryPolicy) delay(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if d, ok := parseRetryAfter(response.Header.Get("Retry-After"), time.Now()); ok {
+			return p.capDelay(d)
+		}
+	}
+
+	backoff := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || backoff < p.MaxDelay); i++ {
+		backoff *= 2
+	}
+	backoff = p.capDelay(backoff)
+	if backoff <= 0 {
+		return 0
+	}
+
+	return rand.N(backoff + 1)
+}
+
+func (p RetryPolicy) capDelay(d time.Duration) time.Duration {
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return d
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a number
+// of seconds, or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if d := at.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// Sleep pauses for d, returning early with ctx.Err() if ctx is cancelled
+// first. Retries and polling commands sleep through this so Ctrl-C interrupts
+// the wait rather than only the next request.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
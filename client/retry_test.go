@@ -0,0 +1,238 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyServer fails the first `failures` requests with status, then
+// succeeds, counting every request it sees.
+func newFlakyServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"123","name":"test"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func newRetryingClient(baseURL string) Client {
+	return Client{
+		Client:  http.DefaultClient,
+		BaseURL: baseURL,
+		Token:   "fake-token",
+		Retry:   RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond},
+	}
+}
+
+func TestExecuteRetriesTransientFailuresOnGet(t *testing.T) {
+	srv, calls := newFlakyServer(t, 2, http.StatusServiceUnavailable, nil)
+	c := newRetryingClient(srv.URL)
+
+	var resp testResponse
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", &resp)
+
+	require.NoError(t, err)
+	require.Equal(t, "123", resp.ID)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestExecuteGivesUpAfterMaxRetries(t *testing.T) {
+	srv, calls := newFlakyServer(t, 10, http.StatusBadGateway, nil)
+	c := newRetryingClient(srv.URL)
+
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", nil)
+
+	var apiErr *UnrecognizedAPIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	require.Equal(t, int32(4), calls.Load())
+}
+
+func TestExecuteDoesNotRetryClientErrors(t *testing.T) {
+	srv, calls := newFlakyServer(t, 10, http.StatusBadRequest, nil)
+	c := newRetryingClient(srv.URL)
+
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", nil)
+
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestExecuteDoesNotRetryPostByDefault(t *testing.T) {
+	srv, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+	c := newRetryingClient(srv.URL)
+
+	_, err := c.ExecuteBuilder(context.Background()).PostRequest("/some-path").Body(map[string]string{"a": "b"}).Execute()
+
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestExecuteRetriesPostWhenOptedIn(t *testing.T) {
+	var bodies []string
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(buf))
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+	c := newRetryingClient(srv.URL)
+
+	_, err := c.ExecuteBuilder(context.Background()).
+		PostRequest("/some-path").
+		Retryable().
+		Body(map[string]string{"a": "b"}).
+		Status(http.StatusCreated).
+		Execute()
+
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+	require.Equal(t, bodies[0], bodies[1], "each attempt should send the same body")
+}
+
+// A builder reused for a second request must not carry over the opt-in.
+func TestExecuteBuilderRetryableIsClearedByNewRequest(t *testing.T) {
+	srv, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+	c := newRetryingClient(srv.URL)
+
+	b := c.ExecuteBuilder(context.Background()).PostRequest("/first").Retryable()
+	_, err := b.PostRequest("/second").Execute()
+
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestExecuteHonoursRetryAfter(t *testing.T) {
+	srv, calls := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"0"}})
+	c := newRetryingClient(srv.URL)
+	// A backoff this long would time the test out if Retry-After were ignored.
+	c.Retry.BaseDelay = time.Hour
+	c.Retry.MaxDelay = time.Hour
+
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", nil)
+
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestExecuteStopsRetryingWhenContextCancelled(t *testing.T) {
+	srv, calls := newFlakyServer(t, 10, http.StatusServiceUnavailable, nil)
+	c := newRetryingClient(srv.URL)
+	c.Retry.BaseDelay = time.Hour
+	c.Retry.MaxDelay = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.ExecuteBuilder(ctx).Get("/some-path", nil)
+
+	require.True(t, errors.Is(err, context.DeadlineExceeded), "got: %v", err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestExecuteWithZeroPolicyNeverRetries(t *testing.T) {
+	srv, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+	c := Client{Client: http.DefaultClient, BaseURL: srv.URL, Token: "fake-token"}
+
+	_, err := c.ExecuteBuilder(context.Background()).Get("/some-path", nil)
+
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "empty", value: "", wantOK: false},
+		{name: "seconds", value: "7", want: 7 * time.Second, wantOK: true},
+		{name: "negative seconds", value: "-1", wantOK: false},
+		{name: "http date", value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second, wantOK: true},
+		{name: "http date in the past", value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0, wantOK: true},
+		{name: "garbage", value: "soon", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value, now)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRetryPolicyDelayStaysWithinBounds(t *testing.T) {
+	p := RetryPolicy{MaxRetries: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		ceiling := p.BaseDelay << (attempt - 1)
+		if ceiling > p.MaxDelay {
+			ceiling = p.MaxDelay
+		}
+		for range 50 {
+			d := p.delay(attempt, nil)
+			require.GreaterOrEqual(t, d, time.Duration(0))
+			require.LessOrEqual(t, d, ceiling, "attempt %d", attempt)
+		}
+	}
+}
+
+func TestRetryPolicyDelayCapsRetryAfter(t *testing.T) {
+	p := RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Second}
+	response := &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+
+	require.Equal(t, 2*time.Second, p.delay(1, response))
+}
+
+func TestRetryPolicyDelayGrowsWithoutAMaxDelay(t *testing.T) {
+	p := RetryPolicy{MaxRetries: 5, BaseDelay: time.Millisecond}
+
+	longest := time.Duration(0)
+	for range 200 {
+		longest = max(longest, p.delay(5, nil))
+	}
+	require.Greater(t, longest, p.BaseDelay)
+	require.LessOrEqual(t, longest, 16*time.Millisecond)
+}
+
+func TestSleepReturnsNilAfterDuration(t *testing.T) {
+	require.NoError(t, Sleep(context.Background(), time.Millisecond))
+}
+
+func TestSleepReturnsEarlyWhenContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	err := Sleep(ctx, time.Minute)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(start), time.Second)
+}
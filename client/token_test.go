@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteFallsBackToTokenSource(t *testing.T) {
+	var gotAuthorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuthorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	calls := 0
+	c := Client{
+		Client:  server.Client(),
+		BaseURL: server.URL,
+		TokenSource: func() (string, error) {
+			calls++
+			return "stored-token", nil
+		},
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodGet, "/studies", nil, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, "Token stored-token", gotAuthorization)
+	require.Equal(t, 1, calls)
+}
+
+func TestExecuteSkipsTokenSourceWhenTokenSet(t *testing.T) {
+	var gotAuthorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuthorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := Client{
+		Client:  server.Client(),
+		BaseURL: server.URL,
+		Token:   "env-token",
+		TokenSource: func() (string, error) {
+			t.Fatal("expected the token source not to be called")
+			return "", nil
+		},
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodGet, "/studies", nil, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, "Token env-token", gotAuthorization)
+}
+
+func TestExecuteReturnsTokenSourceError(t *testing.T) {
+	c := Client{
+		Client:  http.DefaultClient,
+		BaseURL: "http://localhost",
+		TokenSource: func() (string, error) {
+			return "", errors.New("locked")
+		},
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodGet, "/studies", nil, nil)
+
+	require.EqualError(t, err, "locked")
+}
+
+func TestExecuteWithEmptyTokenSourceReturnsErrTokenNotSet(t *testing.T) {
+	c := Client{
+		Client:      http.DefaultClient,
+		BaseURL:     "http://localhost",
+		TokenSource: func() (string, error) { return "", nil },
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodGet, "/studies", nil, nil)
+
+	require.ErrorIs(t, err, ErrTokenNotSet)
+}
+
+func TestWithTokenLeavesOriginalUntouched(t *testing.T) {
+	c := &Client{Token: "old", TokenSource: func() (string, error) { return "stored", nil }}
+
+	clone := c.WithToken("new").(*Client)
+
+	require.Equal(t, "new", clone.Token)
+	require.Nil(t, clone.TokenSource)
+	require.Equal(t, "old", c.Token)
+}
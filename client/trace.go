@@ -0,0 +1,231 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prolific-oss/cli/version"
+)
+
+// The types below are the parts of the HAR 1.2 format we record. See
+// http://www.softwareishard.com/blog/har-12-spec/ for the full format.
+
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	// Error holds the reason no response was received, if any. Custom
+	// fields must start with an underscore.
+	Error string `json:"_error,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// harTrace collects the requests made by every client writing to one file.
+// The file is rewritten after each request, so the trace is complete even if
+// the command exits early.
+type harTrace struct {
+	mu     sync.Mutex
+	path   string
+	file   harFile
+	warned bool
+}
+
+var (
+	tracesMu sync.Mutex
+	traces   = map[string]*harTrace{}
+)
+
+// traceTo returns the trace for path, starting a new one the first time the
+// path is used in this process.
+func traceTo(path string) *harTrace {
+	tracesMu.Lock()
+	defer tracesMu.Unlock()
+
+	if t, ok := traces[path]; ok {
+		return t
+	}
+
+	t := &harTrace{
+		path: path,
+		file: harFile{Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "prolific-oss/cli", Version: version.Get()},
+			Entries: []harEntry{},
+		}},
+	}
+	traces[path] = t
+	return t
+}
+
+// record adds an exchange to the trace, with secrets redacted. response is
+// nil if the request failed before one arrived, in which case err says why.
+// A trace that can't be written doesn't fail the command, but is warned
+// about once.
+func (t *harTrace) record(request *http.Request, payload []byte, response *http.Response, body []byte, started time.Time, elapsed time.Duration, err error) {
+	ms := float64(elapsed.Microseconds()) / 1000
+
+	entry := harEntry{
+		StartedDateTime: started.Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: harRequest{
+			Method:      request.Method,
+			URL:         request.URL.String(),
+			HTTPVersion: "HTTP/1.1",
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(request.Header),
+			QueryString: harQuery(request),
+			HeadersSize: -1,
+			BodySize:    len(payload),
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings: harTimings{Send: 0, Wait: ms, Receive: 0},
+	}
+
+	if payload != nil {
+		entry.Request.PostData = &harPostData{
+			MimeType: request.Header.Get("Content-Type"),
+			Text:     string(redactBody(payload)),
+		}
+	}
+
+	if response != nil {
+		entry.Response.Status = response.StatusCode
+		entry.Response.StatusText = strings.TrimSpace(strings.TrimPrefix(response.Status, fmt.Sprint(response.StatusCode)))
+		entry.Response.HTTPVersion = response.Proto
+		entry.Response.Headers = harHeaders(response.Header)
+		entry.Response.RedirectURL = response.Header.Get("Location")
+		entry.Response.BodySize = len(body)
+		entry.Response.Content = harContent{
+			Size:     len(body),
+			MimeType: response.Header.Get("Content-Type"),
+			Text:     string(redactBody(body)),
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.file.Log.Entries = append(t.file.Log.Entries, entry)
+
+	data, writeErr := json.MarshalIndent(t.file, "", "  ")
+	if writeErr == nil {
+		writeErr = os.WriteFile(t.path, append(data, '\n'), 0o600)
+	}
+	if writeErr != nil && !t.warned {
+		t.warned = true
+		fmt.Fprintf(os.Stderr, "warning: unable to write trace file %s: %s\n", t.path, writeErr)
+	}
+}
+
+func harHeaders(h http.Header) []harNameValue {
+	h = redactHeaders(h)
+
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	headers := []harNameValue{}
+	for _, name := range names {
+		for _, value := range h[name] {
+			headers = append(headers, harNameValue{Name: name, Value: value})
+		}
+	}
+	return headers
+}
+
+func harQuery(request *http.Request) []harNameValue {
+	query := request.URL.Query()
+
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := []harNameValue{}
+	for _, name := range names {
+		for _, value := range query[name] {
+			params = append(params, harNameValue{Name: name, Value: value})
+		}
+	}
+	return params
+}
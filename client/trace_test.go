@@ -0,0 +1,115 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteWritesRedactedDebugOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"s-1","secret":"returned-secret"}`))
+	}))
+	defer server.Close()
+
+	var debug bytes.Buffer
+	c := Client{
+		Client:      server.Client(),
+		BaseURL:     server.URL,
+		Token:       "super-secret-token",
+		Debug:       true,
+		DebugOutput: &debug,
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodPost, "/api/v1/hooks/secrets/", map[string]string{"workspace_id": "w-1"}, nil)
+	require.NoError(t, err)
+
+	out := debug.String()
+	require.Contains(t, out, "> POST "+server.URL+"/api/v1/hooks/secrets/")
+	require.Contains(t, out, "> Authorization: Token [REDACTED]")
+	require.Contains(t, out, `{"workspace_id":"w-1"}`)
+	require.Contains(t, out, "< HTTP/1.1 200 OK in ")
+	require.Contains(t, out, `"secret":"[REDACTED]"`)
+	require.NotContains(t, out, "super-secret-token")
+	require.NotContains(t, out, "returned-secret")
+}
+
+func TestExecuteRecordsHARTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":"Not found."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"returned-token","name":"ok"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.har")
+	c := Client{
+		Client:    server.Client(),
+		BaseURL:   server.URL,
+		Token:     "super-secret-token",
+		TraceFile: path,
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodGet, "/studies?status=ACTIVE", nil, nil)
+	require.NoError(t, err)
+	_, err = c.Execute(context.Background(), http.MethodGet, "/missing", nil, nil)
+	require.Error(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "super-secret-token")
+	require.NotContains(t, string(data), "returned-token")
+
+	var har harFile
+	require.NoError(t, json.Unmarshal(data, &har))
+	require.Equal(t, "1.2", har.Log.Version)
+	require.Len(t, har.Log.Entries, 2)
+
+	first := har.Log.Entries[0]
+	require.Equal(t, http.MethodGet, first.Request.Method)
+	require.Equal(t, server.URL+"/studies?status=ACTIVE", first.Request.URL)
+	require.Equal(t, []harNameValue{{Name: "status", Value: "ACTIVE"}}, first.Request.QueryString)
+	require.Contains(t, first.Request.Headers, harNameValue{Name: "Authorization", Value: "Token [REDACTED]"})
+	require.Equal(t, 200, first.Response.Status)
+	require.Equal(t, "OK", first.Response.StatusText)
+	require.True(t, strings.Contains(first.Response.Content.Text, `"token":"[REDACTED]"`))
+	require.GreaterOrEqual(t, first.Time, 0.0)
+
+	require.Equal(t, 404, har.Log.Entries[1].Response.Status)
+	require.Equal(t, `{"detail":"Not found."}`, har.Log.Entries[1].Response.Content.Text)
+}
+
+func TestTraceRecordsFailedRequests(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.har")
+	c := Client{
+		Client:    http.DefaultClient,
+		BaseURL:   "http://127.0.0.1:1",
+		Token:     "token",
+		TraceFile: path,
+	}
+
+	_, err := c.Execute(context.Background(), http.MethodGet, "/studies", nil, nil)
+	require.Error(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var har harFile
+	require.NoError(t, json.Unmarshal(data, &har))
+	require.Len(t, har.Log.Entries, 1)
+	require.Equal(t, 0, har.Log.Entries[0].Response.Status)
+	require.NotEmpty(t, har.Log.Entries[0].Error)
+}
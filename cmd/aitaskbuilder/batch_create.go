@@ -1,11 +1,14 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +23,7 @@ type BatchCreateOptions struct {
 	BatchItemsFile   string
 	BatchItemsJSON   string
 	AutoSync         bool
+	Output           shared.OutputOptions
 }
 
 func NewBatchCreateCommand(client client.API, w io.Writer) *cobra.Command {
@@ -65,9 +69,9 @@ $ prolific aitaskbuilder batch create -n "My Batch" -w <workspace_id> -d <datase
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := createAITaskBuilderBatch(client, opts, w)
+			err := createAITaskBuilderBatch(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -91,12 +95,13 @@ $ prolific aitaskbuilder batch create -n "My Batch" -w <workspace_id> -d <datase
 	_ = cmd.MarkFlagRequired("task-name")
 	_ = cmd.MarkFlagRequired("task-introduction")
 	_ = cmd.MarkFlagRequired("task-steps")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // createAITaskBuilderBatch will create a new AI Task Builder batch
-func createAITaskBuilderBatch(c client.API, opts BatchCreateOptions, w io.Writer) error {
+func createAITaskBuilderBatch(ctx context.Context, c client.API, opts BatchCreateOptions, w io.Writer) error {
 	if opts.Name == "" {
 		return errors.New(ErrNameRequired)
 	}
@@ -132,11 +137,15 @@ func createAITaskBuilderBatch(c client.API, opts BatchCreateOptions, w io.Writer
 		AutoSync:         opts.AutoSync,
 	}
 
-	response, err := c.CreateAITaskBuilderBatch(params)
+	response, err := c.CreateAITaskBuilderBatch(ctx, params)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	fmt.Fprintf(w, "AI Task Builder Batch Created Successfully:\n")
 	fmt.Fprintf(w, "ID: %s\n", response.ID)
 	fmt.Fprintf(w, "Name: %s\n", response.Name)
@@ -149,7 +158,7 @@ func createAITaskBuilderBatch(c client.API, opts BatchCreateOptions, w io.Writer
 	}
 	fmt.Fprintf(w, "Workspace ID: %s\n", response.WorkspaceID)
 	fmt.Fprintf(w, "Created By: %s\n", response.CreatedBy)
-	fmt.Fprintf(w, "Created At: %s\n", response.CreatedAt)
+	fmt.Fprintf(w, "Created At: %s\n", ui.FormatDateTimeString(response.CreatedAt))
 
 	if len(response.Datasets) > 0 {
 		fmt.Fprintf(w, "Datasets: %d\n", len(response.Datasets))
@@ -72,7 +72,7 @@ func TestNewBatchCreateCommandCallsAPI(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderBatch(client.CreateBatchParams{
+	c.EXPECT().CreateAITaskBuilderBatch(gomock.Any(), client.CreateBatchParams{
 		Name:             batchName,
 		WorkspaceID:      workspaceID,
 		DatasetID:        datasetID,
@@ -102,7 +102,7 @@ func TestNewBatchCreateCommandCallsAPI(t *testing.T) {
 	writer.Flush()
 
 	expected := fmt.Sprintf("AI Task Builder Batch Created Successfully:\nID: %s\nName: %s\nStatus: %s\nAuto Sync Enabled: %v\nTotal Task Count: %d\nTotal Instruction Count: %d\nWorkspace ID: %s\nCreated By: %s\nCreated At: %s\nDatasets: %d\n  Dataset 1: %s (100 datapoints)\n\nTask Details:\n  Name: %s\n  Introduction: %s\n  Steps: %s\n",
-		response.ID, response.Name, response.Status, response.AutoSyncEnabled, response.TotalTaskCount, response.TotalInstructionCount, response.WorkspaceID, response.CreatedBy, response.CreatedAt, len(response.Datasets), datasetID, taskName, taskIntroduction, taskSteps)
+		response.ID, response.Name, response.Status, response.AutoSyncEnabled, response.TotalTaskCount, response.TotalInstructionCount, response.WorkspaceID, response.CreatedBy, "24-08-2019 14:15", len(response.Datasets), datasetID, taskName, taskIntroduction, taskSteps)
 
 	if b.String() != expected {
 		t.Fatalf("expected output:\n%s\ngot output:\n%s", expected, b.String())
@@ -121,7 +121,7 @@ func TestNewBatchCreateCommandAPIError(t *testing.T) {
 	taskIntroduction := "This is a sample task for testing"
 	taskSteps := "1. Review the data\n2. Provide your response"
 
-	c.EXPECT().CreateAITaskBuilderBatch(client.CreateBatchParams{
+	c.EXPECT().CreateAITaskBuilderBatch(gomock.Any(), client.CreateBatchParams{
 		Name:             batchName,
 		WorkspaceID:      workspaceID,
 		DatasetID:        datasetID,
@@ -238,7 +238,7 @@ func TestNewBatchCreateCommandWithBatchItemsJSON(t *testing.T) {
 		WorkspaceID: "6278acb09062db3b35bcbeb0",
 	}
 
-	c.EXPECT().CreateAITaskBuilderBatch(client.CreateBatchParams{
+	c.EXPECT().CreateAITaskBuilderBatch(gomock.Any(), client.CreateBatchParams{
 		Name:             "Test Batch",
 		WorkspaceID:      "6278acb09062db3b35bcbeb0",
 		DatasetID:        "1234acb09999db4b99bcded1",
@@ -278,7 +278,7 @@ func TestNewBatchCreateCommandWithBatchItemsFile(t *testing.T) {
 		WorkspaceID: "6278acb09062db3b35bcbeb0",
 	}
 
-	c.EXPECT().CreateAITaskBuilderBatch(client.CreateBatchParams{
+	c.EXPECT().CreateAITaskBuilderBatch(gomock.Any(), client.CreateBatchParams{
 		Name:             "Test Batch",
 		WorkspaceID:      "6278acb09062db3b35bcbeb0",
 		DatasetID:        "1234acb09999db4b99bcded1",
@@ -313,7 +313,7 @@ func TestNewBatchCreateCommandWithAutoSync(t *testing.T) {
 		WorkspaceID: "6278acb09062db3b35bcbeb0",
 	}
 
-	c.EXPECT().CreateAITaskBuilderBatch(client.CreateBatchParams{
+	c.EXPECT().CreateAITaskBuilderBatch(gomock.Any(), client.CreateBatchParams{
 		Name:             "Test Batch",
 		WorkspaceID:      "6278acb09062db3b35bcbeb0",
 		DatasetID:        "1234acb09999db4b99bcded1",
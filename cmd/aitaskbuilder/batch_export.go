@@ -26,7 +26,7 @@ const (
 
 // batchExportPollSleep is the sleep function used between export status polls.
 // Replaced in tests via SetBatchExportPollSleepForTesting to avoid real delays.
-var batchExportPollSleep func(time.Duration) = time.Sleep
+var batchExportPollSleep func(context.Context, time.Duration) error = client.Sleep
 
 // batchExportDownloadClient is the HTTP client used to fetch the export ZIP.
 // Replaced in tests via SetBatchExportDownloadClientForTesting to supply a TLS-aware client.
@@ -80,7 +80,7 @@ $ prolific aitaskbuilder batch export 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a --out
 				opts.Output = fmt.Sprintf("%s-export-%s.zip", opts.Args[0], time.Now().Format("20060102-150405"))
 			}
 
-			return exportBatch(c, opts, w)
+			return exportBatch(cmd.Context(), c, opts, w)
 		},
 	}
 
@@ -89,20 +89,20 @@ $ prolific aitaskbuilder batch export 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a --out
 	return cmd
 }
 
-func exportBatch(c client.API, opts BatchExportOptions, w io.Writer) error {
+func exportBatch(ctx context.Context, c client.API, opts BatchExportOptions, w io.Writer) error {
 	batchID := opts.Args[0]
 
 	fmt.Fprintf(w, "Requesting export for batch %s...\n", batchID)
 
 	// Step 1: POST to initiate the export job.
-	initResult, err := c.InitiateBatchExport(batchID)
+	initResult, err := c.InitiateBatchExport(ctx, batchID)
 	if err != nil {
-		return fmt.Errorf("error requesting export: %s", err.Error())
+		return fmt.Errorf("error requesting export: %w", err)
 	}
 
 	// If already complete (cached result), download immediately.
 	if initResult.Status == batchExportStatusComplete {
-		return batchDownloadExport(initResult.URL, opts.Output, w)
+		return batchDownloadExport(ctx, initResult.URL, opts.Output, w)
 	}
 
 	if initResult.Status != batchExportStatusGenerating {
@@ -120,16 +120,21 @@ func exportBatch(c client.API, opts BatchExportOptions, w io.Writer) error {
 		}
 
 		fmt.Fprint(w, ".")
-		batchExportPollSleep(batchExportPollInterval)
+		if err := batchExportPollSleep(ctx, batchExportPollInterval); err != nil {
+			return batchExportCancelledError(batchID, exportID, err)
+		}
 
-		pollResult, err := c.GetBatchExportStatus(batchID, exportID)
+		pollResult, err := c.GetBatchExportStatus(ctx, batchID, exportID)
 		if err != nil {
-			return fmt.Errorf("error polling export status: %s", err.Error())
+			if ctx.Err() != nil {
+				return batchExportCancelledError(batchID, exportID, ctx.Err())
+			}
+			return fmt.Errorf("error polling export status: %w", err)
 		}
 
 		switch pollResult.Status {
 		case batchExportStatusComplete:
-			return batchDownloadExport(pollResult.URL, opts.Output, w)
+			return batchDownloadExport(ctx, pollResult.URL, opts.Output, w)
 		case batchExportStatusFailed:
 			return fmt.Errorf("export generation failed for batch %s", batchID)
 		case batchExportStatusGenerating:
@@ -140,16 +145,23 @@ func exportBatch(c client.API, opts BatchExportOptions, w io.Writer) error {
 	}
 }
 
-func batchDownloadExport(rawURL, outputPath string, w io.Writer) error {
+// batchExportCancelledError explains that polling stopped while the export
+// was still generating; the job carries on server-side, so re-running the
+// command picks up the finished archive rather than starting again.
+func batchExportCancelledError(batchID, exportID string, cause error) error {
+	return fmt.Errorf("export %s for batch %s was still generating when polling stopped; re-run the command to download it once ready: %w", exportID, batchID, cause)
+}
+
+func batchDownloadExport(ctx context.Context, rawURL, outputPath string, w io.Writer) error {
 	fmt.Fprintf(w, "\nExport ready. Downloading to %s...\n", outputPath)
-	if err := batchDownloadFile(rawURL, outputPath); err != nil {
-		return fmt.Errorf("error downloading export: %s", err.Error())
+	if err := batchDownloadFile(ctx, rawURL, outputPath); err != nil {
+		return fmt.Errorf("error downloading export: %w", err)
 	}
 	fmt.Fprintf(w, "Export saved to %s\n", outputPath)
 	return nil
 }
 
-func batchDownloadFile(rawURL, outputPath string) error {
+func batchDownloadFile(ctx context.Context, rawURL, outputPath string) error {
 	parsed, err := url.Parse(rawURL)
 	if err != nil {
 		return fmt.Errorf("invalid download URL: %w", err)
@@ -158,7 +170,7 @@ func batchDownloadFile(rawURL, outputPath string) error {
 		return fmt.Errorf("download URL must use HTTPS, got %q", parsed.Scheme)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), batchExportTimeout)
+	ctx, cancel := context.WithTimeout(ctx, batchExportTimeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
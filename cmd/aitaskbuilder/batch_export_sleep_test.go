@@ -1,6 +1,7 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"net/http"
 	"time"
 )
@@ -11,7 +12,7 @@ import (
 // This file is compiled only during `go test` and is intentionally in
 // package aitaskbuilder (not aitaskbuilder_test) so that it can access unexported
 // variables while still being callable from external test packages.
-func SetBatchExportPollSleepForTesting(f func(time.Duration)) func() {
+func SetBatchExportPollSleepForTesting(f func(context.Context, time.Duration) error) func() {
 	prev := batchExportPollSleep
 	batchExportPollSleep = f
 	return func() { batchExportPollSleep = prev }
@@ -3,6 +3,7 @@ package aitaskbuilder_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -78,7 +79,7 @@ func TestBatchExportCommandImmediateComplete(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		InitiateBatchExport(gomock.Eq(testBatchID)).
+		InitiateBatchExport(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.BatchExportResponse{
 			Status:    "complete",
 			URL:       srv.URL + "/export.zip",
@@ -91,6 +92,7 @@ func TestBatchExportCommandImmediateComplete(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 	if err := cmd.Flags().Set("output", outputPath); err != nil {
 		t.Fatalf("failed to set output flag: %v", err)
 	}
@@ -118,7 +120,7 @@ func TestBatchExportCommandImmediateComplete(t *testing.T) {
 // TestBatchExportCommandPollingToComplete covers the normal async flow:
 // POST returns "generating", then GET eventually returns "complete".
 func TestBatchExportCommandPollingToComplete(t *testing.T) {
-	defer aitaskbuilder.SetBatchExportPollSleepForTesting(func(time.Duration) {})()
+	defer aitaskbuilder.SetBatchExportPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	zipContent := []byte("PK\x03\x04fake zip content")
 	srv := newBatchZIPServer(t, zipContent)
@@ -129,7 +131,7 @@ func TestBatchExportCommandPollingToComplete(t *testing.T) {
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		InitiateBatchExport(gomock.Eq(testBatchID)).
+		InitiateBatchExport(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.BatchExportResponse{
 			Status:   "generating",
 			ExportID: testBatchExportID,
@@ -138,11 +140,11 @@ func TestBatchExportCommandPollingToComplete(t *testing.T) {
 
 	gomock.InOrder(
 		mockClient.EXPECT().
-			GetBatchExportStatus(gomock.Eq(testBatchID), gomock.Eq(testBatchExportID)).
+			GetBatchExportStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testBatchExportID)).
 			Return(&client.BatchExportResponse{Status: "generating"}, nil).
 			Times(1),
 		mockClient.EXPECT().
-			GetBatchExportStatus(gomock.Eq(testBatchID), gomock.Eq(testBatchExportID)).
+			GetBatchExportStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testBatchExportID)).
 			Return(&client.BatchExportResponse{
 				Status:    "complete",
 				URL:       srv.URL + "/export.zip",
@@ -156,6 +158,7 @@ func TestBatchExportCommandPollingToComplete(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 	if err := cmd.Flags().Set("output", outputPath); err != nil {
 		t.Fatalf("failed to set output flag: %v", err)
 	}
@@ -172,14 +175,14 @@ func TestBatchExportCommandPollingToComplete(t *testing.T) {
 }
 
 func TestBatchExportCommandFailedStatus(t *testing.T) {
-	defer aitaskbuilder.SetBatchExportPollSleepForTesting(func(time.Duration) {})()
+	defer aitaskbuilder.SetBatchExportPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		InitiateBatchExport(gomock.Eq(testBatchID)).
+		InitiateBatchExport(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.BatchExportResponse{
 			Status:   "generating",
 			ExportID: testBatchExportID,
@@ -187,13 +190,14 @@ func TestBatchExportCommandFailedStatus(t *testing.T) {
 		Times(1)
 
 	mockClient.EXPECT().
-		GetBatchExportStatus(gomock.Eq(testBatchID), gomock.Eq(testBatchExportID)).
+		GetBatchExportStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testBatchExportID)).
 		Return(&client.BatchExportResponse{Status: "failed"}, nil).
 		Times(1)
 
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testBatchID})
 	w.Flush()
@@ -211,13 +215,14 @@ func TestBatchExportCommandInitiateError(t *testing.T) {
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		InitiateBatchExport(gomock.Eq(testBatchID)).
+		InitiateBatchExport(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(nil, errors.New("network error")).
 		Times(1)
 
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testBatchID})
 	w.Flush()
@@ -236,7 +241,7 @@ func TestBatchExportCommandDefaultOutputPath(t *testing.T) {
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		InitiateBatchExport(gomock.Eq(testBatchID)).
+		InitiateBatchExport(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.BatchExportResponse{
 			Status:    "complete",
 			URL:       srv.URL + "/export.zip",
@@ -261,6 +266,7 @@ func TestBatchExportCommandDefaultOutputPath(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err = cmd.RunE(cmd, []string{testBatchID})
 	w.Flush()
@@ -1,6 +1,7 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,7 +9,9 @@ import (
 	"os"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +21,7 @@ type BatchInstructionsOptions struct {
 	BatchID          string
 	InstructionsFile string
 	InstructionsJSON string
+	Output           shared.OutputOptions
 }
 
 // NewBatchInstructionsCommand creates a new command for creating AI Task Builder instructions.
@@ -52,9 +56,9 @@ https://github.com/prolific-oss/cli/blob/main/docs/examples/batch-instructions.j
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := createBatchInstructions(client, opts, w)
+			err := createBatchInstructions(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -65,6 +69,7 @@ https://github.com/prolific-oss/cli/blob/main/docs/examples/batch-instructions.j
 	flags.StringVarP(&opts.BatchID, "batch-id", "b", "", "Batch ID (required) - The ID of the batch to add instructions to.")
 	flags.StringVarP(&opts.InstructionsFile, "file", "f", "", "Path to JSON file containing instructions")
 	flags.StringVarP(&opts.InstructionsJSON, "json", "j", "", "JSON string containing instructions")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("batch-id")
 
@@ -72,7 +77,7 @@ https://github.com/prolific-oss/cli/blob/main/docs/examples/batch-instructions.j
 }
 
 // createBatchInstructions will create instructions for an AI Task Builder batch
-func createBatchInstructions(c client.API, opts BatchInstructionsOptions, w io.Writer) error {
+func createBatchInstructions(ctx context.Context, c client.API, opts BatchInstructionsOptions, w io.Writer) error {
 	if opts.BatchID == "" {
 		return errors.New(ErrBatchIDRequired)
 	}
@@ -120,19 +125,23 @@ func createBatchInstructions(c client.API, opts BatchInstructionsOptions, w io.W
 	}
 
 	// Create the instructions
-	response, err := c.CreateAITaskBuilderInstructions(opts.BatchID, instructions)
+	response, err := c.CreateAITaskBuilderInstructions(ctx, opts.BatchID, instructions)
 	if err != nil {
 		return err
 	}
 
 	// Output the created instructions
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	fmt.Fprintf(w, "Successfully added %d instruction(s) to batch %s\n", len(*response), opts.BatchID)
 	for i, instruction := range *response {
 		fmt.Fprintf(w, "\nInstruction %d:\n", i+1)
 		fmt.Fprintf(w, "  ID: %s\n", instruction.ID)
 		fmt.Fprintf(w, "  Type: %s\n", instruction.Type)
 		fmt.Fprintf(w, "  Description: %s\n", instruction.Description)
-		fmt.Fprintf(w, "  Created At: %s\n", instruction.CreatedAt)
+		fmt.Fprintf(w, "  Created At: %s\n", ui.FormatDateTimeString(instruction.CreatedAt))
 		if len(instruction.Options) > 0 {
 			fmt.Fprintf(w, "  Options: %d\n", len(instruction.Options))
 		}
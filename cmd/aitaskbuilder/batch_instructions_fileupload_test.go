@@ -53,7 +53,7 @@ func TestNewBatchInstructionsCommandWithFileUpload(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -121,7 +121,7 @@ func TestNewBatchInstructionsCommandFileUploadMinimal(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -431,7 +431,7 @@ func TestNewBatchInstructionsCommandFileUploadMaxFileCountWithoutMin(t *testing.
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -63,7 +63,7 @@ func TestNewBatchInstructionsCommandCallsAPI(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -157,7 +157,7 @@ func TestNewBatchInstructionsCommandWithFile(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -199,7 +199,7 @@ func TestNewBatchInstructionsCommandAPIError(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(nil, errors.New("API error"))
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(nil, errors.New("API error"))
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -407,7 +407,7 @@ func TestNewBatchInstructionsCommandWithFreeTextWithUnit(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -684,7 +684,7 @@ func TestNewBatchInstructionsCommandFreeTextWithUnitWithoutDefaultUnit(t *testin
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -761,7 +761,7 @@ func TestNewBatchInstructionsCommandFreeTextWithUnitPrefixPosition(t *testing.T)
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -838,7 +838,7 @@ func TestNewBatchInstructionsCommandWithExclusiveOptionMultiSelect(t *testing.T)
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -915,7 +915,7 @@ func TestNewBatchInstructionsCommandExclusiveWithMultipleChoiceWithFreeText(t *t
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -1017,7 +1017,7 @@ func TestNewBatchInstructionsCommandFreeTextWithValidation(t *testing.T) {
 				},
 			}
 
-			c.EXPECT().CreateAITaskBuilderInstructions(tt.batchID, instructions).Return(&response, nil)
+			c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), tt.batchID, instructions).Return(&response, nil)
 
 			var buf bytes.Buffer
 			writer := bufio.NewWriter(&buf)
@@ -1078,7 +1078,7 @@ func TestNewBatchInstructionsCommandFreeTextWithValidationTypeOnly(t *testing.T)
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -1149,7 +1149,7 @@ func TestNewBatchInstructionsCommandFreeTextWithUnitWithUnitOptionValidation(t *
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
@@ -1209,7 +1209,7 @@ func TestNewBatchInstructionsCommandFreeTextWithExplicitNullValidation(t *testin
 		},
 	}
 
-	c.EXPECT().CreateAITaskBuilderInstructions(batchID, instructions).Return(&response, nil)
+	c.EXPECT().CreateAITaskBuilderInstructions(gomock.Any(), batchID, instructions).Return(&response, nil)
 
 	var buf bytes.Buffer
 	writer := bufio.NewWriter(&buf)
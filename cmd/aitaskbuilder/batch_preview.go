@@ -1,6 +1,7 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -53,9 +54,9 @@ $ prolific aitaskbuilder batch preview <batch_id>
 			opts.Args = args
 			opts.BatchID = args[0]
 
-			err := renderAITaskBuilderBatchPreview(c, opts, w)
+			err := renderAITaskBuilderBatchPreview(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err)
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -67,20 +68,20 @@ $ prolific aitaskbuilder batch preview <batch_id>
 
 // renderAITaskBuilderBatchPreview validates access to the batch, resolves a task
 // group, builds the preview URL, prints it, and attempts to open it in a browser.
-func renderAITaskBuilderBatchPreview(c client.API, opts BatchPreviewOptions, w io.Writer) error {
+func renderAITaskBuilderBatchPreview(ctx context.Context, c client.API, opts BatchPreviewOptions, w io.Writer) error {
 	if opts.BatchID == "" {
 		return errors.New(ErrBatchIDRequired)
 	}
 
 	// Fetch batch to validate access
-	_, err := c.GetAITaskBuilderBatch(opts.BatchID)
+	_, err := c.GetAITaskBuilderBatch(ctx, opts.BatchID)
 	if err != nil {
-		return fmt.Errorf("failed to get batch: %s", err)
+		return fmt.Errorf("failed to get batch: %w", err)
 	}
 
-	taskGroups, err := c.GetAITaskBuilderTaskGroups(opts.BatchID)
+	taskGroups, err := c.GetAITaskBuilderTaskGroups(ctx, opts.BatchID)
 	if err != nil {
-		return fmt.Errorf("failed to get task groups: %s", err)
+		return fmt.Errorf("failed to get task groups: %w", err)
 	}
 	if len(*taskGroups) == 0 {
 		return fmt.Errorf("%s %s", ErrNoTaskGroupsFound, opts.BatchID)
@@ -67,8 +67,8 @@ func TestBatchPreviewAcceptsPositionalBatchID(t *testing.T) {
 	}
 	taskGroups := client.GetAITaskBuilderTaskGroupsResponse{taskGroupID}
 
-	c.EXPECT().GetAITaskBuilderBatch(gomock.Eq(batchID)).Return(&response, nil).Times(1)
-	c.EXPECT().GetAITaskBuilderTaskGroups(gomock.Eq(batchID)).Return(&taskGroups, nil).Times(1)
+	c.EXPECT().GetAITaskBuilderBatch(gomock.Any(), gomock.Eq(batchID)).Return(&response, nil).Times(1)
+	c.EXPECT().GetAITaskBuilderTaskGroups(gomock.Any(), gomock.Eq(batchID)).Return(&taskGroups, nil).Times(1)
 
 	cmd := aitaskbuilder.NewBatchPreviewCommandWithOpener(c, os.Stdout, noOpBrowserOpener)
 	if err := cmd.RunE(cmd, []string{batchID}); err != nil {
@@ -89,8 +89,8 @@ func TestBatchPreviewCallsGetBatchAndTaskGroups(t *testing.T) {
 	}
 	taskGroups := client.GetAITaskBuilderTaskGroupsResponse{taskGroupID}
 
-	c.EXPECT().GetAITaskBuilderBatch(gomock.Eq(batchID)).Return(&response, nil).Times(1)
-	c.EXPECT().GetAITaskBuilderTaskGroups(gomock.Eq(batchID)).Return(&taskGroups, nil).Times(1)
+	c.EXPECT().GetAITaskBuilderBatch(gomock.Any(), gomock.Eq(batchID)).Return(&response, nil).Times(1)
+	c.EXPECT().GetAITaskBuilderTaskGroups(gomock.Any(), gomock.Eq(batchID)).Return(&taskGroups, nil).Times(1)
 
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
@@ -111,7 +111,7 @@ func TestBatchPreviewReturnsErrorOnBatchClientError(t *testing.T) {
 
 	batchID := "an-invalid-batch-id"
 
-	c.EXPECT().GetAITaskBuilderBatch(gomock.Eq(batchID)).Return(nil, errors.New("batch not found")).Times(1)
+	c.EXPECT().GetAITaskBuilderBatch(gomock.Any(), gomock.Eq(batchID)).Return(nil, errors.New("batch not found")).Times(1)
 
 	cmd := aitaskbuilder.NewBatchPreviewCommandWithOpener(c, os.Stdout, noOpBrowserOpener)
 	err := cmd.RunE(cmd, []string{batchID})
@@ -134,8 +134,8 @@ func TestBatchPreviewReturnsErrorWhenNoTaskGroups(t *testing.T) {
 	}
 	taskGroups := client.GetAITaskBuilderTaskGroupsResponse{}
 
-	c.EXPECT().GetAITaskBuilderBatch(gomock.Eq(batchID)).Return(&response, nil).Times(1)
-	c.EXPECT().GetAITaskBuilderTaskGroups(gomock.Eq(batchID)).Return(&taskGroups, nil).Times(1)
+	c.EXPECT().GetAITaskBuilderBatch(gomock.Any(), gomock.Eq(batchID)).Return(&response, nil).Times(1)
+	c.EXPECT().GetAITaskBuilderTaskGroups(gomock.Any(), gomock.Eq(batchID)).Return(&taskGroups, nil).Times(1)
 
 	cmd := aitaskbuilder.NewBatchPreviewCommandWithOpener(c, os.Stdout, noOpBrowserOpener)
 	err := cmd.RunE(cmd, []string{batchID})
@@ -159,8 +159,8 @@ func TestBatchPreviewOutputContainsURL(t *testing.T) {
 	}
 	taskGroups := client.GetAITaskBuilderTaskGroupsResponse{taskGroupID}
 
-	c.EXPECT().GetAITaskBuilderBatch(gomock.Eq(batchID)).Return(&response, nil).Times(1)
-	c.EXPECT().GetAITaskBuilderTaskGroups(gomock.Eq(batchID)).Return(&taskGroups, nil).Times(1)
+	c.EXPECT().GetAITaskBuilderBatch(gomock.Any(), gomock.Eq(batchID)).Return(&response, nil).Times(1)
+	c.EXPECT().GetAITaskBuilderTaskGroups(gomock.Any(), gomock.Eq(batchID)).Return(&taskGroups, nil).Times(1)
 
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
@@ -1,11 +1,13 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -14,6 +16,7 @@ type BatchSetupOptions struct {
 	BatchID       string
 	DatasetID     string
 	TasksPerGroup int
+	Output        shared.OutputOptions
 }
 
 func NewBatchSetupCommand(client client.API, w io.Writer) *cobra.Command {
@@ -34,9 +37,9 @@ $ prolific aitaskbuilder batch setup -b <batch_id> -d <dataset_id> --tasks-per-g
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := setupAITaskBuilderBatch(client, opts, w)
+			err := setupAITaskBuilderBatch(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -47,6 +50,7 @@ $ prolific aitaskbuilder batch setup -b <batch_id> -d <dataset_id> --tasks-per-g
 	flags.StringVarP(&opts.BatchID, "batch-id", "b", "", "Batch ID (required) - The ID of the batch to setup.")
 	flags.StringVarP(&opts.DatasetID, "dataset-id", "d", "", "Dataset ID (required) - The ID of the dataset to use for setup.")
 	flags.IntVar(&opts.TasksPerGroup, "tasks-per-group", 1, "Tasks per group - The number of tasks to assign per group (default 1).")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("batch-id")
 	_ = cmd.MarkFlagRequired("dataset-id")
@@ -55,7 +59,7 @@ $ prolific aitaskbuilder batch setup -b <batch_id> -d <dataset_id> --tasks-per-g
 }
 
 // setupAITaskBuilderBatch will setup an AI Task Builder batch
-func setupAITaskBuilderBatch(c client.API, opts BatchSetupOptions, w io.Writer) error {
+func setupAITaskBuilderBatch(ctx context.Context, c client.API, opts BatchSetupOptions, w io.Writer) error {
 	if opts.BatchID == "" {
 		return errors.New(ErrBatchIDRequired)
 	}
@@ -66,11 +70,15 @@ func setupAITaskBuilderBatch(c client.API, opts BatchSetupOptions, w io.Writer)
 		return errors.New(ErrTasksPerGroupMinimum)
 	}
 
-	_, err := c.SetupAITaskBuilderBatch(opts.BatchID, opts.DatasetID, opts.TasksPerGroup)
+	response, err := c.SetupAITaskBuilderBatch(ctx, opts.BatchID, opts.DatasetID, opts.TasksPerGroup)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	fmt.Fprintf(w, "Successfully setup batch %s\n", opts.BatchID)
 	fmt.Fprintf(w, "Dataset ID: %s\n", opts.DatasetID)
 	fmt.Fprintf(w, "Tasks per Group: %d\n", opts.TasksPerGroup)
@@ -71,7 +71,7 @@ func TestNewBatchSetupCommandCallsAPI(t *testing.T) {
 				// Empty response body
 			}
 
-			c.EXPECT().SetupAITaskBuilderBatch(batchID, datasetID, tc.tasksPerGroup).Return(response, nil)
+			c.EXPECT().SetupAITaskBuilderBatch(gomock.Any(), batchID, datasetID, tc.tasksPerGroup).Return(response, nil)
 
 			var b bytes.Buffer
 			writer := bufio.NewWriter(&b)
@@ -112,7 +112,7 @@ func TestNewBatchSetupCommandAPIError(t *testing.T) {
 	datasetID := "8c4c51f1-f6f3-43bc-b65d-7415e8ef22c0"
 	tasksPerGroup := 3
 
-	c.EXPECT().SetupAITaskBuilderBatch(batchID, datasetID, tasksPerGroup).Return(nil, errors.New("API error"))
+	c.EXPECT().SetupAITaskBuilderBatch(gomock.Any(), batchID, datasetID, tasksPerGroup).Return(nil, errors.New("API error"))
 
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
@@ -1,12 +1,14 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"time"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -25,12 +27,13 @@ const (
 
 // batchSyncPollSleep is the sleep function used between sync status polls.
 // Replaced in tests via SetBatchSyncPollSleepForTesting to avoid real delays.
-var batchSyncPollSleep func(time.Duration) = time.Sleep
+var batchSyncPollSleep func(context.Context, time.Duration) error = client.Sleep
 
 // BatchSyncOptions holds the options for the batch sync command.
 type BatchSyncOptions struct {
 	Args    []string
 	Timeout time.Duration
+	Output  shared.OutputOptions
 }
 
 // NewBatchSyncCommand creates a new `aitaskbuilder batch sync` command to extend
@@ -56,6 +59,10 @@ $ prolific aitaskbuilder batch sync 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a
 Sync with a shorter timeout:
 
 $ prolific aitaskbuilder batch sync 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a --timeout 2m
+
+Print only the tasks created, for scripting:
+
+$ prolific aitaskbuilder batch sync 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a --jq .tasks_created
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
@@ -64,31 +71,39 @@ $ prolific aitaskbuilder batch sync 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a --timeo
 				return errors.New(ErrBatchIDRequired)
 			}
 
-			return syncBatch(c, opts, w)
+			return syncBatch(cmd.Context(), c, opts, w)
 		},
 	}
 
 	cmd.Flags().DurationVarP(&opts.Timeout, "timeout", "t", batchSyncDefaultTimeout,
 		"Maximum time to wait for the sync to complete")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func syncBatch(c client.API, opts BatchSyncOptions, w io.Writer) error {
+func syncBatch(ctx context.Context, c client.API, opts BatchSyncOptions, w io.Writer) error {
 	batchID := opts.Args[0]
 
-	fmt.Fprintf(w, "Starting sync for batch %s...\n", batchID)
+	// Progress is only shown with the text output, so structured output can be
+	// piped straight into other tools.
+	progress := w
+	if opts.Output.IsStructured() {
+		progress = io.Discard
+	}
+
+	fmt.Fprintf(progress, "Starting sync for batch %s...\n", batchID)
 
 	// Step 1: POST to start the sync job.
-	initResult, err := c.SyncAITaskBuilderBatch(batchID)
+	initResult, err := c.SyncAITaskBuilderBatch(ctx, batchID)
 	if err != nil {
-		return fmt.Errorf("error: %s", err)
+		return fmt.Errorf("error: %w", err)
 	}
 
 	// A sync could in principle come back already terminal; handle both here.
 	switch initResult.Status {
 	case batchSyncStatusComplete:
-		return reportSyncComplete(initResult, batchID, w)
+		return reportSyncComplete(initResult, batchID, opts.Output, w)
 	case batchSyncStatusFailed:
 		return fmt.Errorf("error: sync failed for batch %s: %s", batchID, syncFailureReason(initResult))
 	case batchSyncStatusQueued, batchSyncStatusProcessing:
@@ -105,35 +120,44 @@ func syncBatch(c client.API, opts BatchSyncOptions, w io.Writer) error {
 	// Step 2: Poll GET until complete or failed, tolerating transient errors.
 	deadline := time.Now().Add(opts.Timeout)
 	consecutiveErrors := 0
+	// lastStatus is what we report if polling is cancelled: the job carries on
+	// server-side regardless, so the user needs to know where it had got to.
+	lastStatus := initResult.Status
 
 	for {
 		if time.Now().After(deadline) {
 			return fmt.Errorf("error: sync timed out after %s for batch %s", opts.Timeout, batchID)
 		}
 
-		fmt.Fprint(w, ".")
+		fmt.Fprint(progress, ".")
 		// Cap the sleep at the time remaining so --timeout bounds the total wait rather than being
 		// overshot by a whole poll interval.
 		sleep := batchSyncPollInterval
 		if remaining := time.Until(deadline); remaining < sleep {
 			sleep = remaining
 		}
-		batchSyncPollSleep(sleep)
+		if err := batchSyncPollSleep(ctx, sleep); err != nil {
+			return syncCancelledError(batchID, syncID, lastStatus, err)
+		}
 
-		pollResult, err := c.GetAITaskBuilderBatchSyncStatus(batchID, syncID)
+		pollResult, err := c.GetAITaskBuilderBatchSyncStatus(ctx, batchID, syncID)
 		if err != nil {
+			if ctx.Err() != nil {
+				return syncCancelledError(batchID, syncID, lastStatus, ctx.Err())
+			}
 			consecutiveErrors++
 			if consecutiveErrors >= batchSyncMaxPollErrors {
-				return fmt.Errorf("error: %s", err)
+				return fmt.Errorf("error: %w", err)
 			}
 			// Transient blip — keep polling until the deadline or the error cap.
 			continue
 		}
 		consecutiveErrors = 0
+		lastStatus = pollResult.Status
 
 		switch pollResult.Status {
 		case batchSyncStatusComplete:
-			return reportSyncComplete(pollResult, batchID, w)
+			return reportSyncComplete(pollResult, batchID, opts.Output, w)
 		case batchSyncStatusFailed:
 			return fmt.Errorf("error: sync failed for batch %s: %s", batchID, syncFailureReason(pollResult))
 		case batchSyncStatusQueued, batchSyncStatusProcessing:
@@ -144,7 +168,15 @@ func syncBatch(c client.API, opts BatchSyncOptions, w io.Writer) error {
 	}
 }
 
-func reportSyncComplete(r *client.AITaskBuilderBatchSyncResponse, batchID string, w io.Writer) error {
+func syncCancelledError(batchID, syncID, status string, cause error) error {
+	return fmt.Errorf("error: stopped waiting for sync %s on batch %s while it was %s; the sync continues server-side: %w", syncID, batchID, status, cause)
+}
+
+func reportSyncComplete(r *client.AITaskBuilderBatchSyncResponse, batchID string, output shared.OutputOptions, w io.Writer) error {
+	if output.IsStructured() {
+		return shared.RenderRecord(output, *r, w)
+	}
+
 	fmt.Fprintf(w, "\nSync complete for batch %s.\n", batchID)
 	fmt.Fprintf(w, "  Datapoints processed: %d\n", r.DatapointsProcessed)
 	fmt.Fprintf(w, "  Tasks created:        %d\n", r.TasksCreated)
@@ -1,6 +1,9 @@
 package aitaskbuilder
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // SetBatchSyncPollSleepForTesting replaces the poll sleep function for the duration of a
 // test. Call the returned function (typically via defer) to restore the original.
@@ -8,7 +11,7 @@ import "time"
 // This file is compiled only during `go test` and is intentionally in
 // package aitaskbuilder (not aitaskbuilder_test) so that it can access unexported
 // variables while still being callable from external test packages.
-func SetBatchSyncPollSleepForTesting(f func(time.Duration)) func() {
+func SetBatchSyncPollSleepForTesting(f func(context.Context, time.Duration) error) func() {
 	prev := batchSyncPollSleep
 	batchSyncPollSleep = f
 	return func() { batchSyncPollSleep = prev }
@@ -3,6 +3,7 @@ package aitaskbuilder_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -48,24 +49,24 @@ func TestBatchSyncCommandRequiresBatchID(t *testing.T) {
 // TestBatchSyncCommandPollingToComplete covers the normal async flow: POST
 // returns "queued", then GET returns "processing" and finally "complete".
 func TestBatchSyncCommandPollingToComplete(t *testing.T) {
-	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(time.Duration) {})()
+	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		SyncAITaskBuilderBatch(gomock.Eq(testBatchID)).
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.AITaskBuilderBatchSyncResponse{Status: "queued", SyncID: testSyncID}, nil).
 		Times(1)
 
 	gomock.InOrder(
 		mockClient.EXPECT().
-			GetAITaskBuilderBatchSyncStatus(gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
+			GetAITaskBuilderBatchSyncStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
 			Return(&client.AITaskBuilderBatchSyncResponse{Status: "processing"}, nil).
 			Times(1),
 		mockClient.EXPECT().
-			GetAITaskBuilderBatchSyncStatus(gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
+			GetAITaskBuilderBatchSyncStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
 			Return(&client.AITaskBuilderBatchSyncResponse{
 				Status:              "complete",
 				TasksCreated:        5,
@@ -79,6 +80,7 @@ func TestBatchSyncCommandPollingToComplete(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testBatchID})
 	w.Flush()
@@ -102,7 +104,7 @@ func TestBatchSyncCommandImmediateComplete(t *testing.T) {
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		SyncAITaskBuilderBatch(gomock.Eq(testBatchID)).
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.AITaskBuilderBatchSyncResponse{
 			Status:              "complete",
 			TasksCreated:        3,
@@ -115,6 +117,7 @@ func TestBatchSyncCommandImmediateComplete(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testBatchID})
 	w.Flush()
@@ -134,7 +137,7 @@ func TestBatchSyncCommandImmediateFailed(t *testing.T) {
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		SyncAITaskBuilderBatch(gomock.Eq(testBatchID)).
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.AITaskBuilderBatchSyncResponse{
 			Status: "failed",
 			Reason: "batch must be in READY status to sync",
@@ -144,6 +147,7 @@ func TestBatchSyncCommandImmediateFailed(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testBatchID})
 	w.Flush()
@@ -156,19 +160,19 @@ func TestBatchSyncCommandImmediateFailed(t *testing.T) {
 }
 
 func TestBatchSyncCommandFailedStatus(t *testing.T) {
-	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(time.Duration) {})()
+	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		SyncAITaskBuilderBatch(gomock.Eq(testBatchID)).
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.AITaskBuilderBatchSyncResponse{Status: "queued", SyncID: testSyncID}, nil).
 		Times(1)
 
 	mockClient.EXPECT().
-		GetAITaskBuilderBatchSyncStatus(gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
+		GetAITaskBuilderBatchSyncStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
 		Return(&client.AITaskBuilderBatchSyncResponse{
 			Status: "failed",
 			Reason: "dataset has an import in progress",
@@ -178,6 +182,7 @@ func TestBatchSyncCommandFailedStatus(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testBatchID})
 	w.Flush()
@@ -189,19 +194,57 @@ func TestBatchSyncCommandFailedStatus(t *testing.T) {
 	}
 }
 
+func TestBatchSyncCommandCancelledWhilePolling(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(ctx context.Context, _ time.Duration) error { return ctx.Err() })()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := mock_client.NewMockAPI(ctrl)
+
+	mockClient.EXPECT().
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
+		Return(&client.AITaskBuilderBatchSyncResponse{Status: "queued", SyncID: testSyncID}, nil).
+		Times(1)
+
+	// The first poll sees the job processing; the user then hits Ctrl-C.
+	mockClient.EXPECT().
+		GetAITaskBuilderBatchSyncStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
+		DoAndReturn(func(_ context.Context, _, _ string) (*client.AITaskBuilderBatchSyncResponse, error) {
+			cancel()
+			return &client.AITaskBuilderBatchSyncResponse{Status: "processing"}, nil
+		}).
+		Times(1)
+
+	var b bytes.Buffer
+	w := bufio.NewWriter(&b)
+	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, w)
+	cmd.SetContext(ctx)
+
+	err := cmd.RunE(cmd, []string{testBatchID})
+	w.Flush()
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), testSyncID) || !strings.Contains(err.Error(), "while it was processing") {
+		t.Errorf("expected error to report the sync state left behind, got: %v", err)
+	}
+}
+
 func TestBatchSyncCommandInitiateError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		SyncAITaskBuilderBatch(gomock.Eq(testBatchID)).
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(nil, errors.New("network error")).
 		Times(1)
 
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	if err := cmd.RunE(cmd, []string{testBatchID}); err == nil {
 		t.Fatal("expected error on client failure, got nil")
@@ -211,24 +254,24 @@ func TestBatchSyncCommandInitiateError(t *testing.T) {
 // TestBatchSyncCommandTransientPollErrorRecovers verifies the poll loop tolerates
 // a transient error and continues to a successful terminal state.
 func TestBatchSyncCommandTransientPollErrorRecovers(t *testing.T) {
-	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(time.Duration) {})()
+	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		SyncAITaskBuilderBatch(gomock.Eq(testBatchID)).
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.AITaskBuilderBatchSyncResponse{Status: "queued", SyncID: testSyncID}, nil).
 		Times(1)
 
 	gomock.InOrder(
 		mockClient.EXPECT().
-			GetAITaskBuilderBatchSyncStatus(gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
+			GetAITaskBuilderBatchSyncStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
 			Return(nil, errors.New("temporary network blip")).
 			Times(1),
 		mockClient.EXPECT().
-			GetAITaskBuilderBatchSyncStatus(gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
+			GetAITaskBuilderBatchSyncStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
 			Return(&client.AITaskBuilderBatchSyncResponse{Status: "complete"}, nil).
 			Times(1),
 	)
@@ -236,6 +279,7 @@ func TestBatchSyncCommandTransientPollErrorRecovers(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testBatchID})
 	w.Flush()
@@ -248,20 +292,21 @@ func TestBatchSyncCommandTransientPollErrorRecovers(t *testing.T) {
 }
 
 func TestBatchSyncCommandTimeout(t *testing.T) {
-	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(time.Duration) {})()
+	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		SyncAITaskBuilderBatch(gomock.Eq(testBatchID)).
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
 		Return(&client.AITaskBuilderBatchSyncResponse{Status: "queued", SyncID: testSyncID}, nil).
 		Times(1)
 
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 	// A zero timeout means the deadline is already in the past by the first poll
 	// check, so the loop exits before calling GET.
 	if err := cmd.Flags().Set("timeout", "0s"); err != nil {
@@ -277,3 +322,36 @@ func TestBatchSyncCommandTimeout(t *testing.T) {
 		t.Errorf("expected timeout error, got: %v", err)
 	}
 }
+
+// TestBatchSyncCommandStructuredOutput checks the progress messages are left
+// out, so the output is only the completed sync.
+func TestBatchSyncCommandStructuredOutput(t *testing.T) {
+	defer aitaskbuilder.SetBatchSyncPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := mock_client.NewMockAPI(ctrl)
+
+	mockClient.EXPECT().
+		SyncAITaskBuilderBatch(gomock.Any(), gomock.Eq(testBatchID)).
+		Return(&client.AITaskBuilderBatchSyncResponse{Status: "queued", SyncID: testSyncID}, nil).
+		Times(1)
+	mockClient.EXPECT().
+		GetAITaskBuilderBatchSyncStatus(gomock.Any(), gomock.Eq(testBatchID), gomock.Eq(testSyncID)).
+		Return(&client.AITaskBuilderBatchSyncResponse{Status: "complete", TasksCreated: 5}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := aitaskbuilder.NewBatchSyncCommand(mockClient, &b)
+	cmd.SetContext(context.Background())
+	_ = cmd.Flags().Set("output", "ndjson")
+
+	if err := cmd.RunE(cmd, []string{testBatchID}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := `{"status":"complete","tasks_created":5}` + "\n"
+	if b.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, b.String())
+	}
+}
@@ -1,17 +1,20 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 type BatchTasksOptions struct {
 	Args    []string
 	BatchID string
+	Output  shared.OutputOptions
 }
 
 func NewBatchTasksCommand(client client.API, w io.Writer) *cobra.Command {
@@ -31,9 +34,9 @@ $ prolific aitaskbuilder batch tasks -b <batch_id>
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderAITaskBuilderTasks(client, opts, w)
+			err := renderAITaskBuilderTasks(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -42,22 +45,27 @@ $ prolific aitaskbuilder batch tasks -b <batch_id>
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.BatchID, "batch-id", "b", "", "Batch ID (required) - The ID of the batch to retrieve tasks from.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("batch-id")
 
 	return cmd
 }
 
-func renderAITaskBuilderTasks(c client.API, opts BatchTasksOptions, w io.Writer) error {
+func renderAITaskBuilderTasks(ctx context.Context, c client.API, opts BatchTasksOptions, w io.Writer) error {
 	if opts.BatchID == "" {
 		return errors.New(ErrBatchIDRequired)
 	}
 
-	taskIDs, err := c.GetAITaskBuilderTasks(opts.BatchID)
+	taskIDs, err := c.GetAITaskBuilderTasks(ctx, opts.BatchID)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, *taskIDs, "", w)
+	}
+
 	fmt.Fprintf(w, "AI Task Builder Batch Tasks:\n")
 	fmt.Fprintf(w, "Batch ID: %s\n", opts.BatchID)
 	fmt.Fprintf(w, "Total Tasks: %d\n", len(*taskIDs))
@@ -48,7 +48,7 @@ func TestNewBatchTasksCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderTasks(gomock.Eq(batchID)).
+		GetAITaskBuilderTasks(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -87,7 +87,7 @@ func TestNewBatchTasksCommandHandlesEmptyResults(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderTasks(gomock.Eq(batchID)).
+		GetAITaskBuilderTasks(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -122,7 +122,7 @@ func TestNewBatchTasksCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderTasks(gomock.Eq(batchID)).
+		GetAITaskBuilderTasks(gomock.Any(), gomock.Eq(batchID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -170,7 +170,7 @@ func TestNewBatchTasksCommandHandlesTasksWithSingleTaskID(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderTasks(gomock.Eq(batchID)).
+		GetAITaskBuilderTasks(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -1,6 +1,7 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,8 @@ import (
 	"os"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -27,6 +30,7 @@ type BatchUpdateOptions struct {
 	ClearBatchItems         bool
 	EnableAutoSync          bool
 	DisableAutoSync         bool
+	Output                  shared.OutputOptions
 }
 
 func NewBatchUpdateCommand(client client.API, w io.Writer) *cobra.Command {
@@ -86,9 +90,9 @@ $ prolific aitaskbuilder batch update -b 497f6eca-6276-4993-bfeb-53cbbbba6f08 --
 			opts.TaskIntroductionChanged = cmd.Flags().Changed("task-introduction")
 			opts.TaskStepsChanged = cmd.Flags().Changed("task-steps")
 
-			err := updateAITaskBuilderBatch(client, opts, w)
+			err := updateAITaskBuilderBatch(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -107,6 +111,7 @@ $ prolific aitaskbuilder batch update -b 497f6eca-6276-4993-bfeb-53cbbbba6f08 --
 	flags.BoolVar(&opts.ClearBatchItems, "clear-batch-items", false, "Set batch_items to null, removing the configured task layout and deleting all associated instructions and content blocks.")
 	flags.BoolVar(&opts.EnableAutoSync, "auto-sync", false, "Enable automatic synchronization of new dataset datapoints into the batch.")
 	flags.BoolVar(&opts.DisableAutoSync, "no-auto-sync", false, "Disable automatic synchronization of new dataset datapoints into the batch.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("batch-id")
 
@@ -114,7 +119,7 @@ $ prolific aitaskbuilder batch update -b 497f6eca-6276-4993-bfeb-53cbbbba6f08 --
 }
 
 // updateAITaskBuilderBatch will update an existing AI Task Builder batch
-func updateAITaskBuilderBatch(c client.API, opts BatchUpdateOptions, w io.Writer) error {
+func updateAITaskBuilderBatch(ctx context.Context, c client.API, opts BatchUpdateOptions, w io.Writer) error {
 	if opts.BatchID == "" {
 		return errors.New(ErrBatchIDRequired)
 	}
@@ -160,18 +165,22 @@ func updateAITaskBuilderBatch(c client.API, opts BatchUpdateOptions, w io.Writer
 	}
 
 	if anyTaskDetailChanged {
-		taskDetails, err := resolveTaskDetails(c, opts, allTaskDetailsChanged)
+		taskDetails, err := resolveTaskDetails(ctx, c, opts, allTaskDetailsChanged)
 		if err != nil {
 			return err
 		}
 		params.TaskDetails = taskDetails
 	}
 
-	response, err := c.UpdateAITaskBuilderBatch(params)
+	response, err := c.UpdateAITaskBuilderBatch(ctx, params)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	fmt.Fprintf(w, "AI Task Builder Batch Updated Successfully:\n")
 	fmt.Fprintf(w, "ID: %s\n", response.ID)
 	fmt.Fprintf(w, "Name: %s\n", response.Name)
@@ -181,7 +190,7 @@ func updateAITaskBuilderBatch(c client.API, opts BatchUpdateOptions, w io.Writer
 	fmt.Fprintf(w, "Total Instruction Count: %d\n", response.TotalInstructionCount)
 	fmt.Fprintf(w, "Workspace ID: %s\n", response.WorkspaceID)
 	fmt.Fprintf(w, "Created By: %s\n", response.CreatedBy)
-	fmt.Fprintf(w, "Created At: %s\n", response.CreatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(w, "Created At: %s\n", ui.FormatDateTime(response.CreatedAt))
 	fmt.Fprintf(w, "Schema Version: %d\n", response.SchemaVersion)
 
 	if len(response.Datasets) > 0 {
@@ -204,7 +213,7 @@ func updateAITaskBuilderBatch(c client.API, opts BatchUpdateOptions, w io.Writer
 // resolveTaskDetails returns the task details to send in the update request.
 // When all three fields are provided they are used directly; otherwise the
 // existing batch is fetched and only the changed fields are overwritten.
-func resolveTaskDetails(c client.API, opts BatchUpdateOptions, allChanged bool) (*client.TaskDetails, error) {
+func resolveTaskDetails(ctx context.Context, c client.API, opts BatchUpdateOptions, allChanged bool) (*client.TaskDetails, error) {
 	if allChanged {
 		return &client.TaskDetails{
 			TaskName:         opts.TaskName,
@@ -213,7 +222,7 @@ func resolveTaskDetails(c client.API, opts BatchUpdateOptions, allChanged bool)
 		}, nil
 	}
 
-	existing, err := c.GetAITaskBuilderBatch(opts.BatchID)
+	existing, err := c.GetAITaskBuilderBatch(ctx, opts.BatchID)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +64,7 @@ func TestNewBatchUpdateCommandUpdatesName(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID: batchID,
 		Name:    batchName,
 	}).Return(response, nil)
@@ -84,7 +84,7 @@ func TestNewBatchUpdateCommandUpdatesName(t *testing.T) {
 
 	expected := fmt.Sprintf("AI Task Builder Batch Updated Successfully:\nID: %s\nName: %s\nStatus: %s\nAuto Sync Enabled: %v\nTotal Task Count: %d\nTotal Instruction Count: %d\nWorkspace ID: %s\nCreated By: %s\nCreated At: %s\nSchema Version: %d\n",
 		response.ID, response.Name, response.Status, response.AutoSyncEnabled, response.TotalTaskCount, response.TotalInstructionCount,
-		response.WorkspaceID, response.CreatedBy, "2025-02-27 18:03:59", response.SchemaVersion)
+		response.WorkspaceID, response.CreatedBy, "27-02-2025 18:03", response.SchemaVersion)
 
 	if b.String() != expected {
 		t.Fatalf("expected output:\n%s\ngot output:\n%s", expected, b.String())
@@ -121,7 +121,7 @@ func TestNewBatchUpdateCommandUpdatesAllTaskDetails(t *testing.T) {
 	}
 
 	// All three task detail flags provided — no GET call expected
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID: batchID,
 		TaskDetails: &client.TaskDetails{
 			TaskName:         taskName,
@@ -150,7 +150,7 @@ func TestNewBatchUpdateCommandUpdatesAllTaskDetails(t *testing.T) {
 
 	expected := fmt.Sprintf("AI Task Builder Batch Updated Successfully:\nID: %s\nName: %s\nStatus: %s\nAuto Sync Enabled: %v\nTotal Task Count: %d\nTotal Instruction Count: %d\nWorkspace ID: %s\nCreated By: %s\nCreated At: %s\nSchema Version: %d\n\nTask Details:\n  Name: %s\n  Introduction: %s\n  Steps: %s\n",
 		response.ID, response.Name, response.Status, response.AutoSyncEnabled, response.TotalTaskCount, response.TotalInstructionCount,
-		response.WorkspaceID, response.CreatedBy, "2025-02-27 18:03:59", response.SchemaVersion,
+		response.WorkspaceID, response.CreatedBy, "27-02-2025 18:03", response.SchemaVersion,
 		taskName, taskIntroduction, taskSteps)
 
 	if b.String() != expected {
@@ -183,7 +183,7 @@ func TestNewBatchUpdateCommandMergesPartialTaskDetails(t *testing.T) {
 	}
 
 	// Partial task details trigger a GET to fetch existing values
-	c.EXPECT().GetAITaskBuilderBatch(batchID).Return(existingBatch, nil)
+	c.EXPECT().GetAITaskBuilderBatch(gomock.Any(), batchID).Return(existingBatch, nil)
 
 	updateResponse := &client.UpdateAITaskBuilderBatchResponse{
 		AITaskBuilderBatch: model.AITaskBuilderBatch{
@@ -204,7 +204,7 @@ func TestNewBatchUpdateCommandMergesPartialTaskDetails(t *testing.T) {
 	}
 
 	// Merged params: new task name + existing introduction and steps
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID: batchID,
 		TaskDetails: &client.TaskDetails{
 			TaskName:         newTaskName,
@@ -238,7 +238,7 @@ func TestNewBatchUpdateCommandGetErrorOnPartialTaskDetails(t *testing.T) {
 
 	batchID := updateBatchID
 
-	c.EXPECT().GetAITaskBuilderBatch(batchID).Return(nil, errors.New("batch not found"))
+	c.EXPECT().GetAITaskBuilderBatch(gomock.Any(), batchID).Return(nil, errors.New("batch not found"))
 
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
@@ -264,7 +264,7 @@ func TestNewBatchUpdateCommandAPIError(t *testing.T) {
 
 	batchID := updateBatchID
 
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID: batchID,
 		Name:    "New Name",
 	}).Return(nil, errors.New("API error"))
@@ -349,7 +349,7 @@ func TestNewBatchUpdateCommandWithBatchItemsJSON(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID:    updateBatchID,
 		BatchItems: json.RawMessage(batchItemsJSON),
 	}).Return(response, nil)
@@ -389,7 +389,7 @@ func TestNewBatchUpdateCommandWithBatchItemsFile(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID:    updateBatchID,
 		BatchItems: json.RawMessage(batchItemsJSON),
 	}).Return(response, nil)
@@ -424,7 +424,7 @@ func TestNewBatchUpdateCommandClearBatchItems(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID:    updateBatchID,
 		BatchItems: json.RawMessage("null"),
 	}).Return(response, nil)
@@ -460,7 +460,7 @@ func TestNewBatchUpdateCommandEnablesAutoSync(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID:  updateBatchID,
 		AutoSync: new(true),
 	}).Return(response, nil)
@@ -496,7 +496,7 @@ func TestNewBatchUpdateCommandDisablesAutoSync(t *testing.T) {
 		},
 	}
 
-	c.EXPECT().UpdateAITaskBuilderBatch(client.UpdateBatchParams{
+	c.EXPECT().UpdateAITaskBuilderBatch(gomock.Any(), client.UpdateBatchParams{
 		BatchID:  updateBatchID,
 		AutoSync: new(false),
 	}).Return(response, nil)
@@ -1,11 +1,14 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +20,7 @@ type CreateDatasetOptions struct {
 	Schema      string // raw --schema value (inline JSON or path)
 	Strict      bool   // --strict flag value
 	StrictSet   bool   // whether --strict was explicitly passed
+	Output      shared.OutputOptions
 }
 
 // NewCreateDatasetCommand creates a new command for creating datasets
@@ -74,7 +78,7 @@ $ prolific aitaskbuilder dataset create -n "test" -w <workspace_id> --strict --s
 			opts.Args = args
 			opts.StrictSet = cmd.Flags().Changed("strict")
 
-			err := createAITaskBuilderDataset(client, opts, w)
+			err := createAITaskBuilderDataset(cmd.Context(), client, opts, w)
 			if err != nil {
 				return err
 			}
@@ -91,12 +95,13 @@ $ prolific aitaskbuilder dataset create -n "test" -w <workspace_id> --strict --s
 
 	_ = cmd.MarkFlagRequired("name")
 	_ = cmd.MarkFlagRequired("workspace-id")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // createAITaskBuilderDataset will create a new dataset
-func createAITaskBuilderDataset(c client.API, opts CreateDatasetOptions, w io.Writer) error {
+func createAITaskBuilderDataset(ctx context.Context, c client.API, opts CreateDatasetOptions, w io.Writer) error {
 	// Validate required fields
 	if opts.Name == "" {
 		return errors.New(ErrNameRequired)
@@ -119,15 +124,19 @@ func createAITaskBuilderDataset(c client.API, opts CreateDatasetOptions, w io.Wr
 	}
 
 	// Call API to create dataset in the specified workspace
-	response, err := c.CreateAITaskBuilderDataset(opts.WorkspaceID, payload)
+	response, err := c.CreateAITaskBuilderDataset(ctx, opts.WorkspaceID, payload)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	// Output full dataset details
 	fmt.Fprintf(w, "ID: %s\n", response.ID)
 	fmt.Fprintf(w, "Name: %s\n", response.Name)
-	fmt.Fprintf(w, "Created At: %s\n", response.CreatedAt)
+	fmt.Fprintf(w, "Created At: %s\n", ui.FormatDateTimeString(response.CreatedAt))
 	fmt.Fprintf(w, "Created By: %s\n", response.CreatedBy)
 	fmt.Fprintf(w, "Status: %s\n", response.Status)
 	fmt.Fprintf(w, "Total Datapoint Count: %d\n", response.TotalDatapointCount)
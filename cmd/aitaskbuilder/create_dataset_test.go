@@ -55,7 +55,7 @@ func TestNewCreateDatasetCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateAITaskBuilderDataset(gomock.Eq(workspaceID), gomock.Eq(payload)).
+		CreateAITaskBuilderDataset(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(payload)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -71,7 +71,7 @@ func TestNewCreateDatasetCommandCallsAPI(t *testing.T) {
 
 	expected := `ID: dataset-456
 Name: Test Dataset
-Created At: 2024-01-15T10:30:00Z
+Created At: 15-01-2024 10:30
 Created By: user-789
 Status: READY
 Total Datapoint Count: 0
@@ -112,7 +112,7 @@ func TestNewCreateDatasetCommandWithSchema(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateAITaskBuilderDataset(gomock.Eq(workspaceID), gomock.Eq(payload)).
+		CreateAITaskBuilderDataset(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(payload)).
 		Return(&response, nil).
 		Times(1)
 
@@ -132,7 +132,7 @@ func TestNewCreateDatasetCommandWithSchema(t *testing.T) {
 
 	expected := `ID: dataset-456
 Name: Test Dataset
-Created At: 2024-01-15T10:30:00Z
+Created At: 15-01-2024 10:30
 Created By: user-789
 Status: READY
 Total Datapoint Count: 0
@@ -174,7 +174,7 @@ func TestNewCreateDatasetCommandWithSchemaDefaultsStrictFalse(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateAITaskBuilderDataset(gomock.Eq(workspaceID), gomock.Eq(payload)).
+		CreateAITaskBuilderDataset(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(payload)).
 		Return(&response, nil).
 		Times(1)
 
@@ -193,7 +193,7 @@ func TestNewCreateDatasetCommandWithSchemaDefaultsStrictFalse(t *testing.T) {
 
 	expected := `ID: dataset-456
 Name: Test Dataset
-Created At: 2024-01-15T10:30:00Z
+Created At: 15-01-2024 10:30
 Created By: user-789
 Status: READY
 Total Datapoint Count: 0
@@ -292,7 +292,7 @@ func TestNewCreateDatasetCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateAITaskBuilderDataset(gomock.Eq(workspaceID), gomock.Eq(payload)).
+		CreateAITaskBuilderDataset(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(payload)).
 		Return(nil, errors.New(workspaceNotFoundError)).
 		AnyTimes()
 
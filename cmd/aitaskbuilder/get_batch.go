@@ -1,17 +1,21 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
 type BatchGetOptions struct {
 	Args    []string
 	BatchID string
+	Output  shared.OutputOptions
 }
 
 func NewGetBatchCommand(client client.API, w io.Writer) *cobra.Command {
@@ -30,9 +34,9 @@ $ prolific aitaskbuilder batch view -b <batch_id>
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderAITaskBuilderBatch(client, opts, w)
+			err := renderAITaskBuilderBatch(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -41,6 +45,7 @@ $ prolific aitaskbuilder batch view -b <batch_id>
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.BatchID, "batch-id", "b", "", "Batch ID (required) - The ID of the batch to retrieve.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("batch-id")
 
@@ -48,16 +53,20 @@ $ prolific aitaskbuilder batch view -b <batch_id>
 }
 
 // renderAITaskBuilderBatch will show details of a specific AI Task Builder batch
-func renderAITaskBuilderBatch(c client.API, opts BatchGetOptions, w io.Writer) error {
+func renderAITaskBuilderBatch(ctx context.Context, c client.API, opts BatchGetOptions, w io.Writer) error {
 	if opts.BatchID == "" {
 		return errors.New(ErrBatchIDRequired)
 	}
 
-	response, err := c.GetAITaskBuilderBatch(opts.BatchID)
+	response, err := c.GetAITaskBuilderBatch(ctx, opts.BatchID)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, response.AITaskBuilderBatch, w)
+	}
+
 	batch := response.AITaskBuilderBatch
 
 	fmt.Fprintf(w, "AI Task Builder Batch Details:\n")
@@ -68,7 +77,7 @@ func renderAITaskBuilderBatch(c client.API, opts BatchGetOptions, w io.Writer) e
 	fmt.Fprintf(w, "Total Instruction Count: %d\n", batch.TotalInstructionCount)
 	fmt.Fprintf(w, "Workspace ID: %s\n", batch.WorkspaceID)
 	fmt.Fprintf(w, "Created By: %s\n", batch.CreatedBy)
-	fmt.Fprintf(w, "Created At: %s\n", batch.CreatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(w, "Created At: %s\n", ui.FormatDateTime(batch.CreatedAt))
 	fmt.Fprintf(w, "Schema Version: %d\n", batch.SchemaVersion)
 
 	if len(batch.Datasets) > 0 {
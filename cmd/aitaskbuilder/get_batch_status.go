@@ -2,17 +2,20 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 type BatchGetStatusOptions struct {
 	Args    []string
 	BatchID string
+	Output  shared.OutputOptions
 }
 
 func NewGetBatchStatusCommand(client client.API, w io.Writer) *cobra.Command {
@@ -32,9 +35,9 @@ $ prolific aitaskbuilder batch check -b <batch_id>
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderAITaskBuilderBatchStatus(client, opts, w)
+			err := renderAITaskBuilderBatchStatus(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -43,22 +46,27 @@ $ prolific aitaskbuilder batch check -b <batch_id>
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.BatchID, "batch-id", "b", "", "Batch ID (required) - The ID of the batch to retrieve.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("batch-id")
 
 	return cmd
 }
 
-func renderAITaskBuilderBatchStatus(c client.API, opts BatchGetStatusOptions, w io.Writer) error {
+func renderAITaskBuilderBatchStatus(ctx context.Context, c client.API, opts BatchGetStatusOptions, w io.Writer) error {
 	if opts.BatchID == "" {
 		return errors.New(ErrBatchIDRequired)
 	}
 
-	response, err := c.GetAITaskBuilderBatchStatus(opts.BatchID)
+	response, err := c.GetAITaskBuilderBatchStatus(ctx, opts.BatchID)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	fmt.Fprintf(w, "AI Task Builder Batch Status:\n")
 	fmt.Fprintf(w, "Batch ID: %s\n", opts.BatchID)
 	fmt.Fprintf(w, "Status: %s\n", response.Status)
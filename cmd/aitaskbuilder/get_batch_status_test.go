@@ -49,7 +49,7 @@ func TestNewGetBatchStatusCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderBatchStatus(gomock.Eq(batchID)).
+		GetAITaskBuilderBatchStatus(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -82,7 +82,7 @@ func TestNewGetBatchStatusCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderBatchStatus(gomock.Eq(batchID)).
+		GetAITaskBuilderBatchStatus(gomock.Any(), gomock.Eq(batchID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -73,7 +73,7 @@ func TestNewGetBatchCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderBatch(gomock.Eq(batchID)).
+		GetAITaskBuilderBatch(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -94,7 +94,7 @@ Total Task Count: 0
 Total Instruction Count: 5
 Workspace ID: 6745ab669112d10b9b3afb48
 Created By: 6139f0d1dc08858054c63b2c
-Created At: 2025-02-27 18:03:59
+Created At: 27-02-2025 18:03
 Schema Version: 3
 Datasets: 1
   Dataset 1: 01954894-562f-71be-b2e0-adc7fdd7b3ea (10 datapoints)
@@ -136,7 +136,7 @@ func TestNewGetBatchCommandCallsAPIWithoutOptionalFields(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderBatch(gomock.Eq(batchID)).
+		GetAITaskBuilderBatch(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -157,7 +157,7 @@ Total Task Count: 5
 Total Instruction Count: 0
 Workspace ID: 6745ab669112d10b9b3afb48
 Created By: 6139f0d1dc08858054c63b2c
-Created At: 2025-02-27 18:03:59
+Created At: 27-02-2025 18:03
 Schema Version: 1
 `
 	actual := b.String()
@@ -176,7 +176,7 @@ func TestNewGetBatchCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderBatch(gomock.Eq(batchID)).
+		GetAITaskBuilderBatch(gomock.Any(), gomock.Eq(batchID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -1,11 +1,13 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -13,18 +15,35 @@ import (
 type BatchGetBatchesOptions struct {
 	Args        []string
 	WorkspaceID string
+	Limit       int
+	Offset      int
+	All         bool
+	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 }
 
-func renderAITaskBuilderBatches(c client.API, opts BatchGetBatchesOptions, w io.Writer) error {
+func renderAITaskBuilderBatches(ctx context.Context, c client.API, opts BatchGetBatchesOptions, w io.Writer) error {
 	if opts.WorkspaceID == "" {
 		return errors.New(ErrWorkspaceIDRequired)
 	}
 
-	response, err := c.GetAITaskBuilderBatches(opts.WorkspaceID)
+	limit := shared.OptionalLimit(opts.Limit, opts.Offset)
+	response, err := shared.FetchList(ctx, opts.All, limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.GetAITaskBuilderBatchesResponse, error) {
+		return c.GetAITaskBuilderBatches(ctx, opts.WorkspaceID, limit, offset)
+	})
 	if err != nil {
 		return err
 	}
 
+	response.Results, err = shared.ApplyQuery(opts.Query, response.Results)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, response.Results, "", w)
+	}
+
 	fmt.Fprintf(w, "AI Task Builder Batches by Workspace:\n")
 	fmt.Fprintf(w, "Workspace ID: %s\n", opts.WorkspaceID)
 	fmt.Fprintf(w, "Batches: %d\n", len(response.Results))
@@ -39,7 +58,7 @@ func renderAITaskBuilderBatches(c client.API, opts BatchGetBatchesOptions, w io.
 	return nil
 }
 
-func NewGetBatchesListCommand(client client.API, w io.Writer) *cobra.Command {
+func NewGetBatchesListCommand(c client.API, w io.Writer) *cobra.Command {
 	var opts BatchGetBatchesOptions
 
 	cmd := &cobra.Command{
@@ -51,13 +70,17 @@ This command lists all batches in a workspace by providing the workspace ID.`,
 		Example: `
 Get AI Task Builder batches:
 $ prolific aitaskbuilder batch list -w <workspace_id>
+
+Use the paging options to limit the batches returned, or fetch them all:
+$ prolific aitaskbuilder batch list -w <workspace_id> -l 10 -o 10
+$ prolific aitaskbuilder batch list -w <workspace_id> --all
 		`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderAITaskBuilderBatches(client, opts, w)
+			err := renderAITaskBuilderBatches(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -66,6 +89,11 @@ $ prolific aitaskbuilder batch list -w <workspace_id>
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.WorkspaceID, "workspace-id", "w", viper.GetString("workspace"), "Workspace ID (required) - The ID of the workspace to retrieve batches from.")
+	flags.IntVarP(&opts.Limit, "limit", "l", 0, "Limit the number of batches returned; by default they are not paged")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of batches to offset")
+	shared.AddAllFlag(cmd, &opts.All, "batches")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	_ = cmd.MarkFlagRequired("workspace-id")
 
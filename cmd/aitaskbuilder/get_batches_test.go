@@ -84,7 +84,7 @@ func TestNewGetBatchesCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderBatches(gomock.Eq(workspaceID)).
+		GetAITaskBuilderBatches(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(0), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -118,7 +118,7 @@ func TestNewGetBatchesCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderBatches(gomock.Eq(workspaceID)).
+		GetAITaskBuilderBatches(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(0), gomock.Eq(client.DefaultRecordOffset)).
 		Return(nil, errors.New(workspaceNotFoundError)).
 		AnyTimes()
 
@@ -166,7 +166,7 @@ func TestNewGetBatchesCommandWithNoBatches(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderBatches(gomock.Eq(workspaceID)).
+		GetAITaskBuilderBatches(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(0), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&response, nil).
 		AnyTimes()
 
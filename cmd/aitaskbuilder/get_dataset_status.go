@@ -2,17 +2,20 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 type DatasetGetStatusOptions struct {
 	Args      []string
 	DatasetID string
+	Output    shared.OutputOptions
 }
 
 func NewGetDatasetStatusCommand(client client.API, w io.Writer) *cobra.Command {
@@ -39,9 +42,9 @@ $ prolific aitaskbuilder dataset check -d <dataset_id>
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderAITaskBuilderDatasetStatus(client, opts, w)
+			err := renderAITaskBuilderDatasetStatus(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -50,22 +53,27 @@ $ prolific aitaskbuilder dataset check -d <dataset_id>
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.DatasetID, "dataset-id", "d", "", "Dataset ID (required) - The ID of the dataset to retrieve.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("dataset-id")
 
 	return cmd
 }
 
-func renderAITaskBuilderDatasetStatus(c client.API, opts DatasetGetStatusOptions, w io.Writer) error {
+func renderAITaskBuilderDatasetStatus(ctx context.Context, c client.API, opts DatasetGetStatusOptions, w io.Writer) error {
 	if opts.DatasetID == "" {
 		return errors.New(ErrDatasetIDRequired)
 	}
 
-	response, err := c.GetAITaskBuilderDatasetStatus(opts.DatasetID)
+	response, err := c.GetAITaskBuilderDatasetStatus(ctx, opts.DatasetID)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	fmt.Fprintf(w, "AI Task Builder Dataset Status:\n")
 	fmt.Fprintf(w, "Dataset ID: %s\n", opts.DatasetID)
 	fmt.Fprintf(w, "Status: %s\n", response.Status)
@@ -21,7 +21,7 @@ func setupMockClient(t *testing.T) *mock_client.MockAPI {
 	t.Cleanup(func() { ctrl.Finish() })
 	c := mock_client.NewMockAPI(ctrl)
 	c.EXPECT().
-		GetAITaskBuilderDataset(gomock.Any()).
+		GetAITaskBuilderDataset(gomock.Any(), gomock.Any()).
 		Return(&client.GetAITaskBuilderDatasetResponse{}, nil).
 		AnyTimes()
 	return c
@@ -82,7 +82,7 @@ func TestNewGetDatasetStatusCommandCallsAPI(t *testing.T) {
 
 			c.
 				EXPECT().
-				GetAITaskBuilderDatasetStatus(gomock.Eq(tc.datasetID)).
+				GetAITaskBuilderDatasetStatus(gomock.Any(), gomock.Eq(tc.datasetID)).
 				Return(&response, nil).
 				AnyTimes()
 
@@ -114,7 +114,7 @@ func TestNewGetDatasetStatusCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderDatasetStatus(gomock.Eq(datasetID)).
+		GetAITaskBuilderDatasetStatus(gomock.Any(), gomock.Eq(datasetID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -1,19 +1,24 @@
 package aitaskbuilder
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"sort"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
 type BatchGetResponsesOptions struct {
 	Args    []string
 	BatchID string
+	Output  shared.OutputOptions
+	Query   shared.QueryOptions
 }
 
 func NewGetResponsesCommand(client client.API, w io.Writer) *cobra.Command {
@@ -33,9 +38,9 @@ $ prolific aitaskbuilder batch responses -b <batch_id>
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderAITaskBuilderResponses(client, opts, w)
+			err := renderAITaskBuilderResponses(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -44,22 +49,33 @@ $ prolific aitaskbuilder batch responses -b <batch_id>
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.BatchID, "batch-id", "b", "", "Batch ID (required) - The ID of the batch to retrieve responses from.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	_ = cmd.MarkFlagRequired("batch-id")
 
 	return cmd
 }
 
-func renderAITaskBuilderResponses(c client.API, opts BatchGetResponsesOptions, w io.Writer) error {
+func renderAITaskBuilderResponses(ctx context.Context, c client.API, opts BatchGetResponsesOptions, w io.Writer) error {
 	if opts.BatchID == "" {
 		return errors.New(ErrBatchIDRequired)
 	}
 
-	response, err := c.GetAITaskBuilderResponses(opts.BatchID)
+	response, err := c.GetAITaskBuilderResponses(ctx, opts.BatchID)
 	if err != nil {
 		return err
 	}
 
+	response.Results, err = shared.ApplyQuery(opts.Query, response.Results)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, response.Results, "", w)
+	}
+
 	fmt.Fprintf(w, "AI Task Builder Batch Responses:\n")
 	fmt.Fprintf(w, "Batch ID: %s\n", opts.BatchID)
 	fmt.Fprintf(w, "Total Responses: %d\n", response.Meta.Count)
@@ -79,7 +95,7 @@ func renderAITaskBuilderResponses(c client.API, opts BatchGetResponsesOptions, w
 		fmt.Fprintf(w, "  Correlation ID: %s\n", resp.CorrelationID)
 		fmt.Fprintf(w, "  Submission ID: %s\n", resp.SubmissionID)
 		fmt.Fprintf(w, "  Schema Version: %d\n", resp.SchemaVersion)
-		fmt.Fprintf(w, "  Created At: %s\n", resp.CreatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Fprintf(w, "  Created At: %s\n", ui.FormatDateTime(resp.CreatedAt))
 
 		if len(resp.Metadata) > 0 {
 			fmt.Fprintf(w, "  Metadata:\n")
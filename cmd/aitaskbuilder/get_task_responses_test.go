@@ -95,7 +95,7 @@ func TestNewGetResponsesCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderResponses(gomock.Eq(batchID)).
+		GetAITaskBuilderResponses(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -120,7 +120,7 @@ Response 1:
   Correlation ID: correlation-001
   Submission ID: submission-001
   Schema Version: 2
-  Created At: 2024-01-01 12:00:00
+  Created At: 01-01-2024 12:00
   Metadata:
     key1: value1
     key2: value2
@@ -137,7 +137,7 @@ Response 2:
   Correlation ID: correlation-002
   Submission ID: submission-002
   Schema Version: 2
-  Created At: 2024-01-01 12:00:00
+  Created At: 01-01-2024 12:00
   Response:
     Instruction ID: instruction-002
     Type: multiple_choice
@@ -167,7 +167,7 @@ func TestNewGetResponsesCommandHandlesEmptyResults(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderResponses(gomock.Eq(batchID)).
+		GetAITaskBuilderResponses(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -202,7 +202,7 @@ func TestNewGetResponsesCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderResponses(gomock.Eq(batchID)).
+		GetAITaskBuilderResponses(gomock.Any(), gomock.Eq(batchID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -271,7 +271,7 @@ func TestNewGetResponsesCommandHandlesResponseWithEmptyAnswer(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderResponses(gomock.Eq(batchID)).
+		GetAITaskBuilderResponses(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -296,7 +296,7 @@ Response 1:
   Correlation ID: correlation-001
   Submission ID: submission-001
   Schema Version: 2
-  Created At: 2024-01-01 12:00:00
+  Created At: 01-01-2024 12:00
   Response:
     Instruction ID: instruction-001
     Type: free_text
@@ -347,7 +347,7 @@ func TestNewGetResponsesCommandHandlesFreeTextWithUnitResponse(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetAITaskBuilderResponses(gomock.Eq(batchID)).
+		GetAITaskBuilderResponses(gomock.Any(), gomock.Eq(batchID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -372,7 +372,7 @@ Response 1:
   Correlation ID: correlation-003
   Submission ID: submission-003
   Schema Version: 2
-  Created At: 2024-01-01 12:00:00
+  Created At: 01-01-2024 12:00
   Response:
     Instruction ID: instruction-003
     Type: free_text_with_unit
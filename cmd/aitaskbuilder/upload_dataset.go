@@ -17,7 +17,9 @@ import (
 	"time"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -29,7 +31,7 @@ const (
 )
 
 // DatasetUploadPollSleep is the sleep function used between dataset import status polls.
-var DatasetUploadPollSleep func(time.Duration) = time.Sleep
+var DatasetUploadPollSleep func(context.Context, time.Duration) error = client.Sleep
 
 var validAudioURLFileExtensions = map[string]bool{
 	".aac": true,
@@ -55,6 +57,7 @@ type DatasetUploadOptions struct {
 	FilePath  string
 	Format    string
 	Timeout   time.Duration
+	Output    shared.OutputOptions
 }
 
 // NewDatasetUploadCommand creates a new command for uploading to an AI Task Builder dataset.
@@ -79,14 +82,17 @@ $ prolific aitaskbuilder dataset upload -d <dataset_id> -f docs/examples/aitb-mo
 
 Upload a JSONL file with an explicit format override:
 $ prolific aitaskbuilder dataset upload -d <dataset_id> -f /tmp/records --format jsonl
+
+Print only the final import job as JSON, without the progress messages:
+$ prolific aitaskbuilder dataset upload -d <dataset_id> -f docs/examples/aitb-model-evaluation.csv --json
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 			opts.Args = args
 
-			err := uploadDatasetFile(client, opts, w)
+			err := uploadDatasetFile(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err)
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -98,6 +104,7 @@ $ prolific aitaskbuilder dataset upload -d <dataset_id> -f /tmp/records --format
 	flags.StringVarP(&opts.FilePath, "file", "f", "", "The path to the CSV or JSONL file to upload (required)")
 	flags.StringVar(&opts.Format, "format", "", "Override the detected file format (csv or jsonl)")
 	flags.DurationVar(&opts.Timeout, "timeout", datasetUploadDefaultTimeout, "Maximum time to wait for import processing. Defaults to 10 minutes.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("dataset-id")
 	_ = cmd.MarkFlagRequired("file")
@@ -113,7 +120,7 @@ type datasetUploadRequest struct {
 }
 
 // uploadDatasetFile uploads a file to an AI Task Builder dataset
-func uploadDatasetFile(client client.API, opts DatasetUploadOptions, w io.Writer) error {
+func uploadDatasetFile(ctx context.Context, client client.API, opts DatasetUploadOptions, w io.Writer) error {
 	if opts.DatasetID == "" {
 		return errors.New(ErrDatasetIDRequired)
 	}
@@ -131,7 +138,7 @@ func uploadDatasetFile(client client.API, opts DatasetUploadOptions, w io.Writer
 		return err
 	}
 
-	dataset, err := client.GetAITaskBuilderDataset(opts.DatasetID)
+	dataset, err := client.GetAITaskBuilderDataset(ctx, opts.DatasetID)
 	if err != nil {
 		return fmt.Errorf("failed to get dataset: %w", err)
 	}
@@ -140,10 +147,17 @@ func uploadDatasetFile(client client.API, opts DatasetUploadOptions, w io.Writer
 		return err
 	}
 
-	fmt.Fprintf(w, "Getting upload URL for dataset %s and file %s...\n", opts.DatasetID, uploadRequest.UploadFilename)
+	// Progress is only shown with the text output, so structured output can be
+	// piped straight into other tools.
+	progress := w
+	if opts.Output.IsStructured() {
+		progress = io.Discard
+	}
+
+	fmt.Fprintf(progress, "Getting upload URL for dataset %s and file %s...\n", opts.DatasetID, uploadRequest.UploadFilename)
 
 	// Get upload URL from API
-	uploadResponse, err := client.GetAITaskBuilderDatasetUploadURL(opts.DatasetID, uploadRequest.UploadFilename)
+	uploadResponse, err := client.GetAITaskBuilderDatasetUploadURL(ctx, opts.DatasetID, uploadRequest.UploadFilename)
 	if err != nil {
 		return fmt.Errorf("failed to get upload URL: %w", err)
 	}
@@ -151,18 +165,36 @@ func uploadDatasetFile(client client.API, opts DatasetUploadOptions, w io.Writer
 		return errors.New("import_id is missing in response")
 	}
 
-	fmt.Fprintf(w, "Upload URL obtained for import %s, expires at: %s\n", uploadResponse.ImportID, uploadResponse.ExpiresAt)
-	fmt.Fprintf(w, "Uploading %s...\n", uploadRequest.DisplayName)
+	fmt.Fprintf(progress, "Upload URL obtained for import %s, expires at: %s\n", uploadResponse.ImportID, ui.FormatDateTimeString(uploadResponse.ExpiresAt))
+	fmt.Fprintf(progress, "Uploading %s...\n", uploadRequest.DisplayName)
 
 	// Upload file to the presigned URL
-	err = uploadFileToPresignedURL(uploadRequest.LocalPath, uploadResponse.UploadURL, uploadResponse.HTTPMethod, uploadResponse.ContentType)
+	err = uploadFileToPresignedURL(ctx, uploadRequest.LocalPath, uploadResponse.UploadURL, uploadResponse.HTTPMethod, uploadResponse.ContentType)
 	if err != nil {
 		return fmt.Errorf("failed to upload file: %w", err)
 	}
 
-	fmt.Fprintf(w, "Upload received for dataset %s\nImport ID: %s\n", opts.DatasetID, uploadResponse.ImportID)
+	fmt.Fprintf(progress, "Upload received for dataset %s\nImport ID: %s\n", opts.DatasetID, uploadResponse.ImportID)
+
+	job, err := waitForDatasetImport(ctx, client, opts.DatasetID, uploadResponse.ImportID, opts.Timeout, progress)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *job, w)
+	}
+
+	switch job.Status {
+	case model.DatasetImportJobStatusPartial:
+		renderDatasetImportPartial(w, *job, uploadRequest.Format)
+	case model.DatasetImportJobStatusPendingSchema:
+		renderDatasetImportPendingSchema(w, *job)
+	default:
+		renderDatasetImportSuccess(w, *job, uploadRequest.Format)
+	}
 
-	return waitForDatasetImport(client, opts.DatasetID, uploadResponse.ImportID, uploadRequest.Format, opts.Timeout, w)
+	return nil
 }
 
 func prepareDatasetUploadRequest(filePath, formatOverride string) (*datasetUploadRequest, error) {
@@ -241,7 +273,7 @@ func detectDatasetUploadFormat(fileName string) (model.DatasetImportFormat, erro
 }
 
 // uploadFileToPresignedURL uploads a file to a presigned URL using the API-provided method and content type.
-func uploadFileToPresignedURL(filePath, uploadURL, method, contentType string) error {
+func uploadFileToPresignedURL(ctx context.Context, filePath, uploadURL, method, contentType string) error {
 	if method == "" {
 		return errors.New("upload response missing http method")
 	}
@@ -261,7 +293,7 @@ func uploadFileToPresignedURL(filePath, uploadURL, method, contentType string) e
 		return fmt.Errorf("failed to inspect file %s: %w", filePath, err)
 	}
 
-	request, err := http.NewRequestWithContext(context.Background(), method, uploadURL, file)
+	request, err := http.NewRequestWithContext(ctx, method, uploadURL, file)
 	if err != nil {
 		return err
 	}
@@ -479,29 +511,34 @@ func hasSupportedURLExtension(value string, extensions map[string]bool) bool {
 }
 
 func waitForDatasetImport(
+	ctx context.Context,
 	client client.API,
 	datasetID, importID string,
-	format model.DatasetImportFormat,
 	timeout time.Duration,
 	w io.Writer,
-) error {
+) (*model.DatasetImportJob, error) {
 	fmt.Fprint(w, "Processing import")
 
 	deadline := time.Now().Add(timeout)
 	consecutivePollErrors := 0
+	lastStatus := model.DatasetImportJobStatusUninitialised
 
 	for {
 		if time.Now().After(deadline) {
 			fmt.Fprintln(w)
-			return datasetImportTimeoutError(datasetID, importID, timeout)
+			return nil, datasetImportTimeoutError(datasetID, importID, timeout)
 		}
 
-		status, err := client.GetAITaskBuilderDatasetImportStatus(datasetID, importID)
+		status, err := client.GetAITaskBuilderDatasetImportStatus(ctx, datasetID, importID)
 		if err != nil {
+			if ctx.Err() != nil {
+				fmt.Fprintln(w)
+				return nil, datasetImportCancelledError(datasetID, importID, lastStatus, ctx.Err())
+			}
 			consecutivePollErrors++
 			if consecutivePollErrors >= datasetUploadMaxConsecutivePollErrors {
 				fmt.Fprintln(w)
-				return fmt.Errorf(
+				return nil, fmt.Errorf(
 					"failed to retrieve dataset %s import %s status after %d consecutive attempts: %s",
 					datasetID,
 					importID,
@@ -511,51 +548,49 @@ func waitForDatasetImport(
 			}
 
 			fmt.Fprint(w, ".")
-			if !sleepUntilNextDatasetImportPoll(deadline) {
+			if err := sleepUntilNextDatasetImportPoll(ctx, deadline); err != nil {
 				fmt.Fprintln(w)
-				return datasetImportTimeoutError(datasetID, importID, timeout)
+				return nil, datasetImportWaitError(datasetID, importID, lastStatus, timeout, err)
 			}
 			continue
 		}
 
 		consecutivePollErrors = 0
 		job := normalizeDatasetImportJob(status.DatasetImportJob, datasetID, importID)
+		lastStatus = job.Status
 
 		switch job.Status {
 		case model.DatasetImportJobStatusUninitialised,
 			model.DatasetImportJobStatusQueued,
 			model.DatasetImportJobStatusProcessing:
 			fmt.Fprint(w, ".")
-			if !sleepUntilNextDatasetImportPoll(deadline) {
+			if err := sleepUntilNextDatasetImportPoll(ctx, deadline); err != nil {
 				fmt.Fprintln(w)
-				return datasetImportTimeoutError(datasetID, importID, timeout)
+				return nil, datasetImportWaitError(datasetID, importID, lastStatus, timeout, err)
 			}
-		case model.DatasetImportJobStatusComplete:
+		case model.DatasetImportJobStatusComplete,
+			model.DatasetImportJobStatusPartial,
+			model.DatasetImportJobStatusPendingSchema:
 			fmt.Fprintln(w)
-			renderDatasetImportSuccess(w, job, format)
-			return nil
-		case model.DatasetImportJobStatusPartial:
-			fmt.Fprintln(w)
-			renderDatasetImportPartial(w, job, format)
-			return nil
+			return &job, nil
 		case model.DatasetImportJobStatusFailed:
 			fmt.Fprintln(w)
-			return errors.New(formatDatasetImportFailure(job))
-		case model.DatasetImportJobStatusPendingSchema:
-			fmt.Fprintln(w)
-			renderDatasetImportPendingSchema(w, job)
-			return nil
+			return nil, errors.New(formatDatasetImportFailure(job))
 		default:
 			fmt.Fprintln(w)
-			return fmt.Errorf("unexpected import status %q for dataset %s import %s", job.Status, job.DatasetID, job.ImportID)
+			return nil, fmt.Errorf("unexpected import status %q for dataset %s import %s", job.Status, job.DatasetID, job.ImportID)
 		}
 	}
 }
 
-func sleepUntilNextDatasetImportPoll(deadline time.Time) bool {
+// errDatasetImportDeadline is returned by sleepUntilNextDatasetImportPoll once
+// the --timeout deadline has passed, as opposed to the context being cancelled.
+var errDatasetImportDeadline = errors.New("dataset import deadline exceeded")
+
+func sleepUntilNextDatasetImportPoll(ctx context.Context, deadline time.Time) error {
 	remaining := time.Until(deadline)
 	if remaining <= 0 {
-		return false
+		return errDatasetImportDeadline
 	}
 
 	sleepDuration := datasetUploadPollInterval
@@ -563,8 +598,14 @@ func sleepUntilNextDatasetImportPoll(deadline time.Time) bool {
 		sleepDuration = remaining
 	}
 
-	DatasetUploadPollSleep(sleepDuration)
-	return true
+	return DatasetUploadPollSleep(ctx, sleepDuration)
+}
+
+func datasetImportWaitError(datasetID, importID string, lastStatus model.DatasetImportJobStatus, timeout time.Duration, err error) error {
+	if errors.Is(err, errDatasetImportDeadline) {
+		return datasetImportTimeoutError(datasetID, importID, timeout)
+	}
+	return datasetImportCancelledError(datasetID, importID, lastStatus, err)
 }
 
 func datasetImportTimeoutError(datasetID, importID string, timeout time.Duration) error {
@@ -576,6 +617,16 @@ func datasetImportTimeoutError(datasetID, importID string, timeout time.Duration
 	)
 }
 
+func datasetImportCancelledError(datasetID, importID string, lastStatus model.DatasetImportJobStatus, cause error) error {
+	return fmt.Errorf(
+		"stopped waiting for dataset %s import %s while it was %s; processing may still continue server-side: %w",
+		datasetID,
+		importID,
+		lastStatus,
+		cause,
+	)
+}
+
 func normalizeDatasetImportJob(job model.DatasetImportJob, datasetID, importID string) model.DatasetImportJob {
 	if job.DatasetID == "" {
 		job.DatasetID = datasetID
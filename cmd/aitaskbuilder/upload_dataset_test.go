@@ -3,6 +3,7 @@ package aitaskbuilder_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -21,7 +22,7 @@ import (
 	"github.com/prolific-oss/cli/model"
 )
 
-func setDatasetUploadPollSleepForTesting(f func(time.Duration)) func() {
+func setDatasetUploadPollSleepForTesting(f func(context.Context, time.Duration) error) func() {
 	prev := aitaskbuilder.DatasetUploadPollSleep
 	aitaskbuilder.DatasetUploadPollSleep = f
 	return func() { aitaskbuilder.DatasetUploadPollSleep = prev }
@@ -31,6 +32,7 @@ func TestNewDatasetUploadCommand(t *testing.T) {
 	c := setupMockClient(t)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 
 	if cmd.Use != "upload" {
 		t.Fatalf("expected use: upload; got %s", cmd.Use)
@@ -72,7 +74,7 @@ func TestNewDatasetUploadCommand(t *testing.T) {
 }
 
 func TestDatasetUploadCommandUploadsDetectedCSV(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	fileContent := []byte("name,score\nalice,10\n")
 	filePath := filepath.Join(t.TempDir(), "dataset.csv")
@@ -102,7 +104,7 @@ func TestDatasetUploadCommandUploadsDetectedCSV(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-123"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -118,7 +120,7 @@ func TestDatasetUploadCommandUploadsDetectedCSV(t *testing.T) {
 	acceptedCount := 3
 	rejectedCount := 0
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-123"),
 			gomock.Eq("import-123"),
 		).
@@ -136,6 +138,7 @@ func TestDatasetUploadCommandUploadsDetectedCSV(t *testing.T) {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, writer)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-123")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -180,7 +183,7 @@ func TestDatasetUploadCommandUploadsDetectedCSV(t *testing.T) {
 }
 
 func TestDatasetUploadCommandFormatOverrideAppendsExtension(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	fileContent := []byte("{\"name\":\"alice\"}\n")
 	filePath := filepath.Join(t.TempDir(), "records.data")
@@ -197,7 +200,7 @@ func TestDatasetUploadCommandFormatOverrideAppendsExtension(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-456"),
 			gomock.Eq("records.data.jsonl"),
 		).
@@ -213,7 +216,7 @@ func TestDatasetUploadCommandFormatOverrideAppendsExtension(t *testing.T) {
 	acceptedCount := 1
 	rejectedCount := 0
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-456"),
 			gomock.Eq("import-456"),
 		).
@@ -231,6 +234,7 @@ func TestDatasetUploadCommandFormatOverrideAppendsExtension(t *testing.T) {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, writer)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-456")
 	_ = cmd.Flags().Set("file", filePath)
 	_ = cmd.Flags().Set("format", "jsonl")
@@ -286,7 +290,7 @@ func TestDatasetUploadCommandRejectsUnsupportedCSVMediaExtension(t *testing.T) {
 			defer ctrl.Finish()
 			c := mock_client.NewMockAPI(ctrl)
 			c.EXPECT().
-				GetAITaskBuilderDataset(gomock.Eq(tt.datasetID)).
+				GetAITaskBuilderDataset(gomock.Any(), gomock.Eq(tt.datasetID)).
 				Return(&client.GetAITaskBuilderDatasetResponse{
 					Schema: &client.DatasetSchema{
 						Fields: map[string]client.DatasetSchemaField{
@@ -297,6 +301,7 @@ func TestDatasetUploadCommandRejectsUnsupportedCSVMediaExtension(t *testing.T) {
 				Times(1)
 
 			cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+			cmd.SetContext(context.Background())
 			_ = cmd.Flags().Set("dataset-id", tt.datasetID)
 			_ = cmd.Flags().Set("file", filePath)
 
@@ -355,7 +360,7 @@ func TestValidateMediaURLFieldsInJSONLRejectsUnsupportedExtension(t *testing.T)
 }
 
 func TestDatasetUploadCommandRendersPartialImportSummary(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	filePath := filepath.Join(t.TempDir(), "dataset.csv")
 	if err := os.WriteFile(filePath, []byte("name,score\nalice,10\n"), 0o600); err != nil {
@@ -369,7 +374,7 @@ func TestDatasetUploadCommandRendersPartialImportSummary(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-partial"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -386,7 +391,7 @@ func TestDatasetUploadCommandRendersPartialImportSummary(t *testing.T) {
 	duplicateCount := 2
 	rejectedCount := 25
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-partial"),
 			gomock.Eq("import-partial"),
 		).
@@ -410,6 +415,7 @@ func TestDatasetUploadCommandRendersPartialImportSummary(t *testing.T) {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, writer)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-partial")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -437,7 +443,7 @@ func TestDatasetUploadCommandRendersPartialImportSummary(t *testing.T) {
 }
 
 func TestDatasetUploadCommandRendersDuplicateOnlySuccess(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	filePath := filepath.Join(t.TempDir(), "dataset.csv")
 	if err := os.WriteFile(filePath, []byte("name,score\nalice,10\n"), 0o600); err != nil {
@@ -451,7 +457,7 @@ func TestDatasetUploadCommandRendersDuplicateOnlySuccess(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-duplicates"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -468,7 +474,7 @@ func TestDatasetUploadCommandRendersDuplicateOnlySuccess(t *testing.T) {
 	duplicateCount := 3
 	rejectedCount := 0
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-duplicates"),
 			gomock.Eq("import-duplicates"),
 		).
@@ -487,6 +493,7 @@ func TestDatasetUploadCommandRendersDuplicateOnlySuccess(t *testing.T) {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, writer)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-duplicates")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -519,6 +526,7 @@ func TestDatasetUploadCommandRejectsUndeterminedFormat(t *testing.T) {
 	c := setupMockClient(t)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-789")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -541,6 +549,7 @@ func TestDatasetUploadCommandRejectsEmptyFile(t *testing.T) {
 	c := setupMockClient(t)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-999")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -555,7 +564,7 @@ func TestDatasetUploadCommandRejectsEmptyFile(t *testing.T) {
 }
 
 func TestDatasetUploadCommandDoesNotPrintUsageForRuntimeError(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	filePath := filepath.Join(t.TempDir(), "dataset.csv")
 	if err := os.WriteFile(filePath, []byte("name,score\nalice,10\n"), 0o600); err != nil {
@@ -569,7 +578,7 @@ func TestDatasetUploadCommandDoesNotPrintUsageForRuntimeError(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-schema"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -583,7 +592,7 @@ func TestDatasetUploadCommandDoesNotPrintUsageForRuntimeError(t *testing.T) {
 		Times(1)
 
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-schema"),
 			gomock.Eq("import-schema"),
 		).
@@ -601,6 +610,7 @@ func TestDatasetUploadCommandDoesNotPrintUsageForRuntimeError(t *testing.T) {
 	var stderr bytes.Buffer
 	writer := bufio.NewWriter(&stdout)
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, writer)
+	cmd.SetContext(context.Background())
 	cmd.SetOut(io.Discard)
 	cmd.SetErr(&stderr)
 	cmd.SetArgs([]string{"-d", "dataset-schema", "-f", filePath})
@@ -625,7 +635,7 @@ func TestDatasetUploadCommandDoesNotPrintUsageForRuntimeError(t *testing.T) {
 }
 
 func TestDatasetUploadCommandTimesOutWhilePolling(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	filePath := filepath.Join(t.TempDir(), "dataset.csv")
 	if err := os.WriteFile(filePath, []byte("name\nalice\n"), 0o600); err != nil {
@@ -639,7 +649,7 @@ func TestDatasetUploadCommandTimesOutWhilePolling(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-timeout"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -653,7 +663,7 @@ func TestDatasetUploadCommandTimesOutWhilePolling(t *testing.T) {
 		Times(1)
 
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-timeout"),
 			gomock.Eq("import-timeout"),
 		).
@@ -665,6 +675,7 @@ func TestDatasetUploadCommandTimesOutWhilePolling(t *testing.T) {
 		MinTimes(1)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-timeout")
 	_ = cmd.Flags().Set("file", filePath)
 	_ = cmd.Flags().Set("timeout", "1ms")
@@ -680,7 +691,7 @@ func TestDatasetUploadCommandTimesOutWhilePolling(t *testing.T) {
 }
 
 func TestDatasetUploadCommandFailsAfterConsecutivePollingErrors(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	filePath := filepath.Join(t.TempDir(), "dataset.csv")
 	if err := os.WriteFile(filePath, []byte("name\nalice\n"), 0o600); err != nil {
@@ -694,7 +705,7 @@ func TestDatasetUploadCommandFailsAfterConsecutivePollingErrors(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-errors"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -708,7 +719,7 @@ func TestDatasetUploadCommandFailsAfterConsecutivePollingErrors(t *testing.T) {
 		Times(1)
 
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-errors"),
 			gomock.Eq("import-errors"),
 		).
@@ -716,6 +727,7 @@ func TestDatasetUploadCommandFailsAfterConsecutivePollingErrors(t *testing.T) {
 		Times(3)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-errors")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -730,7 +742,7 @@ func TestDatasetUploadCommandFailsAfterConsecutivePollingErrors(t *testing.T) {
 }
 
 func TestDatasetUploadCommandWarnsForPendingSchema(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	filePath := filepath.Join(t.TempDir(), "dataset.csv")
 	if err := os.WriteFile(filePath, []byte("name\nalice\n"), 0o600); err != nil {
@@ -744,7 +756,7 @@ func TestDatasetUploadCommandWarnsForPendingSchema(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-schema"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -758,7 +770,7 @@ func TestDatasetUploadCommandWarnsForPendingSchema(t *testing.T) {
 		Times(1)
 
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-schema"),
 			gomock.Eq("import-schema"),
 		).
@@ -772,6 +784,7 @@ func TestDatasetUploadCommandWarnsForPendingSchema(t *testing.T) {
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, writer)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-schema")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -792,7 +805,7 @@ func TestDatasetUploadCommandWarnsForPendingSchema(t *testing.T) {
 }
 
 func TestDatasetUploadCommandReturnsFailedImportError(t *testing.T) {
-	defer setDatasetUploadPollSleepForTesting(func(time.Duration) {})()
+	defer setDatasetUploadPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	filePath := filepath.Join(t.TempDir(), "dataset.csv")
 	if err := os.WriteFile(filePath, []byte("name\nalice\n"), 0o600); err != nil {
@@ -806,7 +819,7 @@ func TestDatasetUploadCommandReturnsFailedImportError(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-failed"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -830,7 +843,7 @@ func TestDatasetUploadCommandReturnsFailedImportError(t *testing.T) {
 	}
 
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-failed"),
 			gomock.Eq("import-failed"),
 		).
@@ -847,6 +860,7 @@ func TestDatasetUploadCommandReturnsFailedImportError(t *testing.T) {
 		Times(1)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-failed")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -877,6 +891,7 @@ func TestDatasetUploadCommandRejectsNonPositiveTimeout(t *testing.T) {
 	c := setupMockClient(t)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-timeout-invalid")
 	_ = cmd.Flags().Set("file", "ignored.csv")
 	_ = cmd.Flags().Set("timeout", "0s")
@@ -904,7 +919,7 @@ func TestDatasetUploadCommandReturnsUploadStatusError(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-321"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -918,6 +933,7 @@ func TestDatasetUploadCommandReturnsUploadStatusError(t *testing.T) {
 		Times(1)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-321")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -939,7 +955,7 @@ func TestDatasetUploadCommandRejectsMissingImportID(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-missing-import"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -952,6 +968,7 @@ func TestDatasetUploadCommandRejectsMissingImportID(t *testing.T) {
 		Times(1)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-missing-import")
 	_ = cmd.Flags().Set("file", filePath)
 
@@ -972,9 +989,10 @@ func TestDatasetUploadCommandCapsPollSleepToRemainingTimeout(t *testing.T) {
 	}
 
 	sleepDurations := make([]time.Duration, 0, 1)
-	restoreSleep := setDatasetUploadPollSleepForTesting(func(d time.Duration) {
+	restoreSleep := setDatasetUploadPollSleepForTesting(func(_ context.Context, d time.Duration) error {
 		sleepDurations = append(sleepDurations, d)
 		time.Sleep(d)
+		return nil
 	})
 	defer restoreSleep()
 
@@ -985,7 +1003,7 @@ func TestDatasetUploadCommandCapsPollSleepToRemainingTimeout(t *testing.T) {
 
 	c := setupMockClient(t)
 	c.EXPECT().
-		GetAITaskBuilderDatasetUploadURL(
+		GetAITaskBuilderDatasetUploadURL(gomock.Any(),
 			gomock.Eq("dataset-short-timeout"),
 			gomock.Eq("dataset.csv"),
 		).
@@ -999,7 +1017,7 @@ func TestDatasetUploadCommandCapsPollSleepToRemainingTimeout(t *testing.T) {
 		Times(1)
 
 	c.EXPECT().
-		GetAITaskBuilderDatasetImportStatus(
+		GetAITaskBuilderDatasetImportStatus(gomock.Any(),
 			gomock.Eq("dataset-short-timeout"),
 			gomock.Eq("import-short-timeout"),
 		).
@@ -1011,6 +1029,7 @@ func TestDatasetUploadCommandCapsPollSleepToRemainingTimeout(t *testing.T) {
 		MinTimes(1)
 
 	cmd := aitaskbuilder.NewDatasetUploadCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
 	_ = cmd.Flags().Set("dataset-id", "dataset-short-timeout")
 	_ = cmd.Flags().Set("file", filePath)
 	_ = cmd.Flags().Set("timeout", "20ms")
@@ -0,0 +1,164 @@
+package apply
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/model"
+	"github.com/spf13/cobra"
+)
+
+// NewApplyCommand creates a new `apply` command to create and update studies
+// to match their study files.
+func NewApplyCommand(c client.API, w io.Writer) *cobra.Command {
+	var opts PlanOptions
+	var nonInteractive bool
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create and update studies to match their study files",
+		Long: `Create and update studies to match their study files
+
+Shows the same plan as "plan", then once confirmed creates the studies that
+do not exist yet and updates the ones that differ from their files. Only the
+fields that differ are sent. Fields the API will not change on a published
+study are skipped with a warning.
+
+The ID of each study is recorded against its file in the state file, so later
+runs find it even if its internal_name changes. Keep the state file alongside
+your study files, for example in version control.`,
+		Example: `
+Apply every study file in a directory
+$ prolific apply -f studies/
+
+Apply without being asked to confirm, for example in CI
+$ prolific apply -f studies/ -n`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			err := applyStudies(cmd.Context(), c, opts, nonInteractive, cmd.InOrStdin(), w)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	addPlanFlags(cmd, &opts)
+	cmd.Flags().BoolVarP(&nonInteractive, "non-interactive", "n", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func applyStudies(ctx context.Context, c client.API, opts PlanOptions, nonInteractive bool, r io.Reader, w io.Writer) error {
+	state, err := LoadState(opts.StatePath)
+	if err != nil {
+		return err
+	}
+
+	plan, err := BuildPlan(ctx, c, opts, state)
+	if err != nil {
+		return err
+	}
+
+	RenderPlan(plan, w)
+
+	if !plan.HasChanges() {
+		fmt.Fprintln(w, "\nNo changes to apply.")
+		return recordMatches(plan, state)
+	}
+
+	confirmed, err := confirmApply(plan, nonInteractive, r, w)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Fprintln(w, "Apply cancelled.")
+		return nil
+	}
+
+	if err := recordMatches(plan, state); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	for _, s := range plan.Studies {
+		switch s.Action {
+		case ActionCreate:
+			study, err := createStudy(ctx, c, s.File, opts.TemplateFile)
+			if err != nil {
+				return fmt.Errorf("%s: %w", s.File, err)
+			}
+			state.Studies[s.Key] = study.ID
+			if err := state.Save(); err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "Created %s: %s\n", s.File, study.ID)
+
+		case ActionUpdate:
+			if _, err := c.UpdateStudy(ctx, s.Study.ID, s.Payload()); err != nil {
+				return fmt.Errorf("%s: %w", s.File, err)
+			}
+			fmt.Fprintf(w, "Updated %s: %s\n", s.File, s.Study.ID)
+		}
+	}
+
+	fmt.Fprintf(w, "\nApply complete: %d created, %d updated, %d unchanged.\n", plan.Count(ActionCreate), plan.Count(ActionUpdate), plan.Count(ActionNone))
+	return nil
+}
+
+// recordMatches records the studies found by internal_name in the state, even
+// if they are unchanged, so renaming one later updates it rather than creating
+// another.
+func recordMatches(plan Plan, state *State) error {
+	recorded := false
+	for _, s := range plan.Studies {
+		if s.Study != nil && state.Studies[s.Key] != s.Study.ID {
+			state.Studies[s.Key] = s.Study.ID
+			recorded = true
+		}
+	}
+	if !recorded {
+		return nil
+	}
+	return state.Save()
+}
+
+// createStudy creates a study from its file, as `study create` does.
+func createStudy(ctx context.Context, c client.API, file string, opts shared.TemplateFileOptions) (*model.Study, error) {
+	v, err := shared.ReadTemplateFile(file, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var s model.CreateStudy
+	if err := v.Unmarshal(&s); err != nil {
+		return nil, fmt.Errorf("unable to map %s to study model: %w", file, err)
+	}
+
+	return c.CreateStudy(ctx, s)
+}
+
+func confirmApply(plan Plan, nonInteractive bool, r io.Reader, w io.Writer) (bool, error) {
+	if nonInteractive {
+		return true, nil
+	}
+
+	fmt.Fprintf(w, "\nCreate %d and update %d studies? [y/N]: ", plan.Count(ActionCreate), plan.Count(ActionUpdate))
+
+	scanner := bufio.NewScanner(r)
+	if scanner.Scan() {
+		answer := strings.TrimSpace(strings.ToLower(scanner.Text()))
+		return answer == "y" || answer == "yes", nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("error reading input: %w", err)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,401 @@
+package apply_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/apply"
+	"github.com/prolific-oss/cli/mock_client"
+	"github.com/prolific-oss/cli/model"
+)
+
+const pilotStudy = `name: Pilot study
+internal_name: pilot
+description: A pilot of the reaction time study.
+prolific_id_option: url_parameters
+total_available_places: 10
+estimated_completion_time: 5
+reward: 100
+`
+
+const mainStudy = `{
+  "name": "Main study",
+  "internal_name": "main",
+  "description": "The main study, with more places.",
+  "total_available_places": 200,
+  "reward": 450
+}
+`
+
+// writeStudyFiles writes the study files into a temporary directory, returning
+// it and the path of a state file in it.
+func writeStudyFiles(t *testing.T, files map[string]string) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("unable to write %s: %s", name, err)
+		}
+	}
+	return dir, filepath.Join(dir, apply.DefaultStatePath)
+}
+
+func expectStudies(c *mock_client.MockAPI, studies ...model.Study) {
+	c.
+		EXPECT().
+		GetStudies(gomock.Any(), gomock.Eq(model.StatusAll), gomock.Eq(""), gomock.Any(), gomock.Any()).
+		Return(&client.ListStudiesResponse{Results: studies}, nil).
+		Times(1)
+
+	for _, s := range studies {
+		c.
+			EXPECT().
+			GetStudy(gomock.Any(), gomock.Eq(s.ID)).
+			Return(&s, nil).
+			AnyTimes()
+	}
+}
+
+func liveMainStudy(status string) model.Study {
+	return model.Study{
+		ID:                   "study-1",
+		Name:                 "Main study",
+		InternalName:         "main",
+		Desc:                 "The main study.",
+		TotalAvailablePlaces: 100,
+		Reward:               450,
+		Status:               status,
+	}
+}
+
+func TestNewPlanCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := apply.NewPlanCommand(c, os.Stdout)
+
+	use := "plan"
+	short := "Show how studies differ from their study files"
+
+	if cmd.Use != use {
+		t.Fatalf("expected use: %s; got %s", use, cmd.Use)
+	}
+
+	if cmd.Short != short {
+		t.Fatalf("expected short: %s; got %s", short, cmd.Short)
+	}
+}
+
+func TestPlanCommandShowsTheChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	dir, state := writeStudyFiles(t, map[string]string{"pilot.yaml": pilotStudy, "main.json": mainStudy})
+	expectStudies(c, liveMainStudy("UNPUBLISHED"))
+
+	var b bytes.Buffer
+	cmd := apply.NewPlanCommand(c, &b)
+	_ = cmd.Flags().Set("file", dir)
+	_ = cmd.Flags().Set("state", state)
+	err := cmd.RunE(cmd, nil)
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	expected := `~ DIR/main.json: update study study-1 "main" (unpublished)
+    ~ description: "The main study." -> "The main study, with more places."
+    ~ total_available_places: 100 -> 200
++ DIR/pilot.yaml: create study "pilot"
+
+Plan: 1 to create, 1 to update, 0 unchanged.
+`
+	expected = strings.ReplaceAll(expected, "DIR", dir)
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+
+	if _, err := os.Stat(state); !os.IsNotExist(err) {
+		t.Fatalf("expected plan not to write the state file; got %v", err)
+	}
+}
+
+func TestPlanCommandShortensLongValuesByCharacter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	description := strings.Repeat("é", 70)
+	dir, state := writeStudyFiles(t, map[string]string{
+		"main.json": strings.Replace(mainStudy, "The main study, with more places.", description, 1),
+	})
+	live := liveMainStudy("UNPUBLISHED")
+	live.TotalAvailablePlaces = 200
+	expectStudies(c, live)
+
+	var b bytes.Buffer
+	cmd := apply.NewPlanCommand(c, &b)
+	_ = cmd.Flags().Set("file", dir)
+	_ = cmd.Flags().Set("state", state)
+	err := cmd.RunE(cmd, nil)
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	expected := `    ~ description: "The main study." -> "` + strings.Repeat("é", 56) + "...\n"
+	if !strings.Contains(b.String(), expected) {
+		t.Fatalf("expected the description to be shortened to whole characters; got\n%s", b.String())
+	}
+}
+
+func TestPlanCommandSkipsFieldsLockedOncePublished(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	dir, state := writeStudyFiles(t, map[string]string{"main.json": strings.Replace(mainStudy, "450", "500", 1)})
+	expectStudies(c, liveMainStudy("ACTIVE"))
+
+	plan, err := apply.BuildPlan(t.Context(), c, apply.PlanOptions{Files: []string{dir}}, mustLoadState(t, state))
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	changes := plan.Studies[0].Changes
+	if len(changes) != 3 {
+		t.Fatalf("expected 3 changes; got %+v", changes)
+	}
+	for _, change := range changes {
+		locked := change.Field != "total_available_places"
+		if change.Locked != locked {
+			t.Fatalf("expected %s to be locked: %v; got %v", change.Field, locked, change.Locked)
+		}
+	}
+
+	payload := plan.Studies[0].Payload()
+	if len(payload) != 1 || payload["total_available_places"] != float64(200) {
+		t.Fatalf("expected only the places to be sent; got %v", payload)
+	}
+}
+
+func TestPlanCommandRejectsAnAmbiguousInternalName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	dir, state := writeStudyFiles(t, map[string]string{"main.json": mainStudy})
+	other := liveMainStudy("UNPUBLISHED")
+	other.ID = "study-2"
+	expectStudies(c, liveMainStudy("UNPUBLISHED"), other)
+
+	cmd := apply.NewPlanCommand(c, os.Stdout)
+	_ = cmd.Flags().Set("file", dir)
+	_ = cmd.Flags().Set("state", state)
+	err := cmd.RunE(cmd, nil)
+
+	expected := `internal_name "main" matches 2 studies (study-1, study-2)`
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q; got %v", expected, err)
+	}
+}
+
+func TestApplyCommandCreatesAndUpdatesStudies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	dir, state := writeStudyFiles(t, map[string]string{"pilot.yaml": pilotStudy, "main.json": mainStudy})
+	expectStudies(c, liveMainStudy("UNPUBLISHED"))
+
+	c.
+		EXPECT().
+		UpdateStudy(gomock.Any(), gomock.Eq("study-1"), gomock.Eq(map[string]any{
+			"description":            "The main study, with more places.",
+			"total_available_places": float64(200),
+		})).
+		Return(&model.Study{ID: "study-1"}, nil).
+		Times(1)
+
+	c.
+		EXPECT().
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, s model.CreateStudy) (*model.Study, error) {
+			if s.InternalName != "pilot" || s.Reward != 100 {
+				t.Fatalf("expected the pilot study to be created; got %+v", s)
+			}
+			return &model.Study{ID: "study-3"}, nil
+		}).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := apply.NewApplyCommand(c, &b)
+	cmd.SetIn(strings.NewReader("y\n"))
+	_ = cmd.Flags().Set("file", dir)
+	_ = cmd.Flags().Set("state", state)
+	err := cmd.RunE(cmd, nil)
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	if !strings.Contains(b.String(), "Apply complete: 1 created, 1 updated, 0 unchanged.") {
+		t.Fatalf("expected a summary; got\n%s", b.String())
+	}
+
+	saved := mustLoadState(t, state)
+	if saved.Studies["main.json"] != "study-1" || saved.Studies["pilot.yaml"] != "study-3" {
+		t.Fatalf("expected both studies to be recorded; got %v", saved.Studies)
+	}
+}
+
+func TestApplyCommandFillsInTemplateFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	t.Setenv("PROLIFIC_TEST_STUDY_URL", "https://example.com/pilot")
+	pilot := strings.Replace(pilotStudy, "reward: 100", "reward: {{ .Vars.reward }}\nexternal_study_url: ${PROLIFIC_TEST_STUDY_URL}", 1)
+	dir, state := writeStudyFiles(t, map[string]string{"pilot.yaml": pilot})
+	expectStudies(c)
+
+	c.
+		EXPECT().
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, s model.CreateStudy) (*model.Study, error) {
+			if s.Reward != 150 || s.ExternalStudyURL != "https://example.com/pilot" {
+				t.Fatalf("expected the variables to be filled in; got %+v", s)
+			}
+			return &model.Study{ID: "study-3"}, nil
+		}).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := apply.NewApplyCommand(c, &b)
+	_ = cmd.Flags().Set("file", dir)
+	_ = cmd.Flags().Set("state", state)
+	_ = cmd.Flags().Set("var", "reward=150")
+	_ = cmd.Flags().Set("non-interactive", "true")
+	err := cmd.RunE(cmd, nil)
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+}
+
+func TestApplyCommandDoesNothingUnlessConfirmed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	recorded := `{"studies": {"old.json": "study-9"}}`
+	dir, state := writeStudyFiles(t, map[string]string{
+		"pilot.yaml":           pilotStudy,
+		"main.json":            mainStudy,
+		apply.DefaultStatePath: recorded,
+	})
+	expectStudies(c, liveMainStudy("UNPUBLISHED"))
+
+	var b bytes.Buffer
+	cmd := apply.NewApplyCommand(c, &b)
+	cmd.SetIn(strings.NewReader("n\n"))
+	_ = cmd.Flags().Set("file", dir)
+	_ = cmd.Flags().Set("state", state)
+	err := cmd.RunE(cmd, nil)
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	if !strings.HasSuffix(b.String(), "Apply cancelled.\n") {
+		t.Fatalf("expected the apply to be cancelled; got\n%s", b.String())
+	}
+
+	saved, err := os.ReadFile(state)
+	if err != nil {
+		t.Fatalf("unable to read the state file: %s", err)
+	}
+	if string(saved) != recorded {
+		t.Fatalf("expected the state file to be unchanged; got\n%s", saved)
+	}
+}
+
+func TestApplyCommandRecordsUnchangedStudies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	dir, state := writeStudyFiles(t, map[string]string{"main.json": mainStudy})
+	live := liveMainStudy("UNPUBLISHED")
+	live.Desc = "The main study, with more places."
+	live.TotalAvailablePlaces = 200
+	expectStudies(c, live)
+
+	var b bytes.Buffer
+	cmd := apply.NewApplyCommand(c, &b)
+	_ = cmd.Flags().Set("file", dir)
+	_ = cmd.Flags().Set("state", state)
+	err := cmd.RunE(cmd, nil)
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	if !strings.Contains(b.String(), "No changes to apply.") {
+		t.Fatalf("expected nothing to apply; got\n%s", b.String())
+	}
+
+	saved := mustLoadState(t, state)
+	if saved.Studies["main.json"] != "study-1" {
+		t.Fatalf("expected the matched study to be recorded; got %v", saved.Studies)
+	}
+}
+
+func TestApplyCommandUsesTheStudyRecordedInTheState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	// The internal name has changed since the study was created.
+	dir, state := writeStudyFiles(t, map[string]string{
+		"main.json":            strings.Replace(mainStudy, `"main"`, `"main-v2"`, 1),
+		apply.DefaultStatePath: `{"studies": {"main.json": "study-1"}}`,
+	})
+
+	live := liveMainStudy("UNPUBLISHED")
+	live.Desc = "The main study, with more places."
+	live.TotalAvailablePlaces = 200
+	c.
+		EXPECT().
+		GetStudy(gomock.Any(), gomock.Eq("study-1")).
+		Return(&live, nil).
+		Times(1)
+
+	c.
+		EXPECT().
+		UpdateStudy(gomock.Any(), gomock.Eq("study-1"), gomock.Eq(map[string]any{"internal_name": "main-v2"})).
+		Return(&model.Study{ID: "study-1"}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := apply.NewApplyCommand(c, &b)
+	_ = cmd.Flags().Set("file", dir)
+	_ = cmd.Flags().Set("state", state)
+	_ = cmd.Flags().Set("non-interactive", "true")
+	err := cmd.RunE(cmd, nil)
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+}
+
+func mustLoadState(t *testing.T, path string) *apply.State {
+	t.Helper()
+
+	state, err := apply.LoadState(path)
+	if err != nil {
+		t.Fatalf("unable to load state: %s", err)
+	}
+	return state
+}
@@ -0,0 +1,442 @@
+// Package apply implements `prolific plan` and `prolific apply`, which keep
+// studies in step with study files kept in version control.
+package apply
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/model"
+	"github.com/spf13/cobra"
+)
+
+// Action is what applying a file does to its study.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionNone   Action = "none"
+)
+
+// publishedFields are the fields the API still changes once a study has been
+// published.
+var publishedFields = []string{"internal_name", "total_available_places", "submissions_config"}
+
+// uncomparableFields are returned by the API in a different form from the one
+// they are created with, so they cannot be compared with a file.
+var uncomparableFields = []string{"eligibility_requirements"}
+
+// Change is a field whose value in the file differs from the live study.
+type Change struct {
+	Field string
+	From  string
+	To    string
+	// Locked changes are to fields the API will not change on a published
+	// study, and are skipped.
+	Locked bool
+}
+
+// StudyPlan is what applying one file will do.
+type StudyPlan struct {
+	// File is the study file, and Key how it is recorded in the state.
+	File string
+	Key  string
+	// Study is the live study the file maps to, or nil when it will be created.
+	Study   *model.Study
+	Action  Action
+	Changes []Change
+	// NotCompared lists the fields in the file the live study cannot be
+	// compared on.
+	NotCompared []string
+	// Note explains anything unexpected found while planning.
+	Note string
+
+	fields map[string]any
+}
+
+// Payload returns the fields to send to update the study.
+func (p StudyPlan) Payload() map[string]any {
+	payload := map[string]any{}
+	for _, c := range p.Changes {
+		if !c.Locked {
+			payload[c.Field] = p.fields[c.Field]
+		}
+	}
+	return payload
+}
+
+// Plan is what applying a set of study files will do.
+type Plan struct {
+	Studies []StudyPlan
+}
+
+// Count returns how many studies the plan takes the action on.
+func (p Plan) Count(action Action) int {
+	n := 0
+	for _, s := range p.Studies {
+		if s.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// HasChanges reports whether applying the plan changes anything.
+func (p Plan) HasChanges() bool {
+	return p.Count(ActionCreate)+p.Count(ActionUpdate) > 0
+}
+
+// PlanOptions is the options for the plan and apply commands.
+type PlanOptions struct {
+	Files        []string
+	StatePath    string
+	TemplateFile shared.TemplateFileOptions
+}
+
+// NewPlanCommand creates a new `plan` command to show what `apply` would do.
+func NewPlanCommand(c client.API, w io.Writer) *cobra.Command {
+	var opts PlanOptions
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show how studies differ from their study files",
+		Long: `Show how studies differ from their study files
+
+Each study file, in the same JSON or YAML format as "study create", with the
+same variables, environment variables and includes, is matched
+to a study: first by the study ID recorded for it in the state file by
+"apply", then by its internal_name. Files with no study yet will be created.
+
+For matched studies, each field set in the file is compared with the live
+study. Once a study is published, the API only changes internal_name,
+total_available_places and submissions_config, so changes to other fields are
+marked with ! and skipped by "apply".
+
+Nothing is changed; run "apply" to make the changes.`,
+		Example: `
+Compare every study file in a directory with the live studies
+$ prolific plan -f studies/
+
+Compare a single file, keeping the state somewhere else
+$ prolific plan -f studies/pilot.yaml --state ~/prolific-state.json
+
+Fill in the variables the study files use
+$ prolific plan -f studies/ --var wave=2 --var-file waves.yaml`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			state, err := LoadState(opts.StatePath)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			plan, err := BuildPlan(cmd.Context(), c, opts, state)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			RenderPlan(plan, w)
+			return nil
+		},
+	}
+
+	addPlanFlags(cmd, &opts)
+
+	return cmd
+}
+
+func addPlanFlags(cmd *cobra.Command, opts *PlanOptions) {
+	flags := cmd.Flags()
+	flags.StringArrayVarP(&opts.Files, "file", "f", nil, "Study file (.json, .yaml or .yml), or directory of study files (can be specified multiple times)")
+	flags.StringVar(&opts.StatePath, "state", DefaultStatePath, "File recording the study ID for each study file")
+	_ = cmd.MarkFlagRequired("file")
+	shared.AddTemplateFileFlags(cmd, &opts.TemplateFile)
+}
+
+// BuildPlan works out what applying the study files will do.
+func BuildPlan(ctx context.Context, c client.API, opts PlanOptions, state *State) (Plan, error) {
+	files, err := studyFiles(opts.Files, state)
+	if err != nil {
+		return Plan{}, err
+	}
+	if len(files) == 0 {
+		return Plan{}, errors.New("no study files found, expected .json, .yaml or .yml files")
+	}
+
+	var plan Plan
+	var studies []model.Study
+	fetched := false
+	seen := map[string]string{}
+
+	for _, file := range files {
+		fields, err := readStudyFile(file, opts.TemplateFile)
+		if err != nil {
+			return Plan{}, err
+		}
+
+		sp := StudyPlan{File: file, Key: state.Key(file), fields: fields}
+
+		var live *model.Study
+		if id, ok := state.Studies[sp.Key]; ok {
+			live, err = c.GetStudy(ctx, id)
+			var apiErr *client.APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+				sp.Note = fmt.Sprintf("study %s recorded in the state no longer exists", id)
+				live, err = nil, nil
+			}
+			if err != nil {
+				return Plan{}, err
+			}
+		} else if name, _ := fields["internal_name"].(string); name != "" {
+			if !fetched {
+				all, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.ListStudiesResponse, error) {
+					return c.GetStudies(ctx, model.StatusAll, "", limit, offset)
+				})
+				if err != nil {
+					return Plan{}, err
+				}
+				studies, fetched = all.Results, true
+			}
+
+			live, err = findByInternalName(ctx, c, studies, name)
+			if err != nil {
+				return Plan{}, fmt.Errorf("%s: %w", file, err)
+			}
+		}
+
+		if live != nil {
+			if other, ok := seen[live.ID]; ok {
+				return Plan{}, fmt.Errorf("%s and %s both map to study %s", other, file, live.ID)
+			}
+			seen[live.ID] = file
+		}
+
+		if live == nil {
+			sp.Action = ActionCreate
+		} else {
+			sp.Study = live
+			sp.Changes, sp.NotCompared, err = diffStudy(*live, fields)
+			if err != nil {
+				return Plan{}, fmt.Errorf("%s: %w", file, err)
+			}
+			sp.Action = ActionNone
+			if len(sp.Payload()) > 0 {
+				sp.Action = ActionUpdate
+			}
+		}
+
+		plan.Studies = append(plan.Studies, sp)
+	}
+
+	return plan, nil
+}
+
+// studyFiles expands the paths given into the study files in them, in order.
+func studyFiles(paths []string, state *State) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				// Skip hidden directories such as .git, but not the one asked for.
+				if file != path && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if file != path && strings.HasPrefix(d.Name(), ".") {
+				return nil
+			}
+			switch strings.ToLower(filepath.Ext(file)) {
+			case ".json", ".yaml", ".yml":
+				if !state.isStateFile(file) {
+					files = append(files, filepath.Clean(file))
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	slices.Sort(files)
+	return slices.Compact(files), nil
+}
+
+func readStudyFile(file string, opts shared.TemplateFileOptions) (map[string]any, error) {
+	v, err := shared.ReadTemplateFile(file, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", file, err)
+	}
+	return v.AllSettings(), nil
+}
+
+// findByInternalName finds the study with the internal name, returning nil if
+// there is none.
+func findByInternalName(ctx context.Context, c client.API, studies []model.Study, name string) (*model.Study, error) {
+	var matches []model.Study
+	for _, s := range studies {
+		if s.InternalName == name {
+			matches = append(matches, s)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		// The list does not hold every field, so fetch the study in full.
+		return c.GetStudy(ctx, matches[0].ID)
+	}
+
+	ids := make([]string, len(matches))
+	for i, s := range matches {
+		ids[i] = s.ID
+	}
+	return nil, fmt.Errorf("internal_name %q matches %d studies (%s); record the one to use in the state file", name, len(matches), strings.Join(ids, ", "))
+}
+
+// diffStudy compares the fields set in a study file with the live study. The
+// file is read into a model.Study too, so both sides are compared in the
+// same form.
+func diffStudy(live model.Study, fields map[string]any) ([]Change, []string, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	var want model.Study
+	if err := json.Unmarshal(data, &want); err != nil {
+		return nil, nil, fmt.Errorf("unable to map to study model: %w", err)
+	}
+
+	liveFields, err := jsonFields(live)
+	if err != nil {
+		return nil, nil, err
+	}
+	wantFields, err := jsonFields(want)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	published := !strings.EqualFold(live.Status, model.StatusUnpublished)
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var changes []Change
+	var notCompared []string
+	for _, name := range names {
+		from, ok := liveFields[name]
+		if !ok || slices.Contains(uncomparableFields, name) {
+			notCompared = append(notCompared, name)
+			continue
+		}
+		if bytes.Equal(from, wantFields[name]) {
+			continue
+		}
+
+		to, err := json.Marshal(fields[name])
+		if err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, Change{
+			Field:  name,
+			From:   string(from),
+			To:     string(to),
+			Locked: published && !slices.Contains(publishedFields, name),
+		})
+	}
+
+	return changes, notCompared, nil
+}
+
+// jsonFields returns each field of v as it encodes to JSON, with object keys
+// sorted so equal values encode the same.
+func jsonFields(v any) (map[string][]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	encoded := make(map[string][]byte, len(fields))
+	for name, value := range fields {
+		if encoded[name], err = json.Marshal(value); err != nil {
+			return nil, err
+		}
+	}
+	return encoded, nil
+}
+
+// RenderPlan writes the plan for people to read.
+func RenderPlan(plan Plan, w io.Writer) {
+	for _, s := range plan.Studies {
+		switch {
+		case s.Study == nil:
+			fmt.Fprintf(w, "+ %s: create study %s\n", s.File, describeFile(s.fields))
+		case s.Action == ActionUpdate:
+			fmt.Fprintf(w, "~ %s: update study %s\n", s.File, describeStudy(*s.Study))
+		case len(s.Changes) > 0:
+			fmt.Fprintf(w, "  %s: no changes can be made to study %s\n", s.File, describeStudy(*s.Study))
+		default:
+			fmt.Fprintf(w, "  %s: no changes to study %s\n", s.File, describeStudy(*s.Study))
+		}
+
+		if s.Note != "" {
+			fmt.Fprintf(w, "    %s\n", s.Note)
+		}
+		for _, c := range s.Changes {
+			if c.Locked {
+				fmt.Fprintf(w, "    ! %s: %s -> %s (cannot change once published, skipped)\n", c.Field, shorten(c.From), shorten(c.To))
+				continue
+			}
+			fmt.Fprintf(w, "    ~ %s: %s -> %s\n", c.Field, shorten(c.From), shorten(c.To))
+		}
+		if s.Study != nil && len(s.NotCompared) > 0 {
+			fmt.Fprintf(w, "    not compared: %s\n", strings.Join(s.NotCompared, ", "))
+		}
+	}
+
+	fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d unchanged.\n", plan.Count(ActionCreate), plan.Count(ActionUpdate), plan.Count(ActionNone))
+}
+
+func describeStudy(s model.Study) string {
+	return fmt.Sprintf("%s %q (%s)", s.ID, s.InternalName, strings.ToLower(s.Status))
+}
+
+func describeFile(fields map[string]any) string {
+	if name, _ := fields["internal_name"].(string); name != "" {
+		return fmt.Sprintf("%q", name)
+	}
+	name, _ := fields["name"].(string)
+	return fmt.Sprintf("%q", name)
+}
+
+// shorten keeps long values, such as lists of filters, to one readable line.
+func shorten(value string) string {
+	const limit = 60
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return string(runes[:limit-3]) + "..."
+}
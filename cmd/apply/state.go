@@ -0,0 +1,76 @@
+package apply
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DefaultStatePath is the state file used when --state is not given.
+const DefaultStatePath = ".prolific-state.json"
+
+// State records the study created or found for each file, so a file keeps
+// mapping to the same study even if its internal_name changes.
+type State struct {
+	path string
+	// Studies maps each file, relative to the state file, to its study ID.
+	Studies map[string]string `json:"studies"`
+}
+
+// LoadState reads the state file at path. A missing file is treated as
+// empty, so the first Save creates it.
+func LoadState(path string) (*State, error) {
+	s := &State{path: path, Studies: map[string]string{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	if s.Studies == nil {
+		s.Studies = map[string]string{}
+	}
+	return s, nil
+}
+
+// Save writes the state back to its file.
+func (s *State) Save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, append(data, '\n'), 0o600)
+}
+
+// Key returns how file is recorded in the state: its path relative to the
+// state file, so the state works from any directory.
+func (s *State) Key(file string) string {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	dir, err := filepath.Abs(filepath.Dir(s.path))
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	rel, err := filepath.Rel(dir, abs)
+	if err != nil {
+		return filepath.ToSlash(abs)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// isStateFile reports whether file is the state file itself.
+func (s *State) isStateFile(file string) bool {
+	a, errA := filepath.Abs(file)
+	b, errB := filepath.Abs(s.path)
+	return errA == nil && errB == nil && a == b
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"io"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/config"
+	"github.com/prolific-oss/cli/tokenstore"
+	"github.com/spf13/cobra"
+)
+
+// Client is the API client the auth commands use. Login also checks a token
+// before storing it, which *client.Client supports with WithToken.
+type Client interface {
+	client.API
+	WithToken(token string) client.API
+}
+
+// NewAuthCommand creates a new `auth` command
+func NewAuthCommand(client Client, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Log in and out of the Prolific API",
+		Long: `Log in and out of the Prolific API
+
+Rather than exporting PROLIFIC_TOKEN, you can log in once and have the token
+stored encrypted under $HOME/.config/prolific-oss, one per profile. The
+PROLIFIC_TOKEN environment variable and any token in the config file still
+take precedence over the stored token.
+`,
+	}
+
+	cmd.AddCommand(
+		NewLoginCommand("login", client, w),
+		NewLogoutCommand("logout", w),
+		NewStatusCommand("status", client, w),
+	)
+	return cmd
+}
+
+// profileName returns the profile tokens are stored under, for display.
+func profileName() string {
+	if profile := config.AppliedProfile(); profile != "" {
+		return profile
+	}
+	return tokenstore.DefaultProfile
+}
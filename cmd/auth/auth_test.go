@@ -0,0 +1,288 @@
+package auth_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/auth"
+	"github.com/prolific-oss/cli/config"
+	"github.com/prolific-oss/cli/mock_client"
+	"github.com/prolific-oss/cli/tokenstore"
+	"github.com/spf13/viper"
+)
+
+// isolate points the token store at a temporary home directory and clears
+// any token or profile the environment would otherwise supply.
+func isolate(t *testing.T) *tokenstore.Store {
+	t.Helper()
+
+	homedir.DisableCache = true
+	t.Cleanup(func() { homedir.DisableCache = false })
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("PROLIFIC_TOKEN", "")
+	t.Setenv(tokenstore.PassphraseEnvVar, "")
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	_ = config.ApplyProfile("")
+
+	store, err := tokenstore.Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+// tokenClient adds the WithToken login needs to the API mock, recording the
+// tokens it is given.
+type tokenClient struct {
+	*mock_client.MockAPI
+	tokens []string
+}
+
+func (c *tokenClient) WithToken(token string) client.API {
+	c.tokens = append(c.tokens, token)
+	return c.MockAPI
+}
+
+func TestNewAuthCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := auth.NewAuthCommand(&tokenClient{MockAPI: c}, os.Stdout)
+
+	if cmd.Use != "auth" {
+		t.Fatalf("expected use: auth; got %s", cmd.Use)
+	}
+
+	for _, name := range []string{"login", "logout", "status"} {
+		if sub, _, err := cmd.Find([]string{name}); err != nil || sub.Name() != name {
+			t.Fatalf("expected subcommand %s to be registered", name)
+		}
+	}
+}
+
+func TestLoginVerifiesAndStoresToken(t *testing.T) {
+	store := isolate(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+	tc := &tokenClient{MockAPI: c}
+
+	c.EXPECT().
+		GetMe(gomock.Any()).
+		Return(&client.MeResponse{FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := auth.NewLoginCommand("login", tc, &b)
+	cmd.SetIn(strings.NewReader("my-token\n"))
+	cmd.SetErr(&bytes.Buffer{})
+
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if !strings.Contains(b.String(), "Logged in as Ada Lovelace (ada@example.com)") {
+		t.Fatalf("expected a logged in message; got %q", b.String())
+	}
+	if !strings.Contains(b.String(), "encrypted with the machine key") {
+		t.Fatalf("expected the machine key to be used; got %q", b.String())
+	}
+
+	token, err := store.Load("", "")
+	if err != nil || token != "my-token" {
+		t.Fatalf("expected my-token to be stored; got %q, %v", token, err)
+	}
+	if len(tc.tokens) != 1 || tc.tokens[0] != "my-token" {
+		t.Fatalf("expected my-token to be verified; got %v", tc.tokens)
+	}
+}
+
+func TestLoginWithPassphrase(t *testing.T) {
+	store := isolate(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+	tc := &tokenClient{MockAPI: c}
+
+	c.EXPECT().GetMe(gomock.Any()).Return(&client.MeResponse{}, nil).Times(1)
+
+	var b bytes.Buffer
+	cmd := auth.NewLoginCommand("login", tc, &b)
+	cmd.SetIn(strings.NewReader("my-token\nsecret\nsecret\n"))
+	cmd.SetErr(&bytes.Buffer{})
+	_ = cmd.Flags().Set("passphrase", "true")
+
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if _, err := store.Load("", ""); !errors.Is(err, tokenstore.ErrPassphraseRequired) {
+		t.Fatalf("expected the token to need a passphrase; got %v", err)
+	}
+
+	token, err := store.Load("", "secret")
+	if err != nil || token != "my-token" {
+		t.Fatalf("expected my-token; got %q, %v", token, err)
+	}
+}
+
+func TestLoginRejectsMismatchedPassphrase(t *testing.T) {
+	isolate(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+	tc := &tokenClient{MockAPI: c}
+
+	c.EXPECT().GetMe(gomock.Any()).Return(&client.MeResponse{}, nil).Times(1)
+
+	cmd := auth.NewLoginCommand("login", tc, &bytes.Buffer{})
+	cmd.SetIn(strings.NewReader("my-token\nsecret\nsecrte\n"))
+	cmd.SetErr(&bytes.Buffer{})
+	_ = cmd.Flags().Set("passphrase", "true")
+
+	err := cmd.RunE(cmd, nil)
+	if err == nil || err.Error() != "error: the passphrases do not match" {
+		t.Fatalf("expected mismatch error; got %v", err)
+	}
+}
+
+func TestLoginDoesNotStoreInvalidToken(t *testing.T) {
+	store := isolate(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+	tc := &tokenClient{MockAPI: c}
+
+	c.EXPECT().GetMe(gomock.Any()).Return(nil, &client.APIError{StatusCode: 401}).Times(1)
+
+	cmd := auth.NewLoginCommand("login", tc, &bytes.Buffer{})
+	cmd.SetIn(strings.NewReader("bad-token\n"))
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.RunE(cmd, nil)
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || !strings.HasPrefix(err.Error(), "error: unable to verify the token") {
+		t.Fatalf("expected a verification error; got %v", err)
+	}
+
+	if profiles, _ := store.Profiles(); len(profiles) != 0 {
+		t.Fatalf("expected nothing stored; got %v", profiles)
+	}
+}
+
+func TestStatusShowsStoredTokenSource(t *testing.T) {
+	store := isolate(t)
+	if err := store.Save("", "my-token", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().
+		GetMe(gomock.Any()).
+		Return(&client.MeResponse{ID: "u-1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := auth.NewStatusCommand("status", c, &b)
+
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	expected := `Profile:       none
+Token from:    token store ` + store.Path() + `, encrypted with the machine key
+Logged in as:  Ada Lovelace (ada@example.com)
+User ID:       u-1
+`
+	if b.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+
+	if strings.Contains(b.String(), "my-token") {
+		t.Fatal("expected the token not to be shown")
+	}
+}
+
+func TestStatusPrefersEnvironmentToken(t *testing.T) {
+	isolate(t)
+	t.Setenv("PROLIFIC_TOKEN", "env-token")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().GetMe(gomock.Any()).Return(&client.MeResponse{}, nil).Times(1)
+
+	var b bytes.Buffer
+	cmd := auth.NewStatusCommand("status", c, &b)
+
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if !strings.Contains(b.String(), "Token from:    PROLIFIC_TOKEN environment variable") {
+		t.Fatalf("expected the environment to be the source; got %q", b.String())
+	}
+}
+
+func TestStatusWhenLoggedOut(t *testing.T) {
+	isolate(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	var b bytes.Buffer
+	cmd := auth.NewStatusCommand("status", c, &b)
+
+	err := cmd.RunE(cmd, nil)
+	if !errors.Is(err, client.ErrTokenNotSet) {
+		t.Fatalf("expected ErrTokenNotSet; got %v", err)
+	}
+
+	if !strings.Contains(b.String(), "none, not logged in") {
+		t.Fatalf("expected a logged out status; got %q", b.String())
+	}
+}
+
+func TestLogout(t *testing.T) {
+	store := isolate(t)
+	if err := config.ApplyProfile("staging"); err == nil {
+		t.Fatal("expected the staging profile not to be defined in the config file")
+	}
+	if err := store.Save("staging", "my-token", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	cmd := auth.NewLogoutCommand("logout", &b)
+
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if b.String() != "Removed the token stored for profile staging\n" {
+		t.Fatalf("unexpected output: %q", b.String())
+	}
+
+	err := cmd.RunE(cmd, nil)
+	if err == nil || err.Error() != "error: no token stored for profile staging" {
+		t.Fatalf("expected a nothing to remove error; got %v", err)
+	}
+}
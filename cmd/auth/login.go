@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/prolific-oss/cli/config"
+	"github.com/prolific-oss/cli/tokenstore"
+	"github.com/prolific-oss/cli/ui"
+	"github.com/spf13/cobra"
+)
+
+// LoginOptions is the options for logging in.
+type LoginOptions struct {
+	Passphrase bool
+}
+
+// NewLoginCommand creates a new command to verify and store an API token.
+func NewLoginCommand(commandName string, c Client, w io.Writer) *cobra.Command {
+	var opts LoginOptions
+
+	cmd := &cobra.Command{
+		Use:   commandName,
+		Args:  cobra.NoArgs,
+		Short: "Verify an API token and store it encrypted",
+		Long: `Verify an API token and store it encrypted
+
+You are prompted for the token, which is not echoed. It is checked against
+the API before being stored for the active profile.
+
+By default the token is encrypted with a key generated for this machine. Use
+--passphrase, or set PROLIFIC_TOKEN_PASSPHRASE, to encrypt it with a
+passphrase instead; you'll then be asked for it whenever the token is used,
+unless PROLIFIC_TOKEN_PASSPHRASE is set.
+
+You can create a token at https://app.prolific.com/researcher/tokens/
+`,
+		Example: `
+Log in with the default profile
+$ prolific auth login
+
+Log in to the staging profile, protecting the token with a passphrase
+$ prolific auth login --profile staging --passphrase
+
+Read the token from a file rather than pasting it
+$ prolific auth login < token.txt
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			in := cmd.InOrStdin()
+			if !ui.IsTerminal(in) {
+				in = bufio.NewReader(in)
+			}
+
+			err := login(cmd, c, opts, in, w)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&opts.Passphrase, "passphrase", false, "Encrypt the token with a passphrase rather than the machine key.")
+
+	return cmd
+}
+
+func login(cmd *cobra.Command, c Client, opts LoginOptions, in io.Reader, w io.Writer) error {
+	prompts := cmd.ErrOrStderr()
+
+	token, err := ui.ReadSecret(in, prompts, "Paste your Prolific API token: ")
+	if err != nil {
+		return fmt.Errorf("unable to read the token: %w", err)
+	}
+	if token == "" {
+		return errors.New("no token given")
+	}
+
+	me, err := c.WithToken(token).GetMe(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("unable to verify the token: %w", err)
+	}
+
+	passphrase := os.Getenv(tokenstore.PassphraseEnvVar)
+	if opts.Passphrase && passphrase == "" {
+		passphrase, err = readNewPassphrase(in, prompts)
+		if err != nil {
+			return err
+		}
+	}
+
+	store, err := tokenstore.Default()
+	if err != nil {
+		return err
+	}
+
+	if err := store.Save(config.AppliedProfile(), token, passphrase); err != nil {
+		return fmt.Errorf("unable to store the token: %w", err)
+	}
+
+	method := "the machine key"
+	if passphrase != "" {
+		method = "your passphrase"
+	}
+
+	fmt.Fprintf(w, "Logged in as %s %s (%s)\n", me.FirstName, me.LastName, me.Email)
+	fmt.Fprintf(w, "Token stored for profile %s in %s, encrypted with %s\n", profileName(), store.Path(), method)
+
+	if os.Getenv("PROLIFIC_TOKEN") != "" {
+		fmt.Fprintln(w, "Note: PROLIFIC_TOKEN is set and will be used instead until you unset it")
+	}
+
+	return nil
+}
+
+func readNewPassphrase(in io.Reader, prompts io.Writer) (string, error) {
+	passphrase, err := ui.ReadSecret(in, prompts, "Choose a passphrase: ")
+	if err != nil {
+		return "", fmt.Errorf("unable to read the passphrase: %w", err)
+	}
+	if passphrase == "" {
+		return "", errors.New("the passphrase cannot be empty")
+	}
+
+	confirm, err := ui.ReadSecret(in, prompts, "Confirm the passphrase: ")
+	if err != nil {
+		return "", fmt.Errorf("unable to read the passphrase: %w", err)
+	}
+	if confirm != passphrase {
+		return "", errors.New("the passphrases do not match")
+	}
+
+	return passphrase, nil
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/prolific-oss/cli/config"
+	"github.com/prolific-oss/cli/tokenstore"
+	"github.com/spf13/cobra"
+)
+
+// NewLogoutCommand creates a new command to remove a stored API token.
+func NewLogoutCommand(commandName string, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   commandName,
+		Args:  cobra.NoArgs,
+		Short: "Remove the stored API token",
+		Long: `Remove the stored API token
+
+Removes the token stored by "prolific auth login" for the active profile. A
+token set with PROLIFIC_TOKEN or in the config file is left alone.
+`,
+		Example: `
+$ prolific auth logout
+$ prolific auth logout --profile staging
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			store, err := tokenstore.Default()
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			removed, err := store.Delete(config.AppliedProfile())
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+			if !removed {
+				return fmt.Errorf("error: no token stored for profile %s", profileName())
+			}
+
+			fmt.Fprintf(w, "Removed the token stored for profile %s\n", profileName())
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/config"
+	"github.com/prolific-oss/cli/tokenstore"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// NewStatusCommand creates a new command to show who the CLI is
+// authenticated as.
+func NewStatusCommand(commandName string, c client.API, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   commandName,
+		Args:  cobra.NoArgs,
+		Short: "Show who you are logged in as",
+		Long: `Show who you are logged in as
+
+Shows the active profile, where its token comes from and the account it
+belongs to. The token itself is never shown.
+`,
+		Example: `
+$ prolific auth status
+$ prolific auth status --profile staging
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			err := renderStatus(cmd.Context(), c, w)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func renderStatus(ctx context.Context, c client.API, w io.Writer) error {
+	source, err := tokenSource()
+	if err != nil {
+		return err
+	}
+
+	profile := config.AppliedProfile()
+	if profile == "" {
+		profile = "none"
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 1, 2, ' ', 0)
+	fmt.Fprintf(tw, "Profile:\t%s\n", profile)
+
+	if source == "" {
+		fmt.Fprintf(tw, "Token from:\tnone, not logged in\n")
+		tw.Flush()
+		return fmt.Errorf("%w: run `prolific auth login` or set PROLIFIC_TOKEN", client.ErrTokenNotSet)
+	}
+	fmt.Fprintf(tw, "Token from:\t%s\n", source)
+
+	me, err := c.GetMe(ctx)
+	if err != nil {
+		tw.Flush()
+		return err
+	}
+
+	fmt.Fprintf(tw, "Logged in as:\t%s %s (%s)\n", me.FirstName, me.LastName, me.Email)
+	fmt.Fprintf(tw, "User ID:\t%s\n", me.ID)
+	return tw.Flush()
+}
+
+// tokenSource describes where the token in use comes from, following the
+// same precedence as the root command's client, or returns an empty string if there is none.
+func tokenSource() (string, error) {
+	if os.Getenv("PROLIFIC_TOKEN") != "" {
+		return "PROLIFIC_TOKEN environment variable", nil
+	}
+
+	if viper.GetString("PROLIFIC_TOKEN") != "" {
+		profile := config.AppliedProfile()
+		if profile != "" && viper.IsSet(config.ProfilesKey+"."+profile+".token") {
+			return fmt.Sprintf("profile %s in %s", profile, viper.ConfigFileUsed()), nil
+		}
+		return fmt.Sprintf("config file %s", viper.ConfigFileUsed()), nil
+	}
+
+	store, err := tokenstore.Default()
+	if err != nil {
+		return "", err
+	}
+
+	entry, ok, err := store.Lookup(config.AppliedProfile())
+	if err != nil || !ok {
+		return "", err
+	}
+
+	method := "the machine key"
+	if entry.UsesPassphrase() {
+		method = "a passphrase"
+	}
+	return fmt.Sprintf("token store %s, encrypted with %s", store.Path(), method), nil
+}
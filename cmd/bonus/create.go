@@ -1,6 +1,7 @@
 package bonus
 
 import (
+	"context"
 	"encoding/csv"
 	"fmt"
 	"io"
@@ -8,6 +9,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
@@ -17,7 +19,7 @@ type CreateOptions struct {
 	Bonuses        []string
 	File           string
 	NonInteractive bool
-	Csv            bool
+	Output         shared.OutputOptions
 }
 
 func NewCreateCommand(commandName string, apiClient client.API, w io.Writer) *cobra.Command {
@@ -41,18 +43,21 @@ Bonus records must be paid separately using the 'bonus pay' command.`,
   # Create from CSV file
   prolific bonus create <study_id> --file bonuses.csv
 
-  # Non-interactive output (for scripting)
-  prolific bonus create <study_id> --file bonuses.csv -n
+  # Table output
+  prolific bonus create <study_id> --file bonuses.csv -t
 
   # CSV output format
-  prolific bonus create <study_id> --file bonuses.csv -c`,
+  prolific bonus create <study_id> --file bonuses.csv -c
+
+  # Just the bonus ID (for scripting)
+  prolific bonus create <study_id> --file bonuses.csv --jq .id`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			studyID := args[0]
 
-			err := createBonusPayments(apiClient, studyID, opts, w)
+			err := createBonusPayments(cmd.Context(), apiClient, studyID, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -62,22 +67,30 @@ Bonus records must be paid separately using the 'bonus pay' command.`,
 	flags := cmd.Flags()
 	flags.StringArrayVarP(&opts.Bonuses, "bonus", "b", nil, "Participant bonus entry in format 'id,amount' (repeatable)")
 	flags.StringVarP(&opts.File, "file", "f", "", "Path to CSV file containing bonus entries")
-	flags.BoolVarP(&opts.NonInteractive, "non-interactive", "n", false, "Non-interactive output for scripting")
-	flags.BoolVarP(&opts.Csv, "csv", "c", false, "Output in CSV format")
+	shared.AddRecordTableOutputFlags(cmd, &opts.Output)
+
+	// --non-interactive / -n predates the output flags, and is kept for the
+	// scripts that read the bonus ID from its first line.
+	flags.BoolVarP(&opts.NonInteractive, "non-interactive", "n", false, "Non-interactive output for scripting, with the bonus ID on the first line")
+	_ = flags.MarkHidden("non-interactive")
 
 	return cmd
 }
 
-func createBonusPayments(apiClient client.API, studyID string, opts CreateOptions, w io.Writer) error {
+func createBonusPayments(ctx context.Context, apiClient client.API, studyID string, opts CreateOptions, w io.Writer) error {
 	// Validate mutual exclusivity
 	if len(opts.Bonuses) > 0 && opts.File != "" {
 		return fmt.Errorf("cannot use both --bonus and --file flags")
 	}
 
-	if opts.Csv && opts.NonInteractive {
+	if opts.NonInteractive && opts.Output.Csv {
 		return fmt.Errorf("cannot use both --csv and --non-interactive flags")
 	}
 
+	if opts.NonInteractive && opts.Output.IsStructured() {
+		return fmt.Errorf("cannot use --non-interactive with another output format")
+	}
+
 	if len(opts.Bonuses) == 0 && opts.File == "" {
 		return fmt.Errorf("either --bonus or --file flag is required")
 	}
@@ -101,20 +114,25 @@ func createBonusPayments(apiClient client.API, studyID string, opts CreateOption
 		CSVBonuses: csvBonuses,
 	}
 
-	response, err := apiClient.CreateBonusPayments(payload)
+	response, err := apiClient.CreateBonusPayments(ctx, payload)
 	if err != nil {
 		return err
 	}
 
-	if opts.Csv {
-		return renderCSVOutput(response, w)
-	}
-
 	if opts.NonInteractive {
 		return renderNonInteractiveOutput(response, w)
 	}
 
-	return renderInteractiveOutput(response, csvBonuses, w)
+	switch shared.ResolveFormat(opts.Output) {
+	case "":
+		return renderInteractiveOutput(response, csvBonuses, w)
+	case "table":
+		return renderTableOutput(response, w)
+	case "csv":
+		return renderCSVOutput(response, w)
+	default:
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
 }
 
 func renderInteractiveOutput(resp *client.CreateBonusPaymentsResponse, csvBonuses string, w io.Writer) error {
@@ -158,6 +176,25 @@ func renderNonInteractiveOutput(resp *client.CreateBonusPaymentsResponse, w io.W
 	return nil
 }
 
+// bonusRow is the bonus as a row of the table and CSV output.
+func bonusRow(resp *client.CreateBonusPaymentsResponse) []string {
+	return []string{
+		resp.ID,
+		resp.Study,
+		ui.RenderMoney(resp.Amount/100, model.DefaultCurrency),
+		ui.RenderMoney(resp.Fees/100, model.DefaultCurrency),
+		ui.RenderMoney(resp.VAT/100, model.DefaultCurrency),
+		ui.RenderMoney(resp.TotalAmount/100, model.DefaultCurrency),
+	}
+}
+
+func renderTableOutput(resp *client.CreateBonusPaymentsResponse, w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
+	fmt.Fprintln(tw, "ID\tStudy\tAmount\tFees\tVAT\tTotal")
+	fmt.Fprintln(tw, strings.Join(bonusRow(resp), "\t"))
+	return tw.Flush()
+}
+
 func renderCSVOutput(resp *client.CreateBonusPaymentsResponse, w io.Writer) error {
 	csvWriter := csv.NewWriter(w)
 
@@ -165,14 +202,7 @@ func renderCSVOutput(resp *client.CreateBonusPaymentsResponse, w io.Writer) erro
 		return err
 	}
 
-	if err := csvWriter.Write([]string{
-		resp.ID,
-		resp.Study,
-		ui.RenderMoney(resp.Amount/100, model.DefaultCurrency),
-		ui.RenderMoney(resp.Fees/100, model.DefaultCurrency),
-		ui.RenderMoney(resp.VAT/100, model.DefaultCurrency),
-		ui.RenderMoney(resp.TotalAmount/100, model.DefaultCurrency),
-	}); err != nil {
+	if err := csvWriter.Write(bonusRow(resp)); err != nil {
 		return err
 	}
 
@@ -25,7 +25,7 @@ func setupCreateMock(t *testing.T, response *client.CreateBonusPaymentsResponse)
 	c := mock_client.NewMockAPI(ctrl)
 
 	c.EXPECT().
-		CreateBonusPayments(gomock.Any()).
+		CreateBonusPayments(gomock.Any(), gomock.Any()).
 		Return(response, nil).
 		AnyTimes()
 
@@ -125,7 +125,7 @@ func TestCreateBonusPayments_APIError(t *testing.T) {
 	errorMessage := "invalid participant ID"
 
 	c.EXPECT().
-		CreateBonusPayments(gomock.Any()).
+		CreateBonusPayments(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -166,10 +166,7 @@ func TestCreateBonusPayments_OutputFormatMutualExclusivity(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	cmd := bonus.NewCreateCommand("create", c, os.Stdout)
-	_ = cmd.Flags().Set("bonus", "pid1,4.25")
-	_ = cmd.Flags().Set("csv", "true")
-	_ = cmd.Flags().Set("non-interactive", "true")
-	cmd.SetArgs([]string{"study-xyz"})
+	cmd.SetArgs([]string{"study-xyz", "--bonus", "pid1,4.25", "--csv", "-n"})
 	err := cmd.Execute()
 
 	if err == nil {
@@ -194,9 +191,7 @@ func TestCreateBonusPayments_NonInteractive(t *testing.T) {
 	c, writer, b := setupCreateMock(t, response)
 
 	cmd := bonus.NewCreateCommand("create", c, writer)
-	_ = cmd.Flags().Set("bonus", "pid1,4.25")
-	_ = cmd.Flags().Set("non-interactive", "true")
-	cmd.SetArgs([]string{"study-xyz"})
+	cmd.SetArgs([]string{"study-xyz", "--bonus", "pid1,4.25", "-n"})
 	err := cmd.Execute()
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
@@ -213,6 +208,40 @@ func TestCreateBonusPayments_NonInteractive(t *testing.T) {
 	if lines[0] != "bonus-ni-123" {
 		t.Fatalf("expected first line to be bonus ID 'bonus-ni-123', got: '%s'", lines[0])
 	}
+	if lines[1] != "study=study-xyz" || lines[5] != "total=£11.50" {
+		t.Fatalf("expected the key=value lines after the ID, got:\n%s", output)
+	}
+}
+
+func TestCreateBonusPayments_TableOutput(t *testing.T) {
+	response := &client.CreateBonusPaymentsResponse{
+		ID:          "bonus-table-123",
+		Study:       "study-xyz",
+		Amount:      975,
+		Fees:        146,
+		VAT:         29,
+		TotalAmount: 1150,
+	}
+
+	c, writer, b := setupCreateMock(t, response)
+
+	cmd := bonus.NewCreateCommand("create", c, writer)
+	_ = cmd.Flags().Set("bonus", "pid1,4.25")
+	_ = cmd.Flags().Set("table", "true")
+	cmd.SetArgs([]string{"study-xyz"})
+	err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	writer.Flush()
+
+	expected := `ID              Study     Amount Fees  VAT   Total
+bonus-table-123 study-xyz £9.75  £1.46 £0.29 £11.50
+`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
 }
 
 func TestCreateBonusPayments_CSVOutput(t *testing.T) {
@@ -1,6 +1,7 @@
 package bonus
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -29,9 +30,9 @@ updated within minutes.`,
 			bonusID := args[0]
 			reader := cmd.InOrStdin()
 
-			err := payBonusPayments(apiClient, bonusID, nonInteractive, reader, w)
+			err := payBonusPayments(cmd.Context(), apiClient, bonusID, nonInteractive, reader, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -44,7 +45,7 @@ updated within minutes.`,
 	return cmd
 }
 
-func payBonusPayments(apiClient client.API, bonusID string, nonInteractive bool, reader io.Reader, w io.Writer) error {
+func payBonusPayments(ctx context.Context, apiClient client.API, bonusID string, nonInteractive bool, reader io.Reader, w io.Writer) error {
 	confirmed, err := confirmPayment(bonusID, nonInteractive, reader, w)
 	if err != nil {
 		return err
@@ -55,7 +56,7 @@ func payBonusPayments(apiClient client.API, bonusID string, nonInteractive bool,
 		return nil
 	}
 
-	err = apiClient.PayBonusPayments(bonusID)
+	err = apiClient.PayBonusPayments(ctx, bonusID)
 	if err != nil {
 		return err
 	}
@@ -37,7 +37,7 @@ func TestPayBonusPayments_SuccessNonInteractive(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	c.EXPECT().
-		PayBonusPayments("bonus-pay-123").
+		PayBonusPayments(gomock.Any(), "bonus-pay-123").
 		Return(nil).
 		AnyTimes()
 
@@ -68,7 +68,7 @@ func TestPayBonusPayments_APIError(t *testing.T) {
 	errorMessage := "bonus already paid"
 
 	c.EXPECT().
-		PayBonusPayments("bonus-err-123").
+		PayBonusPayments(gomock.Any(), "bonus-err-123").
 		Return(errors.New(errorMessage)).
 		AnyTimes()
 
@@ -1,12 +1,14 @@
 package campaign
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -18,6 +20,9 @@ type CampaignListOptions struct {
 	WorkspaceID string
 	Limit       int
 	Offset      int
+	All         bool
+	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 }
 
 // NewListCommand creates a new command to deal with campaigns
@@ -43,13 +48,16 @@ $ prolific campaign list -w <workspace_id> -l 1
 
 Offset records in the result set, for example by 2
 $ prolific campaign list -w <workspace_id> -l 1 -o 2
+
+Fetch every campaign, however many pages that takes
+$ prolific campaign list -w <workspace_id> --all
 		`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderCampaigns(c, opts, w)
+			err := renderCampaigns(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -59,21 +67,35 @@ $ prolific campaign list -w <workspace_id> -l 1 -o 2
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "Filter campaigns by workspace.")
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of campaigns returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of campaigns to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of campaigns to offset")
+	shared.AddAllFlag(cmd, &opts.All, "campaigns")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
 
 // renderCampaigns will show your campaigns
-func renderCampaigns(c client.API, opts CampaignListOptions, w io.Writer) error {
+func renderCampaigns(ctx context.Context, c client.API, opts CampaignListOptions, w io.Writer) error {
 	if opts.WorkspaceID == "" {
 		return errors.New("please provide a workspace ID")
 	}
-	campaigns, err := c.GetCampaigns(opts.WorkspaceID, opts.Limit, opts.Offset)
+	campaigns, err := shared.FetchList(ctx, opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListCampaignsResponse, error) {
+		return c.GetCampaigns(ctx, opts.WorkspaceID, limit, offset)
+	})
 	if err != nil {
 		return err
 	}
 
+	campaigns.Results, err = shared.ApplyQuery(opts.Query, campaigns.Results)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, campaigns.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\n", "ID", "Name", "Link")
 	for _, campaign := range campaigns.Results {
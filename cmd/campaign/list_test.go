@@ -63,7 +63,7 @@ func TestNewCampaignCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetCampaigns(gomock.Eq("991199"), gomock.Eq(10), gomock.Eq(2)).
+		GetCampaigns(gomock.Any(), gomock.Eq("991199"), gomock.Eq(10), gomock.Eq(2)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -99,7 +99,7 @@ func TestNewListCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetCampaigns(gomock.Eq("991199"), client.DefaultRecordLimit, client.DefaultRecordOffset).
+		GetCampaigns(gomock.Any(), gomock.Eq("991199"), client.DefaultRecordLimit, client.DefaultRecordOffset).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
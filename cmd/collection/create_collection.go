@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -17,6 +18,7 @@ import (
 type CreateCollectionOptions struct {
 	Args         []string
 	TemplatePath string
+	Output       shared.OutputOptions
 }
 
 // NewCreateCollectionCommand creates a new `collection create` command to allow you to create
@@ -105,13 +107,13 @@ collection_items:
 				return fmt.Errorf("error: template path required. Use -t or --template-path flag")
 			}
 
-			err := createCollection(c, opts, w)
+			err := createCollection(cmd.Context(), c, opts, w)
 			if err != nil {
 				if shared.IsFeatureNotEnabledError(err) {
 					ui.RenderFeatureAccessMessage(FeatureNameAITBCollection, FeatureContactURLAITBCollection)
-					return nil
+					return fmt.Errorf("error: %w", err)
 				}
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -121,6 +123,7 @@ collection_items:
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a YAML or JSON file containing the collection you want to create")
 	_ = cmd.MarkFlagRequired("template-path")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
@@ -159,29 +162,33 @@ func validatePayload(payload model.CreateAITaskBuilderCollection) error {
 	return nil
 }
 
-func createCollection(c client.API, opts CreateCollectionOptions, w io.Writer) error {
+func createCollection(ctx context.Context, c client.API, opts CreateCollectionOptions, w io.Writer) error {
 	v := viper.New()
 	v.SetConfigFile(opts.TemplatePath)
 	err := v.ReadInConfig()
 	if err != nil {
-		return fmt.Errorf("unable to read config file: %s", err)
+		return fmt.Errorf("unable to read config file: %w", err)
 	}
 
 	var payload model.CreateAITaskBuilderCollection
 	err = v.Unmarshal(&payload)
 	if err != nil {
-		return fmt.Errorf("unable to map %s to collection model: %s", opts.TemplatePath, err)
+		return fmt.Errorf("unable to map %s to collection model: %w", opts.TemplatePath, err)
 	}
 
 	if err := validatePayload(payload); err != nil {
 		return err
 	}
 
-	collection, err := c.CreateAITaskBuilderCollection(payload)
+	collection, err := c.CreateAITaskBuilderCollection(ctx, payload)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *collection, w)
+	}
+
 	// Output collection details
 	fmt.Fprintf(w, "Collection created successfully!\n")
 	fmt.Fprintf(w, "ID:              %s\n", collection.ID)
@@ -3,6 +3,7 @@ package collection_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -144,7 +145,7 @@ func TestNewCreateCollectionCommandCallsAPIWithJSON(t *testing.T) {
 
 	// Set up mock expectation - use Any since exact payload matching is complex
 	c.EXPECT().
-		CreateAITaskBuilderCollection(gomock.Any()).
+		CreateAITaskBuilderCollection(gomock.Any(), gomock.Any()).
 		Return(&response, nil)
 
 	// Execute command with buffer to capture output
@@ -241,8 +242,8 @@ collection_items:
 
 	var capturedPayload model.CreateAITaskBuilderCollection
 	c.EXPECT().
-		CreateAITaskBuilderCollection(gomock.Any()).
-		DoAndReturn(func(payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
+		CreateAITaskBuilderCollection(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
 			capturedPayload = payload
 			return &response, nil
 		})
@@ -354,7 +355,7 @@ func TestNewCreateCollectionCommandHandlesAPIError(t *testing.T) {
 	}
 
 	c.EXPECT().
-		CreateAITaskBuilderCollection(gomock.Any()).
+		CreateAITaskBuilderCollection(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New(collection.ErrWorkspaceNotFound))
 
 	cmd := collection.NewCreateCollectionCommand(c, os.Stdout)
@@ -585,8 +586,8 @@ func TestNewCreateCollectionCommandWithContentBlocks(t *testing.T) {
 
 	var capturedPayload model.CreateAITaskBuilderCollection
 	c.EXPECT().
-		CreateAITaskBuilderCollection(gomock.Any()).
-		DoAndReturn(func(payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
+		CreateAITaskBuilderCollection(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
 			capturedPayload = payload
 			return &response, nil
 		})
@@ -707,7 +708,7 @@ func TestNewCreateCollectionCommandPassesThroughContentFormatJSON(t *testing.T)
 	}
 
 	c.EXPECT().
-		CreateAITaskBuilderCollection(gomock.Eq(expectedPayload)).
+		CreateAITaskBuilderCollection(gomock.Any(), gomock.Eq(expectedPayload)).
 		Return(&client.CreateAITaskBuilderCollectionResponse{
 			ID:          "collection-123",
 			Name:        "test-collection",
@@ -778,7 +779,7 @@ collection_items:
 	}
 
 	c.EXPECT().
-		CreateAITaskBuilderCollection(gomock.Eq(expectedPayload)).
+		CreateAITaskBuilderCollection(gomock.Any(), gomock.Eq(expectedPayload)).
 		Return(&client.CreateAITaskBuilderCollectionResponse{
 			ID:          "collection-123",
 			Name:        "test-collection",
@@ -837,8 +838,8 @@ func TestNewCreateCollectionCommandWithTaskDetails(t *testing.T) {
 
 	var capturedPayload model.CreateAITaskBuilderCollection
 	c.EXPECT().
-		CreateAITaskBuilderCollection(gomock.Any()).
-		DoAndReturn(func(payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
+		CreateAITaskBuilderCollection(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
 			capturedPayload = payload
 			return &response, nil
 		})
@@ -1066,7 +1067,7 @@ func TestNewCreateCollectionCommandWithExclusiveOptionMultiSelect(t *testing.T)
 	}
 
 	c.EXPECT().
-		CreateAITaskBuilderCollection(gomock.Any()).
+		CreateAITaskBuilderCollection(gomock.Any(), gomock.Any()).
 		Return(&response, nil)
 
 	var b bytes.Buffer
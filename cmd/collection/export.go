@@ -28,7 +28,7 @@ const (
 
 // pollSleep is the sleep function used between export status polls.
 // Replaced in tests via SetPollSleepForTesting to avoid real delays.
-var pollSleep func(time.Duration) = time.Sleep
+var pollSleep func(context.Context, time.Duration) error = client.Sleep
 
 // downloadClient is the HTTP client used to fetch the export ZIP.
 // Replaced in tests via SetDownloadClientForTesting to supply a TLS-aware client.
@@ -81,7 +81,7 @@ $ prolific collection export 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a --output /tmp/
 				opts.Output = fmt.Sprintf("%s-export-%s.zip", opts.Args[0], time.Now().Format("20060102-150405"))
 			}
 
-			return exportCollection(c, opts, w)
+			return exportCollection(cmd.Context(), c, opts, w)
 		},
 	}
 
@@ -90,24 +90,24 @@ $ prolific collection export 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a --output /tmp/
 	return cmd
 }
 
-func exportCollection(c client.API, opts ExportOptions, w io.Writer) error {
+func exportCollection(ctx context.Context, c client.API, opts ExportOptions, w io.Writer) error {
 	collectionID := opts.Args[0]
 
 	fmt.Fprintf(w, "Requesting export for collection %s...\n", collectionID)
 
 	// Step 1: POST to initiate the export job.
-	initResult, err := c.InitiateCollectionExport(collectionID)
+	initResult, err := c.InitiateCollectionExport(ctx, collectionID)
 	if err != nil {
 		if shared.IsFeatureNotEnabledError(err) {
 			ui.RenderFeatureAccessMessage(FeatureNameAITBCollection, FeatureContactURLAITBCollection)
-			return nil
+			return fmt.Errorf("error requesting export: %w", err)
 		}
-		return fmt.Errorf("error requesting export: %s", err.Error())
+		return fmt.Errorf("error requesting export: %w", err)
 	}
 
 	// If already complete (cached result), download immediately.
 	if initResult.Status == exportStatusComplete {
-		return downloadExport(initResult.URL, opts.Output, w)
+		return downloadExport(ctx, initResult.URL, opts.Output, w)
 	}
 
 	if initResult.Status != exportStatusGenerating {
@@ -125,16 +125,21 @@ func exportCollection(c client.API, opts ExportOptions, w io.Writer) error {
 		}
 
 		fmt.Fprint(w, ".")
-		pollSleep(exportPollInterval)
+		if err := pollSleep(ctx, exportPollInterval); err != nil {
+			return exportCancelledError(collectionID, exportID, err)
+		}
 
-		pollResult, err := c.GetCollectionExportStatus(collectionID, exportID)
+		pollResult, err := c.GetCollectionExportStatus(ctx, collectionID, exportID)
 		if err != nil {
-			return fmt.Errorf("error polling export status: %s", err.Error())
+			if ctx.Err() != nil {
+				return exportCancelledError(collectionID, exportID, ctx.Err())
+			}
+			return fmt.Errorf("error polling export status: %w", err)
 		}
 
 		switch pollResult.Status {
 		case exportStatusComplete:
-			return downloadExport(pollResult.URL, opts.Output, w)
+			return downloadExport(ctx, pollResult.URL, opts.Output, w)
 		case exportStatusFailed:
 			return fmt.Errorf("export generation failed for collection %s", collectionID)
 		case exportStatusGenerating:
@@ -145,16 +150,23 @@ func exportCollection(c client.API, opts ExportOptions, w io.Writer) error {
 	}
 }
 
-func downloadExport(url, outputPath string, w io.Writer) error {
+// exportCancelledError explains that polling stopped while the export was
+// still generating; the job carries on server-side, so re-running the command
+// picks up the finished archive rather than starting again.
+func exportCancelledError(collectionID, exportID string, cause error) error {
+	return fmt.Errorf("export %s for collection %s was still generating when polling stopped; re-run the command to download it once ready: %w", exportID, collectionID, cause)
+}
+
+func downloadExport(ctx context.Context, url, outputPath string, w io.Writer) error {
 	fmt.Fprintf(w, "\nExport ready. Downloading to %s...\n", outputPath)
-	if err := downloadFile(url, outputPath); err != nil {
-		return fmt.Errorf("error downloading export: %s", err.Error())
+	if err := downloadFile(ctx, url, outputPath); err != nil {
+		return fmt.Errorf("error downloading export: %w", err)
 	}
 	fmt.Fprintf(w, "Export saved to %s\n", outputPath)
 	return nil
 }
 
-func downloadFile(rawURL, outputPath string) error {
+func downloadFile(ctx context.Context, rawURL, outputPath string) error {
 	parsed, err := url.Parse(rawURL)
 	if err != nil {
 		return fmt.Errorf("invalid download URL: %w", err)
@@ -163,7 +175,7 @@ func downloadFile(rawURL, outputPath string) error {
 		return fmt.Errorf("download URL must use HTTPS, got %q", parsed.Scheme)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"context"
 	"net/http"
 	"time"
 )
@@ -11,7 +12,7 @@ import (
 // This file is compiled only during `go test` and is intentionally in
 // package collection (not collection_test) so that it can access unexported
 // variables while still being callable from external test packages.
-func SetPollSleepForTesting(f func(time.Duration)) func() {
+func SetPollSleepForTesting(f func(context.Context, time.Duration) error) func() {
 	prev := pollSleep
 	pollSleep = f
 	return func() { pollSleep = prev }
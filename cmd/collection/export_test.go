@@ -3,6 +3,7 @@ package collection_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -77,7 +78,7 @@ func TestExportCommandImmediateComplete(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		InitiateCollectionExport(gomock.Eq(testCollectionID)).
+		InitiateCollectionExport(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(&client.CollectionExportResponse{
 			Status:    "complete",
 			URL:       srv.URL + "/export.zip",
@@ -90,6 +91,7 @@ func TestExportCommandImmediateComplete(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := collection.NewExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 	if err := cmd.Flags().Set("output", outputPath); err != nil {
 		t.Fatalf("failed to set output flag: %v", err)
 	}
@@ -117,7 +119,7 @@ func TestExportCommandImmediateComplete(t *testing.T) {
 // TestExportCommandPollingToComplete covers the normal async flow:
 // POST returns "generating", then GET eventually returns "complete".
 func TestExportCommandPollingToComplete(t *testing.T) {
-	defer collection.SetPollSleepForTesting(func(time.Duration) {})()
+	defer collection.SetPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	zipContent := []byte("PK\x03\x04fake zip content")
 	srv := newZIPServer(t, zipContent)
@@ -128,7 +130,7 @@ func TestExportCommandPollingToComplete(t *testing.T) {
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		InitiateCollectionExport(gomock.Eq(testCollectionID)).
+		InitiateCollectionExport(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(&client.CollectionExportResponse{
 			Status:   "generating",
 			ExportID: testExportID,
@@ -137,11 +139,11 @@ func TestExportCommandPollingToComplete(t *testing.T) {
 
 	gomock.InOrder(
 		mockClient.EXPECT().
-			GetCollectionExportStatus(gomock.Eq(testCollectionID), gomock.Eq(testExportID)).
+			GetCollectionExportStatus(gomock.Any(), gomock.Eq(testCollectionID), gomock.Eq(testExportID)).
 			Return(&client.CollectionExportResponse{Status: "generating"}, nil).
 			Times(1),
 		mockClient.EXPECT().
-			GetCollectionExportStatus(gomock.Eq(testCollectionID), gomock.Eq(testExportID)).
+			GetCollectionExportStatus(gomock.Any(), gomock.Eq(testCollectionID), gomock.Eq(testExportID)).
 			Return(&client.CollectionExportResponse{
 				Status:    "complete",
 				URL:       srv.URL + "/export.zip",
@@ -155,6 +157,7 @@ func TestExportCommandPollingToComplete(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := collection.NewExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 	if err := cmd.Flags().Set("output", outputPath); err != nil {
 		t.Fatalf("failed to set output flag: %v", err)
 	}
@@ -171,14 +174,14 @@ func TestExportCommandPollingToComplete(t *testing.T) {
 }
 
 func TestExportCommandFailedStatus(t *testing.T) {
-	defer collection.SetPollSleepForTesting(func(time.Duration) {})()
+	defer collection.SetPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		InitiateCollectionExport(gomock.Eq(testCollectionID)).
+		InitiateCollectionExport(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(&client.CollectionExportResponse{
 			Status:   "generating",
 			ExportID: testExportID,
@@ -186,13 +189,14 @@ func TestExportCommandFailedStatus(t *testing.T) {
 		Times(1)
 
 	mockClient.EXPECT().
-		GetCollectionExportStatus(gomock.Eq(testCollectionID), gomock.Eq(testExportID)).
+		GetCollectionExportStatus(gomock.Any(), gomock.Eq(testCollectionID), gomock.Eq(testExportID)).
 		Return(&client.CollectionExportResponse{Status: "failed"}, nil).
 		Times(1)
 
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := collection.NewExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testCollectionID})
 	w.Flush()
@@ -204,19 +208,52 @@ func TestExportCommandFailedStatus(t *testing.T) {
 	}
 }
 
+// TestExportCommandCancelledWhilePolling covers Ctrl-C between polls: the
+// command stops without another request and reports the in-flight export.
+func TestExportCommandCancelledWhilePolling(t *testing.T) {
+	defer collection.SetPollSleepForTesting(func(context.Context, time.Duration) error { return context.Canceled })()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockClient := mock_client.NewMockAPI(ctrl)
+
+	mockClient.EXPECT().
+		InitiateCollectionExport(gomock.Any(), gomock.Eq(testCollectionID)).
+		Return(&client.CollectionExportResponse{
+			Status:   "generating",
+			ExportID: testExportID,
+		}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	w := bufio.NewWriter(&b)
+	cmd := collection.NewExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
+
+	err := cmd.RunE(cmd, []string{testCollectionID})
+	w.Flush()
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), testExportID) || !strings.Contains(err.Error(), "still generating") {
+		t.Errorf("expected error to report the in-flight export, got: %v", err)
+	}
+}
+
 func TestExportCommandInitiateError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		InitiateCollectionExport(gomock.Eq(testCollectionID)).
+		InitiateCollectionExport(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(nil, errors.New("network error")).
 		Times(1)
 
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := collection.NewExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err := cmd.RunE(cmd, []string{testCollectionID})
 	w.Flush()
@@ -235,7 +272,7 @@ func TestExportCommandDefaultOutputPath(t *testing.T) {
 	mockClient := mock_client.NewMockAPI(ctrl)
 
 	mockClient.EXPECT().
-		InitiateCollectionExport(gomock.Eq(testCollectionID)).
+		InitiateCollectionExport(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(&client.CollectionExportResponse{
 			Status:    "complete",
 			URL:       srv.URL + "/export.zip",
@@ -260,6 +297,7 @@ func TestExportCommandDefaultOutputPath(t *testing.T) {
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
 	cmd := collection.NewExportCommand(mockClient, w)
+	cmd.SetContext(context.Background())
 
 	err = cmd.RunE(cmd, []string{testCollectionID})
 	w.Flush()
@@ -13,7 +13,8 @@ import (
 
 // GetOptions is the options for the get collection command.
 type GetOptions struct {
-	Args []string
+	Args   []string
+	Output shared.OutputOptions
 }
 
 // NewGetCommand creates a new `collection get` command to retrieve details about
@@ -41,18 +42,24 @@ $ prolific collection get 123456789
 				return errors.New("please provide a collection ID")
 			}
 
-			coll, err := c.GetCollection(opts.Args[0])
+			coll, err := c.GetCollection(cmd.Context(), opts.Args[0])
 			if err != nil {
 				if shared.IsFeatureNotEnabledError(err) {
 					ui.RenderFeatureAccessMessage(FeatureNameAITBCollection, FeatureContactURLAITBCollection)
-					return nil
+					return fmt.Errorf("error: %w", err)
 				}
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *coll, w)
 			}
 
 			return RenderCollection(coll, w)
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+
 	return cmd
 }
@@ -63,7 +63,7 @@ func TestGetCommandCallsGetCollection(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
@@ -88,7 +88,7 @@ func TestGetCommandReturnsErrorOnClientError(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(nil, errors.New("collection not found")).
 		Times(1)
 
@@ -116,7 +116,7 @@ func TestGetCommandOutputContainsCollectionDetails(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -22,9 +23,11 @@ type ListOptions struct {
 	Args        []string
 	Fields      string
 	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 	WorkspaceID string
 	Limit       int
 	Offset      int
+	All         bool
 }
 
 // NewListCommand creates a new `collection list` command to give you details about
@@ -61,6 +64,9 @@ You can specify the fields you want to render in table or CSV output
 $ prolific collection list -w <workspace-id> -f ID,Name,ItemCount -t
 $ prolific collection list -w <workspace-id> -f ID,Name,ItemCount -c
 
+You can fetch every collection rather than a single page
+$ prolific collection list -w <workspace-id> --all -t
+
 The fields you can use are:
 - ID
 - Name
@@ -74,13 +80,20 @@ The fields you can use are:
 				return fmt.Errorf("workspace ID is required")
 			}
 
-			collections, err := c.GetCollections(opts.WorkspaceID, opts.Limit, opts.Offset)
+			collections, err := shared.FetchList(cmd.Context(), opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListCollectionsResponse, error) {
+				return c.GetCollections(ctx, opts.WorkspaceID, limit, offset)
+			})
 			if err != nil {
 				if shared.IsFeatureNotEnabledError(err) {
 					ui.RenderFeatureAccessMessage(FeatureNameAITBCollection, FeatureContactURLAITBCollection)
-					return nil
+					return fmt.Errorf("error: %w", err)
 				}
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
+			}
+
+			collections.Results, err = shared.ApplyQuery(opts.Query, collections.Results)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
 			}
 
 			format := shared.ResolveFormat(opts.Output)
@@ -88,27 +101,16 @@ The fields you can use are:
 			if fields == "" {
 				fields = defaultListFields
 			}
-			switch format {
-			case "json":
-				r := ui.JSONRenderer[model.Collection]{}
-				if err := r.Render(collections.Results, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			case "csv":
-				r := ui.CsvRenderer[model.Collection]{}
-				if err := r.Render(collections.Results, fields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			case "table":
-				r := ui.TableRenderer[model.Collection]{}
-				if err := r.Render(collections.Results, fields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			default:
+			if format == "" {
 				r := &InteractiveRenderer{}
 				if err := r.Render(c, *collections, w); err != nil {
-					return fmt.Errorf("error: %s", err)
+					return fmt.Errorf("error: %w", err)
 				}
+				return nil
+			}
+
+			if err := shared.RenderList(opts.Output, collections.Results, fields, w); err != nil {
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -120,7 +122,9 @@ The fields you can use are:
 	flags.StringVarP(&opts.Fields, "fields", "f", "", "Comma separated list of fields you want to display in table/csv mode.")
 	flags.IntVar(&opts.Limit, "limit", client.DefaultRecordLimit, "Limit the number of results returned.")
 	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "Offset for pagination.")
+	shared.AddAllFlag(cmd, &opts.All, "collections")
 	shared.AddOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
@@ -148,7 +152,7 @@ func (r *InteractiveRenderer) Render(c client.API, collections client.ListCollec
 
 	p := tea.NewProgram(lv)
 	if _, err := p.Run(); err != nil {
-		return fmt.Errorf("cannot render collections: %s", err)
+		return fmt.Errorf("cannot render collections: %w", err)
 	}
 
 	return nil
@@ -63,7 +63,7 @@ func TestListCommandPassesWorkspaceIDToGetCollections(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollections(gomock.Eq(testWorkspaceID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetCollections(gomock.Any(), gomock.Eq(testWorkspaceID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&collectionResponse, nil).
 		Times(1)
 
@@ -92,7 +92,7 @@ func TestListCommandPassesLimitAndOffsetToGetCollections(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollections(gomock.Eq(testWorkspaceID), gomock.Eq(limit), gomock.Eq(offset)).
+		GetCollections(gomock.Any(), gomock.Eq(testWorkspaceID), gomock.Eq(limit), gomock.Eq(offset)).
 		Return(&collectionResponse, nil).
 		Times(1)
 
@@ -120,7 +120,7 @@ func TestListCommandUsesJSONRendererWithJsonFlag(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollections(gomock.Eq(testWorkspaceID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetCollections(gomock.Any(), gomock.Eq(testWorkspaceID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&collectionResponse, nil).
 		Times(1)
 
@@ -60,13 +60,13 @@ $ prolific collection preview 123456789
 			collectionID := opts.Args[0]
 
 			// Fetch collection to validate access
-			_, err := c.GetCollection(collectionID)
+			_, err := c.GetCollection(cmd.Context(), collectionID)
 			if err != nil {
 				if shared.IsFeatureNotEnabledError(err) {
 					ui.RenderFeatureAccessMessage(FeatureNameAITBCollection, FeatureContactURLAITBCollection)
-					return nil
+					return fmt.Errorf("failed to get collection: %w", err)
 				}
-				return fmt.Errorf("failed to get collection: %s", err.Error())
+				return fmt.Errorf("failed to get collection: %w", err)
 			}
 
 			// Build the preview URL and display it
@@ -4,12 +4,15 @@ import (
 	"bufio"
 	"bytes"
 	"errors"
+	"net/http"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/prolific-oss/cli/client"
 	"github.com/prolific-oss/cli/cmd/collection"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/mock_client"
 	"github.com/prolific-oss/cli/model"
 )
@@ -94,7 +97,7 @@ func TestPreviewCommandCallsGetCollection(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
@@ -117,7 +120,7 @@ func TestPreviewCommandReturnsErrorOnClientError(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(nil, errors.New("collection not found")).
 		Times(1)
 
@@ -143,12 +146,14 @@ func TestPreviewCommandHandlesFeatureNotEnabledError(t *testing.T) {
 	defer ctrl.Finish()
 	mockClient := mock_client.NewMockAPI(ctrl)
 
-	// The feature not enabled error must contain "request failed", "permission", and "feature"
-	featureError := errors.New("request failed: you do not currently have permission to access this feature")
+	featureError := &client.APIError{
+		StatusCode: http.StatusForbidden,
+		Detail:     "you do not currently have permission to access this feature",
+	}
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(nil, featureError).
 		Times(1)
 
@@ -159,10 +164,13 @@ func TestPreviewCommandHandlesFeatureNotEnabledError(t *testing.T) {
 	err := cmd.RunE(cmd, []string{testCollectionID})
 	writer.Flush()
 
-	// When feature is not enabled, the command should not return an error
-	// but should display a feature access message
-	if err != nil {
-		t.Fatalf("expected no error for feature not enabled, got: %v", err)
+	// When the feature is not enabled, the command displays a feature access
+	// message and returns the API error, so the process exits with its code.
+	if err == nil {
+		t.Fatal("expected an error for feature not enabled")
+	}
+	if code := shared.ExitCode(err); code != shared.ExitFeatureNotEnabled {
+		t.Fatalf("expected exit code %d, got %d", shared.ExitFeatureNotEnabled, code)
 	}
 }
 
@@ -181,7 +189,7 @@ func TestPreviewCommandOutputContainsURL(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -11,7 +12,6 @@ import (
 	"github.com/prolific-oss/cli/model"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // PublishOptions is the options for the publish collection command.
@@ -22,6 +22,8 @@ type PublishOptions struct {
 	Description  string
 	TemplatePath string
 	Draft        bool
+	TemplateFile shared.TemplateFileOptions
+	Output       shared.OutputOptions
 }
 
 // NewPublishCommand creates a new `collection publish` command to publish
@@ -86,7 +88,7 @@ $ prolific collection publish 67890abcdef -t /path/to/template.json -p 200
 				return errors.New("please provide a valid number of participants using --participants or -p, or provide a template file using --template or -t")
 			}
 
-			return publishCollection(c, opts, w)
+			return publishCollection(cmd.Context(), c, opts, w)
 		},
 	}
 
@@ -95,36 +97,37 @@ $ prolific collection publish 67890abcdef -t /path/to/template.json -p 200
 	flags.StringVarP(&opts.Name, "name", "n", "", "Study name (defaults to collection's task name)")
 	flags.StringVar(&opts.Description, "description", "", "Study description (defaults to collection's task introduction)")
 	flags.BoolVarP(&opts.Draft, "draft", "d", false, "Create the study in draft status without publishing")
-	flags.StringVarP(&opts.TemplatePath, "template", "t", "", "Path to a study template file (JSON/YAML) - collection ID and method will be set automatically")
+	flags.StringVarP(&opts.TemplatePath, "template", "t", "", "Path to a study template file (.json, .yaml or .yml) - collection ID and method will be set automatically")
+	shared.AddTemplateFileFlags(cmd, &opts.TemplateFile)
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func publishCollection(c client.API, opts PublishOptions, w io.Writer) error {
+func publishCollection(ctx context.Context, c client.API, opts PublishOptions, w io.Writer) error {
 	collectionID := opts.Args[0]
 
 	// Fetch the collection to get default name/description
-	coll, err := c.GetCollection(collectionID)
+	coll, err := c.GetCollection(ctx, collectionID)
 	if err != nil {
 		if shared.IsFeatureNotEnabledError(err) {
 			ui.RenderFeatureAccessMessage(FeatureNameAITBCollection, FeatureContactURLAITBCollection)
-			return nil
+			return fmt.Errorf("failed to get collection: %w", err)
 		}
-		return fmt.Errorf("failed to get collection: %s", err.Error())
+		return fmt.Errorf("failed to get collection: %w", err)
 	}
 
 	var createStudy model.CreateStudy
 
 	if opts.TemplatePath != "" {
 		// Load study configuration from template file
-		v := viper.New()
-		v.SetConfigFile(opts.TemplatePath)
-		if err := v.ReadInConfig(); err != nil {
-			return fmt.Errorf("failed to read template file: %s", err.Error())
+		v, err := shared.ReadTemplateFile(opts.TemplatePath, opts.TemplateFile)
+		if err != nil {
+			return fmt.Errorf("failed to read template file: %w", err)
 		}
 
 		if err := v.Unmarshal(&createStudy); err != nil {
-			return fmt.Errorf("failed to parse template file: %s", err.Error())
+			return fmt.Errorf("failed to parse template file: %w", err)
 		}
 
 		// Override collection-specific fields
@@ -182,12 +185,16 @@ func publishCollection(c client.API, opts PublishOptions, w io.Writer) error {
 		}
 	}
 
-	study, err := c.CreateStudy(createStudy)
+	study, err := c.CreateStudy(ctx, createStudy)
 	if err != nil {
-		return fmt.Errorf("failed to create study: %s", err.Error())
+		return fmt.Errorf("failed to create study: %w", err)
 	}
 
 	if opts.Draft {
+		if opts.Output.IsStructured() {
+			return shared.RenderRecord(opts.Output, *study, w)
+		}
+
 		fmt.Fprintln(w, cmdStudy.RenderStudy(*study))
 		fmt.Fprintf(w, "\nStudy created in draft status. Study ID: %s\n", study.ID)
 		fmt.Fprintf(w, "Study URL: %s\n", cmdStudy.GetStudyURL(study.ID))
@@ -197,15 +204,19 @@ func publishCollection(c client.API, opts PublishOptions, w io.Writer) error {
 	}
 
 	// Transition the study to publish
-	_, err = c.TransitionStudy(study.ID, model.TransitionStudyPublish)
+	_, err = c.TransitionStudy(ctx, study.ID, model.TransitionStudyPublish)
 	if err != nil {
-		return fmt.Errorf("failed to publish study: %s", err.Error())
+		return fmt.Errorf("failed to publish study: %w", err)
 	}
 
 	// Fetch the updated study to get the latest status
-	study, err = c.GetStudy(study.ID)
+	study, err = c.GetStudy(ctx, study.ID)
 	if err != nil {
-		return fmt.Errorf("failed to get study details: %s", err.Error())
+		return fmt.Errorf("failed to get study details: %w", err)
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *study, w)
 	}
 
 	fmt.Fprintln(w, cmdStudy.RenderStudy(*study))
@@ -2,6 +2,7 @@ package collection_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"os"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/client/fake"
 	"github.com/prolific-oss/cli/cmd/collection"
 	"github.com/prolific-oss/cli/mock_client"
 	"github.com/prolific-oss/cli/model"
@@ -96,14 +98,14 @@ func TestPublishCommandSuccess(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			if s.DataCollectionMethod != model.DataCollectionMethodAITBCollection {
 				t.Errorf("expected DataCollectionMethod %s, got %s", model.DataCollectionMethodAITBCollection, s.DataCollectionMethod)
 			}
@@ -119,13 +121,13 @@ func TestPublishCommandSuccess(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-123"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-123"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&client.TransitionStudyResponse{}, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		GetStudy(gomock.Eq("study-123")).
+		GetStudy(gomock.Any(), gomock.Eq("study-123")).
 		Return(testStudy, nil).
 		Times(1)
 
@@ -151,7 +153,7 @@ func TestPublishCommandCollectionNotFound(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(nil, errors.New("collection not found")).
 		Times(1)
 
@@ -184,13 +186,13 @@ func TestPublishCommandCreateStudyError(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
+		CreateStudy(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("validation error")).
 		Times(1)
 
@@ -230,19 +232,19 @@ func TestPublishCommandTransitionError(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
+		CreateStudy(gomock.Any(), gomock.Any()).
 		Return(testStudy, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-123"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-123"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(nil, errors.New("insufficient funds")).
 		Times(1)
 
@@ -282,14 +284,14 @@ func TestPublishCommandUsesCustomNameAndDescription(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			if s.Name != "Custom Study Name" {
 				t.Errorf("expected Name 'Custom Study Name', got %s", s.Name)
 			}
@@ -302,13 +304,13 @@ func TestPublishCommandUsesCustomNameAndDescription(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-123"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-123"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&client.TransitionStudyResponse{}, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		GetStudy(gomock.Eq("study-123")).
+		GetStudy(gomock.Any(), gomock.Eq("study-123")).
 		Return(testStudy, nil).
 		Times(1)
 
@@ -365,14 +367,14 @@ func TestPublishCommandWithTemplate(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			// Verify the collection fields are set correctly
 			if s.DataCollectionMethod != model.DataCollectionMethodAITBCollection {
 				t.Errorf("expected DataCollectionMethod %s, got %s", model.DataCollectionMethodAITBCollection, s.DataCollectionMethod)
@@ -396,13 +398,13 @@ func TestPublishCommandWithTemplate(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-456"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-456"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&client.TransitionStudyResponse{}, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		GetStudy(gomock.Eq("study-456")).
+		GetStudy(gomock.Any(), gomock.Eq("study-456")).
 		Return(testStudy, nil).
 		Times(1)
 
@@ -465,14 +467,14 @@ func TestPublishCommandWithTemplateOverridesCollectionFields(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			// Verify the collection fields are OVERRIDDEN correctly
 			if s.DataCollectionMethod != model.DataCollectionMethodAITBCollection {
 				t.Errorf("expected DataCollectionMethod to be overridden to %s, got %s", model.DataCollectionMethodAITBCollection, s.DataCollectionMethod)
@@ -490,13 +492,13 @@ func TestPublishCommandWithTemplateOverridesCollectionFields(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-789"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-789"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&client.TransitionStudyResponse{}, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		GetStudy(gomock.Eq("study-789")).
+		GetStudy(gomock.Any(), gomock.Eq("study-789")).
 		Return(testStudy, nil).
 		Times(1)
 
@@ -525,7 +527,7 @@ func TestPublishCommandWithInvalidTemplatePath(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
@@ -621,14 +623,14 @@ func TestPublishCommandWithTemplateUsesCollectionDescriptionFallback(t *testing.
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			// Verify the description falls back to collection's task introduction
 			expectedDescription := "This is the task introduction from the collection"
 			if s.Description != expectedDescription {
@@ -640,13 +642,13 @@ func TestPublishCommandWithTemplateUsesCollectionDescriptionFallback(t *testing.
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-desc-test"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-desc-test"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&client.TransitionStudyResponse{}, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		GetStudy(gomock.Eq("study-desc-test")).
+		GetStudy(gomock.Any(), gomock.Eq("study-desc-test")).
 		Return(testStudy, nil).
 		Times(1)
 
@@ -700,14 +702,14 @@ func TestPublishCommandWithTemplateAndParticipantsFlag(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			// Verify -p flag overrides template's total_available_places
 			if s.TotalAvailablePlaces != 150 {
 				t.Errorf("expected TotalAvailablePlaces to be overridden to 150, got %d", s.TotalAvailablePlaces)
@@ -722,13 +724,13 @@ func TestPublishCommandWithTemplateAndParticipantsFlag(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-override-test"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-override-test"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&client.TransitionStudyResponse{}, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		GetStudy(gomock.Eq("study-override-test")).
+		GetStudy(gomock.Any(), gomock.Eq("study-override-test")).
 		Return(testStudy, nil).
 		Times(1)
 
@@ -783,14 +785,14 @@ func TestPublishCommandWithTemplateAndNameDescriptionFlags(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			// Verify --name and --description flags override template values
 			if s.Name != "Flag Override Name" {
 				t.Errorf("expected Name to be overridden to 'Flag Override Name', got %q", s.Name)
@@ -811,13 +813,13 @@ func TestPublishCommandWithTemplateAndNameDescriptionFlags(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-name-desc-test"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-name-desc-test"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&client.TransitionStudyResponse{}, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		GetStudy(gomock.Eq("study-name-desc-test")).
+		GetStudy(gomock.Any(), gomock.Eq("study-name-desc-test")).
 		Return(testStudy, nil).
 		Times(1)
 
@@ -859,18 +861,18 @@ func TestPublishCommandDraftSuccess(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
+		CreateStudy(gomock.Any(), gomock.Any()).
 		Return(testStudy, nil).
 		Times(1)
 
-	mockClient.EXPECT().TransitionStudy(gomock.Any(), gomock.Any()).Times(0)
-	mockClient.EXPECT().GetStudy(gomock.Any()).Times(0)
+	mockClient.EXPECT().TransitionStudy(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockClient.EXPECT().GetStudy(gomock.Any(), gomock.Any()).Times(0)
 
 	var buf bytes.Buffer
 	cmd := collection.NewPublishCommand(mockClient, &buf)
@@ -939,14 +941,14 @@ func TestPublishCommandDraftWithTemplate(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			if s.DataCollectionMethod != model.DataCollectionMethodAITBCollection {
 				t.Errorf("expected DataCollectionMethod %s, got %s", model.DataCollectionMethodAITBCollection, s.DataCollectionMethod)
 			}
@@ -957,8 +959,8 @@ func TestPublishCommandDraftWithTemplate(t *testing.T) {
 		}).
 		Times(1)
 
-	mockClient.EXPECT().TransitionStudy(gomock.Any(), gomock.Any()).Times(0)
-	mockClient.EXPECT().GetStudy(gomock.Any()).Times(0)
+	mockClient.EXPECT().TransitionStudy(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockClient.EXPECT().GetStudy(gomock.Any(), gomock.Any()).Times(0)
 
 	var buf bytes.Buffer
 	cmd := collection.NewPublishCommand(mockClient, &buf)
@@ -1020,14 +1022,14 @@ func TestPublishCommandWithTemplateUsesDefaultDescriptionFallback(t *testing.T)
 
 	mockClient.
 		EXPECT().
-		GetCollection(gomock.Eq(testCollectionID)).
+		GetCollection(gomock.Any(), gomock.Eq(testCollectionID)).
 		Return(testCollection, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		CreateStudy(gomock.Any()).
-		DoAndReturn(func(s model.CreateStudy) (*model.Study, error) {
+		CreateStudy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s model.CreateStudy) (*model.Study, error) {
 			// Verify the description falls back to the default format
 			expectedDescription := "Study for collection: My Test Collection"
 			if s.Description != expectedDescription {
@@ -1039,13 +1041,13 @@ func TestPublishCommandWithTemplateUsesDefaultDescriptionFallback(t *testing.T)
 
 	mockClient.
 		EXPECT().
-		TransitionStudy(gomock.Eq("study-fallback-test"), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq("study-fallback-test"), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&client.TransitionStudyResponse{}, nil).
 		Times(1)
 
 	mockClient.
 		EXPECT().
-		GetStudy(gomock.Eq("study-fallback-test")).
+		GetStudy(gomock.Any(), gomock.Eq("study-fallback-test")).
 		Return(testStudy, nil).
 		Times(1)
 
@@ -1058,3 +1060,41 @@ func TestPublishCommandWithTemplateUsesDefaultDescriptionFallback(t *testing.T)
 		t.Fatalf("expected no error, got: %v", err)
 	}
 }
+
+func TestPublishCommandPublishesAgainstTheFake(t *testing.T) {
+	ctx := context.Background()
+	c := fake.New()
+
+	workspace, err := c.CreateWorkspace(ctx, model.Workspace{Title: "Lab"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	coll, err := c.CreateAITaskBuilderCollection(ctx, model.CreateAITaskBuilderCollection{
+		Name:        "Test Collection",
+		WorkspaceID: workspace.ID,
+		TaskDetails: &model.TaskDetails{TaskName: "Test Task Name"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	cmd := collection.NewPublishCommand(c, &buf)
+	cmd.SetContext(ctx)
+	_ = cmd.Flags().Set("participants", "50")
+	err = cmd.RunE(cmd, []string{coll.ID})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	studies, err := c.GetStudies(ctx, model.StatusActive, "", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(studies.Results) != 1 {
+		t.Fatalf("expected one active study, got %d", len(studies.Results))
+	}
+	if studies.Results[0].Name != "Test Task Name" || studies.Results[0].TotalAvailablePlaces != 50 {
+		t.Fatalf("expected the study to be made from the collection, got %+v", studies.Results[0])
+	}
+}
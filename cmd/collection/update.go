@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -13,6 +14,7 @@ import (
 
 type UpdateOptions struct {
 	TemplatePath string
+	Output       shared.OutputOptions
 }
 
 // NewUpdateCommand creates a new `collection update` command to update a collection
@@ -70,11 +72,15 @@ Example JSON config file:
 				return err
 			}
 
-			collection, err := client.UpdateCollection(collectionID, updatePayload)
+			collection, err := client.UpdateCollection(cmd.Context(), collectionID, updatePayload)
 			if err != nil {
 				return err
 			}
 
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *collection, w)
+			}
+
 			fmt.Fprintf(w, "Collection updated successfully\n")
 			fmt.Fprintf(w, "ID: %s\n", collection.ID)
 			fmt.Fprintf(w, "Name: %s\n", collection.Name)
@@ -84,6 +90,7 @@ Example JSON config file:
 	}
 
 	cmd.Flags().StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a YAML or JSON file containing your collection updates")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 	_ = cmd.MarkFlagRequired("template-path")
 
 	return cmd
@@ -97,12 +104,12 @@ func validateTemplate(opts UpdateOptions) (model.UpdateCollection, error) {
 	err := v.ReadInConfig()
 
 	if err != nil {
-		return updatePayload, fmt.Errorf("unable to read config file: %s", err)
+		return updatePayload, fmt.Errorf("unable to read config file: %w", err)
 	}
 
 	err = v.UnmarshalExact(&updatePayload)
 	if err != nil {
-		return updatePayload, fmt.Errorf("unable to unmarshal config file: %s", err)
+		return updatePayload, fmt.Errorf("unable to unmarshal config file: %w", err)
 	}
 
 	if updatePayload.Name == "" {
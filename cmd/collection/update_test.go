@@ -467,7 +467,7 @@ collection_items:
 			// Only expect API call if we have valid args and config
 			if !tt.skipMock && len(tt.args) > 0 && tt.configExt != "" {
 				c.EXPECT().
-					UpdateCollection(tt.args[0], gomock.Eq(tt.expectedPayload)).
+					UpdateCollection(gomock.Any(), tt.args[0], gomock.Eq(tt.expectedPayload)).
 					Return(tt.mockReturn, tt.mockError).
 					Times(1)
 			}
@@ -618,7 +618,7 @@ func TestUpdateCollectionExactPayload(t *testing.T) {
 	}
 
 	c.EXPECT().
-		UpdateCollection(collectionID, gomock.Eq(expectedPayload)).
+		UpdateCollection(gomock.Any(), collectionID, gomock.Eq(expectedPayload)).
 		Return(&model.Collection{
 			ID:        collectionID,
 			Name:      "Exact Payload Test",
@@ -694,7 +694,7 @@ func TestUpdateCollectionPassesThroughContentFormat(t *testing.T) {
 	}
 
 	c.EXPECT().
-		UpdateCollection(collectionID, gomock.Eq(expectedPayload)).
+		UpdateCollection(gomock.Any(), collectionID, gomock.Eq(expectedPayload)).
 		Return(&model.Collection{
 			ID:        collectionID,
 			Name:      "Test Collection",
@@ -792,7 +792,7 @@ func TestUpdateCollectionWithExclusiveOptionMultiSelect(t *testing.T) {
 	}
 
 	c.EXPECT().
-		UpdateCollection(collectionID, gomock.Eq(expectedPayload)).
+		UpdateCollection(gomock.Any(), collectionID, gomock.Eq(expectedPayload)).
 		Return(&model.Collection{
 			ID:        collectionID,
 			Name:      "Collection With Exclusive Options",
@@ -65,7 +65,7 @@ func RenderCollection(collection *model.Collection, w io.Writer) error {
 	content := fmt.Sprintln(ui.RenderHeading(collection.Name))
 	content += fmt.Sprintf("ID:         %s\n", collection.ID)
 	content += fmt.Sprintf("Created by: %s\n", collection.CreatedBy)
-	content += fmt.Sprintf("Created at: %s\n", collection.CreatedAt.Format("2006-01-02 15:04:05"))
+	content += fmt.Sprintf("Created at: %s\n", ui.FormatDateTime(collection.CreatedAt))
 
 	if collection.TaskDetails != nil {
 		content += "\nTask Details:\n"
@@ -83,7 +83,7 @@ func renderCollectionString(collection model.Collection) string {
 	content := fmt.Sprintln(ui.RenderHeading(collection.Name))
 	content += fmt.Sprintf("ID:         %s\n", collection.ID)
 	content += fmt.Sprintf("Created by: %s\n", collection.CreatedBy)
-	content += fmt.Sprintf("Created at: %s\n", collection.CreatedAt.Format("2006-01-02 15:04:05"))
+	content += fmt.Sprintf("Created at: %s\n", ui.FormatDateTime(collection.CreatedAt))
 
 	if collection.TaskDetails != nil {
 		content += "\nTask Details:\n"
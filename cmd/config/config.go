@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/prolific-oss/cli/config"
+	"github.com/spf13/cobra"
+)
+
+// NewConfigCommand creates a new `config` command
+func NewConfigCommand(w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "View and edit the CLI's config file",
+		Long: `View and edit the CLI's config file
+
+The config file holds default settings, and named profiles that each carry
+their own token, API URL, application URL, workspace and project. Select a
+profile with --profile, the PROLIFIC_PROFILE environment variable, or
+"prolific config use-profile". Environment variables such as PROLIFIC_TOKEN
+still take precedence over the config file.
+
+Settings: ` + strings.Join(config.ProfileSettingNames(), ", ") + `
+
+Without --profile (or PROLIFIC_PROFILE) these commands edit the default
+settings at the top of the file.
+`,
+	}
+
+	cmd.AddCommand(
+		NewGetCommand("get", w),
+		NewSetCommand("set", w),
+		NewUnsetCommand("unset", w),
+		NewListCommand("list", w),
+		NewUseProfileCommand("use-profile", w),
+	)
+	return cmd
+}
+
+// loadFile opens the config file in use for editing.
+func loadFile() (*config.File, error) {
+	path, err := config.FilePath()
+	if err != nil {
+		return nil, err
+	}
+	return config.LoadFile(path)
+}
+
+// targetProfile returns the profile the command should edit, as given by the
+// root --profile flag or PROLIFIC_PROFILE. current_profile is deliberately
+// ignored, so the default settings stay editable while a profile is in use.
+func targetProfile(cmd *cobra.Command) (string, error) {
+	profile := ""
+	if f := cmd.Flag("profile"); f != nil {
+		profile = f.Value.String()
+	}
+	if profile == "" {
+		profile = os.Getenv(config.ProfileEnvVar)
+	}
+	if strings.Contains(profile, ".") {
+		return "", fmt.Errorf("profile names cannot contain \".\": %s", profile)
+	}
+	return profile, nil
+}
+
+// settingKey maps a setting name to its dotted key in the config file, either
+// within profile or, when profile is empty, at the top level.
+func settingKey(name, profile string) (string, error) {
+	if profile == "" && strings.EqualFold(name, config.CurrentProfileKey) {
+		return config.CurrentProfileKey, nil
+	}
+
+	s, ok := config.LookupProfileSetting(name)
+	if !ok {
+		return "", fmt.Errorf("unknown setting %q, expected one of: %s", name, strings.Join(config.ProfileSettingNames(), ", "))
+	}
+
+	if profile == "" {
+		return strings.ToLower(s.Key), nil
+	}
+	return strings.Join([]string{config.ProfilesKey, profile, s.Name}, "."), nil
+}
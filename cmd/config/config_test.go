@@ -0,0 +1,231 @@
+package config_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	configcmd "github.com/prolific-oss/cli/cmd/config"
+	"github.com/prolific-oss/cli/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// run executes the config command under a root carrying the --profile flag,
+// against a config file in a temporary directory.
+func run(t *testing.T, path string, args ...string) (string, error) {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.SetConfigFile(path)
+
+	var b bytes.Buffer
+	root := &cobra.Command{Use: "prolific"}
+	root.PersistentFlags().String("profile", "", "")
+	root.AddCommand(configcmd.NewConfigCommand(&b))
+	root.SetArgs(append([]string{"config"}, args...))
+	root.SetOut(&b)
+	root.SetErr(&b)
+	root.SilenceUsage = true
+	root.SilenceErrors = true
+
+	err := root.Execute()
+	return b.String(), err
+}
+
+func TestNewConfigCommand(t *testing.T) {
+	cmd := configcmd.NewConfigCommand(os.Stdout)
+
+	if cmd.Use != "config" {
+		t.Fatalf("expected use: config; got %s", cmd.Use)
+	}
+
+	for _, name := range []string{"get", "set", "unset", "list", "use-profile"} {
+		if c, _, err := cmd.Find([]string{name}); err != nil || c.Name() != name {
+			t.Fatalf("expected subcommand %s to be registered", name)
+		}
+	}
+}
+
+func TestConfigSetAndGet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+
+	if _, err := run(t, path, "set", "workspace", "ws-1"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+	if _, err := run(t, path, "set", "token", "staging-token", "--profile", "staging"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	out, err := run(t, path, "get", "workspace")
+	if err != nil || out != "ws-1\n" {
+		t.Fatalf("expected ws-1; got %q, %v", out, err)
+	}
+
+	out, err = run(t, path, "get", "token", "--profile", "staging")
+	if err != nil || out != "staging-token\n" {
+		t.Fatalf("expected staging-token; got %q, %v", out, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `workspace: ws-1
+profiles:
+  staging:
+    token: staging-token
+`
+	if string(data) != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, data)
+	}
+}
+
+func TestConfigSetTokenAtTopLevelUsesEnvironmentName(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+
+	if _, err := run(t, path, "set", "token", "abc"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	f, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := f.Get("PROLIFIC_TOKEN"); !ok || v != "abc" {
+		t.Fatalf("expected prolific_token abc; got %q, %v", v, ok)
+	}
+}
+
+func TestConfigUsesProfileFromEnvironment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+	t.Setenv(config.ProfileEnvVar, "staging")
+
+	if _, err := run(t, path, "set", "project", "p-1"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	f, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := f.Get("profiles.staging.project"); !ok || v != "p-1" {
+		t.Fatalf("expected the staging profile's project to be set; got %q, %v", v, ok)
+	}
+}
+
+func TestConfigRejectsUnknownSetting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+
+	_, err := run(t, path, "set", "colour", "blue")
+	if err == nil || !strings.Contains(err.Error(), `unknown setting "colour"`) {
+		t.Fatalf("expected unknown setting error; got %v", err)
+	}
+}
+
+func TestConfigGetUnsetSetting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+
+	_, err := run(t, path, "get", "workspace")
+	if err == nil || err.Error() != "error: workspace is not set in "+path {
+		t.Fatalf("expected not set error; got %v", err)
+	}
+}
+
+func TestConfigUnset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+	_, _ = run(t, path, "set", "workspace", "ws-1")
+	_, _ = run(t, path, "set", "url", "https://staging.example.com", "--profile", "staging")
+
+	if _, err := run(t, path, "unset", "url", "--profile", "staging"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "workspace: ws-1\n" {
+		t.Fatalf("expected the empty profile to be removed; got %q", data)
+	}
+
+	if _, err := run(t, path, "unset", "url", "--profile", "staging"); err == nil {
+		t.Fatal("expected an error unsetting a missing setting")
+	}
+}
+
+func TestConfigUseProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+
+	_, err := run(t, path, "use-profile", "staging")
+	if err == nil || !strings.Contains(err.Error(), `profile "staging" not found`) {
+		t.Fatalf("expected profile not found error; got %v", err)
+	}
+
+	_, _ = run(t, path, "set", "token", "abc", "--profile", "staging")
+
+	out, err := run(t, path, "use-profile", "staging")
+	if err != nil || out != "Now using profile staging\n" {
+		t.Fatalf("expected profile to be used; got %q, %v", out, err)
+	}
+
+	f, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, _ := f.Get(config.CurrentProfileKey); v != "staging" {
+		t.Fatalf("expected current_profile staging; got %q", v)
+	}
+}
+
+func TestConfigListMasksTokens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+	contents := `workspace: ws-1
+prolific_token: default-token-1234
+current_profile: staging
+profiles:
+  staging:
+    token: staging-token-5678
+    url: https://staging.example.com
+  production:
+    workspace: ws-2
+`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := run(t, path, "list")
+	if err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	expected := `Config file:     ` + path + `
+Active profile:  staging
+
+Defaults:
+  workspace       ws-1
+  prolific_token  ********1234
+
+Profiles:
+* staging
+    token  ********5678
+    url    https://staging.example.com
+  production
+    workspace  ws-2
+`
+	if out != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, out)
+	}
+
+	if strings.Contains(out, "staging-token") {
+		t.Fatal("expected the token to be masked")
+	}
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// NewGetCommand creates a new command to print a setting from the config file.
+func NewGetCommand(commandName string, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   commandName + " <setting>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Print a setting from the config file",
+		Example: `
+Print your default workspace
+$ prolific config get workspace
+
+Print the API URL of the staging profile
+$ prolific config get url --profile staging
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := targetProfile(cmd)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			key, err := settingKey(args[0], profile)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			f, err := loadFile()
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			value, ok := f.Get(key)
+			if !ok {
+				return fmt.Errorf("error: %s is not set in %s", key, f.Path())
+			}
+
+			fmt.Fprintln(w, value)
+			return nil
+		},
+	}
+
+	return cmd
+}
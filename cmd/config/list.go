@@ -0,0 +1,123 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/prolific-oss/cli/config"
+	"github.com/spf13/cobra"
+)
+
+// NewListCommand creates a new command to show everything in the config file.
+func NewListCommand(commandName string, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   commandName,
+		Args:  cobra.NoArgs,
+		Short: "Show the settings and profiles in the config file",
+		Long: `Show the settings and profiles in the config file
+
+Tokens are masked. The profile in use is marked with an asterisk.
+`,
+		Example: `
+$ prolific config list
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			profile, err := targetProfile(cmd)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			f, err := loadFile()
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			renderConfig(f, profile, w)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// renderConfig writes the file's default settings followed by each profile.
+// selected is the profile chosen by flag or environment, if any.
+func renderConfig(f *config.File, selected string, w io.Writer) {
+	active := selected
+	if active == "" {
+		active, _ = f.Get(config.CurrentProfileKey)
+	}
+
+	var defaults []config.Setting
+	var profiles []string
+	profileSettings := map[string][]config.Setting{}
+
+	for _, s := range f.Settings() {
+		parts := strings.SplitN(s.Key, ".", 3)
+		if len(parts) == 3 && strings.EqualFold(parts[0], config.ProfilesKey) {
+			name := parts[1]
+			if _, seen := profileSettings[name]; !seen {
+				profiles = append(profiles, name)
+			}
+			profileSettings[name] = append(profileSettings[name], config.Setting{Key: parts[2], Value: s.Value})
+			continue
+		}
+		if strings.EqualFold(s.Key, config.CurrentProfileKey) {
+			continue
+		}
+		defaults = append(defaults, s)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 1, 2, ' ', 0)
+	fmt.Fprintf(tw, "Config file:\t%s\n", f.Path())
+	if active == "" {
+		active = "none"
+	}
+	fmt.Fprintf(tw, "Active profile:\t%s\n", active)
+
+	fmt.Fprintf(tw, "\nDefaults:\n")
+	if len(defaults) == 0 {
+		fmt.Fprintf(tw, "  (none)\n")
+	}
+	for _, s := range defaults {
+		fmt.Fprintf(tw, "  %s\t%s\n", s.Key, displayValue(s))
+	}
+
+	fmt.Fprintf(tw, "\nProfiles:\n")
+	if len(profiles) == 0 {
+		fmt.Fprintf(tw, "  (none)\n")
+	}
+	for _, name := range profiles {
+		marker := " "
+		if strings.EqualFold(name, active) {
+			marker = "*"
+		}
+		fmt.Fprintf(tw, "%s %s\n", marker, name)
+		for _, s := range profileSettings[name] {
+			fmt.Fprintf(tw, "    %s\t%s\n", s.Key, displayValue(s))
+		}
+	}
+
+	tw.Flush()
+}
+
+// displayValue masks secret settings, leaving the last few characters so
+// tokens can still be told apart.
+func displayValue(s config.Setting) string {
+	secret := false
+	for _, ps := range config.ProfileSettings {
+		if ps.Secret && (strings.EqualFold(s.Key, ps.Name) || strings.EqualFold(s.Key, ps.Key)) {
+			secret = true
+		}
+	}
+
+	if !secret {
+		return s.Value
+	}
+	if len(s.Value) <= 8 {
+		return "********"
+	}
+	return "********" + s.Value[len(s.Value)-4:]
+}
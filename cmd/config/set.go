@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// NewSetCommand creates a new command to store a setting in the config file.
+func NewSetCommand(commandName string, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   commandName + " <setting> <value>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Store a setting in the config file",
+		Long: `Store a setting in the config file
+
+The file is created if it does not exist yet. Setting a value in a profile
+that does not exist creates the profile.
+`,
+		Example: `
+Set your default workspace
+$ prolific config set workspace 6261321e223a605c7a4f7561
+
+Create a staging profile
+$ prolific config set token <token> --profile staging
+$ prolific config set url https://api.staging.example.com --profile staging
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := targetProfile(cmd)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			key, err := settingKey(args[0], profile)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			f, err := loadFile()
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if err := f.Set(key, args[1]); err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if err := f.Save(); err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			fmt.Fprintf(w, "Set %s in %s\n", key, f.Path())
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// NewUnsetCommand creates a new command to remove a setting from the config
+// file.
+func NewUnsetCommand(commandName string, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   commandName + " <setting>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove a setting from the config file",
+		Long: `Remove a setting from the config file
+
+A profile is removed along with its last setting.
+`,
+		Example: `
+Stop defaulting to a workspace
+$ prolific config unset workspace
+
+Remove the project from the staging profile
+$ prolific config unset project --profile staging
+
+Stop using a profile by default
+$ prolific config unset current_profile
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			profile, err := targetProfile(cmd)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			key, err := settingKey(args[0], profile)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			f, err := loadFile()
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if !f.Unset(key) {
+				return fmt.Errorf("error: %s is not set in %s", key, f.Path())
+			}
+
+			if err := f.Save(); err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			fmt.Fprintf(w, "Removed %s from %s\n", key, f.Path())
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/prolific-oss/cli/config"
+	"github.com/spf13/cobra"
+)
+
+// NewUseProfileCommand creates a new command to choose the profile used by
+// default.
+func NewUseProfileCommand(commandName string, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   commandName + " <profile>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Use a profile by default",
+		Long: `Use a profile by default
+
+Stores the profile as current_profile in the config file. --profile and the
+PROLIFIC_PROFILE environment variable still override it.
+`,
+		Example: `
+Use the staging profile until told otherwise
+$ prolific config use-profile staging
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			f, err := loadFile()
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if !hasProfile(f, name) {
+				return fmt.Errorf("error: profile %q not found in %s, create it with: prolific config set token <token> --profile %s", name, f.Path(), name)
+			}
+
+			if err := f.Set(config.CurrentProfileKey, name); err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if err := f.Save(); err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			fmt.Fprintf(w, "Now using profile %s\n", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// hasProfile reports whether the file holds any settings for the profile.
+func hasProfile(f *config.File, name string) bool {
+	prefix := strings.ToLower(config.ProfilesKey + "." + name + ".")
+	for _, s := range f.Settings() {
+		if strings.HasPrefix(strings.ToLower(s.Key), prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +14,7 @@ type CreateOptions struct {
 	FilePath    string
 	Credentials string
 	WorkspaceID string
+	Output      shared.OutputOptions
 }
 
 // NewCreateCommand creates a new `credentials create` command to create a credential pool
@@ -49,11 +51,15 @@ user3@example.com,p4ssw0rd3`,
 				return err
 			}
 
-			response, err := client.CreateCredentialPool(credentials, opts.WorkspaceID)
+			response, err := client.CreateCredentialPool(cmd.Context(), credentials, opts.WorkspaceID)
 			if err != nil {
 				return err
 			}
 
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *response, w)
+			}
+
 			fmt.Fprintf(w, "Credential pool created successfully\n")
 			fmt.Fprintf(w, "Credential Pool ID: %s\n", response.CredentialPoolID)
 
@@ -64,6 +70,7 @@ user3@example.com,p4ssw0rd3`,
 	cmd.Flags().StringVarP(&opts.FilePath, "file", "f", "", "Path to file containing credentials")
 	cmd.Flags().StringVarP(&opts.WorkspaceID, "workspace-id", "w", "", "Workspace ID (required)")
 	_ = cmd.MarkFlagRequired("workspace-id")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
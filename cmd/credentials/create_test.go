@@ -111,7 +111,7 @@ Credential Pool ID: pool123456
 			// Only expect API call if we have workspace ID and args
 			if len(tt.args) > 0 && tt.workspaceID != "" {
 				c.EXPECT().
-					CreateCredentialPool(gomock.Any(), tt.workspaceID).
+					CreateCredentialPool(gomock.Any(), gomock.Any(), tt.workspaceID).
 					Return(tt.mockReturn, tt.mockError).
 					Times(1)
 			}
@@ -163,7 +163,7 @@ func TestCreateCredentialPoolFromFile(t *testing.T) {
 	workspaceID := "workspace789"
 
 	c.EXPECT().
-		CreateCredentialPool(testCredentials, workspaceID).
+		CreateCredentialPool(gomock.Any(), testCredentials, workspaceID).
 		Return(&client.CredentialPoolResponse{
 			CredentialPoolID: testCredPoolID,
 		}, nil).
@@ -198,7 +198,7 @@ func TestCreateCredentialPoolFromFileWithTrailingNewline(t *testing.T) {
 	workspaceID := "workspace789"
 
 	c.EXPECT().
-		CreateCredentialPool(testCredentials, workspaceID).
+		CreateCredentialPool(gomock.Any(), testCredentials, workspaceID).
 		Return(&client.CredentialPoolResponse{
 			CredentialPoolID: testCredPoolID,
 		}, nil).
@@ -5,12 +5,15 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 // ListOptions are the options for listing credential pools
 type ListOptions struct {
 	WorkspaceID string
+	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 }
 
 // NewListCommand creates a new `credentials list` command to list credential pools for a workspace
@@ -32,13 +35,25 @@ Required:
 		Example: `
 List all credential pools for a workspace:
 $ prolific credentials list -w <workspace_id>
-$ prolific credentials list --workspace-id 507f1f77bcf86cd799439011`,
+$ prolific credentials list --workspace-id 507f1f77bcf86cd799439011
+
+List the IDs of pools with credentials left:
+$ prolific credentials list -w <workspace_id> --go-template '{{if .AvailableCredentials}}{{.CredentialPoolID}}{{end}}' | grep .`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			response, err := client.ListCredentialPools(opts.WorkspaceID)
+			response, err := client.ListCredentialPools(cmd.Context(), opts.WorkspaceID)
 			if err != nil {
 				return err
 			}
 
+			response.CredentialPools, err = shared.ApplyQuery(opts.Query, response.CredentialPools)
+			if err != nil {
+				return err
+			}
+
+			if opts.Output.IsStructured() {
+				return shared.RenderList(opts.Output, response.CredentialPools, "", w)
+			}
+
 			if len(response.CredentialPools) == 0 {
 				fmt.Fprintf(w, "No credential pools found for workspace %s\n", opts.WorkspaceID)
 				return nil
@@ -56,6 +71,8 @@ $ prolific credentials list --workspace-id 507f1f77bcf86cd799439011`,
 	}
 
 	cmd.Flags().StringVarP(&opts.WorkspaceID, "workspace-id", "w", "", "Workspace ID (required)")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 	_ = cmd.MarkFlagRequired("workspace-id")
 
 	return cmd
@@ -149,7 +149,7 @@ Credential Pool ID: cred_pool_12345
 			// Only expect API call if we have workspace ID
 			if tt.workspaceID != "" {
 				c.EXPECT().
-					ListCredentialPools(tt.workspaceID).
+					ListCredentialPools(gomock.Any(), tt.workspaceID).
 					Return(tt.mockReturn, tt.mockError).
 					Times(1)
 			}
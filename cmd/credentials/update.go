@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -12,6 +13,7 @@ import (
 type UpdateOptions struct {
 	FilePath    string
 	Credentials string
+	Output      shared.OutputOptions
 }
 
 // NewUpdateCommand creates a new `credentials update` command to update a credential pool
@@ -50,11 +52,15 @@ user3@example.com,p4ssw0rd3`,
 				return err
 			}
 
-			response, err := client.UpdateCredentialPool(credentialPoolID, credentials)
+			response, err := client.UpdateCredentialPool(cmd.Context(), credentialPoolID, credentials)
 			if err != nil {
 				return err
 			}
 
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *response, w)
+			}
+
 			fmt.Fprintf(w, "Credential pool updated successfully\n")
 			fmt.Fprintf(w, "Credential Pool ID: %s\n", response.CredentialPoolID)
 
@@ -63,6 +69,7 @@ user3@example.com,p4ssw0rd3`,
 	}
 
 	cmd.Flags().StringVarP(&opts.FilePath, "file", "f", "", "Path to file containing credentials")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
@@ -0,0 +1,20 @@
+package dev
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// NewDevCommand creates a new `dev` command
+func NewDevCommand(w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dev",
+		Short: "Tools for developing against the Prolific API",
+	}
+
+	cmd.AddCommand(
+		NewServerCommand("server", w),
+	)
+	return cmd
+}
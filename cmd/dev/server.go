@@ -0,0 +1,150 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prolific-oss/cli/client/fake"
+	"github.com/prolific-oss/cli/model"
+	"github.com/spf13/cobra"
+)
+
+// ServerOptions is the options for the dev server command.
+type ServerOptions struct {
+	Addr     string
+	Fixtures string
+	Quiet    bool
+}
+
+// NewServerCommand creates a new command to run a local mock of the API.
+func NewServerCommand(commandName string, w io.Writer) *cobra.Command {
+	var opts ServerOptions
+
+	cmd := &cobra.Command{
+		Use:   commandName,
+		Args:  cobra.NoArgs,
+		Short: "Run a local mock of the Prolific API",
+		Long: `Run a local mock of the Prolific API
+
+Serves the /api/v1/ routes the CLI uses from an in-memory store, so that
+whole workflows can be run end to end without an account. Studies created
+through it are listed, transitions change their status, dataset uploads are
+accepted and counted, and long running jobs such as dataset imports and
+batch setup complete after a couple of status checks. Nothing is saved when
+the server stops.
+
+Point the CLI at it by setting PROLIFIC_URL to the address it prints. The
+server accepts any token, but the CLI still needs PROLIFIC_TOKEN set.
+
+Without --fixtures the server starts with one workspace and project. A
+fixtures file is JSON with any of the keys "me", "workspaces", "projects",
+"studies", "submissions" and "messages"; see
+docs/scripts/dev-server-fixtures.json for an example.
+`,
+		Example: `
+$ prolific dev server
+$ prolific dev server --addr 127.0.0.1:9000 --fixtures docs/scripts/dev-server-fixtures.json
+
+Then, in another shell:
+$ export PROLIFIC_URL=http://127.0.0.1:8080 PROLIFIC_TOKEN=dev
+$ prolific study create -t docs/examples/standard-sample.json -p
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			err := runServer(cmd.Context(), opts, w)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Addr, "addr", "127.0.0.1:8080", "Address to listen on")
+	flags.StringVarP(&opts.Fixtures, "fixtures", "f", "", "Path to a JSON file of data to seed the server with")
+	flags.BoolVarP(&opts.Quiet, "quiet", "q", false, "Don't log each request")
+
+	return cmd
+}
+
+func runServer(ctx context.Context, opts ServerOptions, w io.Writer) error {
+	f := fake.New()
+	if opts.Fixtures != "" {
+		fixtures, err := fake.LoadFixtures(opts.Fixtures)
+		if err != nil {
+			return err
+		}
+		if err := f.Seed(fixtures); err != nil {
+			return fmt.Errorf("unable to seed %s: %w", opts.Fixtures, err)
+		}
+	} else {
+		workspace := f.AddWorkspace(model.Workspace{Title: "Dev workspace"})
+		project := f.AddProject(workspace.ID, model.Project{Title: "Dev project"})
+		fmt.Fprintf(w, "Workspace ID: %s\nProject ID:   %s\n\n", workspace.ID, project.ID)
+	}
+
+	listener, err := net.Listen("tcp", opts.Addr)
+	if err != nil {
+		return err
+	}
+	baseURL := "http://" + listener.Addr().String()
+	f.UploadBaseURL = baseURL + "/uploads"
+
+	var handler http.Handler = f.Handler()
+	if !opts.Quiet {
+		handler = logRequests(handler, w)
+	}
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+	fmt.Fprintf(w, "Serving a mock Prolific API at %s\n", baseURL)
+	fmt.Fprintf(w, "Use it with: export PROLIFIC_URL=%s PROLIFIC_TOKEN=dev\n", baseURL)
+
+	errs := make(chan error, 1)
+	go func() { errs <- server.Serve(listener) }()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdown); err != nil {
+		return err
+	}
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// logRequests writes a line to w for each request served.
+func logRequests(next http.Handler, w io.Writer) http.Handler {
+	var mu sync.Mutex
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		uri := r.URL.RequestURI()
+		recorder := &statusRecorder{ResponseWriter: rw, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintf(w, "%s %s %d\n", r.Method, uri, recorder.status)
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,129 @@
+package dev_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/dev"
+	"github.com/prolific-oss/cli/model"
+)
+
+// syncBuffer is a bytes.Buffer the server can write to while the test reads.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// start runs the server command until the test ends, returning its URL.
+func start(t *testing.T, out *syncBuffer, flags map[string]string) string {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cmd := dev.NewServerCommand("server", out)
+	cmd.SetContext(ctx)
+	_ = cmd.Flags().Set("addr", "127.0.0.1:0")
+	for name, value := range flags {
+		_ = cmd.Flags().Set(name, value)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.RunE(cmd, nil) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("expected the server to stop cleanly, got %v", err)
+		}
+	})
+
+	const prefix = "Serving a mock Prolific API at "
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		for line := range strings.SplitSeq(out.String(), "\n") {
+			if url, ok := strings.CutPrefix(line, prefix); ok {
+				return url
+			}
+		}
+	}
+	t.Fatalf("server did not start; output: %s", out.String())
+	return ""
+}
+
+func TestNewServerCommand(t *testing.T) {
+	cmd := dev.NewDevCommand(os.Stdout)
+
+	if cmd.Use != "dev" {
+		t.Fatalf("expected use: dev; got %s", cmd.Use)
+	}
+	if sub, _, err := cmd.Find([]string{"server"}); err != nil || sub.Name() != "server" {
+		t.Fatalf("expected subcommand server to be registered")
+	}
+}
+
+func TestServerCommandSeedsADefaultWorkspace(t *testing.T) {
+	var out syncBuffer
+	url := start(t, &out, map[string]string{"quiet": "true"})
+
+	c := client.Client{Client: http.DefaultClient, BaseURL: url, Token: "dev"}
+	workspaces, err := c.GetWorkspaces(context.Background(), client.DefaultRecordLimit, client.DefaultRecordOffset)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(workspaces.Results) != 1 || !strings.Contains(out.String(), "Workspace ID: "+workspaces.Results[0].ID) {
+		t.Fatalf("expected the default workspace to be printed; got %+v and output %s", workspaces.Results, out.String())
+	}
+	if strings.Contains(out.String(), "GET /api/v1/workspaces") {
+		t.Fatalf("expected --quiet to stop request logging; got %s", out.String())
+	}
+}
+
+func TestServerCommandLoadsFixtures(t *testing.T) {
+	var out syncBuffer
+	url := start(t, &out, map[string]string{"fixtures": "../../docs/scripts/dev-server-fixtures.json"})
+
+	c := client.Client{Client: http.DefaultClient, BaseURL: url, Token: "dev"}
+	studies, err := c.GetStudies(context.Background(), model.StatusActive, "", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(studies.Results) != 1 || studies.Results[0].Name != "Reaction times" {
+		t.Fatalf("expected the fixture study; got %+v", studies.Results)
+	}
+	if !strings.Contains(out.String(), "GET /api/v1/studies/?active=1&limit=") {
+		t.Fatalf("expected the request to be logged; got %s", out.String())
+	}
+}
+
+func TestServerCommandRejectsInvalidFixtures(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	if err := os.WriteFile(path, []byte(`{"projects": [{"title": "Orphan", "workspace": "missing"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := dev.NewServerCommand("server", &syncBuffer{})
+	cmd.SetContext(context.Background())
+	_ = cmd.Flags().Set("fixtures", path)
+	err := cmd.RunE(cmd, nil)
+
+	if err == nil || !strings.Contains(err.Error(), `workspace "missing" not found`) {
+		t.Fatalf("expected a missing workspace error; got %v", err)
+	}
+}
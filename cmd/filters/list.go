@@ -1,17 +1,20 @@
 package filters
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 func NewListCommand(client client.API, w io.Writer) *cobra.Command {
 	var nonInteractive bool
+	var output shared.OutputOptions
 
 	cmd := &cobra.Command{
 		Use:   "filters",
@@ -34,16 +37,21 @@ List all filters in an interactive, searchable interface
 $ prolific filters
 
 List all filters in a non-interactive format for scripting or AI agents
-$ prolific filters -n`,
+$ prolific filters -n
+
+List the ID and title of each filter with a Go template
+$ prolific filters --go-template '{{.FilterID}}: {{.FilterTitle}}'`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
-			if nonInteractive {
-				err = renderNonInteractiveList(client, w)
+			if output.IsStructured() {
+				err = renderStructuredList(cmd.Context(), client, output, w)
+			} else if nonInteractive {
+				err = renderNonInteractiveList(cmd.Context(), client, w)
 			} else {
-				err = renderInteractiveList(client)
+				err = renderInteractiveList(cmd.Context(), client)
 			}
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -52,12 +60,13 @@ $ prolific filters -n`,
 
 	flags := cmd.Flags()
 	flags.BoolVarP(&nonInteractive, "non-interactive", "n", false, "Render the filter details straight to the terminal.")
+	shared.AddRecordOutputFlags(cmd, &output)
 
 	return cmd
 }
 
-func renderNonInteractiveList(client client.API, w io.Writer) error {
-	filters, err := client.GetFilters()
+func renderNonInteractiveList(ctx context.Context, client client.API, w io.Writer) error {
+	filters, err := client.GetFilters(ctx)
 	if err != nil {
 		return err
 	}
@@ -69,8 +78,17 @@ func renderNonInteractiveList(client client.API, w io.Writer) error {
 	return nil
 }
 
-func renderInteractiveList(client client.API) error {
-	filters, err := client.GetFilters()
+func renderStructuredList(ctx context.Context, client client.API, output shared.OutputOptions, w io.Writer) error {
+	filters, err := client.GetFilters(ctx)
+	if err != nil {
+		return err
+	}
+
+	return shared.RenderList(output, filters.Results, "", w)
+}
+
+func renderInteractiveList(ctx context.Context, client client.API) error {
+	filters, err := client.GetFilters(ctx)
 	if err != nil {
 		return err
 	}
@@ -89,7 +107,7 @@ func renderInteractiveList(client client.API) error {
 
 	p := tea.NewProgram(lv)
 	if _, err := p.Run(); err != nil {
-		return fmt.Errorf("cannot render filters: %s", err)
+		return fmt.Errorf("cannot render filters: %w", err)
 	}
 
 	return nil
@@ -61,7 +61,7 @@ func TestListFiltersNonInteractive(t *testing.T) {
 	}
 
 	c.EXPECT().
-		GetFilters().
+		GetFilters(gomock.Any()).
 		Return(&response, nil)
 
 	var b bytes.Buffer
@@ -89,7 +89,7 @@ func TestListFiltersNonInteractiveEmpty(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	response := client.ListFiltersResponse{Results: []model.Filter{}}
-	c.EXPECT().GetFilters().Return(&response, nil)
+	c.EXPECT().GetFilters(gomock.Any()).Return(&response, nil)
 
 	var b bytes.Buffer
 	w := bufio.NewWriter(&b)
@@ -108,7 +108,7 @@ func TestListFiltersNonInteractiveError(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	c.EXPECT().
-		GetFilters().
+		GetFilters(gomock.Any()).
 		Return(nil, assert.AnError)
 
 	var b bytes.Buffer
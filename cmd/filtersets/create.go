@@ -1,13 +1,14 @@
 package filtersets
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // CreateOptions are the options for creating a filter set.
@@ -16,6 +17,8 @@ type CreateOptions struct {
 	TemplatePath string
 	Name         string
 	Workspace    string
+	TemplateFile shared.TemplateFileOptions
+	Output       shared.OutputOptions
 }
 
 // NewCreateCommand creates a new command for creating a filter set.
@@ -49,9 +52,9 @@ $ prolific filter-sets create -t /path/to/filter-set.json -w 644aaabfaf6bbc363b9
 				return fmt.Errorf("error: a template file is required, use -t to specify the path")
 			}
 
-			err := createFilterSet(client, opts, w)
+			err := createFilterSet(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -59,17 +62,17 @@ $ prolific filter-sets create -t /path/to/filter-set.json -w 644aaabfaf6bbc363b9
 	}
 
 	flags := cmd.Flags()
-	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a JSON/YAML file defining the filter set")
+	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a .json, .yaml or .yml file defining the filter set")
 	flags.StringVarP(&opts.Name, "name", "N", "", "Override the name of the filter set")
 	flags.StringVarP(&opts.Workspace, "workspace", "w", "", "Override the workspace ID for the filter set")
+	shared.AddTemplateFileFlags(cmd, &opts.TemplateFile)
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func createFilterSet(client client.API, opts CreateOptions, w io.Writer) error {
-	v := viper.New()
-	v.SetConfigFile(opts.TemplatePath)
-	err := v.ReadInConfig()
+func createFilterSet(ctx context.Context, client client.API, opts CreateOptions, w io.Writer) error {
+	v, err := shared.ReadTemplateFile(opts.TemplatePath, opts.TemplateFile)
 	if err != nil {
 		return err
 	}
@@ -77,7 +80,7 @@ func createFilterSet(client client.API, opts CreateOptions, w io.Writer) error {
 	var fs model.CreateFilterSet
 	err = v.Unmarshal(&fs)
 	if err != nil {
-		return fmt.Errorf("unable to map %s to filter set model: %s", opts.TemplatePath, err)
+		return fmt.Errorf("unable to map %s to filter set model: %w", opts.TemplatePath, err)
 	}
 
 	for i := range fs.Filters {
@@ -94,11 +97,15 @@ func createFilterSet(client client.API, opts CreateOptions, w io.Writer) error {
 		fs.WorkspaceID = opts.Workspace
 	}
 
-	record, err := client.CreateFilterSet(fs)
+	record, err := client.CreateFilterSet(ctx, fs)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *record, w)
+	}
+
 	fmt.Fprintf(w, "Created filter set: %s (eligible participants: %d)\n", record.ID, record.EligibleParticipantCount)
 
 	return nil
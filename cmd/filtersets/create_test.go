@@ -3,6 +3,7 @@ package filtersets_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -73,8 +74,8 @@ func TestNewCreateCommandSuccess(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateFilterSet(gomock.Any()).
-		DoAndReturn(func(fs model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
+		CreateFilterSet(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fs model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
 			if fs.Name != "Test filter set" {
 				t.Errorf("expected name 'Test filter set'; got '%s'", fs.Name)
 			}
@@ -132,8 +133,8 @@ func TestNewCreateCommandWithNameOverride(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateFilterSet(gomock.Any()).
-		DoAndReturn(func(fs model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
+		CreateFilterSet(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fs model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
 			if fs.Name != "Overridden name" {
 				t.Errorf("expected name 'Overridden name'; got '%s'", fs.Name)
 			}
@@ -187,8 +188,8 @@ func TestNewCreateCommandWithWorkspaceOverride(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateFilterSet(gomock.Any()).
-		DoAndReturn(func(fs model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
+		CreateFilterSet(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, fs model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
 			if fs.WorkspaceID != "overridden-ws" {
 				t.Errorf("expected workspace_id 'overridden-ws'; got '%s'", fs.WorkspaceID)
 			}
@@ -248,7 +249,7 @@ func TestNewCreateCommandHandlesAPIError(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateFilterSet(gomock.Any()).
+		CreateFilterSet(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New(errorMessage)).
 		Times(1)
 
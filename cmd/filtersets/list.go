@@ -1,12 +1,14 @@
 package filtersets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -18,6 +20,9 @@ type ListOptions struct {
 	WorkspaceID string
 	Limit       int
 	Offset      int
+	All         bool
+	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 }
 
 // NewListCommand creates a new command to deal with filter sets
@@ -35,13 +40,17 @@ Filter Sets are assigned to a workspace.
 List the Filter Sets you have defined in a given workspace
 
 $ prolific filters list -w 6261321e223a605c7a4f7623
+
+Fetch every Filter Set, however many pages that takes
+
+$ prolific filters list -w 6261321e223a605c7a4f7623 --all
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := render(c, opts, w)
+			err := render(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -51,18 +60,28 @@ $ prolific filters list -w 6261321e223a605c7a4f7623
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "Filter filter sets by workspace.")
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of filter sets returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of filter sets to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of filter sets to offset")
+	shared.AddAllFlag(cmd, &opts.All, "filter sets")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
 
 // render will list your filter sets
-func render(client client.API, opts ListOptions, w io.Writer) error {
+func render(ctx context.Context, c client.API, opts ListOptions, w io.Writer) error {
 	if opts.WorkspaceID == "" {
 		return errors.New("please provide a workspace ID")
 	}
 
-	records, err := client.GetFilterSets(opts.WorkspaceID, opts.Limit, opts.Offset)
+	records, err := shared.FetchList(ctx, opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListFilterSetsResponse, error) {
+		return c.GetFilterSets(ctx, opts.WorkspaceID, limit, offset)
+	})
+	if err != nil {
+		return err
+	}
+
+	records.Results, err = shared.ApplyQuery(opts.Query, records.Results)
 	if err != nil {
 		return err
 	}
@@ -72,6 +91,10 @@ func render(client client.API, opts ListOptions, w io.Writer) error {
 		count = records.Meta.Count
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, records.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\n", "ID", "Name")
 	for _, record := range records.Results {
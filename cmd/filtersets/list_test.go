@@ -63,7 +63,7 @@ func TestNewListCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetFilterSets(gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
+		GetFilterSets(gomock.Any(), gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -113,7 +113,7 @@ func TestNewListCommandHandlesAnAPIError(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetFilterSets(gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
+		GetFilterSets(gomock.Any(), gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -1,6 +1,7 @@
 package filtersets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -8,14 +9,16 @@ import (
 
 	"github.com/pkg/browser"
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
 // ViewOptions is the options for the detail view of a filter set.
 type ViewOptions struct {
-	Args []string
-	Web  bool
+	Args   []string
+	Web    bool
+	Output shared.OutputOptions
 }
 
 // NewViewCommand creates a new command to show a filter set.
@@ -38,9 +41,9 @@ $ prolific filter-sets view 64efb93c7788944088864cec
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderProject(client, opts, w)
+			err := renderProject(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -50,17 +53,18 @@ $ prolific filter-sets view 64efb93c7788944088864cec
 	flags := cmd.Flags()
 
 	flags.BoolVarP(&opts.Web, "web", "W", false, "Open the filter set in the web application")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // renderProject will show your project
-func renderProject(client client.API, opts ViewOptions, w io.Writer) error {
+func renderProject(ctx context.Context, client client.API, opts ViewOptions, w io.Writer) error {
 	if len(opts.Args) < 1 || opts.Args[0] == "" {
 		return errors.New("please provide a filter set ID")
 	}
 
-	filterSet, err := client.GetFilterSet(opts.Args[0])
+	filterSet, err := client.GetFilterSet(ctx, opts.Args[0])
 	if err != nil {
 		return err
 	}
@@ -69,6 +73,10 @@ func renderProject(client client.API, opts ViewOptions, w io.Writer) error {
 		return browser.OpenURL(GetFilterSetURL(filterSet.WorkspaceID, opts.Args[0]))
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *filterSet, w)
+	}
+
 	var content strings.Builder
 	content.WriteString(fmt.Sprintln(ui.RenderHeading(filterSet.Name)))
 
@@ -52,7 +52,7 @@ func TestNewViewCommandHandlesNoFiltersInTheSet(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetFilterSet(gomock.Eq(filterSetID)).
+		GetFilterSet(gomock.Any(), gomock.Eq(filterSetID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -128,7 +128,7 @@ func TestNewViewCommandHandlesFiltersInTheSet(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetFilterSet(gomock.Eq(filterSetID)).
+		GetFilterSet(gomock.Any(), gomock.Eq(filterSetID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -208,7 +208,7 @@ func TestNewViewCommandHandlesErrorsFromTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetFilterSet(gomock.Eq(filterSetID)).
+		GetFilterSet(gomock.Any(), gomock.Eq(filterSetID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -14,6 +15,7 @@ type CreateOptions struct {
 	WorkspaceID string
 	EventType   string
 	TargetURL   string
+	Output      shared.OutputOptions
 }
 
 // NewCreateSubscriptionCommand creates a new `hook create` command to create a hook subscription.
@@ -40,14 +42,18 @@ $ prolific hook create -w 63722982f9cc073ecc730f6b -e study.status.change -u htt
 
 			// The secret here is a one-time secret used for confirming the subscription received via X-Hook-Secret header,
 			// and is not the same as the hook secret used for signing webhook requests.
-			hook, secret, err := c.CreateHookSubscription(payload)
+			hook, secret, err := c.CreateHookSubscription(cmd.Context(), payload)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
-			confirmedHook, err := c.ConfirmHookSubscription(hook.ID, secret)
+			confirmedHook, err := c.ConfirmHookSubscription(cmd.Context(), hook.ID, secret)
 			if err != nil {
-				return fmt.Errorf("subscription created (ID: %s) but confirmation failed: %s", hook.ID, err.Error())
+				return fmt.Errorf("subscription created (ID: %s) but confirmation failed: %w", hook.ID, err)
+			}
+
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *confirmedHook, w)
 			}
 
 			fmt.Fprintf(w, "Subscription created successfully\n")
@@ -66,6 +72,8 @@ $ prolific hook create -w 63722982f9cc073ecc730f6b -e study.status.change -u htt
 	flags.StringVarP(&opts.EventType, "event-type", "e", "", "The event type to subscribe to (required)")
 	flags.StringVarP(&opts.TargetURL, "target-url", "u", "", "The URL to notify when the event is triggered (required)")
 
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+
 	_ = cmd.MarkFlagRequired("workspace")
 	_ = cmd.MarkFlagRequired("event-type")
 	_ = cmd.MarkFlagRequired("target-url")
@@ -45,7 +45,7 @@ func TestNewCreateSubscriptionCommandHandlesErrors(t *testing.T) {
 	}
 
 	c.EXPECT().
-		CreateHookSubscription(gomock.Eq(payload)).
+		CreateHookSubscription(gomock.Any(), gomock.Eq(payload)).
 		Return(nil, "", errors.New(errorMessage))
 
 	cmd := hook.NewCreateSubscriptionCommand(c, os.Stdout)
@@ -80,11 +80,11 @@ func TestNewCreateSubscriptionCommandHandlesConfirmationError(t *testing.T) {
 	const secret = "x-hook-secret-value" //nolint:gosec
 
 	c.EXPECT().
-		CreateHookSubscription(gomock.Eq(payload)).
+		CreateHookSubscription(gomock.Any(), gomock.Eq(payload)).
 		Return(created, secret, nil)
 
 	c.EXPECT().
-		ConfirmHookSubscription(gomock.Eq(created.ID), gomock.Eq(secret)).
+		ConfirmHookSubscription(gomock.Any(), gomock.Eq(created.ID), gomock.Eq(secret)).
 		Return(nil, errors.New("confirmation failed"))
 
 	cmd := hook.NewCreateSubscriptionCommand(c, os.Stdout)
@@ -126,11 +126,11 @@ func TestNewCreateSubscriptionCommandCallsTheAPI(t *testing.T) {
 	const secret = "x-hook-secret-value" //nolint:gosec
 
 	c.EXPECT().
-		CreateHookSubscription(gomock.Eq(payload)).
+		CreateHookSubscription(gomock.Any(), gomock.Eq(payload)).
 		Return(created, secret, nil)
 
 	c.EXPECT().
-		ConfirmHookSubscription(gomock.Eq(created.ID), gomock.Eq(secret)).
+		ConfirmHookSubscription(gomock.Any(), gomock.Eq(created.ID), gomock.Eq(secret)).
 		Return(confirmed, nil)
 
 	var b bytes.Buffer
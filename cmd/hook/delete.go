@@ -24,8 +24,8 @@ $ prolific hook delete 6261321e223a605c7a4f7564`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			subscriptionID := args[0]
 
-			if err := c.DeleteHookSubscription(subscriptionID); err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+			if err := c.DeleteHookSubscription(cmd.Context(), subscriptionID); err != nil {
+				return fmt.Errorf("error: %w", err)
 			}
 
 			fmt.Fprintf(w, "Subscription %s deleted successfully\n", subscriptionID)
@@ -35,7 +35,7 @@ func TestNewDeleteSubscriptionCommandHandlesAPIError(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	c.EXPECT().
-		DeleteHookSubscription("sub-id-123").
+		DeleteHookSubscription(gomock.Any(), "sub-id-123").
 		Return(errors.New("something went wrong"))
 
 	cmd := hook.NewDeleteSubscriptionCommand(c, os.Stdout)
@@ -53,7 +53,7 @@ func TestNewDeleteSubscriptionCommandDeletesSuccessfully(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	c.EXPECT().
-		DeleteHookSubscription("sub-id-123").
+		DeleteHookSubscription(gomock.Any(), "sub-id-123").
 		Return(nil)
 
 	var b bytes.Buffer
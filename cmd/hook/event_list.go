@@ -1,12 +1,14 @@
 package hook
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +19,9 @@ type EventListOptions struct {
 	SubscriptionID string
 	Limit          int
 	Offset         int
+	All            bool
+	Output         shared.OutputOptions
+	Query          shared.QueryOptions
 }
 
 // NewListCommand creates a new command to deal with listing events
@@ -43,13 +48,16 @@ $ prolific hook events -s 637e081185389c0ca5595915 -l 10
 
 This will offset by 10 events, and get the next 10 events.
 $ prolific hook events -s 637e081185389c0ca5595915 -l 10 -o 10
+
+This will page through and get every event for your subscription.
+$ prolific hook events -s 637e081185389c0ca5595915 --all
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderEvents(c, opts, w)
+			err := renderEvents(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -59,26 +67,40 @@ $ prolific hook events -s 637e081185389c0ca5595915 -l 10 -o 10
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.SubscriptionID, "subscription", "s", "", "List the events for a subscription")
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of events returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of events to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of events to offset")
+	shared.AddAllFlag(cmd, &opts.All, "events")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
 
 // renderEvents will show your projects
-func renderEvents(client client.API, opts EventListOptions, w io.Writer) error {
+func renderEvents(ctx context.Context, c client.API, opts EventListOptions, w io.Writer) error {
 	if opts.SubscriptionID == "" {
 		return errors.New("please provide a subscription ID")
 	}
 
-	events, err := client.GetEvents(opts.SubscriptionID, opts.Limit, opts.Offset)
+	events, err := shared.FetchList(ctx, opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListHookEventsResponse, error) {
+		return c.GetEvents(ctx, opts.SubscriptionID, limit, offset)
+	})
 	if err != nil {
 		return err
 	}
 
+	events.Results, err = shared.ApplyQuery(opts.Query, events.Results)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, events.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", "ID", "Created", "Updated", "Status", "Resource ID")
 	for _, event := range events.Results {
-		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", event.ID, event.DateCreated.Format(ui.AppDateTimeFormat), event.DateUpdated.Format(ui.AppDateTimeFormat), event.Status, event.ResourceID)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", event.ID, ui.FormatDateTime(event.DateCreated), ui.FormatDateTime(event.DateUpdated), event.Status, event.ResourceID)
 	}
 
 	_ = tw.Flush()
@@ -67,7 +67,7 @@ func TestNewEventListCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetEvents(gomock.Eq(subscriptionID), gomock.Eq(44), gomock.Eq(29)).
+		GetEvents(gomock.Any(), gomock.Eq(subscriptionID), gomock.Eq(44), gomock.Eq(29)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -119,7 +119,7 @@ func TestNewEventListCommandSetsDefaultsForLimitOffset(t *testing.T) {
 	c.
 		EXPECT().
 		// This is the defaults we have
-		GetEvents(gomock.Eq(subscriptionID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetEvents(gomock.Any(), gomock.Eq(subscriptionID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(nil, errors.New(failureMessage)).
 		AnyTimes()
 
@@ -1,17 +1,21 @@
 package hook
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 // NewEventTypeCommand creates a new `hook event-types` command to give you details about
 // your which events you can register subscriptions for.
 func NewEventTypeCommand(commandName string, client client.API, w io.Writer) *cobra.Command {
+	var output shared.OutputOptions
+
 	cmd := &cobra.Command{
 		Use:   commandName,
 		Short: "List of event types you can subscribe to",
@@ -21,25 +25,31 @@ There are several events in the Prolific Platform you can listen to. This
 command aims to surface those events so you can decide what to register
 interest for.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := renderEventTypes(client, w)
+			err := renderEventTypes(cmd.Context(), client, output, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &output)
+
 	return cmd
 }
 
 // renderEventTypes will show all of the event types that can be registered.
-func renderEventTypes(client client.API, w io.Writer) error {
-	eventTypes, err := client.GetHookEventTypes()
+func renderEventTypes(ctx context.Context, client client.API, output shared.OutputOptions, w io.Writer) error {
+	eventTypes, err := client.GetHookEventTypes(ctx)
 	if err != nil {
 		return err
 	}
 
+	if output.IsStructured() {
+		return shared.RenderList(output, eventTypes.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\n", "Event Type", "Description")
 
@@ -50,7 +50,7 @@ func TestNewEventTypeCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetHookEventTypes().
+		GetHookEventTypes(gomock.Any()).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -81,7 +81,7 @@ func TestNewEventTypeCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetHookEventTypes().
+		GetHookEventTypes(gomock.Any()).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
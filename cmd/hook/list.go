@@ -1,11 +1,13 @@
 package hook
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -19,6 +21,9 @@ type ListOptions struct {
 	Disabled    bool
 	Limit       int
 	Offset      int
+	All         bool
+	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 }
 
 // NewListCommand creates a new `hook list` command to give you details about
@@ -44,13 +49,16 @@ $ prolific hook list -w 3461321e223a605c7a4f7612
 You can couple this with options to only show disabled or enabled subscriptions
 $ prolific hook list -w 3461321e223a605c7a4f7612 -d
 $ prolific hook list -w 3461321e223a605c7a4f7612 -e
+
+Fetch every subscription, however many pages that takes
+$ prolific hook list -w 3461321e223a605c7a4f7612 --all
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderHooks(c, opts, w)
+			err := renderHooks(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -62,20 +70,30 @@ $ prolific hook list -w 3461321e223a605c7a4f7612 -e
 	flags.BoolVarP(&opts.Enabled, "enabled", "e", true, "Filter on enabled subscriptions.")
 	flags.BoolVarP(&opts.Disabled, "disabled", "d", false, "Filter on disabled subscriptions.")
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of subscriptions returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of subscriptions to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of subscriptions to offset")
+	shared.AddAllFlag(cmd, &opts.All, "subscriptions")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
 
 // renderHooks will show the users subscriptions.
-func renderHooks(client client.API, opts ListOptions, w io.Writer) error {
+func renderHooks(ctx context.Context, c client.API, opts ListOptions, w io.Writer) error {
 	enabled := opts.Enabled
 
 	if opts.Disabled {
 		enabled = false
 	}
 
-	hooks, err := client.GetHooks(opts.WorkspaceID, enabled, opts.Limit, opts.Offset)
+	hooks, err := shared.FetchList(ctx, opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListHooksResponse, error) {
+		return c.GetHooks(ctx, opts.WorkspaceID, enabled, limit, offset)
+	})
+	if err != nil {
+		return err
+	}
+
+	hooks.Results, err = shared.ApplyQuery(opts.Query, hooks.Results)
 	if err != nil {
 		return err
 	}
@@ -85,6 +103,10 @@ func renderHooks(client client.API, opts ListOptions, w io.Writer) error {
 		count = hooks.Meta.Count
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, hooks.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", "ID", "Event", "Target URL", "Enabled", "Workspace ID")
 	for _, hook := range hooks.Results {
@@ -44,7 +44,7 @@ func TestNewListCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetHooks(gomock.Eq(""), gomock.Eq(true), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetHooks(gomock.Any(), gomock.Eq(""), gomock.Eq(true), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -67,7 +67,7 @@ func TestNewListCommandCanAskForDisabledHooks(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetHooks(gomock.Eq(""), gomock.Eq(false), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetHooks(gomock.Any(), gomock.Eq(""), gomock.Eq(false), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -109,7 +109,7 @@ func TestNewListCommandCallsTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetHooks(gomock.Eq(workspaceID), gomock.Eq(false), gomock.Eq(44), gomock.Eq(33)).
+		GetHooks(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(false), gomock.Eq(44), gomock.Eq(33)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -2,20 +2,28 @@ package hook
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// secretListFields are the fields shown by the table and CSV output of the
+// list secrets command.
+const secretListFields = "ID,Value,WorkspaceID"
+
 // ListOptions is the options for the listing secrets command.
 type ListSecretOptions struct {
 	Args        []string
 	WorkspaceID string
+	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 }
 
 // NewListSecretCommand creates a new `hook secrets` command to give you details about
@@ -28,9 +36,9 @@ func NewListSecretCommand(commandName string, c client.API, w io.Writer) *cobra.
 		Short: "List your hook secrets",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
-			err := renderSecrets(c, opts, w)
+			err := renderSecrets(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -39,6 +47,8 @@ func NewListSecretCommand(commandName string, c client.API, w io.Writer) *cobra.
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "Filter secrets by workspace.")
+	shared.AddOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
@@ -47,6 +57,7 @@ func NewListSecretCommand(commandName string, c client.API, w io.Writer) *cobra.
 type CreateSecretOptions struct {
 	WorkspaceID     string
 	DeleteOldSecret bool
+	Output          shared.OutputOptions
 }
 
 // NewCreateSecretCommand creates a new `hook create-secret` command to generate a secret
@@ -67,23 +78,36 @@ $ prolific hook create-secret -w 63722982f9cc073ecc730f6b
 
 For a non-interactive experience, you can use the --delete-old-secret flag to confirm deletion of the existing secret without being prompted:
 $ prolific hook create-secret -w 63722982f9cc073ecc730f6b --delete-old-secret
+
+Capture just the new secret in a script
+$ prolific hook create-secret -w 63722982f9cc073ecc730f6b --delete-old-secret --jq .value
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			confirmed, err := confirmSecretCreation(opts.DeleteOldSecret, cmd.InOrStdin(), w)
+			// Keep the prompt out of structured output, which scripts read.
+			prompt := w
+			if opts.Output.IsStructured() {
+				prompt = cmd.ErrOrStderr()
+			}
+
+			confirmed, err := confirmSecretCreation(opts.DeleteOldSecret, cmd.InOrStdin(), prompt)
 			if err != nil {
 				return err
 			}
 
 			if !confirmed {
-				fmt.Fprintln(w, "Secret creation cancelled.")
+				fmt.Fprintln(prompt, "Secret creation cancelled.")
 				return nil
 			}
 
-			secret, err := c.CreateHookSecret(client.CreateSecretPayload{
+			secret, err := c.CreateHookSecret(cmd.Context(), client.CreateSecretPayload{
 				WorkspaceID: opts.WorkspaceID,
 			})
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *secret, w)
 			}
 
 			fmt.Fprintf(w, "Secret created successfully\n")
@@ -99,6 +123,7 @@ $ prolific hook create-secret -w 63722982f9cc073ecc730f6b --delete-old-secret
 	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "The workspace to create the secret in (required).")
 	flags.BoolVar(&opts.DeleteOldSecret, "delete-old-secret", false, "Confirm deletion of the existing secret without being prompted.")
 	_ = cmd.MarkFlagRequired("workspace")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
@@ -126,12 +151,21 @@ func confirmSecretCreation(deleteOldSecret bool, r io.Reader, w io.Writer) (bool
 }
 
 // renderSecrets will show all of the secrets in a given workspace.
-func renderSecrets(c client.API, opts ListSecretOptions, w io.Writer) error {
-	secrets, err := c.GetHookSecrets(opts.WorkspaceID)
+func renderSecrets(ctx context.Context, c client.API, opts ListSecretOptions, w io.Writer) error {
+	secrets, err := c.GetHookSecrets(ctx, opts.WorkspaceID)
 	if err != nil {
 		return err
 	}
 
+	secrets.Results, err = shared.ApplyQuery(opts.Query, secrets.Results)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, secrets.Results, secretListFields, w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\n", "ID", "Secret", "Workspace ID")
 	for _, secret := range secrets.Results {
@@ -51,7 +51,7 @@ func TestNewListSecretCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetHookSecrets("").
+		GetHookSecrets(gomock.Any(), "").
 		Return(&response, nil).
 		AnyTimes()
 
@@ -106,7 +106,7 @@ func TestNewCreateSecretCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateHookSecret(client.CreateSecretPayload{WorkspaceID: workspaceID}).
+		CreateHookSecret(gomock.Any(), client.CreateSecretPayload{WorkspaceID: workspaceID}).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -129,6 +129,76 @@ func TestNewCreateSecretCommandCallsAPI(t *testing.T) {
 	}
 }
 
+func TestNewCreateSecretCommandRendersJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	response := model.Secret{ID: "63722971f9cc073ecc730f6a", Value: "secret-value", WorkspaceID: testWorkspaceID}
+
+	c.
+		EXPECT().
+		CreateHookSecret(gomock.Any(), client.CreateSecretPayload{WorkspaceID: testWorkspaceID}).
+		Return(&response, nil).
+		Times(1)
+
+	var b, prompt bytes.Buffer
+	cmd := hook.NewCreateSecretCommand(c, &b)
+	cmd.SetErr(&prompt)
+	cmd.SetIn(strings.NewReader("y\n"))
+	cmd.SetArgs([]string{"-w", testWorkspaceID, "--json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	expected := `{
+  "id": "63722971f9cc073ecc730f6a",
+  "value": "secret-value",
+  "workspace_id": "63722982f9cc073ecc730f6b"
+}
+`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+	if !strings.Contains(prompt.String(), "Are you sure?") {
+		t.Fatalf("expected the prompt on stderr; got %q", prompt.String())
+	}
+}
+
+func TestNewListSecretCommandRendersCSV(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	response := client.ListSecretsResponse{
+		Results: []model.Secret{
+			{ID: "secret-2", Value: "b", WorkspaceID: testWorkspaceID},
+			{ID: "secret-1", Value: "a", WorkspaceID: testWorkspaceID},
+		},
+	}
+
+	c.
+		EXPECT().
+		GetHookSecrets(gomock.Any(), "").
+		Return(&response, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := hook.NewListSecretCommand("secrets", c, &b)
+	cmd.SetArgs([]string{"-w", "", "--csv", "--sort", "ID"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	expected := `ID,Value,WorkspaceID
+secret-1,a,63722982f9cc073ecc730f6b
+secret-2,b,63722982f9cc073ecc730f6b
+`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
 func TestNewCreateSecretCommandHandlesErrors(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -138,7 +208,7 @@ func TestNewCreateSecretCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateHookSecret(client.CreateSecretPayload{WorkspaceID: workspaceID}).
+		CreateHookSecret(gomock.Any(), client.CreateSecretPayload{WorkspaceID: workspaceID}).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -208,7 +278,7 @@ func TestNewCreateSecretCommandConfirmationPrompt(t *testing.T) {
 
 			if tt.expectAPICall {
 				c.EXPECT().
-					CreateHookSecret(client.CreateSecretPayload{WorkspaceID: workspaceID}).
+					CreateHookSecret(gomock.Any(), client.CreateSecretPayload{WorkspaceID: workspaceID}).
 					Return(&response, nil).
 					Times(1)
 			}
@@ -239,7 +309,7 @@ func TestNewListSecretCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetHookSecrets(gomock.Eq(workspaceID)).
+		GetHookSecrets(gomock.Any(), gomock.Eq(workspaceID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -15,6 +16,7 @@ type UpdateOptions struct {
 	TargetURL string
 	Enable    bool
 	Disable   bool
+	Output    shared.OutputOptions
 }
 
 // NewUpdateSubscriptionCommand creates a new `hook update` command to update a hook subscription.
@@ -60,9 +62,13 @@ $ prolific hook update 6261321e223a605c7a4f7564 -e study.status.change --enable`
 				payload.IsEnabled = &v
 			}
 
-			hook, err := c.UpdateHookSubscription(subscriptionID, payload)
+			hook, err := c.UpdateHookSubscription(cmd.Context(), subscriptionID, payload)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *hook, w)
 			}
 
 			fmt.Fprintf(w, "Subscription updated successfully\n")
@@ -81,6 +87,7 @@ $ prolific hook update 6261321e223a605c7a4f7564 -e study.status.change --enable`
 	flags.StringVarP(&opts.TargetURL, "target-url", "u", "", "The URL to notify when the event is triggered")
 	flags.BoolVar(&opts.Enable, "enable", false, "Enable the subscription")
 	flags.BoolVar(&opts.Disable, "disable", false, "Disable the subscription")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
@@ -54,7 +54,7 @@ func TestNewUpdateSubscriptionCommandHandlesAPIError(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	c.EXPECT().
-		UpdateHookSubscription("sub-id-123", client.UpdateHookPayload{}).
+		UpdateHookSubscription(gomock.Any(), "sub-id-123", client.UpdateHookPayload{}).
 		Return(nil, errors.New("something went wrong"))
 
 	cmd := hook.NewUpdateSubscriptionCommand(c, os.Stdout)
@@ -127,7 +127,7 @@ func TestNewUpdateSubscriptionCommandUpdatesFields(t *testing.T) {
 			}
 
 			c.EXPECT().
-				UpdateHookSubscription("sub-id-123", tt.expectedPayload).
+				UpdateHookSubscription(gomock.Any(), "sub-id-123", tt.expectedPayload).
 				Return(updated, nil)
 
 			var b bytes.Buffer
@@ -1,12 +1,14 @@
 package invitation
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"strings"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +21,7 @@ type CreateOptions struct {
 	Workspace string
 	Emails    []string
 	Role      string
+	Output    shared.OutputOptions
 }
 
 // NewCreateCommand creates a new command for creating invitations.
@@ -43,9 +46,9 @@ $ prolific invitation create -w 60d9aa5fa100c40b8c3fac61 -e user1@example.com -e
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := createInvitation(client, opts, w)
+			err := createInvitation(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -56,12 +59,13 @@ $ prolific invitation create -w 60d9aa5fa100c40b8c3fac61 -e user1@example.com -e
 	flags.StringVarP(&opts.Workspace, "workspace", "w", "", "The ID of the workspace to invite users to.")
 	flags.StringArrayVarP(&opts.Emails, "email", "e", nil, "Email address to invite (can be specified multiple times).")
 	flags.StringVarP(&opts.Role, "role", "r", "", "The role for the invitee: WORKSPACE_ADMIN or WORKSPACE_COLLABORATOR.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // createInvitation will create invitations for the given emails
-func createInvitation(client client.API, opts CreateOptions, w io.Writer) error {
+func createInvitation(ctx context.Context, client client.API, opts CreateOptions, w io.Writer) error {
 	if opts.Workspace == "" {
 		return errors.New("workspace is required")
 	}
@@ -84,11 +88,15 @@ func createInvitation(client client.API, opts CreateOptions, w io.Writer) error
 		Role:        opts.Role,
 	}
 
-	response, err := client.CreateInvitation(invitation)
+	response, err := client.CreateInvitation(ctx, invitation)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	for _, inv := range response.Invitations {
 		fmt.Fprintf(w, "Invited %s as %s\n", inv.Invitee.Email, inv.Role)
 	}
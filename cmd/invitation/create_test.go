@@ -41,7 +41,7 @@ func TestCreateCommandErrorsIfNoWorkspace(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateInvitation(gomock.Any()).
+		CreateInvitation(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -68,7 +68,7 @@ func TestCreateCommandErrorsIfNoEmail(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateInvitation(gomock.Any()).
+		CreateInvitation(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -95,7 +95,7 @@ func TestCreateCommandErrorsIfNoRole(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateInvitation(gomock.Any()).
+		CreateInvitation(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -122,7 +122,7 @@ func TestCreateCommandErrorsIfInvalidRole(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateInvitation(gomock.Any()).
+		CreateInvitation(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -164,7 +164,7 @@ func TestCreateCommandCreatesInvitation(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateInvitation(gomock.Any()).
+		CreateInvitation(gomock.Any(), gomock.Any()).
 		Return(&r, nil).
 		MaxTimes(1)
 
@@ -210,7 +210,7 @@ func TestCreateCommandCreatesMultipleInvitations(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateInvitation(gomock.Any()).
+		CreateInvitation(gomock.Any(), gomock.Any()).
 		Return(&r, nil).
 		MaxTimes(1)
 
@@ -244,7 +244,7 @@ func TestCreateCommandHandlesFailureToCreateInvitation(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateInvitation(gomock.Any()).
+		CreateInvitation(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("unable to create invitation")).
 		MaxTimes(1)
 
@@ -1,12 +1,14 @@
 package message
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -15,6 +17,7 @@ type BulkSendOptions struct {
 	IDs     string
 	StudyID string
 	Body    string
+	Output  shared.OutputOptions
 }
 
 // NewBulkSendCommand creates a new command to send a message to multiple participants
@@ -33,9 +36,9 @@ to all of them.
 $ prolific message bulk-send -i id1,id2,id3 -s study-id -b "Thanks for participating"
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := bulkSendMessage(client, opts, w)
+			err := bulkSendMessage(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -46,11 +49,12 @@ $ prolific message bulk-send -i id1,id2,id3 -s study-id -b "Thanks for participa
 	flags.StringVarP(&opts.IDs, "ids", "i", "", "Comma-separated list of participant IDs.")
 	flags.StringVarP(&opts.StudyID, "study", "s", "", "Specify the study to which the message relates.")
 	flags.StringVarP(&opts.Body, "body", "b", "", "Specify the body of message.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func bulkSendMessage(c client.API, opts BulkSendOptions, w io.Writer) error {
+func bulkSendMessage(ctx context.Context, c client.API, opts BulkSendOptions, w io.Writer) error {
 	ids := splitAndTrim(opts.IDs)
 	if len(ids) == 0 {
 		return fmt.Errorf("at least one participant ID is required")
@@ -64,11 +68,15 @@ func bulkSendMessage(c client.API, opts BulkSendOptions, w io.Writer) error {
 		return fmt.Errorf("body is required")
 	}
 
-	err := c.BulkSendMessage(ids, opts.Body, opts.StudyID)
+	err := c.BulkSendMessage(ctx, ids, opts.Body, opts.StudyID)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, client.BulkSendMessagePayload{IDs: ids, Body: opts.Body, StudyID: opts.StudyID}, w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\n", "Recipients", "Study ID", "Body")
 	fmt.Fprintf(tw, "%d\t%s\t%s\n",
@@ -43,7 +43,7 @@ func TestNewBulkSendCommandCallsTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkSendMessage(ids, body, studyID).
+		BulkSendMessage(gomock.Any(), ids, body, studyID).
 		Return(nil).
 		AnyTimes()
 
@@ -77,7 +77,7 @@ func TestNewBulkSendCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkSendMessage(gomock.Any(), gomock.Any(), gomock.Any()).
+		BulkSendMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errors.New(errorMessage)).
 		AnyTimes()
 
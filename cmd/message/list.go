@@ -1,11 +1,13 @@
 package message
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
@@ -17,6 +19,8 @@ type ListOptions struct {
 	UserID       string
 	CreatedAfter string
 	Unread       bool
+	Output       shared.OutputOptions
+	Query        shared.QueryOptions
 }
 
 // NewListCommand creates a new command to deal with messages
@@ -48,9 +52,9 @@ $ prolific message list -U
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderMessages(client, opts, w)
+			err := renderMessages(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -62,12 +66,14 @@ $ prolific message list -U
 	flags.StringVarP(&opts.UserID, "user", "u", "", "Filter messages sent to user.")
 	flags.StringVarP(&opts.CreatedAfter, "created_after", "c", "", "Filter messages created after a certain date (YYYY-MM-DD). You can only fetch up to the last 30 days of messages.")
 	flags.BoolVarP(&opts.Unread, "unread", "U", false, "Filter messages to show only unread. Cannot be used with any other flags.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
 
 // renderMessages will show your messages
-func renderMessages(c client.API, opts ListOptions, w io.Writer) error {
+func renderMessages(ctx context.Context, c client.API, opts ListOptions, w io.Writer) error {
 	if opts.Unread && (opts.UserID != "" || opts.CreatedAfter != "") {
 		return fmt.Errorf("'unread' cannot be used with any other flags")
 	}
@@ -87,19 +93,28 @@ func renderMessages(c client.API, opts ListOptions, w io.Writer) error {
 	var results []model.Message
 
 	if opts.Unread {
-		messages, err := c.GetUnreadMessages()
+		messages, err := c.GetUnreadMessages(ctx)
 		if err != nil {
 			return err
 		}
 		results = messages.Results
 	} else {
-		messages, err := c.GetMessages(userID, createdAfter)
+		messages, err := c.GetMessages(ctx, userID, createdAfter)
 		if err != nil {
 			return err
 		}
 		results = messages.Results
 	}
 
+	results, err := shared.ApplyQuery(opts.Query, results)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, results, "", w)
+	}
+
 	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", "ID", "Sender ID", "Study ID", "Category", "Created", "Body")
 	for _, msg := range results {
 		studyID := ""
@@ -113,7 +128,7 @@ func renderMessages(c client.API, opts ListOptions, w io.Writer) error {
 			msg.GetSenderID(),
 			studyID,
 			category,
-			msg.DatetimeCreated.Format(ui.AppDateTimeFormat),
+			ui.FormatDateTime(msg.DatetimeCreated),
 			msg.Body,
 		)
 	}
@@ -45,7 +45,7 @@ func TestNewListCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetMessages(nil, nil).
+		GetMessages(gomock.Any(), nil, nil).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -90,7 +90,7 @@ func TestNewListCommandCallsTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetMessages(gomock.Any(), gomock.Any()).
+		GetMessages(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -147,7 +147,7 @@ func TestNewListCommandWithUnreadFlagCallsTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetUnreadMessages().
+		GetUnreadMessages(gomock.Any()).
 		Return(&response, nil).
 		AnyTimes()
 
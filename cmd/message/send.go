@@ -1,11 +1,13 @@
 package message
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -15,6 +17,7 @@ type SendOptions struct {
 	RecipientID string
 	StudyID     string
 	Body        string
+	Output      shared.OutputOptions
 }
 
 // NewSendCommand creates a new command to deal with sending a message
@@ -39,9 +42,9 @@ Please make sure you quote the message with "" for the -b flag.
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := createMessage(client, opts, w)
+			err := createMessage(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -52,12 +55,13 @@ Please make sure you quote the message with "" for the -b flag.
 	flags.StringVarP(&opts.RecipientID, "recipient", "r", "", "Specify the recipient.")
 	flags.StringVarP(&opts.StudyID, "study", "s", "", "Specify the study to which the message relates.")
 	flags.StringVarP(&opts.Body, "body", "b", "", "Specify the body of message.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // createMessage will show your message
-func createMessage(client client.API, opts SendOptions, w io.Writer) error {
+func createMessage(ctx context.Context, c client.API, opts SendOptions, w io.Writer) error {
 	if opts.RecipientID == "" {
 		return fmt.Errorf("recipient is required")
 	}
@@ -70,11 +74,15 @@ func createMessage(client client.API, opts SendOptions, w io.Writer) error {
 		return fmt.Errorf("body is required")
 	}
 
-	err := client.SendMessage(opts.Body, opts.RecipientID, opts.StudyID)
+	err := c.SendMessage(ctx, opts.Body, opts.RecipientID, opts.StudyID)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, client.SendMessagePayload{RecipientID: opts.RecipientID, StudyID: opts.StudyID, Body: opts.Body}, w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\n", "Recipient ID", "Study ID", "Body")
 	fmt.Fprintf(tw, "%s\t%s\t%s\n",
@@ -1,11 +1,13 @@
 package message
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -14,6 +16,7 @@ type SendGroupOptions struct {
 	GroupID string
 	StudyID string
 	Body    string
+	Output  shared.OutputOptions
 }
 
 // NewSendGroupCommand creates a new command to send a message to a participant group
@@ -33,9 +36,9 @@ $ prolific message send-group -g group-id -b "Thanks for participating"
 $ prolific message send-group -g group-id -s study-id -b "Thanks for participating"
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := sendGroupMessage(client, opts, w)
+			err := sendGroupMessage(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -46,11 +49,12 @@ $ prolific message send-group -g group-id -s study-id -b "Thanks for participati
 	flags.StringVarP(&opts.GroupID, "group", "g", "", "Specify the participant group ID.")
 	flags.StringVarP(&opts.StudyID, "study", "s", "", "Specify the study to which the message relates (optional).")
 	flags.StringVarP(&opts.Body, "body", "b", "", "Specify the body of message.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func sendGroupMessage(c client.API, opts SendGroupOptions, w io.Writer) error {
+func sendGroupMessage(ctx context.Context, c client.API, opts SendGroupOptions, w io.Writer) error {
 	if opts.GroupID == "" {
 		return fmt.Errorf("group is required")
 	}
@@ -64,11 +68,15 @@ func sendGroupMessage(c client.API, opts SendGroupOptions, w io.Writer) error {
 		studyID = &opts.StudyID
 	}
 
-	err := c.SendGroupMessage(opts.GroupID, opts.Body, studyID)
+	err := c.SendGroupMessage(ctx, opts.GroupID, opts.Body, studyID)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, client.SendGroupMessagePayload{ParticipantGroupID: opts.GroupID, Body: opts.Body, StudyID: opts.StudyID}, w)
+	}
+
 	displayStudyID := "N/A"
 	if studyID != nil {
 		displayStudyID = *studyID
@@ -43,7 +43,7 @@ func TestNewSendGroupCommandCallsTheAPIWithStudy(t *testing.T) {
 
 	c.
 		EXPECT().
-		SendGroupMessage(groupID, body, &studyID).
+		SendGroupMessage(gomock.Any(), groupID, body, &studyID).
 		Return(nil).
 		AnyTimes()
 
@@ -78,7 +78,7 @@ func TestNewSendGroupCommandCallsTheAPIWithoutStudy(t *testing.T) {
 
 	c.
 		EXPECT().
-		SendGroupMessage(groupID, body, (*string)(nil)).
+		SendGroupMessage(gomock.Any(), groupID, body, (*string)(nil)).
 		Return(nil).
 		AnyTimes()
 
@@ -140,7 +140,7 @@ func TestNewSendGroupCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		SendGroupMessage(gomock.Any(), gomock.Any(), gomock.Any()).
+		SendGroupMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errors.New(errorMessage)).
 		AnyTimes()
 
@@ -88,7 +88,7 @@ func TestNewSendCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		SendMessage(gomock.Any(), gomock.Any(), gomock.Any()).
+		SendMessage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errors.New(errorMessage)).
 		AnyTimes()
 
@@ -116,7 +116,7 @@ func TestNewSendCommandCallsTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		SendMessage(body, recipientID, studyID).
+		SendMessage(gomock.Any(), body, recipientID, studyID).
 		Return(nil).
 		AnyTimes()
 
@@ -1,11 +1,13 @@
 package participantgroup
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -18,6 +20,7 @@ type CreateOptions struct {
 	WorkspaceID    string
 	Description    string
 	ParticipantIDs []string
+	Output         shared.OutputOptions
 }
 
 // NewCreateCommand creates a new command for creating a participant group.
@@ -45,9 +48,9 @@ $ prolific participant create -N "My Group" -w <workspace_id> -p <participant_id
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := createParticipantGroup(client, opts, w)
+			err := createParticipantGroup(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -59,12 +62,13 @@ $ prolific participant create -N "My Group" -w <workspace_id> -p <participant_id
 	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "The ID of the workspace to create the participant group in.")
 	flags.StringVarP(&opts.Description, "description", "d", "", "The description of the participant group.")
 	flags.StringArrayVarP(&opts.ParticipantIDs, "participant-id", "p", nil, "The ID of a participant to add to the group. Can be specified multiple times.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // createParticipantGroup will create a participant group
-func createParticipantGroup(client client.API, opts CreateOptions, w io.Writer) error {
+func createParticipantGroup(ctx context.Context, client client.API, opts CreateOptions, w io.Writer) error {
 	if opts.Name == "" {
 		return errors.New("name is required")
 	}
@@ -80,11 +84,15 @@ func createParticipantGroup(client client.API, opts CreateOptions, w io.Writer)
 		ParticipantIDs: opts.ParticipantIDs,
 	}
 
-	record, err := client.CreateParticipantGroup(group)
+	record, err := client.CreateParticipantGroup(ctx, group)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *record, w)
+	}
+
 	fmt.Fprintf(w, "Created participant group: %s\n", record.ID)
 
 	return nil
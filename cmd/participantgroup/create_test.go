@@ -41,7 +41,7 @@ func TestCreateCommandErrorsIfNoName(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateParticipantGroup(gomock.Any()).
+		CreateParticipantGroup(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -67,7 +67,7 @@ func TestCreateCommandErrorsIfNoWorkspace(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateParticipantGroup(gomock.Any()).
+		CreateParticipantGroup(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -101,7 +101,7 @@ func TestCreateCommandCreatesParticipantGroup(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateParticipantGroup(gomock.Eq(model.CreateParticipantGroup{
+		CreateParticipantGroup(gomock.Any(), gomock.Eq(model.CreateParticipantGroup{
 			Name:        groupName,
 			WorkspaceID: workspaceID,
 			Description: description,
@@ -142,7 +142,7 @@ func TestCreateCommandReturnsErrorIfCreateFails(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateParticipantGroup(gomock.Eq(model.CreateParticipantGroup{
+		CreateParticipantGroup(gomock.Any(), gomock.Eq(model.CreateParticipantGroup{
 			Name:        groupName,
 			WorkspaceID: workspaceID,
 		})).
@@ -1,12 +1,14 @@
 package participantgroup
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -18,6 +20,9 @@ type ListOptions struct {
 	WorkspaceID string
 	Limit       int
 	Offset      int
+	All         bool
+	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 }
 
 // NewListCommand creates a new command to deal with participant groups
@@ -35,13 +40,17 @@ Participant groups are assigned to a workspace.
 List the participant groups you have defined in a given workspace
 
 $ prolific participant list -w 6261321e223a605c7a4f7623
+
+Fetch every participant group, however many pages that takes
+
+$ prolific participant list -w 6261321e223a605c7a4f7623 --all
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := render(c, opts, w)
+			err := render(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -51,18 +60,28 @@ $ prolific participant list -w 6261321e223a605c7a4f7623
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "Filter participant groups by workspace.")
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of participant groups returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of participant groups to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of participant groups to offset")
+	shared.AddAllFlag(cmd, &opts.All, "participant groups")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
 
 // render will list your participant groups
-func render(client client.API, opts ListOptions, w io.Writer) error {
+func render(ctx context.Context, c client.API, opts ListOptions, w io.Writer) error {
 	if opts.WorkspaceID == "" {
 		return errors.New("please provide a workspace ID")
 	}
 
-	groups, err := client.GetParticipantGroups(opts.WorkspaceID, opts.Limit, opts.Offset)
+	groups, err := shared.FetchList(ctx, opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListParticipantGroupsResponse, error) {
+		return c.GetParticipantGroups(ctx, opts.WorkspaceID, limit, offset)
+	})
+	if err != nil {
+		return err
+	}
+
+	groups.Results, err = shared.ApplyQuery(opts.Query, groups.Results)
 	if err != nil {
 		return err
 	}
@@ -72,6 +91,10 @@ func render(client client.API, opts ListOptions, w io.Writer) error {
 		count = groups.Meta.Count
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, groups.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\n", "ID", "Name")
 	for _, group := range groups.Results {
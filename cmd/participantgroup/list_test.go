@@ -65,7 +65,7 @@ func TestNewListCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetParticipantGroups(gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
+		GetParticipantGroups(gomock.Any(), gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -115,7 +115,7 @@ func TestNewListCommandHandlesAnAPIError(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetParticipantGroups(gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
+		GetParticipantGroups(gomock.Any(), gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -1,6 +1,7 @@
 package participantgroup
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -14,6 +15,7 @@ type RemoveOptions struct {
 	Args           []string
 	ParticipantIDs []string
 	File           string
+	Output         shared.OutputOptions
 }
 
 // NewRemoveCommand creates a new command for removing participants from a participant group.
@@ -41,9 +43,9 @@ The two methods are mutually exclusive.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := removeParticipants(client, opts, w)
+			err := removeParticipants(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err)
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -53,11 +55,12 @@ The two methods are mutually exclusive.`,
 	flags := cmd.Flags()
 	flags.StringArrayVarP(&opts.ParticipantIDs, "participant-id", "p", nil, "The ID of a participant to remove. Can be specified multiple times.")
 	flags.StringVarP(&opts.File, "file", "f", "", "Path to a file containing one participant ID per line.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func removeParticipants(c client.API, opts RemoveOptions, w io.Writer) error {
+func removeParticipants(ctx context.Context, c client.API, opts RemoveOptions, w io.Writer) error {
 	groupID := opts.Args[0]
 
 	hasInlineIDs := len(opts.ParticipantIDs) > 0
@@ -79,11 +82,15 @@ func removeParticipants(c client.API, opts RemoveOptions, w io.Writer) error {
 		return fmt.Errorf("you must provide at least one participant ID via --participant-id or --file")
 	}
 
-	response, err := c.RemoveParticipantGroupMembers(groupID, opts.ParticipantIDs)
+	response, err := c.RemoveParticipantGroupMembers(ctx, groupID, opts.ParticipantIDs)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, response.Results, "", w)
+	}
+
 	fmt.Fprintf(w, "Removed %d participant(s) from group %s (%d remaining)\n", len(opts.ParticipantIDs), groupID, len(response.Results))
 
 	return nil
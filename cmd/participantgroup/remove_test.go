@@ -45,7 +45,7 @@ func TestRemoveCommandErrorsIfNoParticipants(t *testing.T) {
 	defer ctrl.Finish()
 	c := mock_client.NewMockAPI(ctrl)
 
-	c.EXPECT().RemoveParticipantGroupMembers(gomock.Any(), gomock.Any()).MaxTimes(0)
+	c.EXPECT().RemoveParticipantGroupMembers(gomock.Any(), gomock.Any(), gomock.Any()).MaxTimes(0)
 
 	var b bytes.Buffer
 	writer := bufio.NewWriter(&b)
@@ -69,7 +69,7 @@ func TestRemoveCommandErrorsIfFileAndInlineIDs(t *testing.T) {
 	defer ctrl.Finish()
 	c := mock_client.NewMockAPI(ctrl)
 
-	c.EXPECT().RemoveParticipantGroupMembers(gomock.Any(), gomock.Any()).MaxTimes(0)
+	c.EXPECT().RemoveParticipantGroupMembers(gomock.Any(), gomock.Any(), gomock.Any()).MaxTimes(0)
 
 	dir := t.TempDir()
 	filePath := filepath.Join(dir, "participants.csv")
@@ -103,7 +103,7 @@ func TestRemoveCommandRemovesByInlineIDs(t *testing.T) {
 
 	c.
 		EXPECT().
-		RemoveParticipantGroupMembers(
+		RemoveParticipantGroupMembers(gomock.Any(),
 			gomock.Eq(removeSuccessGroupID),
 			gomock.Eq([]string{"part-1", "part-2"}),
 		).
@@ -137,7 +137,7 @@ func TestRemoveCommandRemovesByFile(t *testing.T) {
 
 	c.
 		EXPECT().
-		RemoveParticipantGroupMembers(
+		RemoveParticipantGroupMembers(gomock.Any(),
 			gomock.Eq(removeSuccessGroupID),
 			gomock.Eq([]string{"part-1", "part-2", "part-3"}),
 		).
@@ -176,7 +176,7 @@ func TestRemoveCommandReturnsErrorIfRemoveFails(t *testing.T) {
 
 	c.
 		EXPECT().
-		RemoveParticipantGroupMembers(gomock.Any(), gomock.Any()).
+		RemoveParticipantGroupMembers(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("api error")).
 		MaxTimes(1)
 
@@ -205,7 +205,7 @@ func TestRemoveCommandFileSkipsBlankLines(t *testing.T) {
 
 	c.
 		EXPECT().
-		RemoveParticipantGroupMembers(
+		RemoveParticipantGroupMembers(gomock.Any(),
 			gomock.Eq(removeSuccessGroupID),
 			gomock.Eq([]string{"part-1", "part-2"}),
 		).
@@ -1,12 +1,14 @@
 package participantgroup
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
@@ -36,33 +38,45 @@ $ prolific participant view 6429b0ea05b2a24cac83c3a4
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderGroup(client, opts, w)
+			err := renderGroup(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
+
 	return cmd
 }
 
 // renderGroup will show your participant group
-func renderGroup(client client.API, opts ListOptions, w io.Writer) error {
+func renderGroup(ctx context.Context, client client.API, opts ListOptions, w io.Writer) error {
 	if len(opts.Args) < 1 || opts.Args[0] == "" {
 		return errors.New("please provide a participant group ID")
 	}
 
-	membership, err := client.GetParticipantGroup(opts.Args[0])
+	membership, err := client.GetParticipantGroup(ctx, opts.Args[0])
 	if err != nil {
 		return err
 	}
 
+	membership.Results, err = shared.ApplyQuery(opts.Query, membership.Results)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, membership.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\n", "Participant ID", "Date added")
 	for _, participant := range membership.Results {
-		fmt.Fprintf(tw, "%s\t%s\n", participant.ParticipantID, participant.DatetimeCreated.Format(ui.AppDateTimeFormat))
+		fmt.Fprintf(tw, "%s\t%s\n", participant.ParticipantID, ui.FormatDateTime(participant.DatetimeCreated))
 	}
 
 	return tw.Flush()
@@ -57,7 +57,7 @@ func TestNewViewCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetParticipantGroup(gomock.Eq(groupID)).
+		GetParticipantGroup(gomock.Any(), gomock.Eq(groupID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -103,7 +103,7 @@ func TestNewViewCommandHandlesAnAPIError(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetParticipantGroup(gomock.Eq(groupID)).
+		GetParticipantGroup(gomock.Any(), gomock.Eq(groupID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
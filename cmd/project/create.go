@@ -1,11 +1,13 @@
 package project
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +19,7 @@ type CreateOptions struct {
 	Workspace   string
 	Description string
 	Owner       string
+	Output      shared.OutputOptions
 }
 
 // NewCreateCommand creates a new command for creating a project.
@@ -37,9 +40,9 @@ $ prolific project create -t "Research into AI" -w 6261321e223a605c7a4f7564 -d "
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := createProject(client, opts, w)
+			err := createProject(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -50,12 +53,13 @@ $ prolific project create -t "Research into AI" -w 6261321e223a605c7a4f7564 -d "
 	flags.StringVarP(&opts.Title, "title", "t", "", "The title of the project.")
 	flags.StringVarP(&opts.Workspace, "workspace", "w", "", "The ID of the workspace to create the project in.")
 	flags.StringVarP(&opts.Description, "description", "d", "", "The description of the project.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // createProject will create a project for you
-func createProject(client client.API, opts CreateOptions, w io.Writer) error {
+func createProject(ctx context.Context, client client.API, opts CreateOptions, w io.Writer) error {
 	if opts.Title == "" {
 		return errors.New("title is required")
 	}
@@ -69,9 +73,9 @@ func createProject(client client.API, opts CreateOptions, w io.Writer) error {
 	}
 
 	// Get the current user's ID to set as the owner
-	me, err := client.GetMe()
+	me, err := client.GetMe(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to get current user: %s", err.Error())
+		return fmt.Errorf("unable to get current user: %w", err)
 	}
 
 	project := model.Project{
@@ -80,11 +84,15 @@ func createProject(client client.API, opts CreateOptions, w io.Writer) error {
 		Owner:       me.ID,
 	}
 
-	record, err := client.CreateProject(opts.Workspace, project)
+	record, err := client.CreateProject(ctx, opts.Workspace, project)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *record, w)
+	}
+
 	fmt.Fprintf(w, "Created project: %s\n", record.ID)
 
 	return nil
@@ -41,7 +41,7 @@ func TestCreateCommandErrorsIfNoDescription(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateProject(gomock.Any(), gomock.Any()).
+		CreateProject(gomock.Any(), gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -78,13 +78,13 @@ func TestCreateCommandCreatesProject(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetMe().
+		GetMe(gomock.Any()).
 		Return(&client.MeResponse{ID: userID}, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		CreateProject(gomock.Eq(workspaceID), gomock.Eq(model.Project{
+		CreateProject(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(model.Project{
 			Title:       record.Title,
 			Description: description,
 			Owner:       userID,
@@ -134,13 +134,13 @@ func TestCreateCommandReturnsErrorIfCreateProjectFails(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetMe().
+		GetMe(gomock.Any()).
 		Return(&client.MeResponse{ID: userID}, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		CreateProject(gomock.Eq(workspaceID), gomock.Eq(model.Project{
+		CreateProject(gomock.Any(), gomock.Eq(workspaceID), gomock.Eq(model.Project{
 			Title:       record.Title,
 			Description: description,
 			Owner:       userID,
@@ -181,7 +181,7 @@ func TestCreateCommandHandlesErrorIfNoWorkspaceProvided(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateProject(gomock.Any(), gomock.Any()).
+		CreateProject(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(&r, nil).
 		MaxTimes(1)
 
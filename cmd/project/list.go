@@ -1,12 +1,14 @@
 package project
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -18,6 +20,9 @@ type ListOptions struct {
 	WorkspaceID string
 	Limit       int
 	Offset      int
+	All         bool
+	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 }
 
 // NewListCommand creates a new command to deal with projects
@@ -41,13 +46,16 @@ $ prolific project list -w 61a65c06b084910b3f0c00d5 -l 1
 
 Offset records in the result set, for example by 2
 $ prolific project list -w 61a65c06b084910b3f0c00d5 -l 1 -o 2
+
+Fetch every project, however many pages that takes
+$ prolific project list -w 61a65c06b084910b3f0c00d5 --all
 		`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderProjects(c, opts, w)
+			err := renderProjects(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -57,18 +65,28 @@ $ prolific project list -w 61a65c06b084910b3f0c00d5 -l 1 -o 2
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "Filter projects by workspace.")
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of projects returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of projects to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of projects to offset")
+	shared.AddAllFlag(cmd, &opts.All, "projects")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
 
 // renderProjects will show your projects
-func renderProjects(client client.API, opts ListOptions, w io.Writer) error {
+func renderProjects(ctx context.Context, c client.API, opts ListOptions, w io.Writer) error {
 	if opts.WorkspaceID == "" {
 		return errors.New("please provide a workspace ID")
 	}
 
-	projects, err := client.GetProjects(opts.WorkspaceID, opts.Limit, opts.Offset)
+	projects, err := shared.FetchList(ctx, opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListProjectsResponse, error) {
+		return c.GetProjects(ctx, opts.WorkspaceID, limit, offset)
+	})
+	if err != nil {
+		return err
+	}
+
+	projects.Results, err = shared.ApplyQuery(opts.Query, projects.Results)
 	if err != nil {
 		return err
 	}
@@ -78,6 +96,10 @@ func renderProjects(client client.API, opts ListOptions, w io.Writer) error {
 		count = projects.Meta.Count
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, projects.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\n", "ID", "Title", "Description")
 	for _, project := range projects.Results {
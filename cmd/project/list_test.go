@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -63,7 +64,7 @@ func TestNewListCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetProjects(gomock.Eq("991199"), gomock.Eq(10), gomock.Eq(2)).
+		GetProjects(gomock.Any(), gomock.Eq("991199"), gomock.Eq(10), gomock.Eq(2)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -117,7 +118,7 @@ func TestNewListCommandHandlesErrorsFromTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetProjects(gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
+		GetProjects(gomock.Any(), gomock.Eq(workspaceID), client.DefaultRecordLimit, client.DefaultRecordOffset).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -131,3 +132,48 @@ func TestNewListCommandHandlesErrorsFromTheAPI(t *testing.T) {
 		t.Fatalf("expected\n'%s'\ngot\n'%s'\n", expected, err.Error())
 	}
 }
+
+func TestNewListCommandAllPagesThroughEveryProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	total := client.DefaultRecordLimit + 1
+	page := func(n int) *client.ListProjectsResponse {
+		response := &client.ListProjectsResponse{JSONAPIMeta: &client.JSONAPIMeta{}}
+		response.Meta.Count = total
+		for i := range n {
+			response.Results = append(response.Results, model.Project{ID: fmt.Sprintf("project-%d", i)})
+		}
+		return response
+	}
+
+	gomock.InOrder(
+		c.
+			EXPECT().
+			GetProjects(gomock.Any(), gomock.Eq("991199"), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(0)).
+			Return(page(client.DefaultRecordLimit), nil),
+		c.
+			EXPECT().
+			GetProjects(gomock.Any(), gomock.Eq("991199"), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordLimit)).
+			Return(page(1), nil),
+	)
+
+	var b bytes.Buffer
+	writer := bufio.NewWriter(&b)
+
+	cmd := project.NewListCommand("projects", c, writer)
+	_ = cmd.Flags().Set("workspace", "991199")
+	_ = cmd.Flags().Set("limit", "10")
+	_ = cmd.Flags().Set("all", "true")
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	writer.Flush()
+
+	expected := fmt.Sprintf("Showing %d records of %d", total, total)
+	if !strings.Contains(b.String(), expected) {
+		t.Fatalf("expected output to contain %q, got\n%s", expected, b.String())
+	}
+}
@@ -1,6 +1,7 @@
 package project
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -8,14 +9,16 @@ import (
 
 	"github.com/pkg/browser"
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
 // ViewOptions is the options for the detail view of a project.
 type ViewOptions struct {
-	Args []string
-	Web  bool
+	Args   []string
+	Web    bool
+	Output shared.OutputOptions
 }
 
 // NewViewCommand creates a new command to show a project.
@@ -42,9 +45,9 @@ $ prolific project view 6261321e223a605c7a4f7678
 				return browser.OpenURL(GetProjectURL(opts.Args[0]))
 			}
 
-			err := renderProject(client, opts, w)
+			err := renderProject(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -54,21 +57,26 @@ $ prolific project view 6261321e223a605c7a4f7678
 	flags := cmd.Flags()
 
 	flags.BoolVarP(&opts.Web, "web", "W", false, "Open the project in the web application")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // renderProject will show your project
-func renderProject(client client.API, opts ViewOptions, w io.Writer) error {
+func renderProject(ctx context.Context, client client.API, opts ViewOptions, w io.Writer) error {
 	if len(opts.Args) < 1 || opts.Args[0] == "" {
 		return errors.New("please provide a project ID")
 	}
 
-	project, err := client.GetProject(opts.Args[0])
+	project, err := client.GetProject(ctx, opts.Args[0])
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *project, w)
+	}
+
 	content := fmt.Sprintln(ui.RenderHeading(project.Title))
 
 	if project.Description != "" {
@@ -56,7 +56,7 @@ func TestNewViewCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetProject(gomock.Eq(projectID)).
+		GetProject(gomock.Any(), gomock.Eq(projectID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -115,7 +115,7 @@ func TestNewViewCommandHandlesErrorsFromTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetProject(gomock.Eq(projectID)).
+		GetProject(gomock.Any(), gomock.Eq(projectID)).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
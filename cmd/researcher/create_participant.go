@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -12,6 +13,7 @@ import (
 // create a test participant for the researcher.
 func NewCreateParticipantCommand(client client.API, w io.Writer) *cobra.Command {
 	var email string
+	var output shared.OutputOptions
 
 	cmd := &cobra.Command{
 		Use:   "create-participant",
@@ -29,11 +31,15 @@ the researcher where the feature is enabled.`,
 To create a test participant:
 $ prolific researcher create-participant -e test@example.com`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			response, err := client.CreateTestParticipant(email)
+			response, err := client.CreateTestParticipant(cmd.Context(), email)
 			if err != nil {
 				return err
 			}
 
+			if output.IsStructured() {
+				return shared.RenderRecord(output, *response, w)
+			}
+
 			fmt.Fprintf(w, "Created test participant: %s\n", response.ParticipantID)
 
 			return nil
@@ -42,6 +48,7 @@ $ prolific researcher create-participant -e test@example.com`,
 
 	flags := cmd.Flags()
 	flags.StringVarP(&email, "email", "e", "", "The email of the test participant (required)")
+	shared.AddRecordOutputFlags(cmd, &output)
 
 	_ = cmd.MarkFlagRequired("email")
 
@@ -47,7 +47,7 @@ func TestCreateParticipantCallsClient(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateTestParticipant(gomock.Eq(email)).
+		CreateTestParticipant(gomock.Any(), gomock.Eq(email)).
 		Return(&response, nil).
 		Times(1)
 
@@ -80,7 +80,7 @@ func TestCreateParticipantHandlesApiErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateTestParticipant(gomock.Eq(email)).
+		CreateTestParticipant(gomock.Any(), gomock.Eq(email)).
 		Return(nil, errors.New("email already registered")).
 		Times(1)
 
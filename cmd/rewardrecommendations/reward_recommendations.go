@@ -1,6 +1,7 @@
 package rewardrecommendations
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -22,6 +24,7 @@ type Options struct {
 	WorkspaceID string
 	Currency    string
 	ScreenerIDs []string
+	Output      shared.OutputOptions
 }
 
 // NewCommand creates a new `reward-recommendations` command to calculate
@@ -51,9 +54,9 @@ Use the workspace set in your config file
 $ prolific reward-recommendations -c USD
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := render(c, opts, w)
+			err := render(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err)
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -64,12 +67,13 @@ $ prolific reward-recommendations -c USD
 	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "The ID of the workspace you'll be creating the study in.")
 	flags.StringVarP(&opts.Currency, "currency", "c", "", fmt.Sprintf("The currency for the recommendation. One of: %s", strings.Join(allowedCurrencies, ", ")))
 	flags.StringArrayVarP(&opts.ScreenerIDs, "screener-id", "s", nil, "A screener/filter ID to scope the recommendation to. Can be specified multiple times.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // render will fetch and display the reward recommendation.
-func render(c client.API, opts Options, w io.Writer) error {
+func render(ctx context.Context, c client.API, opts Options, w io.Writer) error {
 	if opts.WorkspaceID == "" {
 		return errors.New("please provide a workspace ID")
 	}
@@ -82,7 +86,7 @@ func render(c client.API, opts Options, w io.Writer) error {
 		return fmt.Errorf("currency must be one of: %s", strings.Join(allowedCurrencies, ", "))
 	}
 
-	response, err := c.GetRewardRecommendations(opts.WorkspaceID, opts.Currency, opts.ScreenerIDs)
+	response, err := c.GetRewardRecommendations(ctx, opts.WorkspaceID, opts.Currency, opts.ScreenerIDs)
 	if err != nil {
 		return err
 	}
@@ -94,6 +98,10 @@ func render(c client.API, opts Options, w io.Writer) error {
 	// The API guarantees the first item is the most recent set of rates.
 	recommendation := (*response)[0]
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, recommendation, w)
+	}
+
 	toCurrency := func(amount int) float64 {
 		return float64(amount) / 100
 	}
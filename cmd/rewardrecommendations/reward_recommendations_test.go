@@ -46,7 +46,7 @@ func TestNewCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetRewardRecommendations("abc123", "GBP", []string{"mandarin", "spanish"}).
+		GetRewardRecommendations(gomock.Any(), "abc123", "GBP", []string{"mandarin", "spanish"}).
 		Return(&response, nil).
 		Times(1)
 
@@ -86,7 +86,7 @@ func TestNewCommandUsesViperWorkspace(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetRewardRecommendations("viper-workspace-id", "USD", []string(nil)).
+		GetRewardRecommendations(gomock.Any(), "viper-workspace-id", "USD", []string(nil)).
 		Return(&response, nil).
 		Times(1)
 
@@ -158,7 +158,7 @@ func TestNewCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetRewardRecommendations("abc123", "USD", []string(nil)).
+		GetRewardRecommendations(gomock.Any(), "abc123", "USD", []string(nil)).
 		Return(nil, errors.New(errorMessage)).
 		Times(1)
 
@@ -182,7 +182,7 @@ func TestNewCommandErrorsWithEmptyResponse(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetRewardRecommendations("abc123", "USD", []string(nil)).
+		GetRewardRecommendations(gomock.Any(), "abc123", "USD", []string(nil)).
 		Return(&response, nil).
 		Times(1)
 
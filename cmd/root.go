@@ -4,17 +4,26 @@
 package cmd
 
 import (
+	"cmp"
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
+	"sync"
+	"syscall"
 
-	homedir "github.com/mitchellh/go-homedir"
 	"github.com/prolific-oss/cli/client"
 	"github.com/prolific-oss/cli/cmd/aitaskbuilder"
+	"github.com/prolific-oss/cli/cmd/apply"
+	"github.com/prolific-oss/cli/cmd/auth"
 	"github.com/prolific-oss/cli/cmd/bonus"
 	"github.com/prolific-oss/cli/cmd/campaign"
 	"github.com/prolific-oss/cli/cmd/collection"
+	configcmd "github.com/prolific-oss/cli/cmd/config"
 	"github.com/prolific-oss/cli/cmd/credentials"
+	"github.com/prolific-oss/cli/cmd/dev"
 	"github.com/prolific-oss/cli/cmd/filters"
 	"github.com/prolific-oss/cli/cmd/filtersets"
 	"github.com/prolific-oss/cli/cmd/hook"
@@ -24,18 +33,29 @@ import (
 	"github.com/prolific-oss/cli/cmd/project"
 	"github.com/prolific-oss/cli/cmd/researcher"
 	"github.com/prolific-oss/cli/cmd/rewardrecommendations"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/cmd/study"
 	"github.com/prolific-oss/cli/cmd/submission"
 	"github.com/prolific-oss/cli/cmd/survey"
 	"github.com/prolific-oss/cli/cmd/template"
+	"github.com/prolific-oss/cli/cmd/tui"
 	"github.com/prolific-oss/cli/cmd/user"
 	"github.com/prolific-oss/cli/cmd/workspace"
+	"github.com/prolific-oss/cli/config"
+	"github.com/prolific-oss/cli/tokenstore"
+	"github.com/prolific-oss/cli/ui"
 	"github.com/prolific-oss/cli/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-var cfgFile string
+var (
+	cfgFile    string
+	profile    string
+	timezone   string
+	dateFormat string
+	locale     string
+)
 
 // ApplicationName is the name of the cli binary
 const ApplicationName = "prolific"
@@ -44,16 +64,48 @@ const ApplicationName = "prolific"
 // This is called by main.main(). It only needs to happen once
 func Execute() {
 	// We need the configuration loaded before we create a NewCli
-	// as that needs the viper configuration up and running
+	// as that needs the viper configuration up and running. Cobra has not
+	// parsed the flags yet, so pick out the ones that affect loading it.
+	cfgFile = globalFlag(os.Args[1:], "config")
+	profile = globalFlag(os.Args[1:], "profile")
 	initConfig()
+	profileErr := config.ApplyProfile(config.ActiveProfile(profile))
+	if profileErr != nil && hasStoredToken(config.AppliedProfile()) {
+		// A profile can be nothing more than a token from `auth login`.
+		profileErr = nil
+	}
 
 	// Build the root command
 	cmd := NewRootCommand()
 
+	// A missing profile only stops commands that would use it, so `config`
+	// can still be used to create it and `auth` to store its token.
+	if profileErr != nil {
+		applyOutput := cmd.PersistentPreRunE
+		cmd.PersistentPreRunE = func(c *cobra.Command, args []string) error {
+			if allowsMissingProfile(c) {
+				return applyOutput(c, args)
+			}
+			return fmt.Errorf("error: %w", profileErr)
+		}
+	}
+
+	// Cancel the command's context on Ctrl-C or SIGTERM so in-flight requests
+	// and polling loops stop promptly instead of running to completion.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Execute the application
-	if err := cmd.Execute(); err != nil {
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		stop()
 		fmt.Println(err)
-		os.Exit(1)
+
+		var apiErr *client.APIError
+		if errors.As(err, &apiErr) && apiErr.RequestID != "" {
+			fmt.Printf("Request ID: %s\n", apiErr.RequestID)
+		}
+
+		os.Exit(shared.ExitCode(err))
 	}
 }
 
@@ -64,28 +116,45 @@ func NewRootCommand() *cobra.Command {
 		Use:     ApplicationName,
 		Short:   "CLI application for retrieving data from the Prolific Platform",
 		Version: version.Get(),
+		PersistentPreRunE: func(*cobra.Command, []string) error {
+			return applyOutputSettings()
+		},
 	}
 
 	cmd.PersistentFlags().StringVar(&cfgFile, "config", "", fmt.Sprintf("config file (default is $HOME/.config/prolific-oss/%s.yaml)", ApplicationName))
+	cmd.PersistentFlags().StringVar(&profile, "profile", "", fmt.Sprintf("Named profile from the config file to use (default is $%s, then current_profile)", config.ProfileEnvVar))
 
 	client := client.New()
+	if client.Token == "" {
+		client.TokenSource = sync.OnceValues(storedToken)
+	}
 
 	cmd.PersistentFlags().StringVar(&client.Skill, "skill", "", "Optional identifier for the AI skill/workflow invoking this command; folded into the User-Agent header sent with API requests")
+	cmd.PersistentFlags().StringVar(&client.TraceFile, "trace-file", client.TraceFile, "Record every API request and response to this HAR file, with secrets redacted, e.g. to attach to a support ticket")
+	cmd.PersistentFlags().DurationVar(&client.Timeout, "timeout", client.Timeout, "Maximum time to wait for each API request (e.g. 30s, 2m); 0 means no limit")
+	cmd.PersistentFlags().StringVar(&timezone, "timezone", "", "Time zone to show times in, e.g. America/New_York or Local (default is $PROLIFIC_TIMEZONE, then the timezone setting, then UTC)")
+	cmd.PersistentFlags().StringVar(&dateFormat, "date-format", "", fmt.Sprintf("Go layout for times, e.g. \"2006-01-02 15:04\", or iso for RFC 3339 (default is $PROLIFIC_DATE_FORMAT, then the date_format setting, then %q)", ui.AppDateTimeFormat))
+	cmd.PersistentFlags().StringVar(&locale, "locale", "", "Locale for amounts of money, e.g. en-US or ja-JP (default is $PROLIFIC_LOCALE, then the locale setting)")
 
 	w := os.Stdout
 
 	cmd.AddCommand(
 		aitaskbuilder.NewAITaskBuilderCommand(&client, w),
+		apply.NewApplyCommand(&client, w),
+		auth.NewAuthCommand(&client, w),
 		bonus.NewBonusCommand(&client, w),
 		campaign.NewListCommand("campaign", &client, w),
 		collection.NewCollectionCommand(&client, w),
+		configcmd.NewConfigCommand(w),
 		credentials.NewCredentialsCommand(&client, w),
+		dev.NewDevCommand(w),
 		filters.NewListCommand(&client, w),
 		filtersets.NewFilterSetCommand(&client, w),
 		hook.NewHookCommand(&client, w),
 		invitation.NewInvitationCommand(&client, w),
 		message.NewMessageCommand(&client, w),
 		participantgroup.NewParticipantCommand(&client, w),
+		apply.NewPlanCommand(&client, w),
 		project.NewProjectCommand(&client, w),
 		researcher.NewResearcherCommand(&client, w),
 		rewardrecommendations.NewCommand("reward-recommendations", &client, w),
@@ -94,6 +163,7 @@ func NewRootCommand() *cobra.Command {
 		submission.NewSubmissionCommand(&client, w),
 		survey.NewSurveyCommand(&client, w),
 		template.NewTemplateCommand(w),
+		tui.NewTUICommand(&client, w),
 		user.NewMeCommand(&client, w),
 		workspace.NewWorkspaceCommand(&client, w),
 	)
@@ -101,6 +171,22 @@ func NewRootCommand() *cobra.Command {
 	return cmd
 }
 
+// applyOutputSettings sets how times and money are shown, from the flags or
+// else the config.
+func applyOutputSettings() error {
+	settings, err := ui.ParseOutputSettings(
+		cmp.Or(timezone, config.GetTimezone()),
+		cmp.Or(dateFormat, config.GetDateFormat()),
+		cmp.Or(locale, config.GetLocale()),
+	)
+	if err != nil {
+		return fmt.Errorf("error: %w", err)
+	}
+
+	ui.SetOutputSettings(settings)
+	return nil
+}
+
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	if cfgFile != "" {
@@ -108,14 +194,14 @@ func initConfig() {
 		viper.SetConfigFile(cfgFile)
 	} else {
 		// Find home directory.
-		home, err := homedir.Dir()
+		dir, err := config.DefaultDir()
 		if err != nil {
 			fmt.Println(err)
 			os.Exit(1)
 		}
 
-		viper.AddConfigPath(strings.Join([]string{home, ".config/prolific-oss"}, "/"))
-		viper.SetConfigName(ApplicationName)
+		viper.AddConfigPath(dir)
+		viper.SetConfigName(config.DefaultFileName)
 	}
 
 	viper.AutomaticEnv() // read in environment variables that match
@@ -123,3 +209,74 @@ func initConfig() {
 	// If a config file is found, read it in.
 	_ = viper.ReadInConfig()
 }
+
+// globalFlag returns the value of a persistent string flag from the raw
+// command line, for the flags needed before the command tree is built.
+func globalFlag(args []string, name string) string {
+	value := ""
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			break
+		}
+		if v, ok := strings.CutPrefix(arg, "--"+name+"="); ok {
+			value = v
+		} else if arg == "--"+name && i+1 < len(args) {
+			value = args[i+1]
+			i++
+		}
+	}
+	return value
+}
+
+// storedToken reads the token saved by `prolific auth login` for the active
+// profile, or returns an empty token if none was saved. A token protected by
+// a passphrase uses PROLIFIC_TOKEN_PASSPHRASE, or asks for it on a terminal.
+func storedToken() (string, error) {
+	store, err := tokenstore.Default()
+	if err != nil {
+		return "", err
+	}
+
+	profile := config.AppliedProfile()
+	token, err := store.Load(profile, os.Getenv(tokenstore.PassphraseEnvVar))
+	if errors.Is(err, tokenstore.ErrPassphraseRequired) && ui.IsTerminal(os.Stdin) {
+		passphrase, promptErr := ui.ReadSecret(os.Stdin, os.Stderr, "Passphrase for the stored token: ")
+		if promptErr != nil {
+			return "", promptErr
+		}
+		token, err = store.Load(profile, passphrase)
+	}
+
+	if errors.Is(err, tokenstore.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to read the token saved by `prolific auth login`: %w", err)
+	}
+
+	return token, nil
+}
+
+// hasStoredToken reports whether `prolific auth login` stored a token for
+// profile.
+func hasStoredToken(profile string) bool {
+	store, err := tokenstore.Default()
+	if err != nil {
+		return false
+	}
+	_, ok, err := store.Lookup(profile)
+	return err == nil && ok
+}
+
+// allowsMissingProfile reports whether c is one of the `config`, `auth` or
+// `dev` commands, which can run for a profile the config file doesn't define
+// yet.
+func allowsMissingProfile(c *cobra.Command) bool {
+	for ; c != nil && c.HasParent(); c = c.Parent() {
+		if c.Parent().Name() == ApplicationName && (c.Name() == "config" || c.Name() == "auth" || c.Name() == "dev") {
+			return true
+		}
+	}
+	return false
+}
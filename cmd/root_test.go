@@ -33,3 +33,34 @@ func TestNewRootCommandRegistersSkillFlag(t *testing.T) {
 		t.Fatalf("expected --skill default value to be empty, got %q", flag.DefValue)
 	}
 }
+
+func TestNewRootCommandRegistersProfileFlag(t *testing.T) {
+	root := cmd.NewRootCommand()
+
+	if root.PersistentFlags().Lookup("profile") == nil {
+		t.Fatal("expected --profile persistent flag to be registered")
+	}
+
+	if c, _, err := root.Find([]string{"config", "list"}); err != nil || c.Name() != "list" {
+		t.Fatal("expected the config command to be registered")
+	}
+}
+
+func TestNewRootCommandValidatesOutputSettings(t *testing.T) {
+	root := cmd.NewRootCommand()
+
+	for _, name := range []string{"timezone", "date-format", "locale"} {
+		if root.PersistentFlags().Lookup(name) == nil {
+			t.Fatalf("expected --%s persistent flag to be registered", name)
+		}
+	}
+
+	_ = root.PersistentFlags().Set("timezone", "Mars/Olympus_Mons")
+	defer func() { _ = root.PersistentFlags().Set("timezone", "") }()
+
+	err := root.PersistentPreRunE(root, nil)
+	expected := `error: unknown time zone "Mars/Olympus_Mons", expected a name such as Europe/London, America/New_York, Local or UTC`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected %q; got %v", expected, err)
+	}
+}
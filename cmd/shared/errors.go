@@ -1,24 +1,14 @@
 package shared
 
 import (
-	"strings"
+	"errors"
+
+	"github.com/prolific-oss/cli/client"
 )
 
 // IsFeatureNotEnabledError checks if the error indicates that a feature
-// is not enabled for the user. Uses semantic matching on key phrases for
-// robustness against minor wording changes.
+// is not enabled for the user.
 func IsFeatureNotEnabledError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errMsg := strings.ToLower(err.Error())
-
-	// Check for API request failure with feature access denial
-	// This matches messages like "request failed: you do not currently have permission to access [to] this feature"
-	hasRequestFailed := strings.Contains(errMsg, "request failed")
-	hasPermission := strings.Contains(errMsg, "permission")
-	hasFeature := strings.Contains(errMsg, "feature")
-
-	return hasRequestFailed && hasPermission && hasFeature
+	var apiErr *client.APIError
+	return errors.As(err, &apiErr) && apiErr.IsFeatureNotEnabled()
 }
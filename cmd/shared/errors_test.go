@@ -3,6 +3,8 @@ package shared
 import (
 	"fmt"
 	"testing"
+
+	"github.com/prolific-oss/cli/client"
 )
 
 func TestIsFeatureNotEnabledError(t *testing.T) {
@@ -18,47 +20,47 @@ func TestIsFeatureNotEnabledError(t *testing.T) {
 		},
 		{
 			name:     "feature not enabled error with structured JSON response returns true",
-			err:      fmt.Errorf("request failed: You do not currently have permission to access to this feature."),
+			err:      &client.APIError{StatusCode: 403, Detail: "You do not currently have permission to access to this feature."},
 			expected: true,
 		},
 		{
-			name:     "feature not enabled error with status code format returns true",
-			err:      fmt.Errorf("request failed with status 404: You do not currently have permission to access to this feature."),
+			name:     "feature not enabled error wrapped by the caller returns true",
+			err:      fmt.Errorf("unable to fulfil request: %w", &client.APIError{StatusCode: 404, Detail: "You do not currently have permission to access to this feature."}),
 			expected: true,
 		},
 		{
 			name:     "feature not enabled error without typo returns true",
-			err:      fmt.Errorf("request failed: You do not currently have permission to access this feature."),
+			err:      &client.APIError{StatusCode: 403, Detail: "You do not currently have permission to access this feature."},
 			expected: true,
 		},
 		{
 			name:     "feature not enabled error with mixed case returns true",
-			err:      fmt.Errorf("request failed: you DO NOT have PERMISSION to access this FEATURE"),
+			err:      &client.APIError{StatusCode: 403, Detail: "you DO NOT have PERMISSION to access this FEATURE"},
 			expected: true,
 		},
 		{
-			name:     "request failed without feature message returns false",
-			err:      fmt.Errorf("request failed: not found"),
+			name:     "API error without feature message returns false",
+			err:      &client.APIError{StatusCode: 404, Detail: "not found"},
 			expected: false,
 		},
 		{
-			name:     "request failed with only permission keyword returns false",
-			err:      fmt.Errorf("request failed: you do not have permission"),
+			name:     "API error with only permission keyword returns false",
+			err:      &client.APIError{StatusCode: 403, Detail: "you do not have permission"},
 			expected: false,
 		},
 		{
-			name:     "request failed with only feature keyword returns false",
-			err:      fmt.Errorf("request failed: this feature is unavailable"),
+			name:     "API error with only feature keyword returns false",
+			err:      &client.APIError{StatusCode: 403, Detail: "this feature is unavailable"},
 			expected: false,
 		},
 		{
-			name:     "generic error without request failed returns false",
-			err:      fmt.Errorf("something went wrong with permission and feature"),
+			name:     "plain error mentioning permission and feature returns false",
+			err:      fmt.Errorf("request failed: you do not currently have permission to access this feature"),
 			expected: false,
 		},
 		{
 			name:     "API error with not found detail returns false",
-			err:      fmt.Errorf("unable to fulfil request: request failed with status 404: {\"detail\":\"Not found.\"}"),
+			err:      fmt.Errorf("unable to fulfil request: %w", &client.APIError{StatusCode: 404, Body: []byte(`{"detail":"Not found."}`)}),
 			expected: false,
 		},
 	}
@@ -0,0 +1,85 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/tokenstore"
+)
+
+// Exit codes returned by the CLI, so scripts can branch on the kind of
+// failure without parsing the message. Anything not listed exits with
+// ExitError.
+const (
+	// ExitOK means the command succeeded.
+	ExitOK = 0
+	// ExitError covers any failure without a more specific code, including
+	// server errors and invalid command-line usage.
+	ExitError = 1
+	// ExitAuth means the token is missing, invalid or lacks access (401/403),
+	// or the stored token could not be decrypted.
+	ExitAuth = 3
+	// ExitNotFound means the requested resource does not exist (404).
+	ExitNotFound = 4
+	// ExitValidation means the API rejected the request's content (400/422).
+	ExitValidation = 5
+	// ExitRateLimited means the API kept rate limiting the request (429),
+	// even after retrying.
+	ExitRateLimited = 6
+	// ExitFeatureNotEnabled means the account doesn't have access to the
+	// feature yet.
+	ExitFeatureNotEnabled = 7
+	// ExitNetwork means the API could not be reached or did not respond in
+	// time.
+	ExitNetwork = 8
+	// ExitCancelled means the command was interrupted, e.g. by Ctrl-C.
+	ExitCancelled = 130
+)
+
+// ExitCode maps an error returned by a command to the process exit code
+// that describes it.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return ExitCancelled
+	}
+
+	if errors.Is(err, client.ErrTokenNotSet) ||
+		errors.Is(err, tokenstore.ErrPassphraseRequired) ||
+		errors.Is(err, tokenstore.ErrWrongPassphrase) {
+		return ExitAuth
+	}
+
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.IsFeatureNotEnabled() {
+			return ExitFeatureNotEnabled
+		}
+
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ExitAuth
+		case http.StatusNotFound:
+			return ExitNotFound
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			return ExitValidation
+		case http.StatusTooManyRequests:
+			return ExitRateLimited
+		}
+
+		return ExitError
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) {
+		return ExitNetwork
+	}
+
+	return ExitError
+}
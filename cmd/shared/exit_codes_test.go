@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/tokenstore"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "nil error", err: nil, expected: ExitOK},
+		{name: "plain error", err: errors.New("boom"), expected: ExitError},
+		{name: "missing token", err: fmt.Errorf("unable to fulfil request: %w", client.ErrTokenNotSet), expected: ExitAuth},
+		{name: "stored token locked", err: fmt.Errorf("error: %w", tokenstore.ErrPassphraseRequired), expected: ExitAuth},
+		{name: "stored token wrong passphrase", err: tokenstore.ErrWrongPassphrase, expected: ExitAuth},
+		{name: "unauthorised", err: &client.APIError{StatusCode: 401}, expected: ExitAuth},
+		{name: "forbidden", err: &client.APIError{StatusCode: 403}, expected: ExitAuth},
+		{name: "not found", err: fmt.Errorf("error: %w", &client.APIError{StatusCode: 404}), expected: ExitNotFound},
+		{name: "bad request", err: &client.APIError{StatusCode: 400}, expected: ExitValidation},
+		{name: "unprocessable", err: &client.APIError{StatusCode: 422}, expected: ExitValidation},
+		{name: "rate limited", err: &client.APIError{StatusCode: 429}, expected: ExitRateLimited},
+		{name: "server error", err: &client.APIError{StatusCode: 503}, expected: ExitError},
+		{
+			name:     "feature not enabled wins over forbidden",
+			err:      &client.APIError{StatusCode: 403, Detail: "You do not currently have permission to access this feature."},
+			expected: ExitFeatureNotEnabled,
+		},
+		{
+			name:     "connection refused",
+			err:      &url.Error{Op: "Get", URL: "https://api.prolific.com", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}},
+			expected: ExitNetwork,
+		},
+		{name: "request timeout", err: fmt.Errorf("error: %w", context.DeadlineExceeded), expected: ExitNetwork},
+		{name: "cancelled", err: fmt.Errorf("stopped waiting: %w", context.Canceled), expected: ExitCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.expected {
+				t.Errorf("ExitCode(%v) = %d, expected %d", tt.err, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package shared
+
+import (
+	"github.com/prolific-oss/cli/ui"
+	"github.com/spf13/cobra"
+)
+
+// jqFlag is the value of --jq. The expression is compiled when the flag is
+// set, so a mistake in it is reported before anything is sent to the API.
+type jqFlag string
+
+func (v *jqFlag) String() string { return string(*v) }
+
+func (v *jqFlag) Type() string { return "expression" }
+
+func (v *jqFlag) Set(s string) error {
+	if _, err := ui.ParseJQ(s); err != nil {
+		return err
+	}
+	*v = jqFlag(s)
+	return nil
+}
+
+// AddJQFlag registers --jq on a command that can output JSON, to filter that
+// JSON in-process, without jq having to be installed.
+func AddJQFlag(cmd *cobra.Command, expr *string) {
+	cmd.Flags().Var((*jqFlag)(expr), "jq", `Filter the JSON output with a jq expression, e.g. '.[] | .id'; strings are printed without quotes`)
+}
@@ -0,0 +1,27 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestJQFlagRejectsInvalidExpressions(t *testing.T) {
+	var expr string
+	cmd := &cobra.Command{}
+	AddJQFlag(cmd, &expr)
+
+	if err := cmd.Flags().Set("jq", ".[] | select("); err == nil {
+		t.Fatal("expected an error for an unfinished expression")
+	}
+	if expr != "" {
+		t.Fatalf("expected the expression to be left unset, got %q", expr)
+	}
+
+	if err := cmd.Flags().Set("jq", ".[] | .id"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if expr != ".[] | .id" {
+		t.Fatalf("expected the expression to be set, got %q", expr)
+	}
+}
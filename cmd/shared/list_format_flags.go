@@ -1,31 +1,138 @@
 package shared
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
+// OutputFormat describes a format list commands can render their results in.
+type OutputFormat struct {
+	Name        string
+	Description string
+}
+
+// OutputFormats are the formats accepted by --output, in the order they are
+// listed in help and completion.
+var OutputFormats = []OutputFormat{
+	{Name: "table", Description: "Tab-aligned table"},
+	{Name: "csv", Description: "Comma separated values"},
+	{Name: "markdown", Description: "Markdown table, for pasting into reports"},
+	{Name: "json", Description: "Indented JSON array"},
+	{Name: "ndjson", Description: "One JSON object per line, for streaming into other tools"},
+	{Name: "yaml", Description: "YAML list"},
+}
+
+// renderers returns how to render a list of T in each of the OutputFormats.
+func renderers[T any]() map[string]func(items []T, fields string, w io.Writer) error {
+	return map[string]func(items []T, fields string, w io.Writer) error{
+		"table":    ui.TableRenderer[T]{}.Render,
+		"csv":      ui.CsvRenderer[T]{}.Render,
+		"markdown": ui.MarkdownRenderer[T]{}.Render,
+		"json": func(items []T, _ string, w io.Writer) error {
+			return ui.JSONRenderer[T]{}.Render(items, w)
+		},
+		"ndjson": func(items []T, _ string, w io.Writer) error {
+			return ui.NDJSONRenderer[T]{}.Render(items, w)
+		},
+		"yaml": func(items []T, _ string, w io.Writer) error {
+			return ui.YAMLRenderer[T]{}.Render(items, w)
+		},
+	}
+}
+
 // OutputOptions holds the output format flags for list commands.
 type OutputOptions struct {
-	Json  bool
-	Csv   bool
-	Table bool
+	Format     string
+	GoTemplate string
+	JQ         string
+	Json       bool
+	Csv        bool
+	Table      bool
 }
 
-// AddOutputFlags registers --json / -j, --table / -t, --csv / -c on the given command.
+// formatFlag is the value of the --output flag, which only accepts the name of
+// one of formats.
+type formatFlag struct {
+	cmd     *cobra.Command
+	value   *string
+	formats []OutputFormat
+}
+
+func (v *formatFlag) String() string { return *v.value }
+
+func (v *formatFlag) Type() string { return "format" }
+
+func (v *formatFlag) Set(s string) error {
+	// -o was --offset on the list commands before it was --output, so a number
+	// is still taken as an offset there, with a warning.
+	if _, err := strconv.Atoi(s); err == nil && v.cmd.Flags().Lookup("offset") != nil {
+		fmt.Fprintln(v.cmd.ErrOrStderr(), "Flag shorthand -o for --offset has been deprecated, use --offset instead")
+		return v.cmd.Flags().Set("offset", s)
+	}
+
+	for _, f := range v.formats {
+		if strings.EqualFold(s, f.Name) {
+			*v.value = f.Name
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %s", strings.Join(formatNames(v.formats), ", "))
+}
+
+func formatNames(formats []OutputFormat) []string {
+	names := make([]string, len(formats))
+	for i, f := range formats {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// addFormatFlag registers --output / -o on cmd, accepting and completing formats.
+func addFormatFlag(cmd *cobra.Command, value *string, formats []OutputFormat, usage string) {
+	cmd.Flags().VarP(&formatFlag{cmd: cmd, value: value, formats: formats}, "output", "o", fmt.Sprintf("Output format: %s (%s)", strings.Join(formatNames(formats), ", "), usage))
+	_ = cmd.RegisterFlagCompletionFunc("output", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		completions := make([]string, len(formats))
+		for i, f := range formats {
+			completions[i] = cobra.CompletionWithDesc(f.Name, f.Description)
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// AddOutputFlags registers --output / -o on the given command, along with --json / -j,
+// --table / -t and --csv / -c as shorthands for the formats they name.
 // --non-interactive / -n is registered as a hidden alias for --table for backwards compatibility.
+// --go-template renders each item through a template instead, and --jq
+// filters the JSON array of items.
 func AddOutputFlags(cmd *cobra.Command, opts *OutputOptions) {
-	cmd.Flags().BoolVarP(&opts.Json, "json", "j", false, "Output as JSON")
-	cmd.Flags().BoolVarP(&opts.Table, "table", "t", false, "Output as table (non-interactive)")
-	cmd.Flags().BoolVarP(&opts.Csv, "csv", "c", false, "Output as CSV")
+	addFormatFlag(cmd, &opts.Format, OutputFormats, "default: interactive in a terminal")
+
+	AddTemplateFlag(cmd, &opts.GoTemplate)
+	AddJQFlag(cmd, &opts.JQ)
+
+	cmd.Flags().BoolVarP(&opts.Json, "json", "j", false, "Output as JSON, the same as --output json")
+	cmd.Flags().BoolVarP(&opts.Table, "table", "t", false, "Output as table (non-interactive), the same as --output table")
+	cmd.Flags().BoolVarP(&opts.Csv, "csv", "c", false, "Output as CSV, the same as --output csv")
 
 	cmd.Flags().BoolVarP(&opts.Table, "non-interactive", "n", false, "Output as table (non-interactive)")
 	_ = cmd.Flags().MarkHidden("non-interactive")
 }
 
 // ResolveFormat returns the resolved format string based on the flags set.
-// Priority: json > csv > table. Returns "" to indicate auto (TUI if TTY, else table).
+// Priority: --go-template > --jq > --output > json > csv > table. Returns "" to indicate auto (TUI if TTY, else table).
 func ResolveFormat(opts OutputOptions) string {
 	switch {
+	case opts.GoTemplate != "":
+		return "go-template"
+	case opts.JQ != "":
+		return "jq"
+	case opts.Format != "":
+		return opts.Format
 	case opts.Json:
 		return "json"
 	case opts.Csv:
@@ -36,3 +143,26 @@ func ResolveFormat(opts OutputOptions) string {
 		return ""
 	}
 }
+
+// RenderList writes items to w in the format chosen by opts. The table, CSV
+// and Markdown formats show fields; the others render every field of each
+// item.
+func RenderList[T any](opts OutputOptions, items []T, fields string, w io.Writer) error {
+	if items == nil {
+		items = []T{}
+	}
+
+	format := ResolveFormat(opts)
+	switch format {
+	case "go-template":
+		return ui.RenderTemplateList(opts.GoTemplate, items, w)
+	case "jq":
+		return ui.RenderJQ(opts.JQ, items, w)
+	}
+
+	render, ok := renderers[T]()[format]
+	if !ok {
+		return fmt.Errorf("unknown output format %q, must be one of %s", format, strings.Join(formatNames(OutputFormats), ", "))
+	}
+	return render(items, fields, w)
+}
@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/prolific-oss/cli/model"
+	"github.com/spf13/cobra"
+)
+
+func TestResolveFormat(t *testing.T) {
+	tt := []struct {
+		name     string
+		flags    map[string]string
+		expected string
+	}{
+		{name: "Nothing set is interactive", flags: map[string]string{}, expected: ""},
+		{name: "Table shorthand", flags: map[string]string{"table": "true"}, expected: "table"},
+		{name: "Non-interactive alias", flags: map[string]string{"non-interactive": "true"}, expected: "table"},
+		{name: "JSON wins over CSV", flags: map[string]string{"json": "true", "csv": "true"}, expected: "json"},
+		{name: "Output flag", flags: map[string]string{"output": "yaml"}, expected: "yaml"},
+		{name: "Output flag ignores case", flags: map[string]string{"output": "Markdown"}, expected: "markdown"},
+		{name: "Output flag wins over shorthands", flags: map[string]string{"output": "ndjson", "table": "true"}, expected: "ndjson"},
+		{name: "jq wins over output", flags: map[string]string{"jq": ".[]", "output": "yaml"}, expected: "jq"},
+		{name: "Go template wins over everything", flags: map[string]string{"go-template": "{{.ID}}", "output": "yaml"}, expected: "go-template"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts OutputOptions
+			cmd := &cobra.Command{}
+			AddOutputFlags(cmd, &opts)
+
+			for name, value := range tc.flags {
+				if err := cmd.Flags().Set(name, value); err != nil {
+					t.Fatalf("expected no error setting %s, got %v", name, err)
+				}
+			}
+
+			if actual := ResolveFormat(opts); actual != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestOutputFlagRejectsUnknownFormats(t *testing.T) {
+	var opts OutputOptions
+	cmd := &cobra.Command{}
+	AddOutputFlags(cmd, &opts)
+
+	err := cmd.Flags().Set("output", "xml")
+	if err == nil || !strings.Contains(err.Error(), "must be one of table, csv, markdown, json, ndjson, yaml") {
+		t.Fatalf("expected the valid formats to be listed, got %v", err)
+	}
+}
+
+func TestOutputShorthandTakesANumberAsTheDeprecatedOffset(t *testing.T) {
+	var opts OutputOptions
+	var offset int
+	var stderr bytes.Buffer
+	cmd := &cobra.Command{Run: func(*cobra.Command, []string) {}}
+	cmd.SetErr(&stderr)
+	AddOutputFlags(cmd, &opts)
+	cmd.Flags().IntVar(&offset, "offset", 0, "")
+
+	cmd.SetArgs([]string{"-o", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.Format != "json" {
+		t.Fatalf("expected -o json to set the format, got %q", opts.Format)
+	}
+
+	cmd.SetArgs([]string{"-o", "20"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if offset != 20 || !cmd.Flags().Changed("offset") {
+		t.Fatalf("expected -o 20 to set --offset, got %d", offset)
+	}
+	if !strings.Contains(stderr.String(), "use --offset instead") {
+		t.Fatalf("expected a deprecation warning, got %q", stderr.String())
+	}
+}
+
+func TestRenderListRendersEveryFormat(t *testing.T) {
+	workspaces := []model.Workspace{{ID: "1", Title: "Lab"}}
+
+	for _, format := range OutputFormats {
+		var b bytes.Buffer
+		if err := RenderList(OutputOptions{Format: format.Name}, workspaces, "ID,Title", &b); err != nil {
+			t.Fatalf("expected no error rendering %s, got %v", format.Name, err)
+		}
+		if !strings.Contains(b.String(), "Lab") {
+			t.Fatalf("expected %s output to contain the workspace, got %s", format.Name, b.String())
+		}
+	}
+
+	if err := RenderList(OutputOptions{Format: "xml"}, workspaces, "ID", &bytes.Buffer{}); err == nil {
+		t.Fatalf("expected an error for an unknown format")
+	}
+}
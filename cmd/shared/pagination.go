@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/spf13/cobra"
+)
+
+// AddAllFlag registers --all on a list command, describing the records it
+// fetches as noun (e.g. "projects").
+func AddAllFlag(cmd *cobra.Command, all *bool, noun string) {
+	cmd.Flags().BoolVar(all, "all", false, fmt.Sprintf("Fetch all %s, paging through the results; ignores --limit and --offset", noun))
+}
+
+// OptionalLimit returns the limit to request on list commands that, by
+// default, show everything the endpoint returns rather than a page of it: 0,
+// which sends no paging parameters, unless --limit or --offset was given.
+func OptionalLimit(limit, offset int) int {
+	if limit == 0 && offset > 0 {
+		return client.DefaultRecordLimit
+	}
+	return limit
+}
+
+// FetchList returns the page of results selected by --limit and --offset, or
+// every result across all pages when --all is set. Either way the caller gets
+// back a single response to render.
+func FetchList[T any, P client.Pageable[T]](ctx context.Context, all bool, limit, offset int, fetch client.PageFetcher[P]) (P, error) {
+	if all {
+		return client.FetchAll(ctx, fetch)
+	}
+	return fetch(ctx, limit, offset)
+}
@@ -0,0 +1,53 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+)
+
+func TestFetchListRequestsASinglePageByDefault(t *testing.T) {
+	var calls [][2]int
+	fetch := func(_ context.Context, limit, offset int) (*client.ListWorkspacesResponse, error) {
+		calls = append(calls, [2]int{limit, offset})
+		return &client.ListWorkspacesResponse{Results: make([]model.Workspace, limit)}, nil
+	}
+
+	response, err := FetchList(context.Background(), false, 5, 10, fetch)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(calls) != 1 || calls[0] != [2]int{5, 10} {
+		t.Fatalf("expected a single call with limit 5 offset 10, got %v", calls)
+	}
+	if len(response.Results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(response.Results))
+	}
+}
+
+func TestFetchListWithAllIgnoresLimitAndOffset(t *testing.T) {
+	var offsets []int
+	fetch := func(_ context.Context, limit, offset int) (*client.ListWorkspacesResponse, error) {
+		offsets = append(offsets, offset)
+		n := limit
+		if offset > 0 {
+			n = 3
+		}
+		return &client.ListWorkspacesResponse{Results: make([]model.Workspace, n)}, nil
+	}
+
+	response, err := FetchList(context.Background(), true, 5, 10, fetch)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(offsets) != 2 || offsets[0] != 0 || offsets[1] != client.DefaultRecordLimit {
+		t.Fatalf("expected offsets [0 %d], got %v", client.DefaultRecordLimit, offsets)
+	}
+	if len(response.Results) != client.DefaultRecordLimit+3 {
+		t.Fatalf("expected %d results, got %d", client.DefaultRecordLimit+3, len(response.Results))
+	}
+}
@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"github.com/prolific-oss/cli/ui"
+	"github.com/spf13/cobra"
+)
+
+// QueryOptions holds the --filter and --sort flags for list commands.
+type QueryOptions struct {
+	Filter string
+	Sort   string
+}
+
+// filterFlag is the value of --filter. The expression is parsed when the flag
+// is set, so a syntax error is reported before anything is sent to the API;
+// the fields it names are checked once the type of the results is known.
+type filterFlag string
+
+func (v *filterFlag) String() string { return string(*v) }
+
+func (v *filterFlag) Type() string { return "expression" }
+
+func (v *filterFlag) Set(s string) error {
+	if _, err := ui.ParseFilter[any](s); err != nil {
+		return err
+	}
+	*v = filterFlag(s)
+	return nil
+}
+
+// sortFlag is the value of --sort.
+type sortFlag string
+
+func (v *sortFlag) String() string { return string(*v) }
+
+func (v *sortFlag) Type() string { return "fields" }
+
+func (v *sortFlag) Set(s string) error {
+	if _, err := ui.ParseSort[any](s); err != nil {
+		return err
+	}
+	*v = sortFlag(s)
+	return nil
+}
+
+// AddQueryFlags registers --filter and --sort on a list command. They apply to
+// the results that were fetched, so combine them with --all to query every
+// record rather than a single page.
+func AddQueryFlags(cmd *cobra.Command, opts *QueryOptions) {
+	cmd.Flags().Var((*filterFlag)(&opts.Filter), "filter", `Only show results matching an expression, e.g. 'Reward >= 500 && StudyType == "SINGLE"'`)
+	cmd.Flags().Var((*sortFlag)(&opts.Sort), "sort", "Sort results by a comma separated list of fields, prefix a field with - for descending order, e.g. -DateCreated,Name")
+}
+
+// ApplyQuery filters and then sorts items as opts asks, before they are
+// rendered. Without either flag the items are returned unchanged.
+func ApplyQuery[T any](opts QueryOptions, items []T) ([]T, error) {
+	if opts.Filter != "" {
+		filtered, err := ui.FilterItems(items, opts.Filter)
+		if err != nil {
+			return nil, err
+		}
+		items = filtered
+	}
+
+	if opts.Sort != "" {
+		if err := ui.SortItems(items, opts.Sort); err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
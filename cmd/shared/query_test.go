@@ -0,0 +1,51 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestQueryFlagsRejectInvalidExpressions(t *testing.T) {
+	var opts QueryOptions
+	cmd := &cobra.Command{}
+	AddQueryFlags(cmd, &opts)
+
+	if err := cmd.Flags().Set("filter", "Reward >="); err == nil {
+		t.Fatal("expected an error for an unfinished filter")
+	}
+	if err := cmd.Flags().Set("sort", ","); err == nil {
+		t.Fatal("expected an error for a sort without fields")
+	}
+	if opts.Filter != "" || opts.Sort != "" {
+		t.Fatalf("expected the flags to be left unset, got %+v", opts)
+	}
+
+	if err := cmd.Flags().Set("filter", `Reward >= 500 && StudyType == "SINGLE"`); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := cmd.Flags().Set("sort", "-DateCreated,Name"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestApplyQuery(t *testing.T) {
+	type item struct {
+		Name  string
+		Count int
+	}
+	items := []item{{"b", 1}, {"a", 3}, {"c", 2}}
+
+	got, err := ApplyQuery(QueryOptions{Filter: "Count > 1", Sort: "Name"}, items)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 2 || got[0].Name != "a" || got[1].Name != "c" {
+		t.Fatalf("expected a then c, got %+v", got)
+	}
+
+	got, err = ApplyQuery(QueryOptions{}, items)
+	if err != nil || len(got) != 3 || got[0].Name != "b" {
+		t.Fatalf("expected the items unchanged, got %+v, %v", got, err)
+	}
+}
@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/prolific-oss/cli/ui"
+	"github.com/spf13/cobra"
+)
+
+// RecordOutputFormats are the formats accepted by --output on commands that
+// show, create or change a resource, rather than list them.
+var RecordOutputFormats = []OutputFormat{
+	{Name: "json", Description: "Indented JSON"},
+	{Name: "ndjson", Description: "JSON on a single line"},
+	{Name: "yaml", Description: "YAML"},
+}
+
+// RecordTableOutputFormats are the formats accepted by --output on commands
+// whose result can also be shown as a table or as CSV.
+var RecordTableOutputFormats = append([]OutputFormat{
+	{Name: "table", Description: "Tab-aligned table"},
+	{Name: "csv", Description: "Comma separated values"},
+}, RecordOutputFormats...)
+
+// AddRecordOutputFlags registers the structured output flags on a command that
+// shows, creates or changes a resource: --output / -o, --json / -j, --go-template and
+// --jq. Without any of them the command prints its usual text.
+// Commands that already use --json or -j for an input keep it, and take
+// --output json instead.
+func AddRecordOutputFlags(cmd *cobra.Command, opts *OutputOptions) {
+	addRecordOutputFlags(cmd, opts, RecordOutputFormats)
+}
+
+// AddRecordTableOutputFlags registers the same flags as AddRecordOutputFlags,
+// but --output also accepts table and csv, with --table / -t and --csv / -c as
+// shorthands for them. The command renders those two formats itself, as
+// ResolveFormat reports, and the others with RenderRecord.
+func AddRecordTableOutputFlags(cmd *cobra.Command, opts *OutputOptions) {
+	addRecordOutputFlags(cmd, opts, RecordTableOutputFormats)
+
+	cmd.Flags().BoolVarP(&opts.Table, "table", "t", false, "Output as a table, the same as --output table")
+	cmd.Flags().BoolVarP(&opts.Csv, "csv", "c", false, "Output as CSV, the same as --output csv")
+}
+
+func addRecordOutputFlags(cmd *cobra.Command, opts *OutputOptions, formats []OutputFormat) {
+	addFormatFlag(cmd, &opts.Format, formats, "default: text")
+	AddTemplateFlag(cmd, &opts.GoTemplate)
+	AddJQFlag(cmd, &opts.JQ)
+
+	switch {
+	case cmd.Flags().Lookup("json") != nil:
+		return
+	case cmd.Flags().ShorthandLookup("j") == nil:
+		cmd.Flags().BoolVarP(&opts.Json, "json", "j", false, "Output as JSON, the same as --output json")
+	default:
+		cmd.Flags().BoolVar(&opts.Json, "json", false, "Output as JSON, the same as --output json")
+	}
+}
+
+// IsStructured reports whether one of the structured output flags was given,
+// so the command should render its result with RenderRecord or RenderList
+// instead of its usual text.
+func (o OutputOptions) IsStructured() bool {
+	return ResolveFormat(o) != ""
+}
+
+// RenderRecord writes a single resource to w in the format chosen by opts.
+func RenderRecord[T any](opts OutputOptions, item T, w io.Writer) error {
+	switch format := ResolveFormat(opts); format {
+	case "go-template":
+		return ui.RenderTemplate(opts.GoTemplate, item, w)
+	case "jq":
+		return ui.RenderJQ(opts.JQ, item, w)
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(item)
+	case "ndjson":
+		return json.NewEncoder(w).Encode(item)
+	case "yaml":
+		return ui.RenderYAML(item, w)
+	default:
+		return fmt.Errorf("unknown output format %q, must be one of %s", format, strings.Join(formatNames(RecordOutputFormats), ", "))
+	}
+}
@@ -0,0 +1,131 @@
+package shared
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+type testRecord struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+func TestRenderRecordRendersEveryFormat(t *testing.T) {
+	record := testRecord{ID: "1234", Status: "ACTIVE"}
+
+	tt := []struct {
+		name     string
+		flags    map[string]string
+		expected string
+	}{
+		{name: "json", flags: map[string]string{"json": "true"}, expected: "{\n  \"id\": \"1234\",\n  \"status\": \"ACTIVE\"\n}\n"},
+		{name: "ndjson", flags: map[string]string{"output": "ndjson"}, expected: "{\"id\":\"1234\",\"status\":\"ACTIVE\"}\n"},
+		{name: "yaml", flags: map[string]string{"output": "yaml"}, expected: "id: \"1234\"\nstatus: ACTIVE\n"},
+		{name: "jq", flags: map[string]string{"jq": ".status"}, expected: "ACTIVE\n"},
+		{name: "go-template", flags: map[string]string{"go-template": "{{.ID}}"}, expected: "1234\n"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts OutputOptions
+			cmd := &cobra.Command{}
+			AddRecordOutputFlags(cmd, &opts)
+
+			for name, value := range tc.flags {
+				if err := cmd.Flags().Set(name, value); err != nil {
+					t.Fatalf("expected no error setting %s, got %v", name, err)
+				}
+			}
+
+			if !opts.IsStructured() {
+				t.Fatal("expected the output to be structured")
+			}
+
+			var b bytes.Buffer
+			if err := RenderRecord(opts, record, &b); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if b.String() != tc.expected {
+				t.Fatalf("expected\n%q\ngot\n%q", tc.expected, b.String())
+			}
+		})
+	}
+}
+
+func TestRecordOutputFlagsAreTextByDefault(t *testing.T) {
+	var opts OutputOptions
+	cmd := &cobra.Command{}
+	AddRecordOutputFlags(cmd, &opts)
+
+	if opts.IsStructured() {
+		t.Fatal("expected the output to be text without any flags")
+	}
+}
+
+func TestRecordOutputFlagsOnlyAcceptRecordFormats(t *testing.T) {
+	var opts OutputOptions
+	cmd := &cobra.Command{}
+	AddRecordOutputFlags(cmd, &opts)
+
+	err := cmd.Flags().Set("output", "csv")
+	if err == nil || !strings.Contains(err.Error(), "must be one of json, ndjson, yaml") {
+		t.Fatalf("expected an invalid format error, got %v", err)
+	}
+}
+
+func TestRecordOutputFlagsKeepExistingJSONFlags(t *testing.T) {
+	t.Run("shorthand taken", func(t *testing.T) {
+		var opts OutputOptions
+		var jobs int
+		cmd := &cobra.Command{}
+		cmd.Flags().IntVarP(&jobs, "jobs", "j", 1, "")
+		AddRecordOutputFlags(cmd, &opts)
+
+		if f := cmd.Flags().Lookup("json"); f == nil || f.Shorthand != "" {
+			t.Fatalf("expected --json without a shorthand, got %v", f)
+		}
+	})
+
+	t.Run("flag taken", func(t *testing.T) {
+		var opts OutputOptions
+		var input string
+		cmd := &cobra.Command{}
+		cmd.Flags().StringVarP(&input, "json", "j", "", "")
+		AddRecordOutputFlags(cmd, &opts)
+
+		if err := cmd.Flags().Set("output", "json"); err != nil {
+			t.Fatalf("expected --output json to be available, got %v", err)
+		}
+		if ResolveFormat(opts) != "json" {
+			t.Fatalf("expected json, got %q", ResolveFormat(opts))
+		}
+	})
+}
+
+func TestRecordTableOutputFlagsAcceptTableAndCSV(t *testing.T) {
+	for flag, expected := range map[string]string{"table": "table", "csv": "csv"} {
+		var opts OutputOptions
+		cmd := &cobra.Command{}
+		AddRecordTableOutputFlags(cmd, &opts)
+
+		if err := cmd.Flags().Set(flag, "true"); err != nil {
+			t.Fatalf("expected --%s to be available, got %v", flag, err)
+		}
+		if ResolveFormat(opts) != expected {
+			t.Fatalf("expected %s, got %q", expected, ResolveFormat(opts))
+		}
+	}
+
+	var opts OutputOptions
+	cmd := &cobra.Command{}
+	AddRecordTableOutputFlags(cmd, &opts)
+	if err := cmd.Flags().Set("output", "table"); err != nil {
+		t.Fatalf("expected --output table to be accepted, got %v", err)
+	}
+	if !opts.IsStructured() {
+		t.Fatal("expected --output table to be structured")
+	}
+}
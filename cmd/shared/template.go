@@ -0,0 +1,29 @@
+package shared
+
+import (
+	"github.com/prolific-oss/cli/ui"
+	"github.com/spf13/cobra"
+)
+
+// templateFlag is the value of --go-template. The template is parsed when the
+// flag is set, so a mistake in it is reported before anything is sent to the
+// API.
+type templateFlag string
+
+func (v *templateFlag) String() string { return string(*v) }
+
+func (v *templateFlag) Type() string { return "template" }
+
+func (v *templateFlag) Set(s string) error {
+	if _, err := ui.ParseTemplate(s); err != nil {
+		return err
+	}
+	*v = templateFlag(s)
+	return nil
+}
+
+// AddTemplateFlag registers --go-template on a command, to render what it
+// returns through a Go template instead of the usual output.
+func AddTemplateFlag(cmd *cobra.Command, tmpl *string) {
+	cmd.Flags().Var((*templateFlag)(tmpl), "go-template", `Render the result with a Go template, e.g. '{{.ID}}'; the money, date, join, json, upper and lower functions are available`)
+}
@@ -0,0 +1,429 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// IncludeKey is the key that includes another file in a template file. An
+// object with it is replaced by the included file; any other keys in the
+// object override the included ones. In a list, an included list is spliced
+// in.
+const IncludeKey = "$include"
+
+// TemplateFileOptions are the variables for a template file, given with --var
+// and --var-file.
+type TemplateFileOptions struct {
+	Vars     []string
+	VarFiles []string
+}
+
+// prolificPlaceholder matches the placeholders Prolific fills in study URLs,
+// such as {{%PROLIFIC_PID%}}, which are kept as they are.
+var prolificPlaceholder = regexp.MustCompile(`\{\{%[^%]*%\}\}`)
+
+// envReference matches ${NAME} and ${NAME:-default}. $${NAME} is left as
+// ${NAME}.
+var envReference = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(:-[^}]*)?\}`)
+
+// templateReferencePattern matches a template action or an environment
+// variable.
+var templateReferencePattern = regexp.MustCompile(`(?s)\{\{.*?\}\}|\$?\$\{[A-Za-z_][A-Za-z0-9_]*(?::-[^}]*)?\}`)
+
+// templatePlaceholder stands in for a template action or environment variable
+// while a file is parsed, so their values are only ever filled into the
+// file's strings and cannot change its structure.
+var templatePlaceholder = regexp.MustCompile(`__PROLIFIC_TEMPLATE_(\d+)__`)
+
+// AddTemplateFileFlags registers --var and --var-file on a command that reads
+// a JSON/YAML template file.
+func AddTemplateFileFlags(cmd *cobra.Command, opts *TemplateFileOptions) {
+	cmd.Flags().StringArrayVar(&opts.Vars, "var", nil, "Set a template variable, used as {{ .Vars.key }}, e.g. --var reward=150; can be repeated")
+	cmd.Flags().StringArrayVar(&opts.VarFiles, "var-file", nil, "Read template variables from a JSON/YAML file; --var takes precedence; can be repeated")
+}
+
+// TemplateFile is a template file that has been read by LoadTemplateFile.
+type TemplateFile struct {
+	Path string
+	// Parsed is the text that was parsed: the file with a placeholder for
+	// each template action and environment variable, so its lines match the
+	// file's.
+	Parsed []byte
+	// Content is the file's object, with its variables filled in and its
+	// includes resolved.
+	Content map[string]any
+}
+
+// ReadTemplateFile reads a JSON or YAML template file, such as a study file,
+// ready to unmarshal into a model. See LoadTemplateFile for how it is read.
+func ReadTemplateFile(path string, opts TemplateFileOptions) (*viper.Viper, error) {
+	file, err := LoadTemplateFile(path, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	v := viper.New()
+	if err := v.MergeConfigMap(file.Content); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// LoadTemplateFile reads and parses a JSON or YAML template file.
+//
+// Once the file is parsed, ${NAME} in its strings is replaced with the
+// environment variable NAME, or ${NAME:-default} with a default when it is
+// unset; ${NAME} is kept as it is when NAME is unset and has no default. Then
+// {{ .Vars.key }} is filled from the variables in opts. Values are
+// only filled into strings, so they cannot add keys or break the file's
+// syntax; a whole, unquoted value that is a number or boolean keeps that type.
+// $${NAME} and {{"{{"}} escape a literal ${NAME} and {{, and text such as
+// {{name}} that is not a valid template is kept as it is. Objects with an
+// $include key are then replaced by the file it names, relative to the file
+// including it, which is read the same way.
+func LoadTemplateFile(path string, opts TemplateFileOptions) (*TemplateFile, error) {
+	vars, err := templateVars(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	r := templateReader{vars: vars}
+	content, parsed, err := r.read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := content.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must contain an object", path)
+	}
+
+	return &TemplateFile{Path: path, Parsed: parsed, Content: object}, nil
+}
+
+// templateVars merges the variable files in order, then the --var values.
+func templateVars(opts TemplateFileOptions) (map[string]any, error) {
+	vars := map[string]any{}
+
+	for _, path := range opts.VarFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var fileVars map[string]any
+		if err := yaml.Unmarshal(data, &fileVars); err != nil {
+			return nil, fmt.Errorf("unable to parse variable file %s: %w", path, err)
+		}
+		maps.Copy(vars, fileVars)
+	}
+
+	for _, v := range opts.Vars {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable %q, expected key=value", v)
+		}
+		vars[key] = value
+	}
+
+	return vars, nil
+}
+
+type templateReader struct {
+	vars map[string]any
+	// including is the chain of files being read, to report include cycles.
+	including []string
+}
+
+// read reads and parses a template file, filling in its variables and
+// resolving its includes. It also returns the text that was parsed.
+func (r *templateReader) read(path string) (any, []byte, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if slices.Contains(r.including, abs) {
+		return nil, nil, fmt.Errorf("%s includes itself: %s", path, strings.Join(append(r.including, abs), " -> "))
+	}
+	r.including = append(r.including, abs)
+	defer func() { r.including = r.including[:len(r.including)-1] }()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+	data, references := mask(data, ext == ".json")
+
+	var content any
+	switch ext {
+	case ".json":
+		err = json.Unmarshal(data, &content)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &content)
+	default:
+		return nil, nil, fmt.Errorf("unsupported file type %q for %s, expected .json, .yaml or .yml", ext, path)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+
+	f := templateFiller{path: path, vars: r.vars, references: references}
+	content, err = f.fill(content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err = r.resolve(filepath.Dir(path), content)
+	return content, data, err
+}
+
+// templateReference is a template action or environment variable in a file,
+// replaced by a placeholder while the file is parsed.
+type templateReference struct {
+	text string
+	// whole is whether it is a whole, unquoted value, which keeps its type
+	// when it is a number or boolean.
+	whole bool
+}
+
+// mask replaces the template actions and environment variables in a file with
+// placeholders. In a JSON file, placeholders outside a string are quoted.
+func mask(data []byte, quote bool) ([]byte, []templateReference) {
+	text := string(data)
+
+	var masked strings.Builder
+	var references []templateReference
+	last := 0
+	for _, match := range templateReferencePattern.FindAllStringIndex(text, -1) {
+		start, end := match[0], match[1]
+		reference := text[start:end]
+		if prolificPlaceholder.MatchString(reference) || strings.HasPrefix(reference, "$$") {
+			continue
+		}
+
+		whole := isWholeValue(text, start, end)
+		placeholder := fmt.Sprintf("__PROLIFIC_TEMPLATE_%d__", len(references))
+		if whole && quote {
+			placeholder = strconv.Quote(placeholder)
+		}
+		references = append(references, templateReference{text: reference, whole: whole})
+
+		masked.WriteString(text[last:start])
+		masked.WriteString(placeholder)
+		last = end
+	}
+	masked.WriteString(text[last:])
+
+	return []byte(masked.String()), references
+}
+
+// isWholeValue reports whether text[start:end] is a value on its own, such as
+// `reward: {{ .Vars.reward }}`, rather than part of a string.
+func isWholeValue(text string, start, end int) bool {
+	lineStart := strings.LastIndexByte(text[:start], '\n') + 1
+	before := strings.TrimSpace(text[lineStart:start])
+
+	lineEnd := strings.IndexByte(text[end:], '\n')
+	if lineEnd < 0 {
+		lineEnd = len(text) - end
+	}
+	after := strings.TrimSpace(text[end : end+lineEnd])
+
+	return (before == "" || before == "-" || strings.ContainsAny(before[len(before)-1:], ":[{,")) &&
+		(after == "" || strings.ContainsAny(after[:1], ",]}#"))
+}
+
+// templateFiller fills in the variables in the strings of a parsed file.
+type templateFiller struct {
+	path       string
+	vars       map[string]any
+	references []templateReference
+}
+
+func (f *templateFiller) fill(content any) (any, error) {
+	switch v := content.(type) {
+	case map[string]any:
+		filled := make(map[string]any, len(v))
+		for key, value := range v {
+			value, err := f.fill(value)
+			if err != nil {
+				return nil, err
+			}
+			filled[f.unmask(key)] = value
+		}
+		return filled, nil
+	case []any:
+		for i, value := range v {
+			value, err := f.fill(value)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = value
+		}
+		return v, nil
+	case string:
+		return f.fillString(v)
+	}
+	return content, nil
+}
+
+// fillString fills in a string: first its environment variables, then its
+// template actions, so neither is applied to the other's values.
+func (f *templateFiller) fillString(s string) (any, error) {
+	if !templatePlaceholder.MatchString(s) && !strings.Contains(s, "$${") {
+		return s, nil
+	}
+
+	whole := false
+	if match := templatePlaceholder.FindStringSubmatch(s); match != nil && match[0] == s {
+		i, _ := strconv.Atoi(match[1])
+		whole = f.references[i].whole
+	}
+
+	text := envReference.ReplaceAllStringFunc(f.unmask(s), func(reference string) string {
+		if strings.HasPrefix(reference, "$$") {
+			return reference[1:]
+		}
+
+		match := envReference.FindStringSubmatch(reference)
+		if value, ok := os.LookupEnv(match[1]); ok {
+			return value
+		}
+		if match[2] != "" {
+			return strings.TrimPrefix(match[2], ":-")
+		}
+		return reference
+	})
+
+	text, err := f.render(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if whole {
+		var value any
+		if err := yaml.Unmarshal([]byte(text), &value); err == nil {
+			switch value.(type) {
+			case int, float64, bool:
+				return value, nil
+			}
+		}
+	}
+	return text, nil
+}
+
+// render fills in the template actions in a string. A string that is not a
+// valid template, such as one with a literal {{name}}, is kept as it is.
+func (f *templateFiller) render(text string) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+
+	protected := prolificPlaceholder.ReplaceAllStringFunc(text, func(placeholder string) string {
+		return "{{" + strconv.Quote(placeholder) + "}}"
+	})
+
+	tmpl, err := template.New(filepath.Base(f.path)).Option("missingkey=error").Parse(protected)
+	if err != nil {
+		return text, nil
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, map[string]any{"Vars": f.vars}); err != nil {
+		return "", fmt.Errorf("unable to fill in %s: %w", f.path, err)
+	}
+	return rendered.String(), nil
+}
+
+// unmask puts back the template actions and environment variables a
+// placeholder stands in for.
+func (f *templateFiller) unmask(s string) string {
+	return templatePlaceholder.ReplaceAllStringFunc(s, func(placeholder string) string {
+		i, _ := strconv.Atoi(templatePlaceholder.FindStringSubmatch(placeholder)[1])
+		return f.references[i].text
+	})
+}
+
+// resolve replaces the includes in content, relative to dir.
+func (r *templateReader) resolve(dir string, content any) (any, error) {
+	switch v := content.(type) {
+	case map[string]any:
+		include, ok := v[IncludeKey]
+		if !ok {
+			for key, value := range v {
+				resolved, err := r.resolve(dir, value)
+				if err != nil {
+					return nil, err
+				}
+				v[key] = resolved
+			}
+			return v, nil
+		}
+
+		name, ok := include.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a file path, got %v", IncludeKey, include)
+		}
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(dir, name)
+		}
+		included, _, err := r.read(name)
+		if err != nil {
+			return nil, err
+		}
+
+		delete(v, IncludeKey)
+		if len(v) == 0 {
+			return included, nil
+		}
+
+		base, ok := included.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must contain an object to be included alongside other keys", name)
+		}
+		for key, value := range v {
+			resolved, err := r.resolve(dir, value)
+			if err != nil {
+				return nil, err
+			}
+			base[key] = resolved
+		}
+		return base, nil
+
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, item := range v {
+			object, _ := item.(map[string]any)
+			_, isInclude := object[IncludeKey]
+
+			resolved, err := r.resolve(dir, item)
+			if err != nil {
+				return nil, err
+			}
+
+			if list, ok := resolved.([]any); ok && isInclude {
+				items = append(items, list...)
+			} else {
+				items = append(items, resolved)
+			}
+		}
+		return items, nil
+	}
+
+	return content, nil
+}
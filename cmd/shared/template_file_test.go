@@ -0,0 +1,216 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeTemplateFiles writes the files into a temporary directory and returns
+// it.
+func writeTemplateFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatalf("failed to create directory: %s", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to create %s: %s", name, err)
+		}
+	}
+	return dir
+}
+
+func TestReadTemplateFileFillsInVariables(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"study.yaml": `name: {{ .Vars.name }}
+external_study_url: https://example.com/{{ .Vars.path }}?PROLIFIC_PID={{%PROLIFIC_PID%}}
+reward: {{ .Vars.reward }}
+total_available_places: {{ .Vars.places }}
+`,
+		"vars.yaml": "name: Pilot\nreward: 100\nplaces: 10\npath: pilot\n",
+	})
+
+	v, err := ReadTemplateFile(filepath.Join(dir, "study.yaml"), TemplateFileOptions{
+		VarFiles: []string{filepath.Join(dir, "vars.yaml")},
+		Vars:     []string{"reward=150", "path=main/a=b"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if v.GetString("name") != "Pilot" {
+		t.Errorf("expected name from the variable file, got %q", v.GetString("name"))
+	}
+	if v.GetInt("reward") != 150 {
+		t.Errorf("expected --var to take precedence, got %v", v.Get("reward"))
+	}
+	if v.GetInt("total_available_places") != 10 {
+		t.Errorf("expected places to be a number, got %v", v.Get("total_available_places"))
+	}
+	expected := "https://example.com/main/a=b?PROLIFIC_PID={{%PROLIFIC_PID%}}"
+	if v.GetString("external_study_url") != expected {
+		t.Errorf("expected %s, got %s", expected, v.GetString("external_study_url"))
+	}
+}
+
+func TestReadTemplateFileErrorsOnAMissingVariable(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{"study.json": `{"reward": {{ .Vars.reward }}}`})
+
+	_, err := ReadTemplateFile(filepath.Join(dir, "study.json"), TemplateFileOptions{})
+	if err == nil || !strings.Contains(err.Error(), `map has no entry for key "reward"`) {
+		t.Fatalf("expected a missing variable error, got %v", err)
+	}
+}
+
+func TestReadTemplateFileErrorsOnAnInvalidVariable(t *testing.T) {
+	_, err := ReadTemplateFile("study.json", TemplateFileOptions{Vars: []string{"reward"}})
+	if err == nil || err.Error() != `invalid variable "reward", expected key=value` {
+		t.Fatalf("expected an invalid variable error, got %v", err)
+	}
+}
+
+func TestReadTemplateFileInterpolatesEnvironmentVariables(t *testing.T) {
+	t.Setenv("STUDY_URL", "https://example.com/study")
+	dir := writeTemplateFiles(t, map[string]string{
+		"study.yaml": `external_study_url: ${STUDY_URL}
+description: ${DESCRIPTION:-A study}
+internal_name: $${NOT_INTERPOLATED}
+`,
+	})
+
+	v, err := ReadTemplateFile(filepath.Join(dir, "study.yaml"), TemplateFileOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"external_study_url": "https://example.com/study",
+		"description":        "A study",
+		"internal_name":      "${NOT_INTERPOLATED}",
+	}
+	for key, value := range expected {
+		if v.GetString(key) != value {
+			t.Errorf("expected %s to be %q, got %q", key, value, v.GetString(key))
+		}
+	}
+}
+
+func TestReadTemplateFileKeepsUnsetEnvironmentVariables(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{"study.yaml": "name: ${PROLIFIC_TEST_UNSET}\ndescription: Batch ${PROLIFIC_TEST_UNSET}\n"})
+
+	v, err := ReadTemplateFile(filepath.Join(dir, "study.yaml"), TemplateFileOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.GetString("name") != "${PROLIFIC_TEST_UNSET}" || v.GetString("description") != "Batch ${PROLIFIC_TEST_UNSET}" {
+		t.Fatalf("expected the unset variable to be kept, got %q and %q", v.GetString("name"), v.GetString("description"))
+	}
+}
+
+func TestReadTemplateFileFillsVariablesIntoStringsOnly(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"study.json": `{"description": {{ .Vars.description }}, "name": "Wave {{ .Vars.description }}", "reward": 100}`,
+		"study.yaml": "description: {{ .Vars.description }}\nname: Wave {{ .Vars.description }}\nreward: 100\n",
+	})
+	description := "Say \"hi\" \\ wave\nreward: 1"
+
+	for _, name := range []string{"study.json", "study.yaml"} {
+		v, err := ReadTemplateFile(filepath.Join(dir, name), TemplateFileOptions{Vars: []string{"description=" + description}})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if v.GetString("description") != description {
+			t.Errorf("%s: expected description %q, got %q", name, description, v.GetString("description"))
+		}
+		if v.GetString("name") != "Wave "+description {
+			t.Errorf("%s: expected name %q, got %q", name, "Wave "+description, v.GetString("name"))
+		}
+		if v.GetInt("reward") != 100 {
+			t.Errorf("%s: expected the reward to be unchanged, got %v", name, v.Get("reward"))
+		}
+	}
+}
+
+func TestReadTemplateFileKeepsLiteralText(t *testing.T) {
+	t.Setenv("PROLIFIC_TEST_NAME", "Pilot")
+	dir := writeTemplateFiles(t, map[string]string{
+		"study.yaml": `name: ${PROLIFIC_TEST_NAME}
+description: Hello {{name}}, costs $${PRICE}
+internal_name: "{{"{{"}} .Vars.name }}"
+`,
+	})
+
+	v, err := ReadTemplateFile(filepath.Join(dir, "study.yaml"), TemplateFileOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"name":          "Pilot",
+		"description":   "Hello {{name}}, costs ${PRICE}",
+		"internal_name": "{{ .Vars.name }}",
+	}
+	for key, value := range expected {
+		if v.GetString(key) != value {
+			t.Errorf("expected %s %q, got %q", key, value, v.GetString(key))
+		}
+	}
+}
+
+func TestReadTemplateFileIncludesFragments(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"studies/study.yaml": `$include: ../shared/base.json
+name: Main
+filters:
+  - $include: ../shared/filters.yaml
+  - filter_id: handedness
+    selected_values: ["0"]
+completion_codes:
+  $include: ../shared/codes.yaml
+`,
+		"shared/base.json":    `{"name": "Base", "reward": {{ .Vars.reward }}}`,
+		"shared/filters.yaml": "- filter_id: age\n  selected_range: {lower: 18, upper: 30}\n- filter_id: sex\n  selected_values: [\"1\"]\n",
+		"shared/codes.yaml":   "- code: ABC123\n  code_type: COMPLETED\n",
+	})
+
+	v, err := ReadTemplateFile(filepath.Join(dir, "studies", "study.yaml"), TemplateFileOptions{Vars: []string{"reward=200"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if v.GetString("name") != "Main" || v.GetInt("reward") != 200 {
+		t.Errorf("expected the base to be included and overridden, got %v", v.AllSettings())
+	}
+
+	var filterIDs []string
+	for _, f := range v.Get("filters").([]any) {
+		filterIDs = append(filterIDs, f.(map[string]any)["filter_id"].(string))
+	}
+	if !reflect.DeepEqual(filterIDs, []string{"age", "sex", "handedness"}) {
+		t.Errorf("expected the included filters to be spliced in, got %v", filterIDs)
+	}
+
+	codes := v.Get("completion_codes").([]any)
+	if len(codes) != 1 || codes[0].(map[string]any)["code"] != "ABC123" {
+		t.Errorf("expected the completion codes to be included, got %v", codes)
+	}
+}
+
+func TestReadTemplateFileErrorsOnAnIncludeCycle(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"a.yaml": "$include: b.yaml\n",
+		"b.yaml": "$include: a.yaml\n",
+	})
+
+	_, err := ReadTemplateFile(filepath.Join(dir, "a.yaml"), TemplateFileOptions{})
+	if err == nil || !strings.Contains(err.Error(), "includes itself") {
+		t.Fatalf("expected an include cycle error, got %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestTemplateFlagRejectsInvalidTemplates(t *testing.T) {
+	var tmpl string
+	cmd := &cobra.Command{}
+	AddTemplateFlag(cmd, &tmpl)
+
+	err := cmd.Flags().Set("go-template", "{{.ID")
+	if err == nil || !strings.Contains(err.Error(), "unclosed action") {
+		t.Fatalf("expected an unclosed action error, got %v", err)
+	}
+	if tmpl != "" {
+		t.Fatalf("expected the template to be left unset, got %q", tmpl)
+	}
+
+	if err := cmd.Flags().Set("go-template", "{{.ID}} {{money .Reward .CurrencyCode}}"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if tmpl != "{{.ID}} {{money .Reward .CurrencyCode}}" {
+		t.Fatalf("expected the template to be set, got %q", tmpl)
+	}
+}
@@ -1,14 +1,15 @@
 package study
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // CreateOptions is the options for the creating a study command.
@@ -17,6 +18,8 @@ type CreateOptions struct {
 	TemplatePath string
 	Publish      bool
 	Silent       bool
+	TemplateFile shared.TemplateFileOptions
+	Output       shared.OutputOptions
 }
 
 // NewCreateCommand creates a new `study create` command to allow you to create
@@ -40,6 +43,13 @@ If you are using the CLI in other tooling, you may want to silence the returned
 output of the study creation, so you can use the "-s" flag.
 $ prolific study create -t /path/to/study.json -p -s
 
+Or print just what you need from the new study with a Go template
+$ prolific study create -t /path/to/study.json --go-template '{{.ID}}'
+
+The file can use variables, environment variables and include other files, so
+one file can be shared by studies that differ only in a few fields
+$ prolific study create -t /path/to/study.yaml --var places=20 --var-file wave-2.yaml
+
 An example of a JSON study file, with an ethnicity screener
 
 {
@@ -108,9 +118,9 @@ peripheral_requirements:
 				return fmt.Errorf("error: Can only create via a template YAML file at the moment")
 			}
 
-			err := createStudy(client, opts, w)
+			err := createStudy(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -118,17 +128,17 @@ peripheral_requirements:
 	}
 
 	flags := cmd.Flags()
-	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a YAML file containing your studies you want to create")
+	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a .json, .yaml or .yml file containing the study you want to create")
 	flags.BoolVarP(&opts.Publish, "publish", "p", false, "Publish the study once created.")
 	flags.BoolVarP(&opts.Silent, "silent", "s", false, "Silently create the study. It will not render the study once created.")
+	shared.AddTemplateFileFlags(cmd, &opts.TemplateFile)
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func createStudy(client client.API, opts CreateOptions, w io.Writer) error {
-	v := viper.New()
-	v.SetConfigFile(opts.TemplatePath)
-	err := v.ReadInConfig()
+func createStudy(ctx context.Context, client client.API, opts CreateOptions, w io.Writer) error {
+	v, err := shared.ReadTemplateFile(opts.TemplatePath, opts.TemplateFile)
 	if err != nil {
 		return err
 	}
@@ -136,26 +146,30 @@ func createStudy(client client.API, opts CreateOptions, w io.Writer) error {
 	var s model.CreateStudy
 	err = v.Unmarshal(&s)
 	if err != nil {
-		return fmt.Errorf("unable to map %s to study model: %s", opts.TemplatePath, err)
+		return fmt.Errorf("unable to map %s to study model: %w", opts.TemplatePath, err)
 	}
 
-	study, err := client.CreateStudy(s)
+	study, err := client.CreateStudy(ctx, s)
 	if err != nil {
 		return err
 	}
 
 	if opts.Publish {
-		_, err = client.TransitionStudy(study.ID, model.TransitionStudyPublish)
+		_, err = client.TransitionStudy(ctx, study.ID, model.TransitionStudyPublish)
 		if err != nil {
 			return err
 		}
 
-		study, err = client.GetStudy(study.ID)
+		study, err = client.GetStudy(ctx, study.ID)
 		if err != nil {
 			return err
 		}
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *study, w)
+	}
+
 	if !opts.Silent {
 		fmt.Fprintln(w, RenderStudy(*study))
 	}
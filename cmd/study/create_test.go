@@ -3,6 +3,7 @@ package study_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/client/fake"
 	"github.com/prolific-oss/cli/cmd/study"
 	"github.com/prolific-oss/cli/mock_client"
 	"github.com/prolific-oss/cli/model"
@@ -79,13 +81,13 @@ func TestCreateCommandHandlesFailureToReadConfig(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateStudy(gomock.Eq(studyTemplate)).
+		CreateStudy(gomock.Any(), gomock.Eq(studyTemplate)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
 	c.
 		EXPECT().
-		GetSubmissions(gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetSubmissions(gomock.Any(), gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&ls, nil).
 		AnyTimes()
 
@@ -149,13 +151,13 @@ func TestCreateCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateStudy(gomock.Eq(studyTemplate)).
+		CreateStudy(gomock.Any(), gomock.Eq(studyTemplate)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
 	c.
 		EXPECT().
-		GetSubmissions(gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetSubmissions(gomock.Any(), gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&ls, nil).
 		AnyTimes()
 
@@ -179,25 +181,25 @@ func TestCreateCommandCanPublish(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateStudy(gomock.Eq(studyTemplate)).
+		CreateStudy(gomock.Any(), gomock.Eq(studyTemplate)).
 		Return(&actualStudy, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		GetSubmissions(gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetSubmissions(gomock.Any(), gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&ls, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		TransitionStudy(gomock.Eq(actualStudy.ID), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq(actualStudy.ID), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&tsr, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(actualStudy.ID)).
+		GetStudy(gomock.Any(), gomock.Eq(actualStudy.ID)).
 		Return(&actualStudy, nil).
 		MaxTimes(1)
 
@@ -211,6 +213,37 @@ func TestCreateCommandCanPublish(t *testing.T) {
 	writer.Flush()
 }
 
+func TestCreateCommandRendersTheGoTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	created := actualStudy
+	created.ID = "63c123af913a974f87e8e7fc"
+
+	c.
+		EXPECT().
+		CreateStudy(gomock.Any(), gomock.Eq(studyTemplate)).
+		Return(&created, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	writer := bufio.NewWriter(&b)
+
+	cmd := study.NewCreateCommand(c, writer)
+	_ = cmd.Flags().Set("template-path", "../../docs/examples/standard-sample.json")
+	_ = cmd.Flags().Set("go-template", "{{.ID}}")
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	writer.Flush()
+
+	expected := "63c123af913a974f87e8e7fc\n"
+	if b.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, b.String())
+	}
+}
+
 func TestCommandFailsIfNoPathSpecified(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -237,7 +270,7 @@ func TestCreateCommandHandlesAnErrorFromTheAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateStudy(gomock.Eq(studyTemplate)).
+		CreateStudy(gomock.Any(), gomock.Eq(studyTemplate)).
 		Return(nil, fmt.Errorf("Whoopsie daisy")).
 		MaxTimes(1)
 
@@ -265,25 +298,25 @@ func TestCreateCommandCanHandleErrorsWhenGettingStudy(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateStudy(gomock.Eq(studyTemplate)).
+		CreateStudy(gomock.Any(), gomock.Eq(studyTemplate)).
 		Return(&actualStudy, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		GetSubmissions(gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetSubmissions(gomock.Any(), gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&ls, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		TransitionStudy(gomock.Eq(actualStudy.ID), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq(actualStudy.ID), gomock.Eq(model.TransitionStudyPublish)).
 		Return(&tsr, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(actualStudy.ID)).
+		GetStudy(gomock.Any(), gomock.Eq(actualStudy.ID)).
 		Return(nil, errors.New("could not get study")).
 		MaxTimes(1)
 
@@ -311,25 +344,25 @@ func TestCreateCommandCanHandleErrorsWhenPublishing(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateStudy(gomock.Eq(studyTemplate)).
+		CreateStudy(gomock.Any(), gomock.Eq(studyTemplate)).
 		Return(&actualStudy, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		GetSubmissions(gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetSubmissions(gomock.Any(), gomock.Eq(actualStudy.ID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&ls, nil).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		TransitionStudy(gomock.Eq(actualStudy.ID), gomock.Eq(model.TransitionStudyPublish)).
+		TransitionStudy(gomock.Any(), gomock.Eq(actualStudy.ID), gomock.Eq(model.TransitionStudyPublish)).
 		Return(nil, errors.New("could not publish")).
 		MaxTimes(1)
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(actualStudy.ID)).
+		GetStudy(gomock.Any(), gomock.Eq(actualStudy.ID)).
 		Return(&actualStudy, nil).
 		MaxTimes(1)
 
@@ -347,3 +380,28 @@ func TestCreateCommandCanHandleErrorsWhenPublishing(t *testing.T) {
 		t.Fatalf("expected %s; got %v", expected, err.Error())
 	}
 }
+
+func TestCreateCommandPublishesAgainstTheFake(t *testing.T) {
+	c := fake.New()
+
+	var b bytes.Buffer
+	cmd := study.NewCreateCommand(c, &b)
+	cmd.SetContext(context.Background())
+	_ = cmd.Flags().Set("template-path", "../../docs/examples/standard-sample.json")
+	_ = cmd.Flags().Set("publish", "true")
+	err := cmd.RunE(cmd, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	studies, err := c.GetStudies(context.Background(), model.StatusActive, "", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(studies.Results) != 1 || studies.Results[0].Name != studyTemplate.Name {
+		t.Fatalf("expected the created study to be active, got %+v", studies.Results)
+	}
+	if !strings.Contains(b.String(), studyTemplate.Name) {
+		t.Fatalf("expected the study to be rendered, got %s", b.String())
+	}
+}
@@ -29,7 +29,7 @@ To save the report to a file:
 $ prolific study credentials-report 64395e9c2332b8a59a65d51e > credentials.csv`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			csvData, err := client.GetStudyCredentialsUsageReportCSV(args[0])
+			csvData, err := client.GetStudyCredentialsUsageReportCSV(cmd.Context(), args[0])
 			if err != nil {
 				return err
 			}
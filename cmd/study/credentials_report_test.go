@@ -100,7 +100,7 @@ func TestCredentialsReportCommand(t *testing.T) {
 
 			c.
 				EXPECT().
-				GetStudyCredentialsUsageReportCSV(gomock.Eq(tt.studyID)).
+				GetStudyCredentialsUsageReportCSV(gomock.Any(), gomock.Eq(tt.studyID)).
 				Return(tt.mockReturn, tt.mockError).
 				Times(1)
 
@@ -23,9 +23,9 @@ To trigger a demographic export for a study:
 $ prolific study demographic-export 64395e9c2332b8a59a65d51e`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			csvData, err := client.ExportDemographics(args[0])
+			csvData, err := client.ExportDemographics(cmd.Context(), args[0])
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			fmt.Fprint(w, csvData)
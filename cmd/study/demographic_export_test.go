@@ -42,7 +42,7 @@ func TestDemographicExportCallsClient(t *testing.T) {
 
 	c.
 		EXPECT().
-		ExportDemographics(gomock.Eq(studyID)).
+		ExportDemographics(gomock.Any(), gomock.Eq(studyID)).
 		Return(csvData, nil).
 		AnyTimes()
 
@@ -70,7 +70,7 @@ func TestDemographicExportHandlesApiErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		ExportDemographics(gomock.Eq(studyID)).
+		ExportDemographics(gomock.Any(), gomock.Eq(studyID)).
 		Return("", errors.New("export failed")).
 		AnyTimes()
 
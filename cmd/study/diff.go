@@ -0,0 +1,346 @@
+package study
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/model"
+	"github.com/spf13/cobra"
+)
+
+// DiffOptions is the options for comparing two studies.
+type DiffOptions struct {
+	Args         []string
+	TemplatePath string
+	ExitCode     bool
+	TemplateFile shared.TemplateFileOptions
+	Output       shared.OutputOptions
+}
+
+// StudyDiff is the result of comparing two studies, or a study and a study
+// file.
+type StudyDiff struct {
+	From        string       `json:"from"`
+	To          string       `json:"to"`
+	Differences []Difference `json:"differences"`
+}
+
+// Difference is a field that differs between the two sides. Fields inside
+// lists are numbered, e.g. filters[0].selected_values. From or To is null
+// when the field is only set on one side.
+type Difference struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// ErrStudiesDiffer is returned with --exit-code when the studies differ.
+var ErrStudiesDiffer = errors.New("the studies differ")
+
+var (
+	diffRemovedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#E5484D"))
+	diffAddedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#30A46C"))
+	diffChangedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFA500"))
+)
+
+// NewDiffCommand creates a new `study diff` command to compare two studies, or
+// a study and a study file.
+func NewDiffCommand(client client.API, w io.Writer) *cobra.Command {
+	var opts DiffOptions
+
+	cmd := &cobra.Command{
+		Use:   "diff <study-id> [<other-study-id>]",
+		Short: "Compare two studies, or a study and a study file",
+		Long: `Compare two studies, or a study and a study file
+
+Both sides are compared as they would be given to "study create", so only the
+fields that define a study are shown: not its status, places taken or cost.
+This includes the filters, eligibility requirements, completion codes and
+submissions config. Filters are compared by their ID, whatever order they are
+in.
+
+Use --exit-code to exit with status 1 when there are differences, for example
+to check in CI that a study still matches its file.`,
+		Example: `
+To see what changed in a duplicated study
+$ prolific study diff 64395e9c2332b8a59a65d51e 6439600c2332b8a59a65d52f
+
+To compare a study with the file it was created from
+$ prolific study diff 64395e9c2332b8a59a65d51e -t /path/to/study.yaml
+
+To fail a CI job when a study no longer matches its file
+$ prolific study diff 64395e9c2332b8a59a65d51e -t study.yaml --exit-code --json`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Args = args
+
+			if opts.TemplatePath == "" && len(args) != 2 {
+				return errors.New("error: requires two study IDs, or a study ID and --template-path")
+			}
+			if opts.TemplatePath != "" && len(args) != 1 {
+				return errors.New("error: requires a single study ID when comparing with --template-path")
+			}
+
+			diff, err := diffStudies(cmd.Context(), client, opts)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if opts.Output.IsStructured() {
+				err = shared.RenderRecord(opts.Output, diff, w)
+			} else {
+				renderDiff(diff, w)
+			}
+			if err != nil {
+				return err
+			}
+
+			if opts.ExitCode && len(diff.Differences) > 0 {
+				// The differences have been printed, so this is not a usage error.
+				cmd.SilenceUsage = true
+				return fmt.Errorf("error: %w", ErrStudiesDiffer)
+			}
+
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a .json, .yaml or .yml study file to compare the study with")
+	flags.BoolVar(&opts.ExitCode, "exit-code", false, "Exit with status 1 when there are differences")
+	shared.AddTemplateFileFlags(cmd, &opts.TemplateFile)
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+
+	return cmd
+}
+
+func diffStudies(ctx context.Context, client client.API, opts DiffOptions) (StudyDiff, error) {
+	from, err := client.GetStudy(ctx, opts.Args[0])
+	if err != nil {
+		return StudyDiff{}, err
+	}
+	fromDefinition, err := studyDefinition(*from)
+	if err != nil {
+		return StudyDiff{}, err
+	}
+
+	diff := StudyDiff{From: from.ID}
+	var toDefinition model.CreateStudy
+
+	if opts.TemplatePath != "" {
+		diff.To = opts.TemplatePath
+		toDefinition, err = fileDefinition(opts.TemplatePath, opts.TemplateFile)
+	} else {
+		var to *model.Study
+		to, err = client.GetStudy(ctx, opts.Args[1])
+		if err != nil {
+			return StudyDiff{}, err
+		}
+		diff.To = to.ID
+		toDefinition, err = studyDefinition(*to)
+	}
+	if err != nil {
+		return StudyDiff{}, err
+	}
+
+	fromFields, err := definitionFields(fromDefinition)
+	if err != nil {
+		return StudyDiff{}, err
+	}
+	toFields, err := definitionFields(toDefinition)
+	if err != nil {
+		return StudyDiff{}, err
+	}
+
+	diff.Differences = diffValues("", fromFields, toFields, []Difference{})
+	return diff, nil
+}
+
+// studyDefinition returns the study as it would be given to "study create".
+func studyDefinition(study model.Study) (model.CreateStudy, error) {
+	var definition model.CreateStudy
+
+	data, err := json.Marshal(study)
+	if err != nil {
+		return definition, err
+	}
+	if err := json.Unmarshal(data, &definition); err != nil {
+		return definition, fmt.Errorf("unable to map study %s to study model: %w", study.ID, err)
+	}
+
+	normaliseDefinition(&definition)
+	return definition, nil
+}
+
+// fileDefinition reads a study file, as "study create" does.
+func fileDefinition(path string, opts shared.TemplateFileOptions) (model.CreateStudy, error) {
+	var definition model.CreateStudy
+
+	v, err := shared.ReadTemplateFile(path, opts)
+	if err != nil {
+		return definition, err
+	}
+	if err := v.Unmarshal(&definition); err != nil {
+		return definition, fmt.Errorf("unable to map %s to study model: %w", path, err)
+	}
+
+	normaliseDefinition(&definition)
+	return definition, nil
+}
+
+// normaliseDefinition removes the differences between how the API returns a
+// study and how it is written in a file that do not change the study.
+func normaliseDefinition(definition *model.CreateStudy) {
+	// The API returns the details of each filter alongside what was selected.
+	for i, f := range definition.Filters {
+		slices.Sort(f.SelectedValues)
+		definition.Filters[i] = model.Filter{
+			FilterID:       f.FilterID,
+			SelectedValues: f.SelectedValues,
+			SelectedRange:  f.SelectedRange,
+			Weightings:     f.Weightings,
+		}
+	}
+	sort.SliceStable(definition.Filters, func(i, j int) bool {
+		return definition.Filters[i].FilterID < definition.Filters[j].FilterID
+	})
+
+	// The API returns every answer of an eligibility requirement, with the
+	// ones that were not selected set to false.
+	for i, requirement := range definition.EligibilityRequirements {
+		attributes := requirement.Attributes[:0]
+		for _, attribute := range requirement.Attributes {
+			if attribute.Value != nil && attribute.Value != false {
+				attributes = append(attributes, attribute)
+			}
+		}
+		definition.EligibilityRequirements[i].Attributes = attributes
+	}
+}
+
+// definitionFields returns the definition as JSON values, keyed by the field
+// names used in study files.
+func definitionFields(definition model.CreateStudy) (map[string]any, error) {
+	data, err := json.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// diffValues appends the fields that differ between from and to, descending
+// into objects and lists so only the fields that changed are reported.
+func diffValues(field string, from, to any, differences []Difference) []Difference {
+	if isEmpty(from) && isEmpty(to) {
+		return differences
+	}
+
+	fromObject, fromIsObject := from.(map[string]any)
+	toObject, toIsObject := to.(map[string]any)
+	if fromIsObject && toIsObject {
+		keys := make([]string, 0, len(fromObject)+len(toObject))
+		for key := range fromObject {
+			keys = append(keys, key)
+		}
+		for key := range toObject {
+			if _, ok := fromObject[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			name := key
+			if field != "" {
+				name = field + "." + key
+			}
+			differences = diffValues(name, fromObject[key], toObject[key], differences)
+		}
+		return differences
+	}
+
+	fromList, fromIsList := from.([]any)
+	toList, toIsList := to.([]any)
+	if fromIsList && toIsList {
+		for i := range max(len(fromList), len(toList)) {
+			var fromItem, toItem any
+			if i < len(fromList) {
+				fromItem = fromList[i]
+			}
+			if i < len(toList) {
+				toItem = toList[i]
+			}
+			differences = diffValues(fmt.Sprintf("%s[%d]", field, i), fromItem, toItem, differences)
+		}
+		return differences
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return differences
+	}
+
+	return append(differences, Difference{Field: field, From: from, To: to})
+}
+
+// isEmpty reports whether a field is unset, so a field left out of a file
+// matches one the API returns empty.
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+func renderDiff(diff StudyDiff, w io.Writer) {
+	fmt.Fprintln(w, diffRemovedStyle.Render("--- "+diff.From))
+	fmt.Fprintln(w, diffAddedStyle.Render("+++ "+diff.To))
+
+	for _, d := range diff.Differences {
+		switch {
+		case isEmpty(d.From):
+			fmt.Fprintln(w, diffAddedStyle.Render(fmt.Sprintf("+ %s: %s", d.Field, renderDiffValue(d.To))))
+		case isEmpty(d.To):
+			fmt.Fprintln(w, diffRemovedStyle.Render(fmt.Sprintf("- %s: %s", d.Field, renderDiffValue(d.From))))
+		default:
+			fmt.Fprintln(w, diffChangedStyle.Render(fmt.Sprintf("~ %s: %s -> %s", d.Field, renderDiffValue(d.From), renderDiffValue(d.To))))
+		}
+	}
+
+	switch len(diff.Differences) {
+	case 0:
+		fmt.Fprintln(w, "\nNo differences.")
+	case 1:
+		fmt.Fprintln(w, "\n1 difference.")
+	default:
+		fmt.Fprintf(w, "\n%d differences.\n", len(diff.Differences))
+	}
+}
+
+func renderDiffValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,202 @@
+package study_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prolific-oss/cli/cmd/study"
+	"github.com/prolific-oss/cli/mock_client"
+	"github.com/prolific-oss/cli/model"
+)
+
+func diffStudy(id string) model.Study {
+	s := model.Study{
+		ID:                      id,
+		Name:                    "Reaction times",
+		InternalName:            "reaction-times",
+		Desc:                    "How quickly can you press a key?",
+		Status:                  model.StatusActive,
+		TotalAvailablePlaces:    100,
+		PlacesTaken:             37,
+		EstimatedCompletionTime: 5,
+		Reward:                  100,
+		DeviceCompatibility:     []string{"desktop"},
+		ProlificIDOption:        "url_parameters",
+		Filters: []model.Filter{
+			{ID: "f1", FilterID: "handedness", FilterTitle: "Handedness", SelectedValues: []string{"1", "0"}},
+			{ID: "f2", FilterID: "age", SelectedRange: &model.FilterRange{Lower: 18, Upper: 30}},
+		},
+		CompletionCodes: []model.CompletionCode{
+			{Code: "ABC123", CodeType: "COMPLETED", Actions: []map[string]any{{"action": "AUTOMATICALLY_APPROVE"}}},
+		},
+		SubmissionsConfig: model.SubmissionsConfig{MaxSubmissionsPerParticipant: 1},
+	}
+	// The API returns every answer, with the ones not selected set to false.
+	requirements := `[{
+		"attributes": [{"index": 0, "value": false}, {"index": 1, "value": true}],
+		"query": {"id": "5950c8413e9d730001924f2a"},
+		"_cls": "web.eligibility.models.SelectAnswerEligibilityRequirement"
+	}]`
+	_ = json.Unmarshal([]byte(requirements), &s.EligibilityRequirements)
+	return s
+}
+
+func TestNewDiffCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := study.NewDiffCommand(c, os.Stdout)
+
+	use := "diff <study-id> [<other-study-id>]"
+	short := "Compare two studies, or a study and a study file"
+
+	if cmd.Use != use {
+		t.Fatalf("expected use: %s; got %s", use, cmd.Use)
+	}
+
+	if cmd.Short != short {
+		t.Fatalf("expected short: %s; got %s", short, cmd.Short)
+	}
+}
+
+func TestDiffCommandComparesTwoStudies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	original := diffStudy("study-a")
+	duplicate := diffStudy("study-b")
+	duplicate.Status = model.StatusUnpublished
+	duplicate.PlacesTaken = 0
+	duplicate.Reward = 150
+	duplicate.Filters = []model.Filter{
+		{FilterID: "age", SelectedRange: &model.FilterRange{Lower: 18, Upper: 40}},
+		{FilterID: "handedness", SelectedValues: []string{"0", "1"}},
+	}
+	duplicate.CompletionCodes = append(duplicate.CompletionCodes, model.CompletionCode{Code: "NOCONSENT", CodeType: "NO_CONSENT"})
+	duplicate.SubmissionsConfig.MaxSubmissionsPerParticipant = 0
+
+	c.EXPECT().GetStudy(gomock.Any(), gomock.Eq("study-a")).Return(&original, nil).Times(1)
+	c.EXPECT().GetStudy(gomock.Any(), gomock.Eq("study-b")).Return(&duplicate, nil).Times(1)
+
+	var b bytes.Buffer
+	cmd := study.NewDiffCommand(c, &b)
+	cmd.SetArgs([]string{"study-a", "study-b"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	expected := `--- study-a
++++ study-b
++ completion_codes[1]: {"actions":null,"code":"NOCONSENT","code_type":"NO_CONSENT"}
+~ filters[0].selected_range.upper: 30 -> 40
+~ reward: 100 -> 150
+- submissions_config.max_submissions_per_participant: 1
+
+4 differences.
+`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
+func TestDiffCommandComparesAStudyWithAFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	s := diffStudy("study-a")
+	c.EXPECT().GetStudy(gomock.Any(), gomock.Eq("study-a")).Return(&s, nil).Times(1)
+
+	file := filepath.Join(t.TempDir(), "study.yaml")
+	content := `name: Reaction times
+internal_name: reaction-times
+description: How quickly can you press a key?
+prolific_id_option: url_parameters
+total_available_places: 100
+estimated_completion_time: 5
+reward: 100
+device_compatibility: [desktop]
+filters:
+  - filter_id: age
+    selected_range: {lower: 18, upper: 30}
+  - filter_id: handedness
+    selected_values: ["0", "1"]
+completion_codes:
+  - code: ABC123
+    code_type: COMPLETED
+    actions: [{action: AUTOMATICALLY_APPROVE}]
+submissions_config:
+  max_submissions_per_participant: 1
+eligibility_requirements:
+  - attributes: [{index: 1, value: true}]
+    query: {id: 5950c8413e9d730001924f2a}
+    _cls: web.eligibility.models.SelectAnswerEligibilityRequirement
+`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("unable to write study file: %s", err)
+	}
+
+	var b bytes.Buffer
+	cmd := study.NewDiffCommand(c, &b)
+	cmd.SetArgs([]string{"study-a", "-t", file, "--exit-code", "--jq", ".differences | length"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	if b.String() != "0\n" {
+		t.Fatalf("expected no differences; got %s", b.String())
+	}
+}
+
+func TestDiffCommandFailsWithExitCodeWhenStudiesDiffer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	a := diffStudy("study-a")
+	b := diffStudy("study-b")
+	b.Name = "Reaction times (copy)"
+
+	c.EXPECT().GetStudy(gomock.Any(), gomock.Eq("study-a")).Return(&a, nil).Times(1)
+	c.EXPECT().GetStudy(gomock.Any(), gomock.Eq("study-b")).Return(&b, nil).Times(1)
+
+	var out bytes.Buffer
+	cmd := study.NewDiffCommand(c, &out)
+	cmd.SetContext(context.Background())
+	_ = cmd.Flags().Set("exit-code", "true")
+	_ = cmd.Flags().Set("json", "true")
+	err := cmd.RunE(cmd, []string{"study-a", "study-b"})
+	if !errors.Is(err, study.ErrStudiesDiffer) {
+		t.Fatalf("expected %v; got %v", study.ErrStudiesDiffer, err)
+	}
+
+	expected := `"field": "name",
+      "from": "Reaction times",
+      "to": "Reaction times (copy)"`
+	if !strings.Contains(out.String(), expected) {
+		t.Fatalf("expected JSON containing\n%s\ngot\n%s", expected, out.String())
+	}
+}
+
+func TestDiffCommandRequiresTwoSides(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := study.NewDiffCommand(c, os.Stdout)
+	err := cmd.RunE(cmd, []string{"study-a"})
+
+	expected := "error: requires two study IDs, or a study ID and --template-path"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error: %s; got %v", expected, err)
+	}
+}
@@ -5,12 +5,15 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 // NewDuplicateCommand creates a new `study duplicate` command to duplicate
 // an existing study.
 func NewDuplicateCommand(client client.API, w io.Writer) *cobra.Command {
+	var output shared.OutputOptions
+
 	cmd := &cobra.Command{
 		Use:   "duplicate",
 		Short: "Duplicate an existing study",
@@ -21,12 +24,19 @@ are mainly using the CLI, you can define a JSON/YAML file to pass into the "stud
 create" command`,
 		Example: `
 To duplicate a study, you need the ID
-$ prolific study duplicate 64395e9c2332b8a59a65d51e`,
+$ prolific study duplicate 64395e9c2332b8a59a65d51e
+
+The new study's ID is printed, or use a Go template to choose what to print
+$ prolific study duplicate 64395e9c2332b8a59a65d51e --go-template '{{.ID}} {{.Name}}'`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			study, err := client.DuplicateStudy(args[0])
+			study, err := client.DuplicateStudy(cmd.Context(), args[0])
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if output.IsStructured() {
+				return shared.RenderRecord(output, *study, w)
 			}
 
 			fmt.Fprintln(w, study.ID)
@@ -35,5 +45,7 @@ $ prolific study duplicate 64395e9c2332b8a59a65d51e`,
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &output)
+
 	return cmd
 }
@@ -55,7 +55,7 @@ func TestDuplicateStudyCallsClient(t *testing.T) {
 
 	c.
 		EXPECT().
-		DuplicateStudy(gomock.Eq(studyID)).
+		DuplicateStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
@@ -83,7 +83,7 @@ func TestDuplicateStudyHandlesApiErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		DuplicateStudy(gomock.Eq(studyID)).
+		DuplicateStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(nil, errors.New("No no no")).
 		AnyTimes()
 
@@ -0,0 +1,299 @@
+package study
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultFeeRate is Prolific's standard platform fee, as a percentage of
+	// the participant rewards.
+	DefaultFeeRate = 33.3
+	// DefaultVATRate is the VAT charged on the platform fee, as a percentage,
+	// for accounts that pay VAT.
+	DefaultVATRate = 20.0
+)
+
+// recommendationCurrencies are the currencies Prolific's reward
+// recommendations are given in.
+var recommendationCurrencies = []string{"USD", "GBP"}
+
+// EstimateOptions is the options for estimating the cost of a study.
+type EstimateOptions struct {
+	TemplatePath string
+	StudyID      string
+	WorkspaceID  string
+	FeeRate      float64
+	VATRate      float64
+	TemplateFile shared.TemplateFileOptions
+	Output       shared.OutputOptions
+}
+
+// Estimate is the cost of a study. Amounts are in the minor unit of Currency,
+// e.g. pence for GBP, as the API returns them.
+type Estimate struct {
+	Study                   string                      `json:"study"`
+	WorkspaceID             string                      `json:"workspace_id"`
+	Currency                string                      `json:"currency"`
+	Places                  int                         `json:"places"`
+	Reward                  int                         `json:"reward"`
+	EstimatedCompletionTime int                         `json:"estimated_completion_time"`
+	RewardPerHour           int                         `json:"reward_per_hour"`
+	Recommendation          *model.RewardRecommendation `json:"recommendation"`
+	FeeRate                 float64                     `json:"fee_rate"`
+	VATRate                 float64                     `json:"vat_rate"`
+	Rewards                 int                         `json:"rewards"`
+	Fees                    int                         `json:"fees"`
+	VAT                     int                         `json:"vat"`
+	Total                   int                         `json:"total"`
+	AvailableBalance        int                         `json:"available_balance"`
+	SufficientFunds         bool                        `json:"sufficient_funds"`
+}
+
+// NewEstimateCommand creates a new `study estimate` command to work out what
+// a study will cost before it is published.
+func NewEstimateCommand(client client.API, w io.Writer) *cobra.Command {
+	var opts EstimateOptions
+
+	cmd := &cobra.Command{
+		Use:   "estimate",
+		Short: "Estimate what a study will cost and check the workspace can pay for it",
+		Long: `Estimate what a study will cost and check the workspace can pay for it
+
+Works out the participant rewards, platform fees and VAT for the study's places
+and reward, from a study file or a draft study. The reward per hour, from the
+estimated completion time, is compared with Prolific's recommended rates for
+the study's filters.
+
+The total is checked against the workspace's available balance, and the
+command fails when there is not enough to publish the study.
+
+The fee and VAT rates depend on your account. Unless they are set with
+--fee-rate and --vat-rate, they are taken from how the workspace's balance is
+split between rewards, fees and VAT, or default to a 33.3% platform fee with
+20% VAT on it. Prolific calculates the final cost when the study is published.`,
+		Example: `
+Estimate the cost of a study file, in the workspace set in your config file
+$ prolific study estimate -t /path/to/study.yaml
+
+Estimate the cost of a draft study
+$ prolific study estimate --study 64395e9c2332b8a59a65d51e -w 6261321e223a605c7a4f7623
+
+Estimate with your account's rates, e.g. without VAT
+$ prolific study estimate -t study.yaml --fee-rate 33.3 --vat-rate 0
+
+Check there are enough funds in a CI job
+$ prolific study estimate -t study.yaml --var places=500 --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (opts.TemplatePath == "") == (opts.StudyID == "") {
+				return errors.New("error: requires either --template-path or --study")
+			}
+			if opts.WorkspaceID == "" {
+				return errors.New("error: please provide a workspace ID")
+			}
+			if opts.FeeRate < 0 || opts.VATRate < 0 {
+				return errors.New("error: the fee and VAT rates cannot be negative")
+			}
+			// Rates that are not given are worked out from the workspace.
+			if !cmd.Flags().Changed("fee-rate") {
+				opts.FeeRate = -1
+			}
+			if !cmd.Flags().Changed("vat-rate") {
+				opts.VATRate = -1
+			}
+
+			estimate, err := estimateStudy(cmd.Context(), client, opts)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if opts.Output.IsStructured() {
+				err = shared.RenderRecord(opts.Output, estimate, w)
+			} else {
+				renderEstimate(estimate, w)
+			}
+			if err != nil {
+				return err
+			}
+
+			if !estimate.SufficientFunds {
+				// The estimate has been printed, so this is not a usage error.
+				cmd.SilenceUsage = true
+				return fmt.Errorf(
+					"error: insufficient funds: the study costs %s but workspace %s has %s available; top up at least %s",
+					renderAmount(estimate.Total, estimate.Currency),
+					estimate.WorkspaceID,
+					renderAmount(estimate.AvailableBalance, estimate.Currency),
+					renderAmount(estimate.Total-estimate.AvailableBalance, estimate.Currency),
+				)
+			}
+
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a .json, .yaml or .yml study file to estimate")
+	flags.StringVar(&opts.StudyID, "study", "", "The ID of a draft study to estimate")
+	flags.StringVarP(&opts.WorkspaceID, "workspace", "w", viper.GetString("workspace"), "The ID of the workspace that will pay for the study")
+	flags.Float64Var(&opts.FeeRate, "fee-rate", DefaultFeeRate, "The platform fee, as a percentage of the participant rewards")
+	flags.Float64Var(&opts.VATRate, "vat-rate", DefaultVATRate, "The VAT, as a percentage of the platform fee")
+	shared.AddTemplateFileFlags(cmd, &opts.TemplateFile)
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+
+	return cmd
+}
+
+func estimateStudy(ctx context.Context, client client.API, opts EstimateOptions) (Estimate, error) {
+	var (
+		definition model.CreateStudy
+		estimate   Estimate
+		err        error
+	)
+
+	if opts.TemplatePath != "" {
+		estimate.Study = opts.TemplatePath
+		definition, err = fileDefinition(opts.TemplatePath, opts.TemplateFile)
+	} else {
+		var study *model.Study
+		study, err = client.GetStudy(ctx, opts.StudyID)
+		if err != nil {
+			return estimate, err
+		}
+		if study.Status != model.StatusUnpublished {
+			return estimate, fmt.Errorf("study %s is %s, only draft studies can be estimated", study.ID, study.Status)
+		}
+		estimate.Study = study.ID
+		definition, err = studyDefinition(*study)
+	}
+	if err != nil {
+		return estimate, err
+	}
+
+	if definition.Reward <= 0 || definition.TotalAvailablePlaces <= 0 || definition.EstimatedCompletionTime <= 0 {
+		return estimate, fmt.Errorf("%s needs a reward, total_available_places and estimated_completion_time to be estimated", estimate.Study)
+	}
+
+	balance, err := client.GetWorkspaceBalance(ctx, opts.WorkspaceID)
+	if err != nil {
+		return estimate, err
+	}
+
+	estimate.WorkspaceID = opts.WorkspaceID
+	estimate.Currency = balance.CurrencyCode
+	estimate.Places = definition.TotalAvailablePlaces
+	estimate.Reward = int(math.Round(definition.Reward))
+	estimate.EstimatedCompletionTime = definition.EstimatedCompletionTime
+	estimate.RewardPerHour = int(math.Round(definition.Reward * 60 / float64(definition.EstimatedCompletionTime)))
+
+	if slices.Contains(recommendationCurrencies, balance.CurrencyCode) {
+		var screenerIDs []string
+		for _, filter := range definition.Filters {
+			screenerIDs = append(screenerIDs, filter.FilterID)
+		}
+
+		recommendations, err := client.GetRewardRecommendations(ctx, opts.WorkspaceID, balance.CurrencyCode, screenerIDs)
+		if err != nil {
+			return estimate, err
+		}
+		// The first recommendation is the most recent one.
+		if len(*recommendations) > 0 {
+			estimate.Recommendation = &(*recommendations)[0]
+		}
+	}
+
+	estimate.FeeRate, estimate.VATRate = feeRates(opts, *balance)
+	estimate.Rewards = int(math.Round(definition.Reward * float64(definition.TotalAvailablePlaces)))
+	estimate.Fees = int(math.Round(float64(estimate.Rewards) * estimate.FeeRate / 100))
+	estimate.VAT = int(math.Round(float64(estimate.Fees) * estimate.VATRate / 100))
+	estimate.Total = estimate.Rewards + estimate.Fees + estimate.VAT
+
+	estimate.AvailableBalance = balance.AvailableBalance
+	estimate.SufficientFunds = estimate.Total <= balance.AvailableBalance
+
+	return estimate, nil
+}
+
+// feeRates returns the fee and VAT rates to use. Rates that were not given,
+// which are negative in opts, are taken from how the workspace's balance has been split between rewards,
+// fees and VAT, which is done with the account's rates, or the defaults when
+// there is no balance to go by.
+func feeRates(opts EstimateOptions, balance client.WorkspaceBalanceResponse) (float64, float64) {
+	feeRate, vatRate := opts.FeeRate, opts.VATRate
+	breakdown := balance.BalanceBreakdown
+
+	if feeRate < 0 {
+		feeRate = DefaultFeeRate
+		if breakdown.Rewards > 0 && breakdown.Fees > 0 {
+			feeRate = math.Round(float64(breakdown.Fees)/float64(breakdown.Rewards)*1000) / 10
+		}
+	}
+	if vatRate < 0 {
+		vatRate = DefaultVATRate
+		if breakdown.Fees > 0 {
+			vatRate = math.Round(float64(breakdown.VAT)/float64(breakdown.Fees)*1000) / 10
+		}
+	}
+
+	return feeRate, vatRate
+}
+
+// renderEstimate writes the estimate as a table.
+func renderEstimate(estimate Estimate, w io.Writer) {
+	money := func(amount int) string {
+		return renderAmount(amount, estimate.Currency)
+	}
+	percent := func(rate float64) string {
+		return strconv.FormatFloat(rate, 'f', -1, 64) + "%"
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 1, 2, ' ', 0)
+	fmt.Fprintf(tw, "Study:\t%s\n", estimate.Study)
+	fmt.Fprintf(tw, "Workspace:\t%s\n", estimate.WorkspaceID)
+	fmt.Fprintf(tw, "Places:\t%d\n", estimate.Places)
+	fmt.Fprintf(tw, "Reward:\t%s\n", money(estimate.Reward))
+	fmt.Fprintf(tw, "Estimated completion time:\t%d minutes\n", estimate.EstimatedCompletionTime)
+	fmt.Fprintf(tw, "Reward per hour:\t%s\n", money(estimate.RewardPerHour))
+	if r := estimate.Recommendation; r != nil {
+		fmt.Fprintf(tw, "Recommended per hour:\t%s, minimum %s\n", money(r.RecommendedRewardPerHour), money(r.MinRewardPerHour))
+	}
+	fmt.Fprintf(tw, "\n")
+	fmt.Fprintf(tw, "Participant rewards:\t%s\n", money(estimate.Rewards))
+	fmt.Fprintf(tw, "Platform fees (%s):\t%s\n", percent(estimate.FeeRate), money(estimate.Fees))
+	fmt.Fprintf(tw, "VAT (%s):\t%s\n", percent(estimate.VATRate), money(estimate.VAT))
+	fmt.Fprintf(tw, "Total:\t%s\n", money(estimate.Total))
+	fmt.Fprintf(tw, "\n")
+	fmt.Fprintf(tw, "Available balance:\t%s\n", money(estimate.AvailableBalance))
+	if estimate.SufficientFunds {
+		fmt.Fprintf(tw, "Remaining balance:\t%s\n", money(estimate.AvailableBalance-estimate.Total))
+	}
+	_ = tw.Flush()
+
+	if r := estimate.Recommendation; r != nil {
+		switch {
+		case estimate.RewardPerHour < r.MinRewardPerHour:
+			fmt.Fprintf(w, "\nThe reward per hour is below the minimum of %s, so the study cannot be published.\n", money(r.MinRewardPerHour))
+		case estimate.RewardPerHour < r.RecommendedRewardPerHour:
+			fmt.Fprintf(w, "\nThe reward per hour is below the recommended %s.\n", money(r.RecommendedRewardPerHour))
+		}
+	}
+}
+
+// renderAmount renders an amount in the minor unit of the currency.
+func renderAmount(amount int, currency string) string {
+	return ui.RenderMoney(float64(amount)/100, currency)
+}
@@ -0,0 +1,237 @@
+package study_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/study"
+	"github.com/prolific-oss/cli/mock_client"
+	"github.com/prolific-oss/cli/model"
+)
+
+func estimateBalance(available int) *client.WorkspaceBalanceResponse {
+	balance := client.WorkspaceBalanceResponse{CurrencyCode: "GBP", AvailableBalance: available}
+	balance.TotalBalance = 14000
+	balance.BalanceBreakdown.Rewards = 10000
+	balance.BalanceBreakdown.Fees = 3330
+	balance.BalanceBreakdown.VAT = 666
+	return &balance
+}
+
+func writeEstimateStudyFile(t *testing.T) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "study.yaml")
+	content := `name: Reaction times
+total_available_places: {{ .Vars.places }}
+estimated_completion_time: 5
+reward: 100
+filters:
+  - filter_id: handedness
+    selected_values: ["1"]
+`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("unable to write study file: %s", err)
+	}
+	return file
+}
+
+func TestNewEstimateCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := study.NewEstimateCommand(c, os.Stdout)
+
+	use := "estimate"
+	short := "Estimate what a study will cost and check the workspace can pay for it"
+
+	if cmd.Use != use {
+		t.Fatalf("expected use: %s; got %s", use, cmd.Use)
+	}
+
+	if cmd.Short != short {
+		t.Fatalf("expected short: %s; got %s", short, cmd.Short)
+	}
+}
+
+func TestEstimateCommandEstimatesAStudyFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().
+		GetWorkspaceBalance(gomock.Any(), gomock.Eq("workspace-1")).
+		Return(estimateBalance(50000), nil).
+		Times(1)
+
+	c.EXPECT().
+		GetRewardRecommendations(gomock.Any(), gomock.Eq("workspace-1"), gomock.Eq("GBP"), gomock.Eq([]string{"handedness"})).
+		Return(&client.RewardRecommendationsResponse{
+			{Currency: "GBP", MinRewardPerHour: 600, RecommendedRewardPerHour: 1200},
+		}, nil).
+		Times(1)
+
+	file := writeEstimateStudyFile(t)
+
+	var b bytes.Buffer
+	cmd := study.NewEstimateCommand(c, &b)
+	cmd.SetArgs([]string{"-t", file, "-w", "workspace-1", "--var", "places=100"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	expected := `Study:                      FILE
+Workspace:                  workspace-1
+Places:                     100
+Reward:                     £1.00
+Estimated completion time:  5 minutes
+Reward per hour:            £12.00
+Recommended per hour:       £12.00, minimum £6.00
+
+Participant rewards:    £100.00
+Platform fees (33.3%):  £33.30
+VAT (20%):              £6.66
+Total:                  £139.96
+
+Available balance:  £500.00
+Remaining balance:  £360.04
+`
+	expected = strings.ReplaceAll(expected, "FILE", file)
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
+func TestEstimateCommandFailsWhenFundsAreInsufficient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	draft := model.Study{
+		ID:                      "study-1",
+		Status:                  model.StatusUnpublished,
+		TotalAvailablePlaces:    10,
+		EstimatedCompletionTime: 10,
+		Reward:                  50,
+	}
+
+	c.EXPECT().
+		GetStudy(gomock.Any(), gomock.Eq("study-1")).
+		Return(&draft, nil).
+		Times(1)
+
+	c.EXPECT().
+		GetWorkspaceBalance(gomock.Any(), gomock.Eq("workspace-1")).
+		Return(estimateBalance(500), nil).
+		Times(1)
+
+	c.EXPECT().
+		GetRewardRecommendations(gomock.Any(), gomock.Eq("workspace-1"), gomock.Eq("GBP"), gomock.Nil()).
+		Return(&client.RewardRecommendationsResponse{
+			{Currency: "GBP", MinRewardPerHour: 600, RecommendedRewardPerHour: 900},
+		}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := study.NewEstimateCommand(c, &b)
+	cmd.SetContext(context.Background())
+	_ = cmd.Flags().Set("study", "study-1")
+	_ = cmd.Flags().Set("workspace", "workspace-1")
+	_ = cmd.Flags().Set("vat-rate", "0")
+	err := cmd.RunE(cmd, nil)
+
+	expected := "error: insufficient funds: the study costs £6.67 but workspace workspace-1 has £5.00 available; top up at least £1.67"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error: %s; got %v", expected, err)
+	}
+
+	output := b.String()
+	for _, line := range []string{
+		"VAT (0%):               £0.00",
+		"The reward per hour is below the minimum of £6.00, so the study cannot be published.",
+	} {
+		if !strings.Contains(output, line) {
+			t.Fatalf("expected output containing %q; got\n%s", line, output)
+		}
+	}
+	if strings.Contains(output, "Remaining balance") {
+		t.Fatalf("expected no remaining balance; got\n%s", output)
+	}
+}
+
+func TestEstimateCommandUsesTheDefaultRatesWithoutABalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().
+		GetWorkspaceBalance(gomock.Any(), gomock.Eq("workspace-1")).
+		Return(&client.WorkspaceBalanceResponse{CurrencyCode: "EUR", AvailableBalance: 100000}, nil).
+		Times(1)
+
+	file := writeEstimateStudyFile(t)
+
+	var b bytes.Buffer
+	cmd := study.NewEstimateCommand(c, &b)
+	cmd.SetArgs([]string{"-t", file, "-w", "workspace-1", "--var", "places=10", "--json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	expected := `  "recommendation": null,
+  "fee_rate": 33.3,
+  "vat_rate": 20,
+  "rewards": 1000,
+  "fees": 333,
+  "vat": 67,
+  "total": 1400,
+  "available_balance": 100000,
+  "sufficient_funds": true`
+	if !strings.Contains(b.String(), expected) {
+		t.Fatalf("expected JSON containing\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
+func TestEstimateCommandOnlyEstimatesDrafts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	active := model.Study{ID: "study-1", Status: model.StatusActive}
+	c.EXPECT().
+		GetStudy(gomock.Any(), gomock.Eq("study-1")).
+		Return(&active, nil).
+		Times(1)
+
+	cmd := study.NewEstimateCommand(c, os.Stdout)
+	cmd.SetContext(context.Background())
+	_ = cmd.Flags().Set("study", "study-1")
+	_ = cmd.Flags().Set("workspace", "workspace-1")
+	err := cmd.RunE(cmd, nil)
+
+	expected := "error: study study-1 is active, only draft studies can be estimated"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error: %s; got %v", expected, err)
+	}
+}
+
+func TestEstimateCommandRequiresAStudy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := study.NewEstimateCommand(c, os.Stdout)
+	err := cmd.RunE(cmd, nil)
+
+	expected := "error: requires either --template-path or --study"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error: %s; got %v", expected, err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,7 @@ import (
 type IncreasePlacesOptions struct {
 	Args   []string
 	Places int
+	Output shared.OutputOptions
 }
 
 // NewIncreasePlacesCommand creates a new `study increase-places` command to
@@ -36,20 +38,24 @@ $ prolific study increase-places 64395e9c2332b8a59a65d51e --places 5000`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			study, err := client.GetStudy(args[0])
+			study, err := client.GetStudy(cmd.Context(), args[0])
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			if study.TotalAvailablePlaces > opts.Places {
 				return fmt.Errorf("study currently has %v places, and you cannot decrease the available places to %v", study.TotalAvailablePlaces, opts.Places)
 			}
 
-			updatedStudy, err := client.UpdateStudy(study.ID, model.UpdateStudy{TotalAvailablePlaces: opts.Places})
+			updatedStudy, err := client.UpdateStudy(cmd.Context(), study.ID, model.UpdateStudy{TotalAvailablePlaces: opts.Places})
 			if err != nil {
 				return err
 			}
 
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *updatedStudy, w)
+			}
+
 			fmt.Fprintln(w, RenderStudy(*updatedStudy))
 
 			return nil
@@ -57,6 +63,7 @@ $ prolific study increase-places 64395e9c2332b8a59a65d51e --places 5000`,
 	}
 
 	flags := cmd.Flags()
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 	flags.IntVarP(&opts.Places, "places", "p", 0, "The number of places you want to set on your study.")
 
 	return cmd
@@ -58,7 +58,7 @@ func TestNewIncreasePlacesCommandValidatesUserInput(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
@@ -83,7 +83,7 @@ func TestNewIncreasePlacesCommandHandlesGetStudyFailure(t *testing.T) {
 	studyID := "11223344"
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(nil, errors.New("failed to get study")).
 		AnyTimes()
 
@@ -126,13 +126,13 @@ func TestNewIncreasePlacesCommandHandlesFailureToUpdateStudy(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Eq(updateStudy)).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Eq(updateStudy)).
 		Return(nil, errors.New("failed to update study")).
 		AnyTimes()
 
@@ -188,13 +188,13 @@ func TestNewIncreasePlacesCommandRendersStudyOnceUpdated(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Eq(updateStudy)).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Eq(updateStudy)).
 		Return(&updatedStudy, nil).
 		AnyTimes()
 
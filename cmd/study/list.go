@@ -1,6 +1,7 @@
 package study
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -11,8 +12,8 @@ import (
 	"github.com/prolific-oss/cli/client"
 	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
-	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // defaultListFields is the default fields shown when the user has not specified --fields.
@@ -22,14 +23,18 @@ type ListOptions struct {
 	Args        []string
 	Fields      string
 	Output      shared.OutputOptions
+	Query       shared.QueryOptions
 	ProjectID   string
 	Status      string
 	Underpaying bool
+	Limit       int
+	Offset      int
+	All         bool
 }
 
 // NewListCommand creates a new `study list` command to give you details about
 // your studies.
-func NewListCommand(commandName string, client client.API, w io.Writer) *cobra.Command {
+func NewListCommand(commandName string, c client.API, w io.Writer) *cobra.Command {
 	var opts ListOptions
 
 	cmd := &cobra.Command{
@@ -57,16 +62,42 @@ You can output as JSON
 $ prolific study list --json
 $ prolific study list -j
 
+You can also output as YAML, newline-delimited JSON or a Markdown table
+$ prolific study list --output yaml
+$ prolific study list --output ndjson | jq .name
+$ prolific study list --output markdown -f ID,Name,TotalCost
+
+You can filter the JSON output with a jq expression, without jq installed
+$ prolific study list --jq '.[] | select(.total_available_places > 100) | .id'
+
 You can specify the fields you want to render in table or CSV output
 $ prolific study list -f ID,InternalName,TotalCost -t
 $ prolific study list -f ID,InternalName,TotalCost -c
 
+Fields can also be the JSON keys shown by --json, and can reach into nested
+values with dots. Lists take an index, or show every item when left without one
+$ prolific study list -f id,researcher.name,submissions_config.max_concurrent_submissions -t
+$ prolific study list -f ID,Filters[0].title,Filters.FilterID -c
+
 You can filter the studies by the project they are assigned to
 $ prolific study list -p 6261321e223a605c7a4f7561
 
+The project defaults to the "project" setting in your config file or profile
+$ prolific config set project 6261321e223a605c7a4f7561
+
 You can filter the studies by their status, for example your active studies
 $ prolific study list -s active
 
+You can filter and sort the studies on any of their fields. Strings compare
+ignoring case, and =~ matches a regular expression
+$ prolific study list --all -t --filter 'Reward >= 500 && StudyType == "SINGLE"'
+$ prolific study list --all -t -s active --sort -Reward,Name -f ID,Name,Reward
+$ prolific study list -t --filter 'Name =~ "^Pilot" || DateCreated > "2024-01-31"'
+
+You can use the paging options to limit the studies returned, or fetch them all
+$ prolific study list -l 10 -o 10 -t
+$ prolific study list --all -t
+
 The fields you can use are
 - ID
 - Name
@@ -96,15 +127,26 @@ The fields you can use are
 - PublishAt
 - IsPilot
 - IsUnderpaying
-- CredentialPoolID`,
+- CredentialPoolID
+- Researcher (ID, Name, Email, Country, Institution)
+- SubmissionsConfig (MaxSubmissionsPerParticipant, MaxConcurrentSubmissions)
+- Filters (FilterID, FilterTitle, SelectedValues, ...)`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			studies, err := client.GetStudies(opts.Status, opts.ProjectID)
+			limit := shared.OptionalLimit(opts.Limit, opts.Offset)
+			studies, err := shared.FetchList(cmd.Context(), opts.All, limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListStudiesResponse, error) {
+				return c.GetStudies(ctx, opts.Status, opts.ProjectID, limit, offset)
+			})
 			if err != nil {
 				return err
 			}
 
+			studies.Results, err = shared.ApplyQuery(opts.Query, studies.Results)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
 			if opts.Underpaying {
 				studies = filterByUnderpaying(*studies)
 			}
@@ -114,27 +156,16 @@ The fields you can use are
 			if fields == "" {
 				fields = defaultListFields
 			}
-			switch format {
-			case "json":
-				r := ui.JSONRenderer[model.Study]{}
-				if err := r.Render(studies.Results, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			case "csv":
-				r := ui.CsvRenderer[model.Study]{}
-				if err := r.Render(studies.Results, fields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			case "table":
-				r := ui.TableRenderer[model.Study]{}
-				if err := r.Render(studies.Results, fields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			default:
+			if format == "" {
 				r := &InteractiveRenderer{}
-				if err := r.Render(client, *studies, w); err != nil {
-					return fmt.Errorf("error: %s", err)
+				if err := r.Render(c, *studies, w); err != nil {
+					return fmt.Errorf("error: %w", err)
 				}
+				return nil
+			}
+
+			if err := shared.RenderList(opts.Output, studies.Results, fields, w); err != nil {
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -145,8 +176,12 @@ The fields you can use are
 	flags.StringVarP(&opts.Status, "status", "s", model.StatusAll, fmt.Sprintf("The status you want to filter on: %s.", strings.Join(model.StudyListStatus, ", ")))
 	flags.BoolVarP(&opts.Underpaying, "underpaying", "u", false, "Filter by underpaying studies.")
 	flags.StringVarP(&opts.Fields, "fields", "f", "", "Comma separated list of fields you want to display in table or csv mode.")
-	flags.StringVarP(&opts.ProjectID, "project", "p", "", "Get studies for a given project ID.")
+	flags.StringVarP(&opts.ProjectID, "project", "p", viper.GetString("project"), "Get studies for a given project ID.")
+	flags.IntVarP(&opts.Limit, "limit", "l", 0, "Limit the number of studies returned; by default they are not paged.")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of studies to offset.")
+	shared.AddAllFlag(cmd, &opts.All, "studies")
 	shared.AddOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
@@ -186,7 +221,7 @@ func (r *InteractiveRenderer) Render(c client.API, studies client.ListStudiesRes
 
 	p := tea.NewProgram(lv)
 	if _, err := p.Run(); err != nil {
-		return fmt.Errorf("cannot render studies: %s", err)
+		return fmt.Errorf("cannot render studies: %w", err)
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -46,7 +47,7 @@ func TestListCommandPassesBothProjectIDAndStatusToGetStudies(t *testing.T) {
 
 	mockClient.
 		EXPECT().
-		GetStudies(gomock.Eq(status), gomock.Eq(projectID)).
+		GetStudies(gomock.Any(), gomock.Eq(status), gomock.Eq(projectID), gomock.Eq(0), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&studyResponse, nil).
 		Times(1)
 
@@ -101,7 +102,7 @@ func TestListCommandFiltersByUnderpaying(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudies(gomock.Eq(model.StatusAll), gomock.Eq("")).
+		GetStudies(gomock.Any(), gomock.Eq(model.StatusAll), gomock.Eq(""), gomock.Eq(0), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&studyResponse, nil).
 		Times(1)
 
@@ -125,3 +126,160 @@ func TestListCommandFiltersByUnderpaying(t *testing.T) {
 		t.Fatalf("expected '%v', got '%v'", expected, b.String())
 	}
 }
+
+func TestListCommandAllFetchesEveryPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	// The API caps each page below the requested limit, so --all keeps going
+	// until it has the reported total.
+	page := func(ids ...string) *client.ListStudiesResponse {
+		response := &client.ListStudiesResponse{JSONAPIMeta: &client.JSONAPIMeta{}}
+		response.Meta.Count = 3
+		for _, id := range ids {
+			response.Results = append(response.Results, model.Study{ID: id})
+		}
+		return response
+	}
+
+	gomock.InOrder(
+		c.EXPECT().
+			GetStudies(gomock.Any(), gomock.Eq(model.StatusAll), gomock.Eq(""), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(0)).
+			Return(page("study-1", "study-2"), nil),
+		c.EXPECT().
+			GetStudies(gomock.Any(), gomock.Eq(model.StatusAll), gomock.Eq(""), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(2)).
+			Return(page("study-3"), nil),
+	)
+
+	var b bytes.Buffer
+	cmd := study.NewListCommand("studies", c, &b)
+	_ = cmd.Flags().Set("all", "true")
+	_ = cmd.Flags().Set("json", "true")
+
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	for _, id := range []string{"study-1", "study-2", "study-3"} {
+		if !strings.Contains(b.String(), id) {
+			t.Fatalf("expected output to contain %s, got\n%s", id, b.String())
+		}
+	}
+}
+
+func TestListCommandOnlyPagesWhenAskedTo(t *testing.T) {
+	tests := []struct {
+		name          string
+		flags         map[string]string
+		limit, offset int
+	}{
+		{name: "no paging flags", flags: map[string]string{}, limit: 0, offset: 0},
+		{name: "limit", flags: map[string]string{"limit": "5"}, limit: 5, offset: 0},
+		{name: "offset", flags: map[string]string{"offset": "10"}, limit: client.DefaultRecordLimit, offset: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := mock_client.NewMockAPI(ctrl)
+
+			c.EXPECT().
+				GetStudies(gomock.Any(), gomock.Eq(model.StatusAll), gomock.Eq(""), gomock.Eq(tt.limit), gomock.Eq(tt.offset)).
+				Return(&client.ListStudiesResponse{}, nil).
+				Times(1)
+
+			var b bytes.Buffer
+			cmd := study.NewListCommand("studies", c, &b)
+			_ = cmd.Flags().Set("json", "true")
+			for name, value := range tt.flags {
+				_ = cmd.Flags().Set(name, value)
+			}
+
+			if err := cmd.RunE(cmd, nil); err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestListCommandRendersTheOutputFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.
+		EXPECT().
+		GetStudies(gomock.Any(), gomock.Eq(model.StatusAll), gomock.Eq(""), gomock.Eq(0), gomock.Eq(client.DefaultRecordOffset)).
+		Return(&client.ListStudiesResponse{Results: []model.Study{{ID: "1234", Name: "Eggs", Status: model.StatusActive}}}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := study.NewListCommand("list", c, &b)
+	_ = cmd.Flags().Set("output", "markdown")
+
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	expected := "| ID | Name | Status |\n| --- | --- | --- |\n| 1234 | Eggs | active |\n"
+
+	if b.String() != expected {
+		t.Fatalf("expected '%v', got '%v'", expected, b.String())
+	}
+}
+
+func TestListCommandFiltersAndSortsTheStudies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.
+		EXPECT().
+		GetStudies(gomock.Any(), gomock.Eq(model.StatusAll), gomock.Eq(""), gomock.Eq(0), gomock.Eq(client.DefaultRecordOffset)).
+		Return(&client.ListStudiesResponse{Results: []model.Study{
+			{ID: "1", Name: "Cheap", Reward: 100, StudyType: "SINGLE"},
+			{ID: "2", Name: "Quota", Reward: 900, StudyType: "QUOTA"},
+			{ID: "3", Name: "Big", Reward: 600, StudyType: "SINGLE"},
+			{ID: "4", Name: "Bigger", Reward: 800, StudyType: "SINGLE"},
+		}}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := study.NewListCommand("list", c, &b)
+	_ = cmd.Flags().Set("filter", `Reward >= 500 && StudyType == "single"`)
+	_ = cmd.Flags().Set("sort", "-Reward")
+	_ = cmd.Flags().Set("output", "csv")
+	_ = cmd.Flags().Set("fields", "ID,Reward")
+
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	expected := "ID,Reward\n4,800\n3,600\n"
+	if b.String() != expected {
+		t.Fatalf("expected '%v', got '%v'", expected, b.String())
+	}
+}
+
+func TestListCommandReportsUnknownFilterFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.
+		EXPECT().
+		GetStudies(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListStudiesResponse{}, nil).
+		Times(1)
+
+	cmd := study.NewListCommand("list", c, os.Stdout)
+	_ = cmd.Flags().Set("filter", "Rewards > 1")
+	_ = cmd.Flags().Set("table", "true")
+
+	err := cmd.RunE(cmd, nil)
+	if err == nil || !strings.Contains(err.Error(), `unknown field "Rewards"`) {
+		t.Fatalf("expected an unknown field error, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 
 	"github.com/spf13/cobra"
@@ -14,6 +15,7 @@ import (
 type SetCredentialPoolOptions struct {
 	Args             []string
 	CredentialPoolID string
+	Output           shared.OutputOptions
 }
 
 // NewSetCredentialPoolCommand creates a new `study set-credential-pool` command to
@@ -39,11 +41,15 @@ $ prolific study set-credential-pool 64395e9c2332b8a59a65d51e --credential-pool-
 				return fmt.Errorf("credential pool ID is required")
 			}
 
-			updatedStudy, err := client.UpdateStudy(args[0], model.UpdateStudy{CredentialPoolID: opts.CredentialPoolID})
+			updatedStudy, err := client.UpdateStudy(cmd.Context(), args[0], model.UpdateStudy{CredentialPoolID: opts.CredentialPoolID})
 			if err != nil {
 				return err
 			}
 
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *updatedStudy, w)
+			}
+
 			fmt.Fprintln(w, RenderStudy(*updatedStudy))
 
 			return nil
@@ -51,6 +57,7 @@ $ prolific study set-credential-pool 64395e9c2332b8a59a65d51e --credential-pool-
 	}
 
 	flags := cmd.Flags()
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 	flags.StringVarP(&opts.CredentialPoolID, "credential-pool-id", "c", "", "The credential pool ID to attach to the study (format: <workspace_id>_<credential_pool_id>)")
 	_ = cmd.MarkFlagRequired("credential-pool-id")
 
@@ -71,7 +71,7 @@ func TestNewSetCredentialPoolCommandHandlesFailureToUpdateStudy(t *testing.T) {
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Eq(updateStudy)).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Eq(updateStudy)).
 		Return(nil, errors.New("failed to update study")).
 		AnyTimes()
 
@@ -115,7 +115,7 @@ func TestNewSetCredentialPoolCommandRendersStudyOnceUpdated(t *testing.T) {
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Eq(updateStudy)).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Eq(updateStudy)).
 		Return(&updatedStudy, nil).
 		AnyTimes()
 
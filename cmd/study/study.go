@@ -27,6 +27,10 @@ func NewStudyCommand(client client.API, w io.Writer) *cobra.Command {
 		NewSubmissionCountsCommand(client, w),
 		NewDemographicExportCommand(client, w),
 		NewTestStudyCommand(client, w),
+		NewWatchCommand(client, w),
+		NewDiffCommand(client, w),
+		NewValidateCommand(w),
+		NewEstimateCommand(client, w),
 	)
 	return cmd
 }
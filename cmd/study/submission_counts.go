@@ -2,14 +2,16 @@ package study
 
 import (
 	"bytes"
-	"encoding/json"
+	"encoding/csv"
 	"fmt"
 	"io"
+	"strconv"
 	"text/tabwriter"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/cmd/submission"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
@@ -17,8 +19,7 @@ import (
 
 // SubmissionCountsOptions is the options for the submission-counts command.
 type SubmissionCountsOptions struct {
-	JSON           bool
-	NonInteractive bool
+	Output shared.OutputOptions
 }
 
 // NewSubmissionCountsCommand creates a new `study submission-counts` command to
@@ -35,31 +36,34 @@ To get submission counts for a study (interactive):
 $ prolific study submission-counts 64395e9c2332b8a59a65d51e
 
 To get submission counts as a table:
-$ prolific study submission-counts 64395e9c2332b8a59a65d51e -n
+$ prolific study submission-counts 64395e9c2332b8a59a65d51e -t
+
+To get submission counts as CSV:
+$ prolific study submission-counts 64395e9c2332b8a59a65d51e --output csv
 
 To get submission counts as JSON:
-$ prolific study submission-counts 64395e9c2332b8a59a65d51e --json`,
+$ prolific study submission-counts 64395e9c2332b8a59a65d51e --json
+
+To get a single count with a Go template:
+$ prolific study submission-counts 64395e9c2332b8a59a65d51e --go-template '{{.AwaitingReview}}'`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			studyID := args[0]
 
-			counts, err := client.GetStudySubmissionCounts(studyID)
+			counts, err := client.GetStudySubmissionCounts(cmd.Context(), studyID)
 			if err != nil {
 				return err
 			}
 
-			if opts.JSON {
-				data, err := json.MarshalIndent(counts, "", "  ")
-				if err != nil {
-					return err
+			if opts.Output.IsStructured() {
+				switch shared.ResolveFormat(opts.Output) {
+				case "table":
+					fmt.Fprint(w, renderSubmissionCounts(counts))
+					return nil
+				case "csv":
+					return renderSubmissionCountsCSV(counts, w)
 				}
-				fmt.Fprintln(w, string(data))
-				return nil
-			}
-
-			if opts.NonInteractive {
-				fmt.Fprint(w, renderSubmissionCounts(counts))
-				return nil
+				return shared.RenderRecord(opts.Output, *counts, w)
 			}
 
 			countItems := counts.ToItems()
@@ -73,39 +77,68 @@ $ prolific study submission-counts 64395e9c2332b8a59a65d51e --json`,
 				items = append(items, item)
 			}
 
-			cv := submission.NewCountsView(items, studyID, client)
+			cv := submission.NewCountsView(cmd.Context(), items, studyID, client)
 			p := tea.NewProgram(cv)
 			if _, err := p.Run(); err != nil {
-				return fmt.Errorf("cannot render submission counts: %s", err)
+				return fmt.Errorf("cannot render submission counts: %w", err)
 			}
 
 			return nil
 		},
 	}
 
-	flags := cmd.Flags()
-	flags.BoolVar(&opts.JSON, "json", false, "Output as JSON")
-	flags.BoolVarP(&opts.NonInteractive, "non-interactive", "n", false, "Render as a table")
+	shared.AddRecordTableOutputFlags(cmd, &opts.Output)
+
+	// --non-interactive / -n predates --table, and is kept as a hidden alias.
+	cmd.Flags().BoolVarP(&opts.Output.Table, "non-interactive", "n", false, "Output as a table, the same as --table")
+	_ = cmd.Flags().MarkHidden("non-interactive")
 
 	return cmd
 }
 
+// submissionCountRows are the rows of the table and CSV output: each status
+// with its count, then the total.
+func submissionCountRows(counts *model.SubmissionCounts) [][]string {
+	return [][]string{
+		{"Active", strconv.Itoa(counts.Active)},
+		{"Approved", strconv.Itoa(counts.Approved)},
+		{"Awaiting Review", strconv.Itoa(counts.AwaitingReview)},
+		{"Rejected", strconv.Itoa(counts.Rejected)},
+		{"Reserved", strconv.Itoa(counts.Reserved)},
+		{"Returned", strconv.Itoa(counts.Returned)},
+		{"Timed Out", strconv.Itoa(counts.TimedOut)},
+		{"Partially Approved", strconv.Itoa(counts.PartiallyApproved)},
+		{"Screened Out", strconv.Itoa(counts.ScreenedOut)},
+		{"Total", strconv.Itoa(counts.Total)},
+	}
+}
+
 func renderSubmissionCounts(counts *model.SubmissionCounts) string {
 	var buf bytes.Buffer
 	tw := tabwriter.NewWriter(&buf, 0, 0, 3, ' ', 0)
 
 	fmt.Fprintln(tw, "STATUS\tCOUNT")
-	fmt.Fprintf(tw, "Active\t%d\n", counts.Active)
-	fmt.Fprintf(tw, "Approved\t%d\n", counts.Approved)
-	fmt.Fprintf(tw, "Awaiting Review\t%d\n", counts.AwaitingReview)
-	fmt.Fprintf(tw, "Rejected\t%d\n", counts.Rejected)
-	fmt.Fprintf(tw, "Reserved\t%d\n", counts.Reserved)
-	fmt.Fprintf(tw, "Returned\t%d\n", counts.Returned)
-	fmt.Fprintf(tw, "Timed Out\t%d\n", counts.TimedOut)
-	fmt.Fprintf(tw, "Partially Approved\t%d\n", counts.PartiallyApproved)
-	fmt.Fprintf(tw, "Screened Out\t%d\n", counts.ScreenedOut)
-	fmt.Fprintf(tw, "Total\t%d\n", counts.Total)
+	for _, row := range submissionCountRows(counts) {
+		fmt.Fprintf(tw, "%s\t%s\n", row[0], row[1])
+	}
 	tw.Flush()
 
 	return buf.String()
 }
+
+func renderSubmissionCountsCSV(counts *model.SubmissionCounts, w io.Writer) error {
+	csvWriter := csv.NewWriter(w)
+
+	if err := csvWriter.Write([]string{"status", "count"}); err != nil {
+		return err
+	}
+
+	for _, row := range submissionCountRows(counts) {
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
@@ -34,7 +34,7 @@ func TestNewSubmissionCountsCommand(t *testing.T) {
 	}
 }
 
-func TestSubmissionCountsNonInteractive(t *testing.T) {
+func TestSubmissionCountsTable(t *testing.T) {
 	studyID := "64395e9c2332b8a59a65d51e"
 
 	tests := []struct {
@@ -92,7 +92,7 @@ func TestSubmissionCountsNonInteractive(t *testing.T) {
 
 			c.
 				EXPECT().
-				GetStudySubmissionCounts(gomock.Eq(tt.studyID)).
+				GetStudySubmissionCounts(gomock.Any(), gomock.Eq(tt.studyID)).
 				Return(tt.mockReturn, tt.mockError).
 				Times(1)
 
@@ -100,7 +100,7 @@ func TestSubmissionCountsNonInteractive(t *testing.T) {
 			writer := bufio.NewWriter(&b)
 
 			cmd := study.NewSubmissionCountsCommand(c, writer)
-			cmd.SetArgs([]string{tt.studyID, "-n"})
+			cmd.SetArgs([]string{tt.studyID, "-t"})
 			err := cmd.Execute()
 			writer.Flush()
 
@@ -142,7 +142,7 @@ func TestSubmissionCountsRendersCorrectValues(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudySubmissionCounts(gomock.Eq(studyID)).
+		GetStudySubmissionCounts(gomock.Any(), gomock.Eq(studyID)).
 		Return(&model.SubmissionCounts{
 			Active:            5,
 			Approved:          10,
@@ -161,7 +161,7 @@ func TestSubmissionCountsRendersCorrectValues(t *testing.T) {
 	writer := bufio.NewWriter(&b)
 
 	cmd := study.NewSubmissionCountsCommand(c, writer)
-	cmd.SetArgs([]string{studyID, "-n"})
+	cmd.SetArgs([]string{studyID, "-t"})
 	_ = cmd.Execute()
 	writer.Flush()
 
@@ -210,7 +210,7 @@ func TestSubmissionCountsJSON(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudySubmissionCounts(gomock.Eq(studyID)).
+		GetStudySubmissionCounts(gomock.Any(), gomock.Eq(studyID)).
 		Return(expected, nil).
 		Times(1)
 
@@ -235,3 +235,63 @@ func TestSubmissionCountsJSON(t *testing.T) {
 		t.Fatalf("expected %+v, got %+v", *expected, actual)
 	}
 }
+
+func TestSubmissionCountsCSV(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	studyID := "11223344"
+
+	c.
+		EXPECT().
+		GetStudySubmissionCounts(gomock.Any(), gomock.Eq(studyID)).
+		Return(&model.SubmissionCounts{Approved: 10, AwaitingReview: 3, Total: 13}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := study.NewSubmissionCountsCommand(c, &b)
+	cmd.SetArgs([]string{studyID, "--output", "csv"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `status,count
+Active,0
+Approved,10
+Awaiting Review,3
+Rejected,0
+Reserved,0
+Returned,0
+Timed Out,0
+Partially Approved,0
+Screened Out,0
+Total,13
+`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
+func TestSubmissionCountsNonInteractiveIsATable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.
+		EXPECT().
+		GetStudySubmissionCounts(gomock.Any(), gomock.Eq("11223344")).
+		Return(&model.SubmissionCounts{Approved: 10, Total: 10}, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	cmd := study.NewSubmissionCountsCommand(c, &b)
+	cmd.SetArgs([]string{"11223344", "-n"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	if !strings.Contains(b.String(), "Approved             10") {
+		t.Fatalf("expected the table output; got\n%s", b.String())
+	}
+}
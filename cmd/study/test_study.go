@@ -5,12 +5,15 @@ import (
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 // NewTestStudyCommand creates a new `study test` command to create a test run
 // of a study to validate configuration before going live.
 func NewTestStudyCommand(client client.API, w io.Writer) *cobra.Command {
+	var output shared.OutputOptions
+
 	cmd := &cobra.Command{
 		Use:   "test <study-id>",
 		Short: "Create a test run of a study",
@@ -28,9 +31,13 @@ To create a test run of a study:
 $ prolific study test 64395e9c2332b8a59a65d51e`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			response, err := client.TestStudy(args[0])
+			response, err := client.TestStudy(cmd.Context(), args[0])
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if output.IsStructured() {
+				return shared.RenderRecord(output, *response, w)
 			}
 
 			fmt.Fprintf(w, "Test study %s created: %s\n", response.StudyID, response.StudyURL)
@@ -39,5 +46,7 @@ $ prolific study test 64395e9c2332b8a59a65d51e`,
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &output)
+
 	return cmd
 }
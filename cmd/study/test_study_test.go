@@ -47,7 +47,7 @@ func TestTestStudyCallsClient(t *testing.T) {
 
 	c.
 		EXPECT().
-		TestStudy(gomock.Eq(studyID)).
+		TestStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -75,7 +75,7 @@ func TestTestStudyHandlesApiErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		TestStudy(gomock.Eq(studyID)).
+		TestStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(nil, errors.New("study not found")).
 		AnyTimes()
 
@@ -1,11 +1,13 @@
 package study
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 
 	"github.com/spf13/cobra"
@@ -16,6 +18,7 @@ type TransitionOptions struct {
 	Args   []string
 	Action string
 	Silent bool
+	Output shared.OutputOptions
 }
 
 // NewTransitionCommand creates a new `study transition` command to allow you
@@ -32,9 +35,9 @@ func NewTransitionCommand(client client.API, w io.Writer) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := transitionStudy(client, opts, w)
+			err := transitionStudy(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -44,26 +47,31 @@ func NewTransitionCommand(client client.API, w io.Writer) *cobra.Command {
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.Action, "action", "a", "", fmt.Sprintf("Transition a study, it can be one of %s", strings.Join(model.TransitionList, ", ")))
 	flags.BoolVarP(&opts.Silent, "silent", "s", false, "Silently transition the study. It will not render the study after transitioning.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func transitionStudy(client client.API, opts TransitionOptions, w io.Writer) error {
+func transitionStudy(ctx context.Context, client client.API, opts TransitionOptions, w io.Writer) error {
 	if opts.Action == "" {
 		return fmt.Errorf("you must provide an action to transition the study to")
 	}
 
-	_, err := client.TransitionStudy(opts.Args[0], opts.Action)
+	_, err := client.TransitionStudy(ctx, opts.Args[0], opts.Action)
 	if err != nil {
 		return err
 	}
 
-	if !opts.Silent {
-		study, err := client.GetStudy(opts.Args[0])
+	if !opts.Silent || opts.Output.IsStructured() {
+		study, err := client.GetStudy(ctx, opts.Args[0])
 		if err != nil {
 			return err
 		}
 
+		if opts.Output.IsStructured() {
+			return shared.RenderRecord(opts.Output, *study, w)
+		}
+
 		fmt.Fprintln(w, RenderStudy(*study))
 	}
 
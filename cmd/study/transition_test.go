@@ -57,13 +57,13 @@ func TestTransitionCommandCallsClient(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionStudy(gomock.Eq(studyID), gomock.Eq(model.TransitionStudyPause)).
+		TransitionStudy(gomock.Any(), gomock.Eq(studyID), gomock.Eq(model.TransitionStudyPause)).
 		Return(&response, nil).
 		AnyTimes()
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
@@ -93,7 +93,7 @@ func TestTransitionStudyHandlesApiErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionStudy(gomock.Eq(studyID), gomock.Eq(model.TransitionStudyPause)).
+		TransitionStudy(gomock.Any(), gomock.Eq(studyID), gomock.Eq(model.TransitionStudyPause)).
 		Return(nil, errors.New("No no no")).
 		AnyTimes()
 
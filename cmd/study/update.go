@@ -1,6 +1,7 @@
 package study
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/prolific-oss/cli/client"
 
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -15,8 +17,8 @@ import (
 type UpdateOptions struct {
 	Args         []string
 	TemplatePath string
-	Json         bool
 	Silent       bool
+	Output       shared.OutputOptions
 }
 
 // NewUpdateCommand creates a new `study update` command to allow you to update
@@ -53,44 +55,47 @@ EOF
 # JSON output for scripting
 $ prolific study update 60d9aadeb86739de712faee0 -t ./updates.json --json
 
+# Only the fields you need from the response
+$ prolific study update 60d9aadeb86739de712faee0 -t ./updates.json --jq '{status, total_available_places}'
+
 # Silent mode (exit code only)
 $ prolific study update 60d9aadeb86739de712faee0 -t ./updates.json -s`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			return updateStudy(client, opts, cmd.InOrStdin(), w)
+			return updateStudy(cmd.Context(), client, opts, cmd.InOrStdin(), w)
 		},
 	}
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.TemplatePath, "template", "t", "", "Path to a JSON file containing the update payload, or - for stdin")
-	flags.BoolVar(&opts.Json, "json", false, "Output the full API response as JSON")
 	flags.BoolVarP(&opts.Silent, "silent", "s", false, "Suppress output (exit code only)")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 	_ = cmd.MarkFlagRequired("template")
 
 	return cmd
 }
 
-func updateStudy(client client.API, opts UpdateOptions, stdin io.Reader, w io.Writer) error {
+func updateStudy(ctx context.Context, client client.API, opts UpdateOptions, stdin io.Reader, w io.Writer) error {
 	var data []byte
 	var err error
 
 	if opts.TemplatePath == "-" {
 		data, err = io.ReadAll(stdin)
 		if err != nil {
-			return fmt.Errorf("error reading from stdin: %s", err.Error())
+			return fmt.Errorf("error reading from stdin: %w", err)
 		}
 	} else {
 		data, err = os.ReadFile(opts.TemplatePath)
 		if err != nil {
-			return fmt.Errorf("error reading template file: %s", err.Error())
+			return fmt.Errorf("error reading template file: %w", err)
 		}
 	}
 
 	var payload map[string]any
 	if err := json.Unmarshal(data, &payload); err != nil {
-		return fmt.Errorf("error parsing JSON template: %s", err.Error())
+		return fmt.Errorf("error parsing JSON template: %w", err)
 	}
 
 	if len(payload) == 0 {
@@ -98,7 +103,7 @@ func updateStudy(client client.API, opts UpdateOptions, stdin io.Reader, w io.Wr
 	}
 
 	studyID := opts.Args[0]
-	study, err := client.UpdateStudy(studyID, payload)
+	study, err := client.UpdateStudy(ctx, studyID, payload)
 	if err != nil {
 		return err
 	}
@@ -107,10 +112,8 @@ func updateStudy(client client.API, opts UpdateOptions, stdin io.Reader, w io.Wr
 		return nil
 	}
 
-	if opts.Json {
-		encoder := json.NewEncoder(w)
-		encoder.SetIndent("", "  ")
-		return encoder.Encode(study)
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *study, w)
 	}
 
 	fmt.Fprintln(w, RenderStudy(*study))
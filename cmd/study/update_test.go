@@ -143,7 +143,7 @@ func TestNewUpdateCommandUpdatesStudyFromFile(t *testing.T) {
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Eq(expectedPayload)).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Eq(expectedPayload)).
 		Return(&updatedStudy, nil).
 		Times(1)
 
@@ -185,7 +185,7 @@ func TestNewUpdateCommandUpdatesStudyFromStdin(t *testing.T) {
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Eq(expectedPayload)).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Eq(expectedPayload)).
 		Return(&updatedStudy, nil).
 		Times(1)
 
@@ -224,7 +224,7 @@ func TestNewUpdateCommandOutputsJSON(t *testing.T) {
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Any()).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Any()).
 		Return(&updatedStudy, nil).
 		Times(1)
 
@@ -268,7 +268,7 @@ func TestNewUpdateCommandSilentMode(t *testing.T) {
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Any()).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Any()).
 		Return(&updatedStudy, nil).
 		Times(1)
 
@@ -302,7 +302,7 @@ func TestNewUpdateCommandHandlesAPIError(t *testing.T) {
 
 	c.
 		EXPECT().
-		UpdateStudy(gomock.Eq(studyID), gomock.Any()).
+		UpdateStudy(gomock.Any(), gomock.Eq(studyID), gomock.Any()).
 		Return(nil, errors.New("unable to update study: request failed: field not allowed")).
 		Times(1)
 
@@ -0,0 +1,470 @@
+package study
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/prolific-oss/cli/cmd/shared"
+	"github.com/prolific-oss/cli/model"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidateOptions is the options for validating a study file.
+type ValidateOptions struct {
+	Args         []string
+	TemplatePath string
+	TemplateFile shared.TemplateFileOptions
+	Output       shared.OutputOptions
+}
+
+const (
+	// SeverityError is a problem the API would reject the study for.
+	SeverityError = "error"
+	// SeverityWarning is something that may be a mistake, such as a value the
+	// CLI does not know about.
+	SeverityWarning = "warning"
+)
+
+// Problem is something wrong with a study file. Path is where it is, such as
+// completion_codes[0].actions[0].action, and Line is its line in the file
+// when known.
+type Problem struct {
+	Path     string `json:"path"`
+	Line     int    `json:"line,omitempty"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// Validation is the result of validating a study file.
+type Validation struct {
+	File     string    `json:"file"`
+	Valid    bool      `json:"valid"`
+	Problems []Problem `json:"problems"`
+}
+
+// NewValidateCommand creates a new `study validate` command to check a study
+// file before it is used to create a study.
+func NewValidateCommand(w io.Writer) *cobra.Command {
+	var opts ValidateOptions
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check a study file without creating a study",
+		Long: `Check a study file without creating a study
+
+Checks the file against the rules the CLI knows about, without calling the API,
+and reports every problem it finds with its line and path:
+
+- the required fields are set
+- prolific_id_option, completion_option, device_compatibility,
+  peripheral_requirements and study_type have values the API accepts
+- each completion code has a code type and actions, and each action has the
+  fields it needs
+- weighted filters are only used with study_type QUOTA
+- access details allocations add up to total_available_places
+- there are no unknown fields, which would be ignored
+
+Unknown completion code types and actions are reported as warnings, as the
+API may accept ones the CLI does not know about. It exits with status 1 if
+there are any errors.
+
+Line numbers are not shown for files that use $include.`,
+		Example: `
+To check a study file before creating the study
+$ prolific study validate -t /path/to/study.yaml
+
+To check a study file in CI
+$ prolific study validate -t study.yaml --var places=20 --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Args = args
+
+			if opts.TemplatePath == "" {
+				return errors.New("error: a study file is required, use -t to specify the path")
+			}
+
+			file, err := shared.LoadTemplateFile(opts.TemplatePath, opts.TemplateFile)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			validation := ValidateStudyFile(file)
+			if opts.Output.IsStructured() {
+				err = shared.RenderRecord(opts.Output, validation, w)
+			} else {
+				renderValidation(validation, w)
+			}
+			if err != nil {
+				return err
+			}
+
+			if !validation.Valid {
+				// The problems have been printed, so this is not a usage error.
+				cmd.SilenceUsage = true
+				return fmt.Errorf("error: %s is not a valid study file", opts.TemplatePath)
+			}
+
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to the .json, .yaml or .yml study file to check")
+	shared.AddTemplateFileFlags(cmd, &opts.TemplateFile)
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+
+	return cmd
+}
+
+// ValidateStudyFile checks a study file against the rules the CLI knows about.
+func ValidateStudyFile(file *shared.TemplateFile) Validation {
+	v := studyValidator{lines: lineIndex(file.Parsed)}
+
+	// viper matches fields whatever their case.
+	content := map[string]any{}
+	for key, value := range file.Content {
+		content[strings.ToLower(key)] = value
+	}
+
+	v.checkFields(reflect.TypeFor[model.CreateStudy](), file.Content, "")
+	v.checkRequired(content)
+
+	settings := viper.New()
+	var study model.CreateStudy
+	err := settings.MergeConfigMap(file.Content)
+	if err == nil {
+		err = settings.Unmarshal(&study)
+	}
+	if err != nil {
+		v.add(SeverityError, "", "%s", err)
+	} else {
+		v.checkStudy(study, content)
+	}
+
+	sort.SliceStable(v.problems, func(i, j int) bool {
+		return v.problems[i].Line < v.problems[j].Line
+	})
+
+	validation := Validation{File: file.Path, Valid: true, Problems: v.problems}
+	for _, p := range v.problems {
+		if p.Severity == SeverityError {
+			validation.Valid = false
+		}
+	}
+	return validation
+}
+
+type studyValidator struct {
+	// lines maps each path in the file, in lower case, to its line.
+	lines    map[string]int
+	problems []Problem
+}
+
+func (v *studyValidator) add(severity, path, format string, args ...any) {
+	v.problems = append(v.problems, Problem{
+		Path:     path,
+		Line:     v.line(path),
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// line returns the line of path, or of the closest field containing it when
+// path is missing from the file.
+func (v *studyValidator) line(path string) int {
+	path = strings.ToLower(path)
+	for path != "" {
+		if line, ok := v.lines[path]; ok {
+			return line
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			return 0
+		}
+		path = path[:i]
+	}
+	return 0
+}
+
+// checkFields reports the fields that are not in the model, as viper ignores
+// them when the file is read.
+func (v *studyValidator) checkFields(t reflect.Type, value any, path string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+
+		fields := map[string]reflect.Type{}
+		for i := range t.NumField() {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("mapstructure"), ",")
+			fields[name] = t.Field(i).Type
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(object)) {
+			fieldType, ok := fields[strings.ToLower(key)]
+			if !ok {
+				message := "unknown field, it would be ignored"
+				if suggestion := closestField(strings.ToLower(key), fields); suggestion != "" {
+					message += fmt.Sprintf("; did you mean %s?", suggestion)
+				}
+				v.add(SeverityError, joinPath(path, key), "%s", message)
+				continue
+			}
+			v.checkFields(fieldType, object[key], joinPath(path, key))
+		}
+
+	case reflect.Slice:
+		items, ok := value.([]any)
+		if !ok {
+			return
+		}
+		for i, item := range items {
+			v.checkFields(t.Elem(), item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
+
+// checkRequired reports the fields the API needs that are not set.
+func (v *studyValidator) checkRequired(content map[string]any) {
+	has := func(key string) bool {
+		value, ok := content[key]
+		return ok && value != nil && value != ""
+	}
+
+	for _, key := range []string{"name", "description", "prolific_id_option", "total_available_places", "estimated_completion_time", "reward"} {
+		if !has(key) {
+			v.add(SeverityError, key, "required field is missing")
+		}
+	}
+
+	if !has("external_study_url") && !has("access_details") && !has("access_details_collection_id") && !has("data_collection_method") {
+		v.add(SeverityError, "external_study_url", "required field is missing, unless the study uses access_details or a data_collection_method")
+	}
+
+	if !has("completion_code") && !has("completion_codes") {
+		v.add(SeverityError, "completion_codes", "required field is missing, set completion_codes or completion_code")
+	}
+}
+
+// checkStudy reports the values the API would reject.
+func (v *studyValidator) checkStudy(study model.CreateStudy, content map[string]any) {
+	v.checkEnum("prolific_id_option", study.ProlificIDOption, model.ProlificIDOptions)
+	v.checkEnum("completion_option", study.CompletionOption, model.CompletionOptions)
+	v.checkEnum("study_type", study.StudyType, model.StudyTypes)
+	for i, device := range study.DeviceCompatibility {
+		v.checkEnum(fmt.Sprintf("device_compatibility[%d]", i), device, model.DeviceCompatibilities)
+	}
+	for i, peripheral := range study.PeripheralRequirements {
+		v.checkEnum(fmt.Sprintf("peripheral_requirements[%d]", i), peripheral, model.PeripheralRequirements)
+	}
+
+	if _, ok := content["total_available_places"]; ok && study.TotalAvailablePlaces <= 0 {
+		v.add(SeverityError, "total_available_places", "must be more than 0, got %d", study.TotalAvailablePlaces)
+	}
+	if _, ok := content["estimated_completion_time"]; ok && study.EstimatedCompletionTime <= 0 {
+		v.add(SeverityError, "estimated_completion_time", "must be more than 0 minutes, got %d", study.EstimatedCompletionTime)
+	}
+
+	for i, code := range study.CompletionCodes {
+		v.checkCompletionCode(fmt.Sprintf("completion_codes[%d]", i), code)
+	}
+
+	for i, filter := range study.Filters {
+		if len(filter.Weightings) > 0 && study.StudyType != model.StudyTypeQuota {
+			v.add(SeverityError, fmt.Sprintf("filters[%d].weightings", i), "weighted filters need study_type %s", model.StudyTypeQuota)
+		}
+	}
+
+	if len(study.AccessDetails) > 0 {
+		total := 0.0
+		for i, access := range study.AccessDetails {
+			path := fmt.Sprintf("access_details[%d]", i)
+			if access.ExternalURL == "" {
+				v.add(SeverityError, path+".external_url", "required field is missing")
+			}
+			if access.TotalAllocation <= 0 {
+				v.add(SeverityError, path+".total_allocation", "must be more than 0")
+			}
+			total += access.TotalAllocation
+		}
+		if math.Abs(total-float64(study.TotalAvailablePlaces)) > 1e-9 {
+			v.add(SeverityError, "access_details", "allocations add up to %g, but total_available_places is %d", total, study.TotalAvailablePlaces)
+		}
+	}
+}
+
+func (v *studyValidator) checkCompletionCode(path string, code model.CompletionCode) {
+	switch {
+	case code.CodeType == "":
+		v.add(SeverityError, path+".code_type", "required field is missing")
+	case !slices.Contains(model.CompletionCodeTypes, code.CodeType):
+		v.add(SeverityWarning, path+".code_type", "unknown code type %q, expected one of %s", code.CodeType, strings.Join(model.CompletionCodeTypes, ", "))
+	}
+
+	if len(code.Actions) == 0 {
+		v.add(SeverityError, path+".actions", "must have at least one action")
+	}
+
+	for i, action := range code.Actions {
+		actionPath := fmt.Sprintf("%s.actions[%d]", path, i)
+
+		name, ok := action["action"].(string)
+		switch {
+		case !ok || name == "":
+			v.add(SeverityError, actionPath+".action", "required field is missing")
+			continue
+		case !slices.Contains(model.CompletionCodeActions, name):
+			v.add(SeverityWarning, actionPath+".action", "unknown action %q, expected one of %s", name, strings.Join(model.CompletionCodeActions, ", "))
+		}
+
+		if name == model.CompletionActionAddToParticipantGroup || name == model.CompletionActionRemoveFromParticipantGroup {
+			if group, _ := action["participant_group"].(string); group == "" {
+				v.add(SeverityError, actionPath+".participant_group", "required for %s", name)
+			}
+		}
+	}
+}
+
+func (v *studyValidator) checkEnum(path, value string, allowed []string) {
+	if value != "" && !slices.Contains(allowed, value) {
+		v.add(SeverityError, path, "%q is not allowed, expected one of %s", value, strings.Join(allowed, ", "))
+	}
+}
+
+// lineIndex maps each path in a JSON or YAML file to its line, with its keys
+// in lower case as viper reads them. It is empty if the file cannot be parsed
+// as YAML, or includes other files, which move the paths from their lines.
+func lineIndex(data []byte) map[string]int {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil
+	}
+
+	lines := map[string]int{}
+	includes := false
+	var walk func(node *yaml.Node, path string)
+	walk = func(node *yaml.Node, path string) {
+		switch node.Kind {
+		case yaml.DocumentNode:
+			for _, child := range node.Content {
+				walk(child, path)
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == shared.IncludeKey {
+					includes = true
+				}
+				key := joinPath(path, strings.ToLower(node.Content[i].Value))
+				lines[key] = node.Content[i].Line
+				walk(node.Content[i+1], key)
+			}
+		case yaml.SequenceNode:
+			for i, child := range node.Content {
+				item := fmt.Sprintf("%s[%d]", path, i)
+				lines[item] = child.Line
+				walk(child, item)
+			}
+		}
+	}
+	walk(&root, "")
+
+	if includes {
+		return nil
+	}
+	return lines
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// closestField returns the field most like key, if one is within two edits of
+// it, to suggest for a typo.
+func closestField(key string, fields map[string]reflect.Type) string {
+	closest, distance := "", 3
+	for _, field := range slices.Sorted(maps.Keys(fields)) {
+		if d := editDistance(key, field); d < distance {
+			closest, distance = field, d
+		}
+	}
+	return closest
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
+
+func renderValidation(validation Validation, w io.Writer) {
+	errorCount, warningCount := 0, 0
+
+	for _, p := range validation.Problems {
+		location := validation.File
+		if p.Line > 0 {
+			location = fmt.Sprintf("%s:%d", validation.File, p.Line)
+		}
+
+		severity := ""
+		if p.Severity == SeverityWarning {
+			severity = "warning: "
+			warningCount++
+		} else {
+			errorCount++
+		}
+
+		if p.Path == "" {
+			fmt.Fprintf(w, "%s: %s%s\n", location, severity, p.Message)
+		} else {
+			fmt.Fprintf(w, "%s: %s%s: %s\n", location, severity, p.Path, p.Message)
+		}
+	}
+
+	if len(validation.Problems) == 0 {
+		fmt.Fprintf(w, "%s is a valid study file.\n", validation.File)
+		return
+	}
+
+	fmt.Fprintf(w, "\nFound %s and %s in %s.\n", plural(errorCount, "error"), plural(warningCount, "warning"), validation.File)
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
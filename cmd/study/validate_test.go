@@ -0,0 +1,175 @@
+package study_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prolific-oss/cli/cmd/study"
+)
+
+func TestNewValidateCommand(t *testing.T) {
+	cmd := study.NewValidateCommand(os.Stdout)
+
+	use := "validate"
+	short := "Check a study file without creating a study"
+
+	if cmd.Use != use {
+		t.Fatalf("expected use: %s; got %s", use, cmd.Use)
+	}
+
+	if cmd.Short != short {
+		t.Fatalf("expected short: %s; got %s", short, cmd.Short)
+	}
+}
+
+func TestValidateCommandAcceptsTheExampleStudies(t *testing.T) {
+	// The AI Task Builder example is filled in with the batch to use.
+	t.Setenv("BATCH_ID", "67890abcdef")
+
+	files, err := filepath.Glob("../../docs/examples/*")
+	if err != nil {
+		t.Fatalf("unable to list examples: %s", err)
+	}
+
+	for _, file := range files {
+		name := filepath.Base(file)
+		isStudy := strings.HasPrefix(name, "study-") || strings.HasPrefix(name, "standard-sample") ||
+			strings.HasPrefix(name, "star-") || strings.HasPrefix(name, "minimal-study") || strings.HasPrefix(name, "multi")
+		if !isStudy {
+			continue
+		}
+
+		t.Run(name, func(t *testing.T) {
+			var b bytes.Buffer
+			cmd := study.NewValidateCommand(&b)
+			cmd.SetArgs([]string{"-t", file})
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("expected no error; got %s\n%s", err, b.String())
+			}
+		})
+	}
+}
+
+func TestValidateCommandReportsEveryProblem(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "study.yaml")
+	content := `name: Reaction times
+description: How quickly can you press a key?
+prolific_id_option: url_params
+total_available_places: 10
+estimated_completion_time: 5
+rewrad: 100
+external_study_url: https://example.com
+device_compatibility: [desktop, phone]
+completion_codes:
+  - code: ABC123
+    code_type: DONE
+    actions:
+      - action: ADD_TO_PARTICIPANT_GROUP
+filters:
+  - filter_id: handedness
+    selected_values: ["1"]
+    weightings: {"1": 1}
+access_details:
+  - external_url: https://example.com/a
+    total_allocation: 4
+`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("unable to write study file: %s", err)
+	}
+
+	var b bytes.Buffer
+	cmd := study.NewValidateCommand(&b)
+	cmd.SetArgs([]string{"-t", file})
+	err := cmd.Execute()
+
+	expected := "error: " + file + " is not a valid study file"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error: %s; got %v", expected, err)
+	}
+
+	expectedOutput := `FILE: reward: required field is missing
+FILE:3: prolific_id_option: "url_params" is not allowed, expected one of question, url_parameters, not_required
+FILE:6: rewrad: unknown field, it would be ignored; did you mean reward?
+FILE:8: device_compatibility[1]: "phone" is not allowed, expected one of desktop, tablet, mobile
+FILE:11: warning: completion_codes[0].code_type: unknown code type "DONE", expected one of COMPLETED, FAILED_ATTENTION_CHECK, FOLLOW_UP_STUDY, GIVE_BONUS, INCOMPATIBLE_DEVICE, NO_CONSENT, OTHER, FIXED_SCREENOUT
+FILE:13: completion_codes[0].actions[0].participant_group: required for ADD_TO_PARTICIPANT_GROUP
+FILE:17: filters[0].weightings: weighted filters need study_type QUOTA
+FILE:18: access_details: allocations add up to 4, but total_available_places is 10
+
+Found 7 errors and 1 warning in FILE.
+`
+	expectedOutput = strings.ReplaceAll(expectedOutput, "FILE", file)
+	if b.String() != expectedOutput {
+		t.Fatalf("expected\n%s\ngot\n%s", expectedOutput, b.String())
+	}
+}
+
+func TestValidateCommandReportsLinesForMixedCaseKeys(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "study.yaml")
+	content := `name: Reaction times
+description: Mentions $include, but includes nothing
+prolific_id_option: question
+Total_Available_Places: 0
+estimated_completion_time: 5
+reward: 100
+external_study_url: https://example.com
+completion_code: ABC123
+`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("unable to write study file: %s", err)
+	}
+
+	var b bytes.Buffer
+	cmd := study.NewValidateCommand(&b)
+	cmd.SetArgs([]string{"-t", file})
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("expected an error for no places")
+	}
+
+	expected := file + ":4: total_available_places: must be more than 0, got 0\n"
+	if !strings.HasPrefix(b.String(), expected) {
+		t.Fatalf("expected output starting with\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
+func TestValidateCommandRendersJSON(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "study.json")
+	content := `{
+  "name": "Reaction times",
+  "description": "How quickly can you press a key?",
+  "prolific_id_option": "question",
+  "total_available_places": 10,
+  "estimated_completion_time": 5,
+  "reward": {{ .Vars.reward }},
+  "external_study_url": "https://example.com",
+  "completion_code": "ABC123",
+  "study_type": "single"
+}
+`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("unable to write study file: %s", err)
+	}
+
+	var b bytes.Buffer
+	cmd := study.NewValidateCommand(&b)
+	cmd.SetArgs([]string{"-t", file, "--var", "reward=100", "--json"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("expected an error for an invalid study type")
+	}
+
+	expected := `  "valid": false,
+  "problems": [
+    {
+      "path": "study_type",
+      "line": 10,
+      "severity": "error",
+      "message": "\"single\" is not allowed, expected one of SINGLE, QUOTA"
+    }
+  ]`
+	if !strings.Contains(b.String(), expected) {
+		t.Fatalf("expected JSON containing\n%s\ngot\n%s", expected, b.String())
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/browser"
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/config"
 	"github.com/prolific-oss/cli/model"
 	"github.com/prolific-oss/cli/ui"
@@ -16,8 +17,9 @@ import (
 
 // ViewOptions is the options for the detail view of a project.
 type ViewOptions struct {
-	Args []string
-	Web  bool
+	Args   []string
+	Web    bool
+	Output shared.OutputOptions
 }
 
 // NewViewCommand creates a new `study view` command to give you details about
@@ -31,7 +33,10 @@ func NewViewCommand(client client.API, w io.Writer) *cobra.Command {
 		Long:  `View study details`,
 		Example: `
 To get details about a study
-$ prolific study view 64395e9c2332b8a59a65d51e`,
+$ prolific study view 64395e9c2332b8a59a65d51e
+
+To print selected details with a Go template
+$ prolific study view 64395e9c2332b8a59a65d51e --go-template '{{.Status}} {{money .Reward .CurrencyCode}}'`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
@@ -40,9 +45,13 @@ $ prolific study view 64395e9c2332b8a59a65d51e`,
 				return browser.OpenURL(GetStudyURL(opts.Args[0]))
 			}
 
-			study, err := client.GetStudy(args[0])
+			study, err := client.GetStudy(cmd.Context(), args[0])
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
+			}
+
+			if opts.Output.IsStructured() {
+				return shared.RenderRecord(opts.Output, *study, w)
 			}
 
 			fmt.Fprintln(w, RenderStudy(*study))
@@ -54,6 +63,7 @@ $ prolific study view 64395e9c2332b8a59a65d51e`,
 	flags := cmd.Flags()
 
 	flags.BoolVarP(&opts.Web, "web", "W", false, "Open the study in the web application")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
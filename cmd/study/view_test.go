@@ -64,7 +64,7 @@ func TestViewStudyRendersStudy(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
@@ -126,7 +126,7 @@ func TestViewStudyHandlesApiErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(nil, errors.New("unable to get study")).
 		AnyTimes()
 
@@ -166,7 +166,7 @@ func TestViewStudyRendersCredentialPoolID(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
@@ -247,7 +247,7 @@ func TestViewStudyRendersUnderpaying(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetStudy(gomock.Eq(studyID)).
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
 		Return(&actualStudy, nil).
 		AnyTimes()
 
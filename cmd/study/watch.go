@@ -0,0 +1,427 @@
+package study
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultWatchInterval = 15 * time.Second
+	// watchMaxPollErrors is how many consecutive polling errors are tolerated
+	// before giving up, so a watch survives transient network/API blips.
+	watchMaxPollErrors = 3
+	// watchBarWidth is the number of characters in the places progress bar.
+	watchBarWidth = 30
+)
+
+// watchPollSleep is the sleep function used between polls when printing a
+// line per change. Replaced in tests via SetWatchPollSleepForTesting to avoid
+// real delays.
+var watchPollSleep func(context.Context, time.Duration) error = client.Sleep
+
+// watchNow returns the time of a poll. Replaced in tests via
+// SetWatchClockForTesting so rates can be checked.
+var watchNow = time.Now
+
+// WatchOptions is the options for the watch study command.
+type WatchOptions struct {
+	Args           []string
+	Interval       time.Duration
+	ExitOnComplete bool
+	NonInteractive bool
+}
+
+// NewWatchCommand creates a new `study watch` command to follow the progress
+// of a running study.
+func NewWatchCommand(client client.API, w io.Writer) *cobra.Command {
+	var opts WatchOptions
+
+	cmd := &cobra.Command{
+		Use:   "watch <study-id>",
+		Short: "Watch the progress of a study as it runs",
+		Long: `Watch the progress of a study as it runs
+
+Polls the study and its submission counts, showing the places taken, the
+submissions in each status, submissions per minute, an estimate of the time
+left to fill the study and the rewards spent so far.
+
+When the output is not a terminal, or with --non-interactive, a line is printed
+each time the study changes instead, for logs and notifications.`,
+		Example: `
+Watch a study until you press q:
+$ prolific study watch 64395e9c2332b8a59a65d51e
+
+Poll every minute, and stop once the study has finished collecting submissions:
+$ prolific study watch 64395e9c2332b8a59a65d51e --interval 1m --exit-on-complete
+
+Log each change, for example from a CI job:
+$ prolific study watch 64395e9c2332b8a59a65d51e -n -e >> study.log`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Args = args
+
+			if opts.Interval <= 0 {
+				return errors.New("error: the interval must be greater than zero")
+			}
+
+			var err error
+			if f, ok := w.(*os.File); ok && !opts.NonInteractive && ui.IsTerminal(f) {
+				err = watchDashboard(cmd.Context(), client, opts)
+			} else {
+				err = watchLines(cmd.Context(), client, opts, w)
+			}
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.DurationVarP(&opts.Interval, "interval", "i", defaultWatchInterval, "How often to poll the study")
+	flags.BoolVarP(&opts.ExitOnComplete, "exit-on-complete", "e", false, "Stop once the study is awaiting review or completed")
+	flags.BoolVarP(&opts.NonInteractive, "non-interactive", "n", false, "Print a line each time the study changes instead of the dashboard")
+
+	return cmd
+}
+
+// watchProgress is what is known about a study after a poll, along with the
+// first poll, which rates are measured from.
+type watchProgress struct {
+	study      model.Study
+	counts     model.SubmissionCounts
+	at         time.Time
+	started    time.Time
+	startTotal int
+}
+
+// next returns the progress after a poll at the given time. p is nil for the
+// first poll.
+func (p *watchProgress) next(study model.Study, counts model.SubmissionCounts, at time.Time) watchProgress {
+	next := watchProgress{study: study, counts: counts, at: at, started: at, startTotal: counts.Total}
+	if p != nil {
+		next.started = p.started
+		next.startTotal = p.startTotal
+	}
+	return next
+}
+
+// changed reports whether anything shown in a progress line differs from prev.
+func (p watchProgress) changed(prev watchProgress) bool {
+	return p.study.Status != prev.study.Status ||
+		p.study.PlacesTaken != prev.study.PlacesTaken ||
+		p.study.TotalAvailablePlaces != prev.study.TotalAvailablePlaces ||
+		p.counts != prev.counts
+}
+
+// done reports whether the study has stopped collecting submissions.
+func (p watchProgress) done() bool {
+	return p.study.Status == model.StatusAwaitingReview || p.study.Status == model.StatusCompleted
+}
+
+// perMinute returns the submissions made per minute since the watch started.
+// It reports false until there are two polls to measure between.
+func (p watchProgress) perMinute() (float64, bool) {
+	minutes := p.at.Sub(p.started).Minutes()
+	if minutes <= 0 {
+		return 0, false
+	}
+	return float64(p.counts.Total-p.startTotal) / minutes, true
+}
+
+// timeToFill estimates how long the remaining places will take to fill at the
+// current rate. It reports false when there is no rate to go on.
+func (p watchProgress) timeToFill() (time.Duration, bool) {
+	remaining := p.study.TotalAvailablePlaces - p.study.PlacesTaken
+	if remaining <= 0 {
+		return 0, true
+	}
+
+	rate, ok := p.perMinute()
+	if !ok || rate <= 0 {
+		return 0, false
+	}
+	return time.Duration(float64(remaining) / rate * float64(time.Minute)), true
+}
+
+// rewards returns the rewards paid for approved submissions, and those
+// committed to submissions that are active, awaiting review or approved.
+func (p watchProgress) rewards() (approved, committed string) {
+	reward := p.study.Reward / 100
+	currency := p.study.GetCurrencyCode()
+
+	paid := p.counts.Approved + p.counts.PartiallyApproved
+	pending := paid + p.counts.AwaitingReview + p.counts.Active
+
+	return ui.RenderMoney(reward*float64(paid), currency), ui.RenderMoney(reward*float64(pending), currency)
+}
+
+func (p watchProgress) rateText() string {
+	rate, ok := p.perMinute()
+	if !ok {
+		return "measuring"
+	}
+	return fmt.Sprintf("%.1f/min", rate)
+}
+
+func (p watchProgress) fillText() string {
+	if p.study.PlacesTaken >= p.study.TotalAvailablePlaces {
+		return "full"
+	}
+
+	d, ok := p.timeToFill()
+	if !ok {
+		return "unknown"
+	}
+	return "about " + formatWatchDuration(d)
+}
+
+// line renders the progress on a single line, for the non-interactive mode.
+func (p watchProgress) line() string {
+	statuses := []string{}
+	for _, item := range p.counts.ToItems() {
+		statuses = append(statuses, fmt.Sprintf("%s %d", strings.ToLower(item.StatusLabel), item.Count))
+	}
+	if len(statuses) == 0 {
+		statuses = append(statuses, "no submissions")
+	}
+
+	fill := "time to fill unknown"
+	if p.study.PlacesTaken >= p.study.TotalAvailablePlaces {
+		fill = "full"
+	} else if d, ok := p.timeToFill(); ok {
+		fill = "fills in about " + formatWatchDuration(d)
+	}
+
+	_, committed := p.rewards()
+
+	return fmt.Sprintf("%s %s %d/%d places | %s | %s | %s | %s committed",
+		ui.FormatMachineDateTime(p.at),
+		p.study.Status,
+		p.study.PlacesTaken,
+		p.study.TotalAvailablePlaces,
+		strings.Join(statuses, ", "),
+		p.rateText(),
+		fill,
+		committed,
+	)
+}
+
+// dashboard renders the progress for the interactive mode.
+func (p watchProgress) dashboard() string {
+	approved, committed := p.rewards()
+
+	content := fmt.Sprintln(ui.RenderHeading(p.study.Name))
+	content += fmt.Sprintf("ID:             %s\n", p.study.ID)
+	content += fmt.Sprintf("Status:         %s\n", p.study.Status)
+	content += fmt.Sprintf("Places:         %s %d/%d\n", renderWatchBar(p.study.PlacesTaken, p.study.TotalAvailablePlaces), p.study.PlacesTaken, p.study.TotalAvailablePlaces)
+	content += fmt.Sprintf("Submissions:    %s\n", p.rateText())
+	content += fmt.Sprintf("Time to fill:   %s\n", p.fillText())
+	content += fmt.Sprintf("Rewards:        %s approved, %s committed\n", approved, committed)
+
+	content += ui.RenderSectionMarker()
+
+	content += fmt.Sprintln(ui.RenderHeading("Submissions"))
+	content += renderSubmissionCounts(&p.counts)
+
+	return content
+}
+
+func renderWatchBar(taken, available int) string {
+	filled := 0
+	if available > 0 {
+		filled = min(taken*watchBarWidth/available, watchBarWidth)
+	}
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", watchBarWidth-filled) + "]"
+}
+
+func formatWatchDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Minute {
+		return "a minute"
+	}
+
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	if hours == 0 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh%02dm", hours, minutes)
+}
+
+func pollStudy(ctx context.Context, c client.API, studyID string) (*model.Study, *model.SubmissionCounts, error) {
+	study, err := c.GetStudy(ctx, studyID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts, err := c.GetStudySubmissionCounts(ctx, studyID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return study, counts, nil
+}
+
+// watchLines polls the study, printing a line to w whenever it changes.
+func watchLines(ctx context.Context, c client.API, opts WatchOptions, w io.Writer) error {
+	studyID := opts.Args[0]
+
+	var progress *watchProgress
+	consecutiveErrors := 0
+
+	for {
+		study, counts, err := pollStudy(ctx, c, studyID)
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			consecutiveErrors++
+			if consecutiveErrors >= watchMaxPollErrors {
+				return err
+			}
+		default:
+			consecutiveErrors = 0
+
+			next := progress.next(*study, *counts, watchNow())
+			if progress == nil || next.changed(*progress) {
+				fmt.Fprintln(w, next.line())
+			}
+			progress = &next
+
+			if opts.ExitOnComplete && next.done() {
+				return nil
+			}
+		}
+
+		if err := watchPollSleep(ctx, opts.Interval); err != nil {
+			return err
+		}
+	}
+}
+
+// watchDashboard runs the interactive dashboard until the user quits, or the
+// study is done when --exit-on-complete is set.
+func watchDashboard(ctx context.Context, c client.API, opts WatchOptions) error {
+	view := WatchView{ctx: ctx, client: c, studyID: opts.Args[0], interval: opts.Interval, exitOnComplete: opts.ExitOnComplete}
+
+	final, err := tea.NewProgram(view, tea.WithContext(ctx)).Run()
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("cannot render study watch: %w", err)
+	}
+
+	if v, ok := final.(WatchView); ok && v.errors >= watchMaxPollErrors {
+		return v.err
+	}
+
+	return nil
+}
+
+// WatchView is the bubbletea model for the `study watch` dashboard.
+type WatchView struct {
+	ctx            context.Context
+	client         client.API
+	studyID        string
+	interval       time.Duration
+	exitOnComplete bool
+	progress       *watchProgress
+	err            error
+	errors         int
+}
+
+type watchPolledMsg struct {
+	study  *model.Study
+	counts *model.SubmissionCounts
+	err    error
+}
+
+type watchTickMsg struct{}
+
+func (v WatchView) poll() tea.Cmd {
+	return func() tea.Msg {
+		study, counts, err := pollStudy(v.ctx, v.client, v.studyID)
+		return watchPolledMsg{study: study, counts: counts, err: err}
+	}
+}
+
+func (v WatchView) tick() tea.Cmd {
+	return tea.Tick(v.interval, func(time.Time) tea.Msg {
+		return watchTickMsg{}
+	})
+}
+
+// Init implements tea.Model.
+func (v WatchView) Init() tea.Cmd {
+	return v.poll()
+}
+
+// Update implements tea.Model.
+func (v WatchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case watchPolledMsg:
+		if msg.err != nil {
+			v.err = msg.err
+			v.errors++
+			if v.errors >= watchMaxPollErrors {
+				return v, tea.Quit
+			}
+			return v, v.tick()
+		}
+
+		v.err = nil
+		v.errors = 0
+		next := v.progress.next(*msg.study, *msg.counts, watchNow())
+		v.progress = &next
+
+		if v.exitOnComplete && next.done() {
+			return v, tea.Quit
+		}
+		return v, v.tick()
+
+	case watchTickMsg:
+		return v, v.poll()
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return v, tea.Quit
+		}
+	}
+
+	return v, nil
+}
+
+// View implements tea.Model.
+func (v WatchView) View() string {
+	if v.progress == nil {
+		if v.err != nil {
+			return fmt.Sprintf("Error: %s\n", v.err)
+		}
+		return "Fetching study...\n"
+	}
+
+	content := v.progress.dashboard()
+	content += ui.RenderSectionMarker()
+	if v.err != nil {
+		content += fmt.Sprintf("Last update failed: %s\n", v.err)
+	}
+	content += fmt.Sprintf("Updated %s, every %s. Press q to quit.\n", ui.InTimezone(v.progress.at).Format("15:04:05"), v.interval)
+
+	return content
+}
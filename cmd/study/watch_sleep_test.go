@@ -0,0 +1,26 @@
+package study
+
+import (
+	"context"
+	"time"
+)
+
+// SetWatchPollSleepForTesting replaces the poll sleep function for the duration of a
+// test. Call the returned function (typically via defer) to restore the original.
+//
+// This file is compiled only during `go test` and is intentionally in
+// package study (not study_test) so that it can access unexported
+// variables while still being callable from external test packages.
+func SetWatchPollSleepForTesting(f func(context.Context, time.Duration) error) func() {
+	prev := watchPollSleep
+	watchPollSleep = f
+	return func() { watchPollSleep = prev }
+}
+
+// SetWatchClockForTesting replaces the clock used to time each poll, so rates
+// and estimates are predictable. Call the returned function to restore.
+func SetWatchClockForTesting(now func() time.Time) func() {
+	prev := watchNow
+	watchNow = now
+	return func() { watchNow = prev }
+}
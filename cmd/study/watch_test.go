@@ -0,0 +1,147 @@
+package study_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prolific-oss/cli/cmd/study"
+	"github.com/prolific-oss/cli/mock_client"
+	"github.com/prolific-oss/cli/model"
+)
+
+func TestNewWatchCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock_client.NewMockAPI(ctrl)
+
+	cmd := study.NewWatchCommand(client, os.Stdout)
+
+	use := "watch <study-id>"
+	short := "Watch the progress of a study as it runs"
+
+	if cmd.Use != use {
+		t.Fatalf("expected use: %s; got %s", use, cmd.Use)
+	}
+
+	if cmd.Short != short {
+		t.Fatalf("expected short: %s; got %s", short, cmd.Short)
+	}
+}
+
+func TestWatchCommandPrintsALinePerChangeUntilComplete(t *testing.T) {
+	defer study.SetWatchPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
+
+	start := time.Date(2026, time.January, 1, 10, 0, 0, 0, time.UTC)
+	polls := []time.Duration{0, time.Minute, 90 * time.Second, 2 * time.Minute}
+	defer study.SetWatchClockForTesting(func() time.Time {
+		at := start.Add(polls[0])
+		polls = polls[1:]
+		return at
+	})()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	studyID := "64395e9c2332b8a59a65d51e"
+	running := func(taken int) *model.Study {
+		return &model.Study{ID: studyID, Name: "Eggs", Status: model.StatusActive, Reward: 200, CurrencyCode: "GBP", PlacesTaken: taken, TotalAvailablePlaces: 100}
+	}
+	finished := running(100)
+	finished.Status = model.StatusAwaitingReview
+
+	for _, s := range []*model.Study{running(10), running(40), running(40), finished} {
+		c.EXPECT().GetStudy(gomock.Any(), gomock.Eq(studyID)).Return(s, nil).Times(1)
+	}
+	for _, counts := range []*model.SubmissionCounts{
+		{Active: 10, Total: 10},
+		{Active: 20, AwaitingReview: 20, Total: 40},
+		{Active: 20, AwaitingReview: 20, Total: 40},
+		{Approved: 10, AwaitingReview: 90, Total: 100},
+	} {
+		c.EXPECT().GetStudySubmissionCounts(gomock.Any(), gomock.Eq(studyID)).Return(counts, nil).Times(1)
+	}
+
+	var b bytes.Buffer
+	cmd := study.NewWatchCommand(c, &b)
+	cmd.SetContext(context.Background())
+	_ = cmd.Flags().Set("exit-on-complete", "true")
+
+	if err := cmd.RunE(cmd, []string{studyID}); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	expected := `2026-01-01T10:00:00Z active 10/100 places | active 10 | measuring | time to fill unknown | £20.00 committed
+2026-01-01T10:01:00Z active 40/100 places | active 20, awaiting review 20 | 30.0/min | fills in about 2m | £80.00 committed
+2026-01-01T10:02:00Z awaiting review 100/100 places | approved 10, awaiting review 90 | 45.0/min | full | £200.00 committed
+`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
+func TestWatchCommandGivesUpAfterRepeatedErrors(t *testing.T) {
+	defer study.SetWatchPollSleepForTesting(func(context.Context, time.Duration) error { return nil })()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.
+		EXPECT().
+		GetStudy(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("unavailable")).
+		Times(3)
+
+	var b bytes.Buffer
+	cmd := study.NewWatchCommand(c, &b)
+	cmd.SetContext(context.Background())
+
+	err := cmd.RunE(cmd, []string{"64395e9c2332b8a59a65d51e"})
+	if err == nil || err.Error() != "error: unavailable" {
+		t.Fatalf("expected error: unavailable, got %v", err)
+	}
+}
+
+func TestWatchCommandStopsWhenCancelled(t *testing.T) {
+	defer study.SetWatchPollSleepForTesting(func(ctx context.Context, _ time.Duration) error { return context.Canceled })()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().GetStudy(gomock.Any(), gomock.Any()).Return(&model.Study{Status: model.StatusActive, TotalAvailablePlaces: 10}, nil).Times(1)
+	c.EXPECT().GetStudySubmissionCounts(gomock.Any(), gomock.Any()).Return(&model.SubmissionCounts{}, nil).Times(1)
+
+	var b bytes.Buffer
+	cmd := study.NewWatchCommand(c, &b)
+	cmd.SetContext(context.Background())
+
+	err := cmd.RunE(cmd, []string{"64395e9c2332b8a59a65d51e"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancellation to be returned, got %v", err)
+	}
+	if !strings.Contains(b.String(), "no submissions") {
+		t.Fatalf("expected the first poll to be printed, got %q", b.String())
+	}
+}
+
+func TestWatchCommandRejectsANonPositiveInterval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := study.NewWatchCommand(c, os.Stdout)
+	_ = cmd.Flags().Set("interval", "0s")
+
+	err := cmd.RunE(cmd, []string{"64395e9c2332b8a59a65d51e"})
+	if err == nil || !strings.Contains(err.Error(), "interval must be greater than zero") {
+		t.Fatalf("expected an interval error, got %v", err)
+	}
+}
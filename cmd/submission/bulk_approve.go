@@ -1,6 +1,7 @@
 package submission
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -15,6 +16,7 @@ type BulkApproveOptions struct {
 	StudyID        string
 	ParticipantIDs []string
 	File           string
+	Output         shared.OutputOptions
 }
 
 // NewBulkApproveCommand creates a new `submission bulk-approve` command.
@@ -44,9 +46,9 @@ The approval is processed asynchronously.`,
   prolific submission bulk-approve -s <study_id> -p <participant_id> -p <participant_id>
   prolific submission bulk-approve -s <study_id> -f participants.csv`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := bulkApproveSubmissions(c, opts, w)
+			err := bulkApproveSubmissions(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -58,11 +60,12 @@ The approval is processed asynchronously.`,
 	flags.StringVarP(&opts.StudyID, "study", "s", "", "Study ID (required with --participant-id, optional with --file)")
 	flags.StringArrayVarP(&opts.ParticipantIDs, "participant-id", "p", nil, "Participant ID to approve (can be specified multiple times, requires --study)")
 	flags.StringVarP(&opts.File, "file", "f", "", "Path to a file containing one ID per line")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func bulkApproveSubmissions(c client.API, opts BulkApproveOptions, w io.Writer) error {
+func bulkApproveSubmissions(ctx context.Context, c client.API, opts BulkApproveOptions, w io.Writer) error {
 	hasSubmissionIDs := len(opts.SubmissionIDs) > 0
 	hasParticipantIDs := len(opts.ParticipantIDs) > 0
 	hasFile := opts.File != ""
@@ -106,11 +109,15 @@ func bulkApproveSubmissions(c client.API, opts BulkApproveOptions, w io.Writer)
 		ParticipantIDs: opts.ParticipantIDs,
 	}
 
-	err := c.BulkApproveSubmissions(payload)
+	err := c.BulkApproveSubmissions(ctx, payload)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, payload, w)
+	}
+
 	fmt.Fprintln(w, "The request to bulk approve has been made successfully.")
 
 	return nil
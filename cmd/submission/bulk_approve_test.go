@@ -44,7 +44,7 @@ func TestBulkApproveCommandErrorsIfNoFlags(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Any()).
+		BulkApproveSubmissions(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -69,7 +69,7 @@ func TestBulkApproveCommandErrorsIfMixedFlags(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Any()).
+		BulkApproveSubmissions(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -96,7 +96,7 @@ func TestBulkApproveCommandErrorsIfStudyWithoutParticipantIDs(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Any()).
+		BulkApproveSubmissions(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -122,7 +122,7 @@ func TestBulkApproveCommandErrorsIfParticipantIDsWithoutStudy(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Any()).
+		BulkApproveSubmissions(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -148,7 +148,7 @@ func TestBulkApproveCommandApprovesBySubmissionIDs(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Eq(client.BulkApproveSubmissionsPayload{
+		BulkApproveSubmissions(gomock.Any(), gomock.Eq(client.BulkApproveSubmissionsPayload{
 			SubmissionIDs: []string{"sub-1", "sub-2"},
 		})).
 		Return(nil).
@@ -180,7 +180,7 @@ func TestBulkApproveCommandApprovesByStudyAndParticipantIDs(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Eq(client.BulkApproveSubmissionsPayload{
+		BulkApproveSubmissions(gomock.Any(), gomock.Eq(client.BulkApproveSubmissionsPayload{
 			StudyID:        "study-1",
 			ParticipantIDs: []string{"part-1", "part-2"},
 		})).
@@ -216,7 +216,7 @@ func TestBulkApproveCommandReturnsErrorIfApprovalFails(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Eq(client.BulkApproveSubmissionsPayload{
+		BulkApproveSubmissions(gomock.Any(), gomock.Eq(client.BulkApproveSubmissionsPayload{
 			SubmissionIDs: []string{"sub-1"},
 		})).
 		Return(errors.New(errorMessage)).
@@ -243,7 +243,7 @@ func TestBulkApproveCommandApprovesByFileAsSubmissionIDs(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Eq(client.BulkApproveSubmissionsPayload{
+		BulkApproveSubmissions(gomock.Any(), gomock.Eq(client.BulkApproveSubmissionsPayload{
 			SubmissionIDs: []string{"sub-1", "sub-2", "sub-3"},
 		})).
 		Return(nil).
@@ -280,7 +280,7 @@ func TestBulkApproveCommandApprovesByFileAsParticipantIDs(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Eq(client.BulkApproveSubmissionsPayload{
+		BulkApproveSubmissions(gomock.Any(), gomock.Eq(client.BulkApproveSubmissionsPayload{
 			StudyID:        "study-1",
 			ParticipantIDs: []string{"part-1", "part-2"},
 		})).
@@ -319,7 +319,7 @@ func TestBulkApproveCommandErrorsIfFileWithInlineIDs(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Any()).
+		BulkApproveSubmissions(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	dir := t.TempDir()
@@ -354,7 +354,7 @@ func assertBulkApproveFileError(t *testing.T, filePath, expectedSubstring string
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Any()).
+		BulkApproveSubmissions(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -396,7 +396,7 @@ func TestBulkApproveCommandFileSkipsBlankLines(t *testing.T) {
 
 	c.
 		EXPECT().
-		BulkApproveSubmissions(gomock.Eq(client.BulkApproveSubmissionsPayload{
+		BulkApproveSubmissions(gomock.Any(), gomock.Eq(client.BulkApproveSubmissionsPayload{
 			SubmissionIDs: []string{"sub-1", "sub-2"},
 		})).
 		Return(nil).
@@ -1,6 +1,7 @@
 package submission
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/prolific-oss/cli/client"
 	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
 )
 
 // CountsView is a two-level bubbletea model for submission counts drill-down.
@@ -20,6 +22,7 @@ type CountsView struct {
 	selectedSub    *model.Submission
 	drillStatus    string
 	studyID        string
+	ctx            context.Context
 	client         client.API
 	submissions    []model.Submission
 	totalCount     int
@@ -28,13 +31,15 @@ type CountsView struct {
 	err            error
 }
 
-// NewCountsView creates a new CountsView from submission count items.
-func NewCountsView(items []list.Item, studyID string, c client.API) CountsView {
+// NewCountsView creates a new CountsView from submission count items. The
+// context bounds the drill-down fetch issued when a status is selected.
+func NewCountsView(ctx context.Context, items []list.Item, studyID string, c client.API) CountsView {
 	l := list.New(items, list.NewDefaultDelegate(), 0, 0)
 	l.Title = "Submission counts"
 	return CountsView{
 		countsList: l,
 		studyID:    studyID,
+		ctx:        ctx,
 		client:     c,
 	}
 }
@@ -45,9 +50,9 @@ type submissionsFetchedMsg struct {
 	err         error
 }
 
-func fetchSubmissions(c client.API, studyID string) tea.Cmd {
+func fetchSubmissions(ctx context.Context, c client.API, studyID string) tea.Cmd {
 	return func() tea.Msg {
-		resp, err := c.GetSubmissions(studyID, client.DefaultRecordLimit, client.DefaultRecordOffset)
+		resp, err := c.GetSubmissions(ctx, studyID, client.DefaultRecordLimit, client.DefaultRecordOffset)
 		if err != nil {
 			return submissionsFetchedMsg{err: err}
 		}
@@ -112,7 +117,7 @@ func (cv CountsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return cv, nil
 				}
 				cv.loading = true
-				return cv, fetchSubmissions(cv.client, cv.studyID)
+				return cv, fetchSubmissions(cv.ctx, cv.client, cv.studyID)
 			}
 		}
 
@@ -186,9 +191,9 @@ func RenderSubmission(sub model.Submission) string {
 	content += fmt.Sprintf("ID:          %s\n", sub.ID)
 	content += fmt.Sprintf("Status:      %s\n", sub.Status)
 	content += fmt.Sprintf("Study Code:  %s\n", sub.StudyCode)
-	content += fmt.Sprintf("Started At:  %s\n", sub.StartedAt.Format("2006-01-02 15:04:05"))
+	content += fmt.Sprintf("Started At:  %s\n", ui.FormatDateTime(sub.StartedAt))
 	if !sub.CompletedAt.IsZero() {
-		content += fmt.Sprintf("Completed:   %s\n", sub.CompletedAt.Format("2006-01-02 15:04:05"))
+		content += fmt.Sprintf("Completed:   %s\n", ui.FormatDateTime(sub.CompletedAt))
 	}
 	content += fmt.Sprintf("Time Taken:  %ds\n", sub.TimeTaken)
 	content += fmt.Sprintf("Reward:      %d\n", sub.Reward)
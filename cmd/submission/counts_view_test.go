@@ -66,8 +66,8 @@ func TestRenderSubmissionWithCount(t *testing.T) {
 		"ID:          sub-123",
 		"Status:      APPROVED",
 		"Study Code:  STUDY-789",
-		"Started At:  2026-01-15 10:30:00",
-		"Completed:   2026-01-15 10:45:00",
+		"Started At:  15-01-2026 10:30",
+		"Completed:   15-01-2026 10:45",
 		"Time Taken:  900s",
 		"Reward:      500",
 	}
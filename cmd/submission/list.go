@@ -1,6 +1,7 @@
 package submission
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -23,9 +24,11 @@ type ListOptions struct {
 	Args   []string
 	Fields string
 	Output shared.OutputOptions
+	Query  shared.QueryOptions
 	Study  string
 	Limit  int
 	Offset int
+	All    bool
 }
 
 // NewListCommand creates a new `submission list` command to give you details about
@@ -50,6 +53,9 @@ $ prolific submission list -s 63c123af913a974f87e8e7fc -l 5
 You can also offset the results, for example skipping 5
 $ prolific submission list -s 63c123af913a974f87e8e7fc -l 5 -o 5
 
+You can fetch every submission rather than a single page
+$ prolific submission list -s 63c123af913a974f87e8e7fc --all
+
 You can output as a table
 $ prolific submission list -s 63c123af913a974f87e8e7fc --table
 $ prolific submission list -s 63c123af913a974f87e8e7fc -t
@@ -62,10 +68,19 @@ You can output as JSON
 $ prolific submission list -s 63c123af913a974f87e8e7fc --json
 $ prolific submission list -s 63c123af913a974f87e8e7fc -j
 
+You can output a Markdown table, for pasting into a report
+$ prolific submission list -s 63c123af913a974f87e8e7fc --output markdown
+
+You can filter the JSON output with a jq expression, without jq installed
+$ prolific submission list -s 63c123af913a974f87e8e7fc --all --jq '.[] | select(.status=="AWAITING REVIEW") | .participant_id'
+
 You can specify the fields you want to render in table or CSV output
 $ prolific submission list -s 63c123af913a974f87e8e7fc -f ID,Status,TimeTaken -t
 $ prolific submission list -s 63c123af913a974f87e8e7fc -f ID,Status,TimeTaken -c
 
+The participant's strata are nested fields, which you can also name by their JSON keys
+$ prolific submission list -s 63c123af913a974f87e8e7fc -f 'participant_id,Strata.Sex,strata.date of birth' -t
+
 The fields you can use are
 - ID
 - ParticipantID
@@ -78,7 +93,8 @@ The fields you can use are
 - StudyCode
 - StarAwarded
 - BonusPayments
-- IP`,
+- IP
+- Strata (DateOfBirth, EthnicitySimplified, Sex)`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
@@ -86,40 +102,37 @@ The fields you can use are
 				return errors.New("please provide a study ID")
 			}
 
-			submissions, err := c.GetSubmissions(opts.Study, opts.Limit, opts.Offset)
+			submissions, err := shared.FetchList(cmd.Context(), opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListSubmissionsResponse, error) {
+				return c.GetSubmissions(ctx, opts.Study, limit, offset)
+			})
 			if err != nil {
 				return err
 			}
 
+			submissions.Results, err = shared.ApplyQuery(opts.Query, submissions.Results)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
 			format := shared.ResolveFormat(opts.Output)
 			fields := opts.Fields
 			if fields == "" {
 				fields = defaultListFields
 			}
 
-			switch format {
-			case "json":
-				r := ui.JSONRenderer[model.Submission]{}
-				if err := r.Render(submissions.Results, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			case "csv":
-				r := ui.CsvRenderer[model.Submission]{}
-				if err := r.Render(submissions.Results, fields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-				fmt.Fprintf(w, "\n%s\n", ui.RenderRecordCounter(len(submissions.Results), submissions.Meta.Count))
-			case "table":
-				r := ui.TableRenderer[model.Submission]{}
-				if err := r.Render(submissions.Results, fields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-				fmt.Fprintf(w, "\n%s\n", ui.RenderRecordCounter(len(submissions.Results), submissions.Meta.Count))
-			default:
+			if format == "" {
 				r := &InteractiveRenderer{}
 				if err := r.Render(*submissions, w); err != nil {
-					return fmt.Errorf("error: %s", err)
+					return fmt.Errorf("error: %w", err)
 				}
+				return nil
+			}
+
+			if err := shared.RenderList(opts.Output, submissions.Results, fields, w); err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+			if format == "csv" || format == "table" {
+				fmt.Fprintf(w, "\n%s\n", ui.RenderRecordCounter(len(submissions.Results), submissions.Meta.Count))
 			}
 
 			return nil
@@ -130,8 +143,10 @@ The fields you can use are
 	flags.StringVarP(&opts.Study, "study", "s", "", "The study we want submissions for.")
 	flags.StringVarP(&opts.Fields, "fields", "f", "", "Comma separated list of fields you want to display in table or CSV output.")
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of submissions returned.")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of submissions to offset.")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of submissions to offset.")
+	shared.AddAllFlag(cmd, &opts.All, "submissions")
 	shared.AddOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
@@ -158,7 +173,7 @@ func (r *InteractiveRenderer) Render(submissions client.ListSubmissionsResponse,
 
 	p := tea.NewProgram(lv)
 	if _, err := p.Run(); err != nil {
-		return fmt.Errorf("cannot render submissions: %s", err)
+		return fmt.Errorf("cannot render submissions: %w", err)
 	}
 
 	return nil
@@ -66,7 +66,7 @@ func TestNewSubmissionCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetSubmissions(gomock.Eq(studyID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		GetSubmissions(gomock.Any(), gomock.Eq(studyID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -82,8 +82,8 @@ func TestNewSubmissionCommandCallsAPI(t *testing.T) {
 
 	writer.Flush()
 
-	expected := `ParticipantID StartedAt                     TimeTaken StudyCode Status
-919           2022-07-24 08:04:00 +0000 UTC 99                  APPROVED
+	expected := `ParticipantID StartedAt        TimeTaken StudyCode Status
+919           24-07-2022 08:04 99                  APPROVED
 
 Showing 1 record of 10
 `
@@ -97,6 +97,45 @@ Showing 1 record of 10
 	}
 }
 
+func TestListCommandFiltersWithJQ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	studyID := "777111999"
+
+	response := client.ListSubmissionsResponse{
+		Results: []model.Submission{
+			{ID: "1122", ParticipantID: "919", Status: "AWAITING REVIEW"},
+			{ID: "3344", ParticipantID: "920", Status: "APPROVED"},
+		},
+		JSONAPIMeta: &client.JSONAPIMeta{},
+	}
+
+	c.
+		EXPECT().
+		GetSubmissions(gomock.Any(), gomock.Eq(studyID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		Return(&response, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	writer := bufio.NewWriter(&b)
+
+	cmd := submission.NewListCommand(c, writer)
+	_ = cmd.Flags().Set("study", studyID)
+	if err := cmd.Flags().Set("jq", `.[] | select(.status=="AWAITING REVIEW") | .participant_id`); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := cmd.RunE(cmd, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	writer.Flush()
+
+	if b.String() != "919\n" {
+		t.Fatalf("expected %q, got %q", "919\n", b.String())
+	}
+}
+
 func TestRenderSubmission(t *testing.T) {
 	submissionStart, _ := time.Parse("2006-01-02 15:04", "2022-07-24 08:04")
 	completedAt, _ := time.Parse("2006-01-02 15:04", "2022-07-24 08:30")
@@ -1,11 +1,13 @@
 package submission
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +15,7 @@ import (
 type RequestReturnOptions struct {
 	SubmissionID string
 	Reasons      []string
+	Output       shared.OutputOptions
 }
 
 // NewRequestReturnCommand creates a new `submission request-return` command to request
@@ -38,9 +41,9 @@ Common reasons include:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.SubmissionID = args[0]
 
-			err := requestReturn(client, opts, w)
+			err := requestReturn(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -49,17 +52,22 @@ Common reasons include:
 
 	flags := cmd.Flags()
 	flags.StringArrayVarP(&opts.Reasons, "reason", "r", nil, "Reason for requesting return (can be specified multiple times)")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 	_ = cmd.MarkFlagRequired("reason")
 
 	return cmd
 }
 
-func requestReturn(client client.API, opts RequestReturnOptions, w io.Writer) error {
-	response, err := client.RequestSubmissionReturn(opts.SubmissionID, opts.Reasons)
+func requestReturn(ctx context.Context, client client.API, opts RequestReturnOptions, w io.Writer) error {
+	response, err := client.RequestSubmissionReturn(ctx, opts.SubmissionID, opts.Reasons)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", "ID", "Status", "Participant", "Return Requested")
 	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
@@ -50,7 +50,7 @@ func TestRequestReturnCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		RequestSubmissionReturn(
+		RequestSubmissionReturn(gomock.Any(),
 			gomock.Eq(testSubmissionID),
 			gomock.Eq([]string{"Didn't finish the study"}),
 		).
@@ -79,7 +79,7 @@ func TestRequestReturnCommandHandlesAPIError(t *testing.T) {
 
 	c.
 		EXPECT().
-		RequestSubmissionReturn(
+		RequestSubmissionReturn(gomock.Any(),
 			gomock.Eq(testSubmissionID),
 			gomock.Eq([]string{"Withdrew consent"}),
 		).
@@ -132,7 +132,7 @@ func TestRequestReturnCommandWithNilReturnRequested(t *testing.T) {
 
 	c.
 		EXPECT().
-		RequestSubmissionReturn(
+		RequestSubmissionReturn(gomock.Any(),
 			gomock.Eq(testSubmissionID),
 			gomock.Eq([]string{"Didn't finish the study"}),
 		).
@@ -171,7 +171,7 @@ func TestRequestReturnCommandWithMultipleReasons(t *testing.T) {
 
 	c.
 		EXPECT().
-		RequestSubmissionReturn(
+		RequestSubmissionReturn(gomock.Any(),
 			gomock.Eq(submissionID),
 			gomock.Eq([]string{"Didn't finish the study", "Encountered technical problems"}),
 		).
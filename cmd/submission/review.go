@@ -0,0 +1,291 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+	"github.com/spf13/cobra"
+)
+
+// defaultRejectMessage is the message sent with a rejection unless
+// --reject-message is given. It must render to at least 100 characters.
+const defaultRejectMessage = "Thank you for taking part in {{.Study.Name}}. Unfortunately we cannot approve your submission because {{.Reason}}. If you think this is a mistake, please contact us through Prolific messages."
+
+// minRejectMessageLength is the shortest message the API accepts with a rejection.
+const minRejectMessageLength = 100
+
+// rejectionReasons describe each of the rejectionCategories for the
+// rejection message.
+var rejectionReasons = map[string]string{
+	"TOO_QUICKLY":         "it was completed too quickly to have followed the study properly",
+	"TOO_SLOWLY":          "it took far longer than the time allowed for the study",
+	"FAILED_INSTRUCTIONS": "it did not follow the instructions given in the study",
+	"INCOMP_LONGITUDINAL": "not every part of this longitudinal study was completed",
+	"FAILED_CHECK":        "it failed the attention checks in the study",
+	"LOW_EFFORT":          "the responses showed too little effort",
+	"MALINGERING":         "the responses were not given in good faith",
+	"NO_CODE":             "no completion code was entered",
+	"BAD_CODE":            "the completion code entered was not correct",
+	"NO_DATA":             "we did not receive any data for it",
+	"UNSUPP_DEVICE":       "it was completed on a device the study does not support",
+	"OTHER":               "it did not meet the requirements of the study",
+}
+
+// runReview runs the review queue until the reviewer finishes with it.
+// Replaced in tests via SetRunReviewForTesting to drive the queue without a
+// terminal.
+var runReview = func(ctx context.Context, v ReviewView) (ReviewView, error) {
+	final, err := tea.NewProgram(v, tea.WithAltScreen(), tea.WithContext(ctx)).Run()
+	if err != nil {
+		return v, err
+	}
+	return final.(ReviewView), nil
+}
+
+// ReviewOptions is the options for the review submissions command.
+type ReviewOptions struct {
+	StudyID       string
+	SurveyID      string
+	BatchID       string
+	RejectMessage string
+	ReturnReasons []string
+}
+
+// NewReviewCommand creates a new `submission review` command to work through
+// the submissions awaiting review for a study.
+func NewReviewCommand(c client.API, w io.Writer) *cobra.Command {
+	var opts ReviewOptions
+
+	cmd := &cobra.Command{
+		Use:   "review <study-id>",
+		Short: "Review the submissions awaiting review for a study",
+		Long: `Review the submissions awaiting review for a study
+
+Shows each submission awaiting review in turn, with the participant, the time
+taken against the study's estimate, the study code and any responses linked to
+it, so you can decide on it with a single key:
+
+  a          Approve
+  r          Reject, choosing a rejection category
+  t          Request that the participant returns the submission
+  s, space   Skip
+  u          Undo the last decision
+  q          Finish early
+
+Nothing is sent until the end, where you see a summary of your decisions and
+can submit them, undo the last one or discard them all.
+
+Rejections are sent with a message, rendered from a Go template given the
+submission (.Submission), the study (.Study), the category (.Category) and a
+description of it (.Reason). It must come to at least 100 characters.
+
+Link survey or AI Task Builder responses with --survey and --batch, so they are
+shown alongside each submission.`,
+		Example: `
+Review a study's submissions:
+$ prolific submission review 64395e9c2332b8a59a65d51e
+
+Show the answers from a survey and an AI Task Builder batch:
+$ prolific submission review 64395e9c2332b8a59a65d51e --survey 6630c7a4f7c0d2f3b1e9a8d5 --batch 5f8e3c2a-1d4b-4e6f-9a7c-2b0d8f3e1c5a
+
+Use your own rejection message:
+$ prolific submission review 64395e9c2332b8a59a65d51e --reject-message 'Hi {{.Submission.ParticipantID}}, we could not approve this submission because {{.Reason}}. Please message us if you have any questions about this decision.'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.StudyID = args[0]
+
+			err := reviewSubmissions(cmd.Context(), c, opts, w)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.SurveyID, "survey", "", "Show the responses to this survey alongside each submission")
+	flags.StringVar(&opts.BatchID, "batch", "", "Show the responses to this AI Task Builder batch alongside each submission")
+	flags.StringVar(&opts.RejectMessage, "reject-message", defaultRejectMessage, "Go template for the message sent with a rejection")
+	flags.StringArrayVar(&opts.ReturnReasons, "return-reason", []string{"Didn't finish the study"}, "Reason given when requesting a return (can be specified multiple times)")
+
+	return cmd
+}
+
+func reviewSubmissions(ctx context.Context, c client.API, opts ReviewOptions, w io.Writer) error {
+	rejectMessage, err := ui.ParseTemplate(opts.RejectMessage)
+	if err != nil {
+		return fmt.Errorf("invalid reject message: %w", err)
+	}
+
+	study, err := c.GetStudy(ctx, opts.StudyID)
+	if err != nil {
+		return err
+	}
+
+	submissions, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.ListSubmissionsResponse, error) {
+		return c.GetSubmissions(ctx, opts.StudyID, limit, offset)
+	})
+	if err != nil {
+		return err
+	}
+
+	queue := FilterSubmissionsByStatus(submissions.Results, "AWAITING REVIEW")
+	if len(queue) == 0 {
+		fmt.Fprintln(w, "No submissions are awaiting review.")
+		return nil
+	}
+
+	linked, err := fetchLinkedResponses(ctx, c, opts)
+	if err != nil {
+		return err
+	}
+
+	view, err := runReview(ctx, NewReviewView(*study, queue, linked, rejectMessage))
+	if err != nil {
+		return fmt.Errorf("cannot render the review: %w", err)
+	}
+
+	decisions := view.Decisions()
+	if !view.Submitted() || len(decisions) == 0 {
+		fmt.Fprintln(w, "No decisions were sent.")
+		return nil
+	}
+
+	return applyDecisions(ctx, c, opts, decisions, w)
+}
+
+// fetchLinkedResponses returns the survey and AI Task Builder responses asked
+// for, rendered and keyed by submission ID, or by participant ID when a
+// response does not name its submission.
+func fetchLinkedResponses(ctx context.Context, c client.API, opts ReviewOptions) (map[string][]string, error) {
+	linked := map[string][]string{}
+	link := func(submissionID, participantID, content string) {
+		key := submissionID
+		if key == "" {
+			key = participantID
+		}
+		linked[key] = append(linked[key], content)
+	}
+
+	if opts.SurveyID != "" {
+		responses, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.ListSurveyResponsesResponse, error) {
+			return c.GetSurveyResponses(ctx, opts.SurveyID, limit, offset)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range responses.Results {
+			link(r.SubmissionID, r.ParticipantID, renderLinkedSurveyResponse(r))
+		}
+	}
+
+	if opts.BatchID != "" {
+		responses, err := c.GetAITaskBuilderResponses(ctx, opts.BatchID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range responses.Results {
+			link(r.SubmissionID, r.ParticipantID, renderLinkedTaskResponse(r))
+		}
+	}
+
+	return linked, nil
+}
+
+func renderLinkedSurveyResponse(r model.SurveyResponse) string {
+	var content strings.Builder
+	content.WriteString("Survey response\n")
+
+	questions := append([]model.SurveyQuestionResponse{}, r.Questions...)
+	for _, section := range r.Sections {
+		questions = append(questions, section.Questions...)
+	}
+	for _, q := range questions {
+		answers := make([]string, len(q.Answers))
+		for i, a := range q.Answers {
+			answers[i] = a.Value
+		}
+		content.WriteString(fmt.Sprintf("  %s: %s\n", q.QuestionTitle, strings.Join(answers, ", ")))
+	}
+
+	return content.String()
+}
+
+func renderLinkedTaskResponse(r model.AITaskBuilderResponse) string {
+	answers := make([]string, 0, len(r.Response.Answer))
+	for _, a := range r.Response.Answer {
+		switch {
+		case a.FileName != "":
+			answers = append(answers, a.FileName)
+		case a.Unit != "":
+			answers = append(answers, a.Value+" "+a.Unit)
+		case a.Explanation != "":
+			answers = append(answers, fmt.Sprintf("%s (%s)", a.Value, a.Explanation))
+		default:
+			answers = append(answers, a.Value)
+		}
+	}
+
+	return fmt.Sprintf("AI Task Builder response to task %s\n  %s\n", r.TaskID, strings.Join(answers, ", "))
+}
+
+// applyDecisions sends the reviewer's decisions: approvals together in one
+// request, then each rejection and return request. It carries on past
+// failures, reporting the outcome of each decision.
+func applyDecisions(ctx context.Context, c client.API, opts ReviewOptions, decisions []ReviewDecision, w io.Writer) error {
+	results := make([]error, len(decisions))
+
+	var approvals []string
+	for _, d := range decisions {
+		if d.Action == ReviewApprove {
+			approvals = append(approvals, d.Submission.ID)
+		}
+	}
+	var approveErr error
+	if len(approvals) > 0 {
+		approveErr = c.BulkApproveSubmissions(ctx, client.BulkApproveSubmissionsPayload{SubmissionIDs: approvals})
+	}
+
+	for i, d := range decisions {
+		switch d.Action {
+		case ReviewApprove:
+			results[i] = approveErr
+		case ReviewReject:
+			_, results[i] = c.TransitionSubmission(ctx, d.Submission.ID, client.TransitionSubmissionPayload{
+				Action:            "REJECT",
+				Message:           d.Message,
+				RejectionCategory: d.Category,
+			})
+		case ReviewReturn:
+			_, results[i] = c.RequestSubmissionReturn(ctx, d.Submission.ID, opts.ReturnReasons)
+		}
+	}
+
+	failed := 0
+	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
+	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", "ID", "Participant", "Decision", "Result")
+	for i, d := range decisions {
+		result := "done"
+		if results[i] != nil {
+			result = results[i].Error()
+			failed++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", d.Submission.ID, d.Submission.ParticipantID, d.Label(), result)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d decisions could not be sent", failed, len(decisions))
+	}
+	return nil
+}
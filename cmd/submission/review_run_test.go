@@ -0,0 +1,16 @@
+package submission
+
+import "context"
+
+// SetRunReviewForTesting replaces how the review queue is run, so tests can
+// drive it without a terminal. Call the returned function (typically via
+// defer) to restore the original.
+//
+// This file is compiled only during `go test` and is intentionally in
+// package submission (not submission_test) so that it can access unexported
+// variables while still being callable from external test packages.
+func SetRunReviewForTesting(f func(context.Context, ReviewView) (ReviewView, error)) func() {
+	prev := runReview
+	runReview = f
+	return func() { runReview = prev }
+}
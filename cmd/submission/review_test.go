@@ -0,0 +1,280 @@
+package submission_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/golang/mock/gomock"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/submission"
+	"github.com/prolific-oss/cli/mock_client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// pressKeys returns a review runner that presses each key in turn.
+func pressKeys(keys ...string) func(context.Context, submission.ReviewView) (submission.ReviewView, error) {
+	return func(_ context.Context, v submission.ReviewView) (submission.ReviewView, error) {
+		for _, k := range keys {
+			var msg tea.KeyMsg
+			switch k {
+			case "enter":
+				msg = tea.KeyMsg{Type: tea.KeyEnter}
+			case "down":
+				msg = tea.KeyMsg{Type: tea.KeyDown}
+			case "esc":
+				msg = tea.KeyMsg{Type: tea.KeyEsc}
+			default:
+				msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+			}
+			m, _ := v.Update(msg)
+			v = m.(submission.ReviewView)
+		}
+		return v, nil
+	}
+}
+
+func expectReviewQueue(c *mock_client.MockAPI, studyID string) {
+	c.
+		EXPECT().
+		GetStudy(gomock.Any(), gomock.Eq(studyID)).
+		Return(&model.Study{ID: studyID, Name: "Reaction times", EstimatedCompletionTime: 10}, nil).
+		Times(1)
+
+	c.
+		EXPECT().
+		GetSubmissions(gomock.Any(), gomock.Eq(studyID), gomock.Eq(client.DefaultRecordLimit), gomock.Eq(client.DefaultRecordOffset)).
+		Return(&client.ListSubmissionsResponse{
+			Results: []model.Submission{
+				{ID: "sub-1", ParticipantID: "p-1", Status: "AWAITING REVIEW", TimeTaken: 300},
+				{ID: "sub-2", ParticipantID: "p-2", Status: "APPROVED"},
+				{ID: "sub-3", ParticipantID: "p-3", Status: "AWAITING REVIEW", TimeTaken: 30},
+				{ID: "sub-4", ParticipantID: "p-4", Status: "AWAITING REVIEW", TimeTaken: 900},
+			},
+			JSONAPIMeta: &client.JSONAPIMeta{},
+		}, nil).
+		Times(1)
+}
+
+func TestNewReviewCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := submission.NewReviewCommand(c, os.Stdout)
+
+	use := "review <study-id>"
+	short := "Review the submissions awaiting review for a study"
+
+	if cmd.Use != use {
+		t.Fatalf("expected use: %s; got %s", use, cmd.Use)
+	}
+
+	if cmd.Short != short {
+		t.Fatalf("expected short: %s; got %s", short, cmd.Short)
+	}
+}
+
+func TestReviewCommandSendsTheDecisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	studyID := "study-1"
+	expectReviewQueue(c, studyID)
+
+	c.
+		EXPECT().
+		BulkApproveSubmissions(gomock.Any(), gomock.Eq(client.BulkApproveSubmissionsPayload{SubmissionIDs: []string{"sub-1"}})).
+		Return(nil).
+		Times(1)
+
+	c.
+		EXPECT().
+		TransitionSubmission(gomock.Any(), gomock.Eq("sub-3"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, payload client.TransitionSubmissionPayload) (*client.TransitionSubmissionResponse, error) {
+			if payload.Action != "REJECT" || payload.RejectionCategory != "TOO_QUICKLY" {
+				t.Fatalf("expected a TOO_QUICKLY rejection; got %+v", payload)
+			}
+			if !strings.Contains(payload.Message, "Reaction times") || !strings.Contains(payload.Message, "completed too quickly") {
+				t.Fatalf("expected the message to be rendered from the template; got %s", payload.Message)
+			}
+			return &client.TransitionSubmissionResponse{}, nil
+		}).
+		Times(1)
+
+	c.
+		EXPECT().
+		RequestSubmissionReturn(gomock.Any(), gomock.Eq("sub-4"), gomock.Eq([]string{"Didn't finish the study"})).
+		Return(&client.RequestSubmissionReturnResponse{}, nil).
+		Times(1)
+
+	defer submission.SetRunReviewForTesting(pressKeys("a", "r", "enter", "t", "enter"))()
+
+	var b bytes.Buffer
+	cmd := submission.NewReviewCommand(c, &b)
+	cmd.SetContext(context.Background())
+	err := cmd.RunE(cmd, []string{studyID})
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	for _, expected := range []string{"sub-1 p-1 Approve", "sub-3 p-3 Reject (TOO_QUICKLY)", "sub-4 p-4 Request return"} {
+		if !strings.Contains(strings.Join(strings.Fields(b.String()), " "), expected+" done") {
+			t.Fatalf("expected output to contain %q; got\n%s", expected, b.String())
+		}
+	}
+}
+
+func TestReviewCommandSendsNothingWhenDiscarded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	studyID := "study-1"
+	expectReviewQueue(c, studyID)
+
+	defer submission.SetRunReviewForTesting(pressKeys("a", "a", "a", "q"))()
+
+	var b bytes.Buffer
+	cmd := submission.NewReviewCommand(c, &b)
+	cmd.SetContext(context.Background())
+	err := cmd.RunE(cmd, []string{studyID})
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	if b.String() != "No decisions were sent.\n" {
+		t.Fatalf("expected nothing to be sent; got\n%s", b.String())
+	}
+}
+
+func TestReviewCommandReportsDecisionsThatFail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	studyID := "study-1"
+	expectReviewQueue(c, studyID)
+
+	c.
+		EXPECT().
+		RequestSubmissionReturn(gomock.Any(), gomock.Eq("sub-1"), gomock.Any()).
+		Return(nil, errors.New("participant has already returned")).
+		Times(1)
+
+	defer submission.SetRunReviewForTesting(pressKeys("t", "s", "s", "y"))()
+
+	var b bytes.Buffer
+	cmd := submission.NewReviewCommand(c, &b)
+	cmd.SetContext(context.Background())
+	err := cmd.RunE(cmd, []string{studyID})
+
+	expected := "error: 1 of 1 decisions could not be sent"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected %q; got %v", expected, err)
+	}
+	if !strings.Contains(b.String(), "participant has already returned") {
+		t.Fatalf("expected the failure to be reported; got\n%s", b.String())
+	}
+}
+
+func TestReviewCommandWithNothingAwaitingReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.
+		EXPECT().
+		GetStudy(gomock.Any(), gomock.Eq("study-1")).
+		Return(&model.Study{ID: "study-1"}, nil).
+		Times(1)
+
+	c.
+		EXPECT().
+		GetSubmissions(gomock.Any(), gomock.Eq("study-1"), gomock.Any(), gomock.Any()).
+		Return(&client.ListSubmissionsResponse{
+			Results:     []model.Submission{{ID: "sub-1", Status: "APPROVED"}},
+			JSONAPIMeta: &client.JSONAPIMeta{},
+		}, nil).
+		Times(1)
+
+	defer submission.SetRunReviewForTesting(func(context.Context, submission.ReviewView) (submission.ReviewView, error) {
+		t.Fatal("expected the review not to run")
+		return submission.ReviewView{}, nil
+	})()
+
+	var b bytes.Buffer
+	cmd := submission.NewReviewCommand(c, &b)
+	cmd.SetContext(context.Background())
+	err := cmd.RunE(cmd, []string{"study-1"})
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	if b.String() != "No submissions are awaiting review.\n" {
+		t.Fatalf("unexpected output\n%s", b.String())
+	}
+}
+
+func TestReviewViewUndoesDecisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	studyID := "study-1"
+	expectReviewQueue(c, studyID)
+
+	c.
+		EXPECT().
+		BulkApproveSubmissions(gomock.Any(), gomock.Eq(client.BulkApproveSubmissionsPayload{SubmissionIDs: []string{"sub-3"}})).
+		Return(nil).
+		Times(1)
+
+	// Approve all three, undo them all from the summary, then skip the first
+	// and approve the second.
+	defer submission.SetRunReviewForTesting(pressKeys("a", "a", "a", "u", "u", "u", "s", "a", "q", "enter"))()
+
+	var b bytes.Buffer
+	cmd := submission.NewReviewCommand(c, &b)
+	cmd.SetContext(context.Background())
+	err := cmd.RunE(cmd, []string{studyID})
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+}
+
+func TestReviewViewRequiresALongEnoughRejectMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	studyID := "study-1"
+	expectReviewQueue(c, studyID)
+
+	var view submission.ReviewView
+	defer submission.SetRunReviewForTesting(func(ctx context.Context, v submission.ReviewView) (submission.ReviewView, error) {
+		view, _ = pressKeys("r", "down", "enter")(ctx, v)
+		return view, nil
+	})()
+
+	var b bytes.Buffer
+	cmd := submission.NewReviewCommand(c, &b)
+	cmd.SetContext(context.Background())
+	_ = cmd.Flags().Set("reject-message", "Sorry, {{.Reason}}.")
+	err := cmd.RunE(cmd, []string{studyID})
+	if err != nil {
+		t.Fatalf("expected no error; got %s", err)
+	}
+
+	if len(view.Decisions()) != 0 {
+		t.Fatalf("expected no decisions; got %+v", view.Decisions())
+	}
+	if !strings.Contains(view.View(), "the reject message must be at least 100 characters") {
+		t.Fatalf("expected the short message to be reported; got\n%s", view.View())
+	}
+}
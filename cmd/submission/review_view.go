@@ -0,0 +1,319 @@
+package submission
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+)
+
+// ReviewAction is what the reviewer decided to do with a submission.
+type ReviewAction int
+
+const (
+	ReviewSkip ReviewAction = iota
+	ReviewApprove
+	ReviewReject
+	ReviewReturn
+)
+
+// ReviewDecision is the reviewer's decision on one submission.
+type ReviewDecision struct {
+	Submission model.Submission
+	Action     ReviewAction
+	// Category and Message are set for rejections.
+	Category string
+	Message  string
+}
+
+// Label describes the decision for the summary.
+func (d ReviewDecision) Label() string {
+	switch d.Action {
+	case ReviewApprove:
+		return "Approve"
+	case ReviewReject:
+		return fmt.Sprintf("Reject (%s)", d.Category)
+	case ReviewReturn:
+		return "Request return"
+	default:
+		return "Skip"
+	}
+}
+
+const (
+	reviewHelpText  = "a approve • r reject • t request return • s skip • u undo • q finish"
+	pickerHelpText  = "↑/↓ choose • enter reject • esc cancel"
+	summaryHelpText = "enter submit • u undo • q discard"
+)
+
+var (
+	reviewHeadingStyle = lipgloss.NewStyle().Bold(true)
+	reviewStatusStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color(ui.DarkGrey))
+	reviewCursorStyle  = lipgloss.NewStyle().Bold(true)
+)
+
+// ReviewView is the bubbletea model for `submission review`. It steps through
+// the queue one submission at a time, recording a decision for each, then
+// shows a summary of the decisions to submit or discard.
+type ReviewView struct {
+	study         model.Study
+	queue         []model.Submission
+	linked        map[string][]string
+	rejectMessage *template.Template
+	// history holds a decision for each submission dealt with so far, in
+	// queue order, so the next submission is queue[len(history)].
+	history   []ReviewDecision
+	picking   bool
+	category  int
+	finished  bool
+	submitted bool
+	status    string
+}
+
+// NewReviewView creates a ReviewView for the queue of submissions. linked
+// holds the rendered responses for each submission, keyed by submission or
+// participant ID.
+func NewReviewView(study model.Study, queue []model.Submission, linked map[string][]string, rejectMessage *template.Template) ReviewView {
+	return ReviewView{
+		study:         study,
+		queue:         queue,
+		linked:        linked,
+		rejectMessage: rejectMessage,
+	}
+}
+
+// Decisions returns the decisions to send, leaving out skipped submissions.
+func (v ReviewView) Decisions() []ReviewDecision {
+	var decisions []ReviewDecision
+	for _, d := range v.history {
+		if d.Action != ReviewSkip {
+			decisions = append(decisions, d)
+		}
+	}
+	return decisions
+}
+
+// Submitted reports whether the reviewer chose to submit their decisions.
+func (v ReviewView) Submitted() bool {
+	return v.submitted
+}
+
+// Init implements tea.Model.
+func (v ReviewView) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model.
+func (v ReviewView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return v, nil
+	}
+	if key.String() == "ctrl+c" {
+		return v, tea.Quit
+	}
+
+	switch {
+	case v.finished:
+		return v.updateSummary(key)
+	case v.picking:
+		return v.updatePicker(key), nil
+	}
+
+	sub := v.queue[len(v.history)]
+	v.status = ""
+	switch key.String() {
+	case "a":
+		v = v.decide(ReviewDecision{Submission: sub, Action: ReviewApprove})
+	case "r":
+		v.picking = true
+	case "t":
+		v = v.decide(ReviewDecision{Submission: sub, Action: ReviewReturn})
+	case "s", " ":
+		v = v.decide(ReviewDecision{Submission: sub, Action: ReviewSkip})
+	case "u":
+		v = v.undo()
+	case "q":
+		v.finished = true
+	}
+	return v, nil
+}
+
+func (v ReviewView) updatePicker(key tea.KeyMsg) ReviewView {
+	switch key.String() {
+	case "up", "k":
+		v.category = (v.category + len(rejectionCategories) - 1) % len(rejectionCategories)
+	case "down", "j":
+		v.category = (v.category + 1) % len(rejectionCategories)
+	case "esc":
+		v.picking = false
+	case "enter":
+		sub := v.queue[len(v.history)]
+		category := rejectionCategories[v.category]
+		message, err := v.renderRejectMessage(sub, category)
+		if err != nil {
+			v.status = err.Error()
+			return v
+		}
+		v.picking = false
+		v = v.decide(ReviewDecision{Submission: sub, Action: ReviewReject, Category: category, Message: message})
+	}
+	return v
+}
+
+func (v ReviewView) updateSummary(key tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch key.String() {
+	case "enter", "y":
+		v.submitted = true
+		return v, tea.Quit
+	case "u":
+		v.finished = false
+		return v.undo(), nil
+	case "q", "esc":
+		return v, tea.Quit
+	}
+	return v, nil
+}
+
+// decide records a decision on the current submission, moving on to the
+// summary after the last one.
+func (v ReviewView) decide(d ReviewDecision) ReviewView {
+	v.history = append(v.history[:len(v.history):len(v.history)], d)
+	v.finished = len(v.history) == len(v.queue)
+	return v
+}
+
+// undo takes back the last decision, returning to its submission.
+func (v ReviewView) undo() ReviewView {
+	if len(v.history) == 0 {
+		v.status = "Nothing to undo"
+		return v
+	}
+	last := v.history[len(v.history)-1]
+	v.history = v.history[:len(v.history)-1]
+	v.status = fmt.Sprintf("Undid %s for %s", strings.ToLower(last.Label()), last.Submission.ParticipantID)
+	return v
+}
+
+func (v ReviewView) renderRejectMessage(sub model.Submission, category string) (string, error) {
+	var b bytes.Buffer
+	err := v.rejectMessage.Execute(&b, map[string]any{
+		"Submission": sub,
+		"Study":      v.study,
+		"Category":   category,
+		"Reason":     rejectionReasons[category],
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot render the reject message: %w", err)
+	}
+
+	message := strings.TrimSpace(b.String())
+	if len(message) < minRejectMessageLength {
+		return "", fmt.Errorf("the reject message must be at least %d characters, it is %d", minRejectMessageLength, len(message))
+	}
+	return message, nil
+}
+
+// View implements tea.Model.
+func (v ReviewView) View() string {
+	var content strings.Builder
+
+	if v.finished {
+		content.WriteString(v.summaryView())
+	} else {
+		content.WriteString(v.submissionView(v.queue[len(v.history)]))
+	}
+
+	help := reviewHelpText
+	switch {
+	case v.finished:
+		help = summaryHelpText
+	case v.picking:
+		help = pickerHelpText
+	}
+	if v.status != "" {
+		content.WriteString("\n" + v.status + "\n")
+	}
+	content.WriteString("\n" + reviewStatusStyle.Render(help) + "\n")
+
+	return content.String()
+}
+
+func (v ReviewView) submissionView(sub model.Submission) string {
+	var content strings.Builder
+
+	heading := fmt.Sprintf("%s - submission %d of %d", v.study.Name, len(v.history)+1, len(v.queue))
+	content.WriteString(reviewHeadingStyle.Render(heading) + "\n\n")
+	content.WriteString(fmt.Sprintf("Participant: %s\n", sub.ParticipantID))
+	content.WriteString(fmt.Sprintf("ID:          %s\n", sub.ID))
+	content.WriteString(fmt.Sprintf("Started At:  %s\n", ui.FormatDateTime(sub.StartedAt)))
+	content.WriteString(fmt.Sprintf("Time Taken:  %s\n", v.timeTaken(sub)))
+	content.WriteString(fmt.Sprintf("Study Code:  %s\n", sub.StudyCode))
+
+	responses := v.linked[sub.ID]
+	if len(responses) == 0 {
+		responses = v.linked[sub.ParticipantID]
+	}
+	for _, r := range responses {
+		content.WriteString("\n" + r)
+	}
+
+	if v.picking {
+		content.WriteString("\nRejection category:\n")
+		for i, category := range rejectionCategories {
+			line := fmt.Sprintf("  %s - %s", category, rejectionReasons[category])
+			if i == v.category {
+				line = reviewCursorStyle.Render("> " + line[2:])
+			}
+			content.WriteString(line + "\n")
+		}
+	}
+
+	return content.String()
+}
+
+// timeTaken describes how long the submission took against the study's
+// estimated completion time.
+func (v ReviewView) timeTaken(sub model.Submission) string {
+	taken := (time.Duration(sub.TimeTaken) * time.Second).String()
+	if v.study.EstimatedCompletionTime <= 0 {
+		return taken
+	}
+
+	estimate := time.Duration(v.study.EstimatedCompletionTime) * time.Minute
+	percent := float64(sub.TimeTaken) / estimate.Seconds() * 100
+	return fmt.Sprintf("%s (%.0f%% of the %s estimate)", taken, percent, estimate)
+}
+
+func (v ReviewView) summaryView() string {
+	var content strings.Builder
+
+	content.WriteString(reviewHeadingStyle.Render(fmt.Sprintf("%s - review summary", v.study.Name)) + "\n\n")
+
+	counts := map[ReviewAction]int{}
+	for _, d := range v.history {
+		counts[d.Action]++
+	}
+	content.WriteString(fmt.Sprintf("Approve:        %d\n", counts[ReviewApprove]))
+	content.WriteString(fmt.Sprintf("Reject:         %d\n", counts[ReviewReject]))
+	content.WriteString(fmt.Sprintf("Request return: %d\n", counts[ReviewReturn]))
+	content.WriteString(fmt.Sprintf("Skip:           %d\n", counts[ReviewSkip]))
+	if remaining := len(v.queue) - len(v.history); remaining > 0 {
+		content.WriteString(fmt.Sprintf("Not reviewed:   %d\n", remaining))
+	}
+
+	if decisions := v.Decisions(); len(decisions) > 0 {
+		content.WriteString("\n")
+		for _, d := range decisions {
+			content.WriteString(fmt.Sprintf("  %s  %s\n", d.Submission.ParticipantID, d.Label()))
+		}
+	}
+
+	return content.String()
+}
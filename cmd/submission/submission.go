@@ -24,6 +24,7 @@ commands allow you to manage those submissions.
 		NewRequestReturnCommand(client, w),
 		NewTransitionCommand(client, w),
 		NewBulkApproveCommand(client, w),
+		NewReviewCommand(client, w),
 	)
 	return cmd
 }
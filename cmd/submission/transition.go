@@ -1,12 +1,14 @@
 package submission
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
 )
@@ -46,6 +48,7 @@ type TransitionOptions struct {
 	CompletionCode       string
 	PercentageOfReward   float64
 	MessageToParticipant string
+	Output               shared.OutputOptions
 }
 
 // NewTransitionCommand creates a new `submission transition` command.
@@ -72,9 +75,9 @@ code has a DYNAMIC_PAYMENT action, you must also provide the percentage of rewar
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.SubmissionID = args[0]
 
-			err := transitionSubmission(c, opts, w)
+			err := transitionSubmission(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -88,13 +91,14 @@ code has a DYNAMIC_PAYMENT action, you must also provide the percentage of rewar
 	flags.StringVar(&opts.CompletionCode, "completion-code", "", "Completion code (required for COMPLETE)")
 	flags.Float64Var(&opts.PercentageOfReward, "percentage-of-reward", 0, "Percentage of reward for dynamic payment (8-99, used with COMPLETE)")
 	flags.StringVar(&opts.MessageToParticipant, "message-to-participant", "", "Message to participant for dynamic payment (used with COMPLETE)")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	_ = cmd.MarkFlagRequired("action")
 
 	return cmd
 }
 
-func transitionSubmission(c client.API, opts TransitionOptions, w io.Writer) error {
+func transitionSubmission(ctx context.Context, c client.API, opts TransitionOptions, w io.Writer) error {
 	if !slices.Contains(submissionTransitionActions, opts.Action) {
 		return fmt.Errorf("invalid action %q, must be one of: %s", opts.Action, strings.Join(submissionTransitionActions, ", "))
 	}
@@ -131,11 +135,15 @@ func transitionSubmission(c client.API, opts TransitionOptions, w io.Writer) err
 		}
 	}
 
-	response, err := c.TransitionSubmission(opts.SubmissionID, payload)
+	response, err := c.TransitionSubmission(ctx, opts.SubmissionID, payload)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", "ID", "Study", "Participant", "Status")
 	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", response.ID, response.StudyID, response.Participant, response.Status)
@@ -41,7 +41,7 @@ func TestTransitionCommandErrorsIfInvalidAction(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Any(), gomock.Any()).
+		TransitionSubmission(gomock.Any(), gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -70,7 +70,7 @@ func TestTransitionCommandErrorsIfRejectWithoutMessage(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Any(), gomock.Any()).
+		TransitionSubmission(gomock.Any(), gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -97,7 +97,7 @@ func TestTransitionCommandErrorsIfRejectWithoutCategory(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Any(), gomock.Any()).
+		TransitionSubmission(gomock.Any(), gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -134,7 +134,7 @@ func TestTransitionCommandTransitionsSubmission(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
+		TransitionSubmission(gomock.Any(), gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
 			Action: action,
 		})).
 		Return(&r, nil).
@@ -167,6 +167,45 @@ func TestTransitionCommandTransitionsSubmission(t *testing.T) {
 	}
 }
 
+func TestTransitionCommandRendersTheSubmissionAsJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	r := client.TransitionSubmissionResponse{
+		ID:          testSubmissionID,
+		Status:      "APPROVED",
+		Participant: "participant-123",
+		StudyID:     "study-456",
+	}
+
+	c.
+		EXPECT().
+		TransitionSubmission(gomock.Any(), gomock.Eq(testSubmissionID), gomock.Eq(client.TransitionSubmissionPayload{
+			Action: "APPROVE",
+		})).
+		Return(&r, nil).
+		Times(1)
+
+	var b bytes.Buffer
+	writer := bufio.NewWriter(&b)
+
+	cmd := submission.NewTransitionCommand(c, writer)
+	_ = cmd.Flags().Set("action", "APPROVE")
+	_ = cmd.Flags().Set("jq", "{id, status}")
+	err := cmd.RunE(cmd, []string{testSubmissionID})
+	if err != nil {
+		t.Fatalf("was not expected error, got %v", err)
+	}
+
+	writer.Flush()
+
+	expected := fmt.Sprintf("{\n  \"id\": %q,\n  \"status\": \"APPROVED\"\n}\n", testSubmissionID)
+	if b.String() != expected {
+		t.Fatalf("expected\n%q\ngot\n%q", expected, b.String())
+	}
+}
+
 func TestTransitionCommandTransitionsSubmissionWithRejection(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -185,7 +224,7 @@ func TestTransitionCommandTransitionsSubmissionWithRejection(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
+		TransitionSubmission(gomock.Any(), gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
 			Action:            action,
 			Message:           message,
 			RejectionCategory: category,
@@ -223,7 +262,7 @@ func TestTransitionCommandErrorsIfInvalidRejectionCategory(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Any(), gomock.Any()).
+		TransitionSubmission(gomock.Any(), gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -254,7 +293,7 @@ func TestTransitionCommandErrorsIfCompleteWithoutCode(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Any(), gomock.Any()).
+		TransitionSubmission(gomock.Any(), gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -290,7 +329,7 @@ func TestTransitionCommandTransitionsSubmissionWithComplete(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
+		TransitionSubmission(gomock.Any(), gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
 			Action:         action,
 			CompletionCode: completionCode,
 		})).
@@ -336,7 +375,7 @@ func TestTransitionCommandTransitionsSubmissionWithDynamicPayment(t *testing.T)
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
+		TransitionSubmission(gomock.Any(), gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
 			Action:         action,
 			CompletionCode: completionCode,
 			CompletionCodeData: &client.CompletionCodeData{
@@ -378,7 +417,7 @@ func TestTransitionCommandReturnsErrorIfTransitionFails(t *testing.T) {
 
 	c.
 		EXPECT().
-		TransitionSubmission(gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
+		TransitionSubmission(gomock.Any(), gomock.Eq(submissionID), gomock.Eq(client.TransitionSubmissionPayload{
 			Action: "APPROVE",
 		})).
 		Return(nil, errors.New(errorMessage)).
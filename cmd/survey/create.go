@@ -1,10 +1,12 @@
 package survey
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -15,6 +17,7 @@ type CreateOptions struct {
 	Args         []string
 	TemplatePath string
 	Title        string
+	Output       shared.OutputOptions
 }
 
 // NewCreateCommand creates a new command for creating a survey.
@@ -44,9 +47,9 @@ $ prolific survey create -t /path/to/survey.json --title "My Survey"
 				return fmt.Errorf("error: a template file is required, use -t to specify the path")
 			}
 
-			err := createSurvey(client, opts, w)
+			err := createSurvey(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -56,11 +59,12 @@ $ prolific survey create -t /path/to/survey.json --title "My Survey"
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a JSON/YAML file defining the survey")
 	flags.StringVar(&opts.Title, "title", "", "Override the title of the survey")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func createSurvey(c client.API, opts CreateOptions, w io.Writer) error {
+func createSurvey(ctx context.Context, c client.API, opts CreateOptions, w io.Writer) error {
 	v := viper.New()
 	v.SetConfigFile(opts.TemplatePath)
 	err := v.ReadInConfig()
@@ -71,7 +75,7 @@ func createSurvey(c client.API, opts CreateOptions, w io.Writer) error {
 	var s model.CreateSurvey
 	err = v.Unmarshal(&s)
 	if err != nil {
-		return fmt.Errorf("unable to map %s to survey model: %s", opts.TemplatePath, err)
+		return fmt.Errorf("unable to map %s to survey model: %w", opts.TemplatePath, err)
 	}
 
 	if opts.Title != "" {
@@ -79,18 +83,22 @@ func createSurvey(c client.API, opts CreateOptions, w io.Writer) error {
 	}
 
 	if s.ResearcherID == "" {
-		me, err := c.GetMe()
+		me, err := c.GetMe(ctx)
 		if err != nil {
 			return err
 		}
 		s.ResearcherID = me.ID
 	}
 
-	record, err := c.CreateSurvey(s)
+	record, err := c.CreateSurvey(ctx, s)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *record, w)
+	}
+
 	fmt.Fprintf(w, "Created survey: %s\n", record.ID)
 
 	return nil
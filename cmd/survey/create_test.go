@@ -3,6 +3,7 @@ package survey_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -65,9 +66,9 @@ func TestCreateSurvey(t *testing.T) {
 				]
 			}`,
 			setupMocks: func(c *mock_client.MockAPI) {
-				c.EXPECT().GetMe().Return(&client.MeResponse{ID: testResearcherID}, nil).Times(1)
-				c.EXPECT().CreateSurvey(gomock.Any()).
-					DoAndReturn(func(s model.CreateSurvey) (*client.CreateSurveyResponse, error) {
+				c.EXPECT().GetMe(gomock.Any()).Return(&client.MeResponse{ID: testResearcherID}, nil).Times(1)
+				c.EXPECT().CreateSurvey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s model.CreateSurvey) (*client.CreateSurveyResponse, error) {
 						if s.Title != "Screening Survey" {
 							return nil, fmt.Errorf("expected title 'Screening Survey'; got '%s'", s.Title)
 						}
@@ -91,9 +92,9 @@ func TestCreateSurvey(t *testing.T) {
 				]
 			}`,
 			setupMocks: func(c *mock_client.MockAPI) {
-				c.EXPECT().GetMe().Return(&client.MeResponse{ID: testResearcherID}, nil).Times(1)
-				c.EXPECT().CreateSurvey(gomock.Any()).
-					DoAndReturn(func(s model.CreateSurvey) (*client.CreateSurveyResponse, error) {
+				c.EXPECT().GetMe(gomock.Any()).Return(&client.MeResponse{ID: testResearcherID}, nil).Times(1)
+				c.EXPECT().CreateSurvey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s model.CreateSurvey) (*client.CreateSurveyResponse, error) {
 						if s.Title != "Overridden Title" {
 							return nil, fmt.Errorf("expected title 'Overridden Title'; got '%s'", s.Title)
 						}
@@ -114,8 +115,8 @@ func TestCreateSurvey(t *testing.T) {
 				]
 			}`,
 			setupMocks: func(c *mock_client.MockAPI) {
-				c.EXPECT().CreateSurvey(gomock.Any()).
-					DoAndReturn(func(s model.CreateSurvey) (*client.CreateSurveyResponse, error) {
+				c.EXPECT().CreateSurvey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s model.CreateSurvey) (*client.CreateSurveyResponse, error) {
 						if s.ResearcherID != "661f9511-f3ac-52f5-b7g9-55h59kh985hd" {
 							return nil, fmt.Errorf("expected researcher_id from template; got '%s'", s.ResearcherID)
 						}
@@ -135,8 +136,8 @@ func TestCreateSurvey(t *testing.T) {
 				]
 			}`,
 			setupMocks: func(c *mock_client.MockAPI) {
-				c.EXPECT().GetMe().Return(&client.MeResponse{ID: testResearcherID}, nil).Times(1)
-				c.EXPECT().CreateSurvey(gomock.Any()).
+				c.EXPECT().GetMe(gomock.Any()).Return(&client.MeResponse{ID: testResearcherID}, nil).Times(1)
+				c.EXPECT().CreateSurvey(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("API error: bad request")).Times(1)
 			},
 			expectedError: "error: API error: bad request",
@@ -1,6 +1,7 @@
 package survey
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -33,9 +34,9 @@ $ prolific survey delete 6261321e223a605c7a4f7678
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := deleteSurvey(client, opts, w)
+			err := deleteSurvey(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -45,8 +46,8 @@ $ prolific survey delete 6261321e223a605c7a4f7678
 	return cmd
 }
 
-func deleteSurvey(client client.API, opts DeleteOptions, w io.Writer) error {
-	err := client.DeleteSurvey(opts.Args[0])
+func deleteSurvey(ctx context.Context, client client.API, opts DeleteOptions, w io.Writer) error {
+	err := client.DeleteSurvey(ctx, opts.Args[0])
 	if err != nil {
 		return err
 	}
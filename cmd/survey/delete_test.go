@@ -57,7 +57,7 @@ func TestDeleteSurvey(t *testing.T) {
 			c := mock_client.NewMockAPI(ctrl)
 
 			c.EXPECT().
-				DeleteSurvey(gomock.Eq(tt.surveyID)).
+				DeleteSurvey(gomock.Any(), gomock.Eq(tt.surveyID)).
 				Return(tt.mockError).
 				Times(1)
 
@@ -1,6 +1,7 @@
 package survey
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -8,8 +9,6 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/prolific-oss/cli/client"
 	"github.com/prolific-oss/cli/cmd/shared"
-	"github.com/prolific-oss/cli/model"
-	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -20,8 +19,10 @@ const defaultListFields = "ID,Title,DateCreated"
 type ListOptions struct {
 	Args   []string
 	Output shared.OutputOptions
+	Query  shared.QueryOptions
 	Limit  int
 	Offset int
+	All    bool
 }
 
 // NewListCommand creates a new command to list surveys
@@ -44,37 +45,41 @@ $ prolific survey list -n
 
 List your surveys as JSON
 $ prolific survey list --json
+
+List every survey as JSON, however many pages that takes
+$ prolific survey list --all --json
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			me, err := c.GetMe()
+			me, err := c.GetMe(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
+			}
+
+			surveys, err := shared.FetchList(cmd.Context(), opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListSurveysResponse, error) {
+				return c.GetSurveys(ctx, me.ID, limit, offset)
+			})
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
-			surveys, err := c.GetSurveys(me.ID, opts.Limit, opts.Offset)
+			surveys.Results, err = shared.ApplyQuery(opts.Query, surveys.Results)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			format := shared.ResolveFormat(opts.Output)
-			switch format {
-			case "json":
-				r := ui.JSONRenderer[model.Survey]{}
-				if err := r.Render(surveys.Results, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			case "table":
-				r := ui.TableRenderer[model.Survey]{}
-				if err := r.Render(surveys.Results, defaultListFields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			default:
+			if format == "" {
 				r := &InteractiveRenderer{}
 				if err := r.Render(c, *surveys, w); err != nil {
-					return fmt.Errorf("error: %s", err)
+					return fmt.Errorf("error: %w", err)
 				}
+				return nil
+			}
+
+			if err := shared.RenderList(opts.Output, surveys.Results, defaultListFields, w); err != nil {
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -83,8 +88,10 @@ $ prolific survey list --json
 
 	flags := cmd.Flags()
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of surveys returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of surveys to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of surveys to offset")
+	shared.AddAllFlag(cmd, &opts.All, "surveys")
 	shared.AddOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
@@ -97,7 +104,7 @@ func (r *InteractiveRenderer) Render(c client.API, surveys client.ListSurveysRes
 	var items []list.Item
 
 	for _, s := range surveys.Results {
-		items = append(items, model.SurveyListItem{Survey: s})
+		items = append(items, SurveyListItem{Survey: s})
 	}
 
 	lv := SurveyListView{
@@ -107,7 +114,7 @@ func (r *InteractiveRenderer) Render(c client.API, surveys client.ListSurveysRes
 
 	p := tea.NewProgram(lv)
 	if _, err := p.Run(); err != nil {
-		return fmt.Errorf("cannot render surveys: %s", err)
+		return fmt.Errorf("cannot render surveys: %w", err)
 	}
 
 	return nil
@@ -61,7 +61,7 @@ func TestListSurveys(t *testing.T) {
 					},
 				},
 			},
-			expectedOutput: "ID                                   Title            DateCreated                   \n6ba7b810-9dad-11d1-80b4-00c04fd430c8 Screening Survey 2026-01-15 00:00:00 +0000 UTC \n7ca8c921-0ebe-22e2-91c5-11d15ge541d9 Follow-up Survey 2026-02-20 00:00:00 +0000 UTC \n",
+			expectedOutput: "ID                                   Title            DateCreated      \n6ba7b810-9dad-11d1-80b4-00c04fd430c8 Screening Survey 15-01-2026 00:00 \n7ca8c921-0ebe-22e2-91c5-11d15ge541d9 Follow-up Survey 20-02-2026 00:00 \n",
 		},
 		{
 			name:     "json output",
@@ -99,13 +99,13 @@ func TestListSurveys(t *testing.T) {
 			c := mock_client.NewMockAPI(ctrl)
 
 			c.EXPECT().
-				GetMe().
+				GetMe(gomock.Any()).
 				Return(tt.meReturn, tt.meError).
 				Times(1)
 
 			if tt.meError == nil {
 				c.EXPECT().
-					GetSurveys(gomock.Eq(testResearcherID), client.DefaultRecordLimit, client.DefaultRecordOffset).
+					GetSurveys(gomock.Any(), gomock.Eq(testResearcherID), client.DefaultRecordLimit, client.DefaultRecordOffset).
 					Return(tt.surveysReturn, tt.surveysError).
 					Times(1)
 			}
@@ -11,6 +11,23 @@ import (
 	"github.com/prolific-oss/cli/ui"
 )
 
+// SurveyListItem wraps a Survey to satisfy the bubbletea list.DefaultItem interface
+// without conflicting with the Survey.Title field.
+type SurveyListItem struct {
+	model.Survey
+}
+
+// FilterValue implements the bubbletea list.Item interface.
+func (s SurveyListItem) FilterValue() string { return s.Survey.Title }
+
+// Title implements the bubbletea list.DefaultItem interface.
+func (s SurveyListItem) Title() string { return s.Survey.Title }
+
+// Description implements the bubbletea list.DefaultItem interface.
+func (s SurveyListItem) Description() string {
+	return fmt.Sprintf("ID: %s - created %s", s.ID, ui.InTimezone(s.DateCreated).Format("2006-01-02"))
+}
+
 // SurveyListView is responsible for presenting a list view to the user.
 type SurveyListView struct {
 	List   list.Model
@@ -31,7 +48,7 @@ func (lv SurveyListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		if msg.String() == "enter" {
-			i, ok := lv.List.SelectedItem().(model.SurveyListItem)
+			i, ok := lv.List.SelectedItem().(SurveyListItem)
 			if ok {
 				s := i.Survey
 				lv.Survey = &s
@@ -64,8 +81,8 @@ func renderSurveyString(s model.Survey) string {
 
 	content.WriteString(fmt.Sprintf("ID:            %v\n", s.ID))
 	content.WriteString(fmt.Sprintf("Researcher:    %v\n", s.ResearcherID))
-	content.WriteString(fmt.Sprintf("Date Created:  %v\n", s.DateCreated.Format("2006-01-02 15:04:05")))
-	content.WriteString(fmt.Sprintf("Date Modified: %v\n", s.DateModified.Format("2006-01-02 15:04:05")))
+	content.WriteString(fmt.Sprintf("Date Created:  %v\n", ui.FormatDateTime(s.DateCreated)))
+	content.WriteString(fmt.Sprintf("Date Modified: %v\n", ui.FormatDateTime(s.DateModified)))
 
 	if len(s.Sections) > 0 {
 		content.WriteString(ui.RenderSectionMarker())
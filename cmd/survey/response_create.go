@@ -1,10 +1,12 @@
 package survey
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -14,6 +16,7 @@ import (
 type ResponseCreateOptions struct {
 	Args         []string
 	TemplatePath string
+	Output       shared.OutputOptions
 }
 
 // NewResponseCreateCommand creates a new command for creating a survey response.
@@ -39,9 +42,9 @@ $ prolific survey response create 6261321e223a605c7a4f7678 -t /path/to/response.
 				return fmt.Errorf("error: a template file is required, use -t to specify the path")
 			}
 
-			err := createSurveyResponse(client, opts, w)
+			err := createSurveyResponse(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -50,11 +53,12 @@ $ prolific survey response create 6261321e223a605c7a4f7678 -t /path/to/response.
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.TemplatePath, "template-path", "t", "", "Path to a JSON/YAML file defining the survey response")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func createSurveyResponse(c client.API, opts ResponseCreateOptions, w io.Writer) error {
+func createSurveyResponse(ctx context.Context, c client.API, opts ResponseCreateOptions, w io.Writer) error {
 	v := viper.New()
 	v.SetConfigFile(opts.TemplatePath)
 	err := v.ReadInConfig()
@@ -65,16 +69,20 @@ func createSurveyResponse(c client.API, opts ResponseCreateOptions, w io.Writer)
 	var r model.CreateSurveyResponseRequest
 	err = v.Unmarshal(&r)
 	if err != nil {
-		return fmt.Errorf("unable to map %s to survey response model: %s", opts.TemplatePath, err)
+		return fmt.Errorf("unable to map %s to survey response model: %w", opts.TemplatePath, err)
 	}
 
 	surveyID := opts.Args[0]
 
-	record, err := c.CreateSurveyResponse(surveyID, r)
+	record, err := c.CreateSurveyResponse(ctx, surveyID, r)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *record, w)
+	}
+
 	fmt.Fprintf(w, "Created survey response: %s\n", record.ID)
 
 	return nil
@@ -3,6 +3,7 @@ package survey_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -65,8 +66,8 @@ func TestCreateSurveyResponse(t *testing.T) {
 				]
 			}`,
 			setupMocks: func(c *mock_client.MockAPI) {
-				c.EXPECT().CreateSurveyResponse(gomock.Eq(testSurveyID), gomock.Any()).
-					DoAndReturn(func(surveyID string, r model.CreateSurveyResponseRequest) (*client.CreateSurveyResponseResponse, error) {
+				c.EXPECT().CreateSurveyResponse(gomock.Any(), gomock.Eq(testSurveyID), gomock.Any()).
+					DoAndReturn(func(_ context.Context, surveyID string, r model.CreateSurveyResponseRequest) (*client.CreateSurveyResponseResponse, error) {
 						if r.ParticipantID != "part-001" {
 							return nil, fmt.Errorf("expected participant_id 'part-001'; got '%s'", r.ParticipantID)
 						}
@@ -99,7 +100,7 @@ func TestCreateSurveyResponse(t *testing.T) {
 				]
 			}`,
 			setupMocks: func(c *mock_client.MockAPI) {
-				c.EXPECT().CreateSurveyResponse(gomock.Eq(testSurveyID), gomock.Any()).
+				c.EXPECT().CreateSurveyResponse(gomock.Any(), gomock.Eq(testSurveyID), gomock.Any()).
 					Return(&client.CreateSurveyResponseResponse{
 						SurveyResponse: model.SurveyResponse{ID: "resp-002"},
 					}, nil).Times(1)
@@ -116,7 +117,7 @@ func TestCreateSurveyResponse(t *testing.T) {
 				]
 			}`,
 			setupMocks: func(c *mock_client.MockAPI) {
-				c.EXPECT().CreateSurveyResponse(gomock.Eq(testSurveyID), gomock.Any()).
+				c.EXPECT().CreateSurveyResponse(gomock.Any(), gomock.Eq(testSurveyID), gomock.Any()).
 					Return(nil, errors.New("API error: bad request")).Times(1)
 			},
 			expectedError: "error: API error: bad request",
@@ -1,6 +1,7 @@
 package survey
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -33,9 +34,9 @@ $ prolific survey response delete 6261321e223a605c7a4f7678 7372432f334b716d8b5g8
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := deleteSurveyResponse(client, opts, w)
+			err := deleteSurveyResponse(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -45,8 +46,8 @@ $ prolific survey response delete 6261321e223a605c7a4f7678 7372432f334b716d8b5g8
 	return cmd
 }
 
-func deleteSurveyResponse(client client.API, opts ResponseDeleteOptions, w io.Writer) error {
-	err := client.DeleteSurveyResponse(opts.Args[0], opts.Args[1])
+func deleteSurveyResponse(ctx context.Context, client client.API, opts ResponseDeleteOptions, w io.Writer) error {
+	err := client.DeleteSurveyResponse(ctx, opts.Args[0], opts.Args[1])
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package survey
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -33,9 +34,9 @@ $ prolific survey response delete-all 6261321e223a605c7a4f7678
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := deleteAllSurveyResponses(client, opts, w)
+			err := deleteAllSurveyResponses(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -45,10 +46,10 @@ $ prolific survey response delete-all 6261321e223a605c7a4f7678
 	return cmd
 }
 
-func deleteAllSurveyResponses(client client.API, opts ResponseDeleteAllOptions, w io.Writer) error {
+func deleteAllSurveyResponses(ctx context.Context, client client.API, opts ResponseDeleteAllOptions, w io.Writer) error {
 	surveyID := opts.Args[0]
 
-	err := client.DeleteAllSurveyResponses(surveyID)
+	err := client.DeleteAllSurveyResponses(ctx, surveyID)
 	if err != nil {
 		return err
 	}
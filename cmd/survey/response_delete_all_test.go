@@ -34,7 +34,7 @@ func TestDeleteAllSurveyResponses_Success(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	c.EXPECT().
-		DeleteAllSurveyResponses(gomock.Eq(testSurveyID)).
+		DeleteAllSurveyResponses(gomock.Any(), gomock.Eq(testSurveyID)).
 		Return(nil).
 		Times(1)
 
@@ -64,7 +64,7 @@ func TestDeleteAllSurveyResponses_APIError(t *testing.T) {
 	c := mock_client.NewMockAPI(ctrl)
 
 	c.EXPECT().
-		DeleteAllSurveyResponses(gomock.Eq(testSurveyID)).
+		DeleteAllSurveyResponses(gomock.Any(), gomock.Eq(testSurveyID)).
 		Return(errors.New("not found")).
 		Times(1)
 
@@ -57,7 +57,7 @@ func TestDeleteSurveyResponse(t *testing.T) {
 			c := mock_client.NewMockAPI(ctrl)
 
 			c.EXPECT().
-				DeleteSurveyResponse(gomock.Eq(testSurveyID), gomock.Eq(tt.responseID)).
+				DeleteSurveyResponse(gomock.Any(), gomock.Eq(testSurveyID), gomock.Eq(tt.responseID)).
 				Return(tt.mockError).
 				Times(1)
 
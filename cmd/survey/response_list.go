@@ -1,6 +1,7 @@
 package survey
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -8,8 +9,6 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/prolific-oss/cli/client"
 	"github.com/prolific-oss/cli/cmd/shared"
-	"github.com/prolific-oss/cli/model"
-	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -19,8 +18,10 @@ const defaultResponseListFields = "ID,ParticipantID,SubmissionID,DateCreated"
 type ResponseListOptions struct {
 	Args   []string
 	Output shared.OutputOptions
+	Query  shared.QueryOptions
 	Limit  int
 	Offset int
+	All    bool
 }
 
 // NewResponseListCommand creates a new command to list survey responses
@@ -44,39 +45,38 @@ $ prolific survey response list 6261321e223a605c7a4f7678 -n
 
 List survey responses as JSON
 $ prolific survey response list 6261321e223a605c7a4f7678 --json
+
+List every survey response as JSON, however many pages that takes
+$ prolific survey response list 6261321e223a605c7a4f7678 --all --json
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
 			surveyID := args[0]
 
-			responses, err := c.GetSurveyResponses(surveyID, opts.Limit, opts.Offset)
+			responses, err := shared.FetchList(cmd.Context(), opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListSurveyResponsesResponse, error) {
+				return c.GetSurveyResponses(ctx, surveyID, limit, offset)
+			})
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
+			}
+
+			responses.Results, err = shared.ApplyQuery(opts.Query, responses.Results)
+			if err != nil {
+				return fmt.Errorf("error: %w", err)
 			}
 
 			format := shared.ResolveFormat(opts.Output)
-			switch format {
-			case "json":
-				r := ui.JSONRenderer[model.SurveyResponse]{}
-				if err := r.Render(responses.Results, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			case "csv":
-				r := ui.CsvRenderer[model.SurveyResponse]{}
-				if err := r.Render(responses.Results, defaultResponseListFields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			case "table":
-				r := ui.TableRenderer[model.SurveyResponse]{}
-				if err := r.Render(responses.Results, defaultResponseListFields, w); err != nil {
-					return fmt.Errorf("error: %s", err)
-				}
-			default:
+			if format == "" {
 				r := &ResponseInteractiveRenderer{}
 				if err := r.Render(*responses, w); err != nil {
-					return fmt.Errorf("error: %s", err)
+					return fmt.Errorf("error: %w", err)
 				}
+				return nil
+			}
+
+			if err := shared.RenderList(opts.Output, responses.Results, defaultResponseListFields, w); err != nil {
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -85,8 +85,10 @@ $ prolific survey response list 6261321e223a605c7a4f7678 --json
 
 	flags := cmd.Flags()
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of responses returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of responses to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of responses to offset")
+	shared.AddAllFlag(cmd, &opts.All, "responses")
 	shared.AddOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
@@ -99,7 +101,7 @@ func (r *ResponseInteractiveRenderer) Render(responses client.ListSurveyResponse
 	var items []list.Item
 
 	for _, resp := range responses.Results {
-		items = append(items, model.SurveyResponseListItem{SurveyResponse: resp})
+		items = append(items, SurveyResponseListItem{SurveyResponse: resp})
 	}
 
 	lv := ResponseListView{
@@ -109,7 +111,7 @@ func (r *ResponseInteractiveRenderer) Render(responses client.ListSurveyResponse
 
 	p := tea.NewProgram(lv)
 	if _, err := p.Run(); err != nil {
-		return fmt.Errorf("cannot render survey responses: %s", err)
+		return fmt.Errorf("cannot render survey responses: %w", err)
 	}
 
 	return nil
@@ -106,7 +106,7 @@ func TestListSurveyResponses(t *testing.T) {
 			c := mock_client.NewMockAPI(ctrl)
 
 			c.EXPECT().
-				GetSurveyResponses(gomock.Eq(testSurveyID), client.DefaultRecordLimit, client.DefaultRecordOffset).
+				GetSurveyResponses(gomock.Any(), gomock.Eq(testSurveyID), client.DefaultRecordLimit, client.DefaultRecordOffset).
 				Return(tt.responsesReturn, tt.responsesError).
 				Times(1)
 
@@ -11,6 +11,22 @@ import (
 	"github.com/prolific-oss/cli/ui"
 )
 
+// SurveyResponseListItem wraps a SurveyResponse to satisfy the bubbletea list.DefaultItem interface.
+type SurveyResponseListItem struct {
+	model.SurveyResponse
+}
+
+// FilterValue implements the bubbletea list.Item interface.
+func (s SurveyResponseListItem) FilterValue() string { return s.ID }
+
+// Title implements the bubbletea list.DefaultItem interface.
+func (s SurveyResponseListItem) Title() string { return s.ID }
+
+// Description implements the bubbletea list.DefaultItem interface.
+func (s SurveyResponseListItem) Description() string {
+	return fmt.Sprintf("Participant: %s - submitted %s", s.ParticipantID, ui.InTimezone(s.DateCreated).Format("2006-01-02"))
+}
+
 // ResponseListView is responsible for presenting a response list view to the user.
 type ResponseListView struct {
 	List     list.Model
@@ -31,7 +47,7 @@ func (lv ResponseListView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		if msg.String() == "enter" {
-			i, ok := lv.List.SelectedItem().(model.SurveyResponseListItem)
+			i, ok := lv.List.SelectedItem().(SurveyResponseListItem)
 			if ok {
 				r := i.SurveyResponse
 				lv.Response = &r
@@ -65,8 +81,8 @@ func renderResponseString(r model.SurveyResponse) string {
 	content.WriteString(fmt.Sprintf("ID:            %v\n", r.ID))
 	content.WriteString(fmt.Sprintf("Participant:   %v\n", r.ParticipantID))
 	content.WriteString(fmt.Sprintf("Submission:    %v\n", r.SubmissionID))
-	content.WriteString(fmt.Sprintf("Date Created:  %v\n", r.DateCreated.Format("2006-01-02 15:04:05")))
-	content.WriteString(fmt.Sprintf("Date Modified: %v\n", r.DateModified.Format("2006-01-02 15:04:05")))
+	content.WriteString(fmt.Sprintf("Date Created:  %v\n", ui.FormatDateTime(r.DateCreated)))
+	content.WriteString(fmt.Sprintf("Date Modified: %v\n", ui.FormatDateTime(r.DateModified)))
 
 	if len(r.Sections) > 0 {
 		content.WriteString(ui.RenderSectionMarker())
@@ -1,12 +1,13 @@
 package survey
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
 	"strings"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
@@ -14,8 +15,8 @@ import (
 
 // ResponseSummaryOptions is the options for the survey response summary command.
 type ResponseSummaryOptions struct {
-	Args []string
-	Json bool
+	Args   []string
+	Output shared.OutputOptions
 }
 
 // NewResponseSummaryCommand creates a new command to view the response summary for a survey.
@@ -37,36 +38,37 @@ $ prolific survey response summary 6261321e223a605c7a4f7678
 
 View the response summary as JSON
 $ prolific survey response summary 6261321e223a605c7a4f7678 --json
+
+Pick out the total answers for each question
+$ prolific survey response summary 6261321e223a605c7a4f7678 --jq '.questions[].total_answers'
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderSurveyResponseSummary(client, opts, w)
+			err := renderSurveyResponseSummary(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
 		},
 	}
 
-	cmd.Flags().BoolVarP(&opts.Json, "json", "j", false, "Output as JSON")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
-func renderSurveyResponseSummary(client client.API, opts ResponseSummaryOptions, w io.Writer) error {
+func renderSurveyResponseSummary(ctx context.Context, client client.API, opts ResponseSummaryOptions, w io.Writer) error {
 	surveyID := opts.Args[0]
 
-	summary, err := client.GetSurveyResponseSummary(surveyID)
+	summary, err := client.GetSurveyResponseSummary(ctx, surveyID)
 	if err != nil {
 		return err
 	}
 
-	if opts.Json {
-		encoder := json.NewEncoder(w)
-		encoder.SetIndent("", "  ")
-		return encoder.Encode(summary)
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *summary, w)
 	}
 
 	fmt.Fprint(w, renderSummaryString(*summary))
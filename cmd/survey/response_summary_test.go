@@ -108,7 +108,7 @@ func TestViewSurveyResponseSummary(t *testing.T) {
 			c := mock_client.NewMockAPI(ctrl)
 
 			c.EXPECT().
-				GetSurveyResponseSummary(gomock.Eq(testSurveyID)).
+				GetSurveyResponseSummary(gomock.Any(), gomock.Eq(testSurveyID)).
 				Return(tt.mockReturn, tt.mockError).
 				Times(1)
 
@@ -1,17 +1,20 @@
 package survey
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 // ResponseViewOptions is the options for viewing a survey response.
 type ResponseViewOptions struct {
-	Args []string
+	Args   []string
+	Output shared.OutputOptions
 }
 
 // NewResponseViewCommand creates a new command to show a survey response.
@@ -34,28 +37,34 @@ $ prolific survey response view 6261321e223a605c7a4f7678 7372432f334b716d8b5g878
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderSurveyResponse(client, opts, w)
+			err := renderSurveyResponse(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+
 	return cmd
 }
 
-func renderSurveyResponse(client client.API, opts ResponseViewOptions, w io.Writer) error {
+func renderSurveyResponse(ctx context.Context, client client.API, opts ResponseViewOptions, w io.Writer) error {
 	if len(opts.Args) < 2 || opts.Args[0] == "" || opts.Args[1] == "" {
 		return errors.New("please provide a survey ID and response ID")
 	}
 
-	response, err := client.GetSurveyResponse(opts.Args[0], opts.Args[1])
+	response, err := client.GetSurveyResponse(ctx, opts.Args[0], opts.Args[1])
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *response, w)
+	}
+
 	fmt.Fprint(w, renderResponseString(*response))
 
 	return nil
@@ -118,7 +118,7 @@ func TestViewSurveyResponse(t *testing.T) {
 			c := mock_client.NewMockAPI(ctrl)
 
 			c.EXPECT().
-				GetSurveyResponse(gomock.Eq(tt.surveyID), gomock.Eq(tt.responseID)).
+				GetSurveyResponse(gomock.Any(), gomock.Eq(tt.surveyID), gomock.Eq(tt.responseID)).
 				Return(tt.mockReturn, tt.mockError).
 				Times(1)
 
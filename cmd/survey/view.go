@@ -1,17 +1,20 @@
 package survey
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 )
 
 // ViewOptions is the options for the detail view of a survey.
 type ViewOptions struct {
-	Args []string
+	Args   []string
+	Output shared.OutputOptions
 }
 
 // NewViewCommand creates a new command to show a survey.
@@ -34,29 +37,35 @@ $ prolific survey view 6261321e223a605c7a4f7678
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderSurvey(client, opts, w)
+			err := renderSurvey(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+
 	return cmd
 }
 
 // renderSurvey will show your survey
-func renderSurvey(client client.API, opts ViewOptions, w io.Writer) error {
+func renderSurvey(ctx context.Context, client client.API, opts ViewOptions, w io.Writer) error {
 	if len(opts.Args) < 1 || opts.Args[0] == "" {
 		return errors.New("please provide a survey ID")
 	}
 
-	survey, err := client.GetSurvey(opts.Args[0])
+	survey, err := client.GetSurvey(ctx, opts.Args[0])
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *survey, w)
+	}
+
 	fmt.Fprint(w, renderSurveyString(*survey))
 
 	return nil
@@ -119,7 +119,7 @@ func TestViewSurvey(t *testing.T) {
 			c := mock_client.NewMockAPI(ctrl)
 
 			c.EXPECT().
-				GetSurvey(gomock.Eq(tt.surveyID)).
+				GetSurvey(gomock.Any(), gomock.Eq(tt.surveyID)).
 				Return(tt.mockReturn, tt.mockError).
 				Times(1)
 
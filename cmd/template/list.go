@@ -9,6 +9,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/docs/examples"
 	"github.com/spf13/cobra"
 )
@@ -87,16 +88,25 @@ func categorise(name string) string {
 
 // NewListCommand creates the `template list` subcommand.
 func NewListCommand(w io.Writer) *cobra.Command {
+	var output shared.OutputOptions
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all available templates",
 		Long:  `List all bundled study and collection templates with their ID, category, and format.`,
 		Example: `
 List all templates
-$ prolific template list`,
+$ prolific template list
+
+List the IDs of the collection templates
+$ prolific template list --go-template '{{if eq .Category "collection"}}{{.ID}}{{end}}' | grep .`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			templates := listTemplates()
 
+			if output.IsStructured() {
+				return shared.RenderList(output, templates, "", w)
+			}
+
 			tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
 			fmt.Fprintln(tw, "ID\tCategory\tFormat")
 
@@ -108,5 +118,7 @@ $ prolific template list`,
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &output)
+
 	return cmd
 }
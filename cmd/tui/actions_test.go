@@ -0,0 +1,14 @@
+package tui
+
+// SetActionsForTesting replaces the clipboard and browser actions for the
+// duration of a test. Call the returned function (typically via defer) to
+// restore the originals.
+//
+// This file is compiled only during `go test` and is intentionally in
+// package tui (not tui_test) so that it can access unexported variables
+// while still being callable from external test packages.
+func SetActionsForTesting(copy, open func(string) error) func() {
+	prevCopy, prevOpen := copyToClipboard, openInBrowser
+	copyToClipboard, openInBrowser = copy, open
+	return func() { copyToClipboard, openInBrowser = prevCopy, prevOpen }
+}
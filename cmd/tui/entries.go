@@ -0,0 +1,251 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/project"
+	"github.com/prolific-oss/cli/cmd/study"
+	"github.com/prolific-oss/cli/cmd/submission"
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+)
+
+// loader fetches the entries shown at one level of the explorer.
+type loader func(ctx context.Context, c client.API) ([]entry, error)
+
+// entry is a row in the explorer: a resource, or a section of a workspace.
+type entry struct {
+	id     string
+	title  string
+	desc   string
+	detail string
+	// url is where the entry is shown in the web application, if anywhere.
+	url string
+	// study is set for studies, which can be transitioned.
+	study *model.Study
+	// open loads the level inside the entry, and is nil when there is nothing
+	// inside it.
+	open loader
+}
+
+// FilterValue implements list.Item for bubbletea.
+func (e entry) FilterValue() string { return e.title + " " + e.id }
+
+// Title implements list.Item for bubbletea.
+func (e entry) Title() string { return e.title }
+
+// Description implements list.Item for bubbletea.
+func (e entry) Description() string { return e.desc }
+
+// renderDetail renders a heading followed by label and value pairs.
+func renderDetail(heading string, pairs ...string) string {
+	width := 0
+	for i := 0; i < len(pairs); i += 2 {
+		width = max(width, len(pairs[i]))
+	}
+
+	content := fmt.Sprintln(ui.RenderHeading(heading))
+	for i := 0; i+1 < len(pairs); i += 2 {
+		content += fmt.Sprintf("%-*s %s\n", width+1, pairs[i]+":", pairs[i+1])
+	}
+	return content
+}
+
+func loadWorkspaces(ctx context.Context, c client.API) ([]entry, error) {
+	workspaces, err := client.FetchAll(ctx, c.GetWorkspaces)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]entry, 0, len(workspaces.Results))
+	for _, w := range workspaces.Results {
+		entries = append(entries, entry{
+			id:     w.ID,
+			title:  w.Title,
+			desc:   w.ID,
+			detail: renderDetail(w.Title, "ID", w.ID, "Description", w.Description, "Users", fmt.Sprint(len(w.Users))),
+			open:   workspaceSections(w.ID),
+		})
+	}
+	return entries, nil
+}
+
+// workspaceSections lists the kinds of resource in a workspace.
+func workspaceSections(workspaceID string) loader {
+	return func(context.Context, client.API) ([]entry, error) {
+		section := func(title, desc string, open loader) entry {
+			return entry{id: workspaceID, title: title, desc: desc, detail: renderDetail(title, "Workspace", workspaceID), open: open}
+		}
+
+		return []entry{
+			section("Projects", "Projects and their studies", loadProjects(workspaceID)),
+			section("Hooks", "Subscriptions to events", loadHooks(workspaceID)),
+			section("Participant groups", "Groups of participants", loadParticipantGroups(workspaceID)),
+			section("AI Task Builder batches", "Batches of tasks", loadBatches(workspaceID)),
+		}, nil
+	}
+}
+
+func loadProjects(workspaceID string) loader {
+	return func(ctx context.Context, c client.API) ([]entry, error) {
+		projects, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.ListProjectsResponse, error) {
+			return c.GetProjects(ctx, workspaceID, limit, offset)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		entries := make([]entry, 0, len(projects.Results))
+		for _, p := range projects.Results {
+			entries = append(entries, entry{
+				id:     p.ID,
+				title:  p.Title,
+				desc:   p.ID,
+				detail: renderDetail(p.Title, "ID", p.ID, "Description", p.Description, "Owner", p.Owner, "Users", fmt.Sprint(len(p.Users))),
+				url:    project.GetProjectURL(p.ID),
+				open:   loadStudies(p.ID),
+			})
+		}
+		return entries, nil
+	}
+}
+
+func loadStudies(projectID string) loader {
+	return func(ctx context.Context, c client.API) ([]entry, error) {
+		studies, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.ListStudiesResponse, error) {
+			return c.GetStudies(ctx, model.StatusAll, projectID, limit, offset)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		entries := make([]entry, 0, len(studies.Results))
+		for _, s := range studies.Results {
+			entries = append(entries, studyEntry(s))
+		}
+		return entries, nil
+	}
+}
+
+func studyEntry(s model.Study) entry {
+	return entry{
+		id:     s.ID,
+		title:  s.Title(),
+		desc:   s.Description(),
+		detail: study.RenderStudy(s),
+		url:    study.GetStudyURL(s.ID),
+		study:  &s,
+		open:   loadSubmissions(s.ID),
+	}
+}
+
+func loadSubmissions(studyID string) loader {
+	return func(ctx context.Context, c client.API) ([]entry, error) {
+		submissions, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.ListSubmissionsResponse, error) {
+			return c.GetSubmissions(ctx, studyID, limit, offset)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		entries := make([]entry, 0, len(submissions.Results))
+		for _, s := range submissions.Results {
+			entries = append(entries, entry{
+				id:     s.ID,
+				title:  s.Title(),
+				desc:   s.Description(),
+				detail: submission.RenderSubmission(s),
+			})
+		}
+		return entries, nil
+	}
+}
+
+func loadHooks(workspaceID string) loader {
+	return func(ctx context.Context, c client.API) ([]entry, error) {
+		var entries []entry
+
+		// Subscriptions are listed by whether they are enabled, so ask for both.
+		for _, enabled := range []bool{true, false} {
+			hooks, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.ListHooksResponse, error) {
+				return c.GetHooks(ctx, workspaceID, enabled, limit, offset)
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, h := range hooks.Results {
+				state := "disabled"
+				if h.IsEnabled {
+					state = "enabled"
+				}
+				entries = append(entries, entry{
+					id:     h.ID,
+					title:  h.EventType,
+					desc:   fmt.Sprintf("%s - %s", state, h.TargetURL),
+					detail: renderDetail(h.EventType, "ID", h.ID, "Target URL", h.TargetURL, "Enabled", fmt.Sprint(h.IsEnabled)),
+				})
+			}
+		}
+		return entries, nil
+	}
+}
+
+func loadParticipantGroups(workspaceID string) loader {
+	return func(ctx context.Context, c client.API) ([]entry, error) {
+		groups, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.ListParticipantGroupsResponse, error) {
+			return c.GetParticipantGroups(ctx, workspaceID, limit, offset)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		entries := make([]entry, 0, len(groups.Results))
+		for _, g := range groups.Results {
+			entries = append(entries, entry{
+				id:     g.ID,
+				title:  g.Name,
+				desc:   fmt.Sprintf("%d participants", g.ParticipantCount),
+				detail: renderDetail(g.Name, "ID", g.ID, "Description", g.Description, "Project", g.ProjectID, "Participants", fmt.Sprint(g.ParticipantCount)),
+			})
+		}
+		return entries, nil
+	}
+}
+
+func loadBatches(workspaceID string) loader {
+	return func(ctx context.Context, c client.API) ([]entry, error) {
+		batches, err := client.FetchAll(ctx, func(ctx context.Context, limit, offset int) (*client.GetAITaskBuilderBatchesResponse, error) {
+			return c.GetAITaskBuilderBatches(ctx, workspaceID, limit, offset)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		entries := make([]entry, 0, len(batches.Results))
+		for _, b := range batches.Results {
+			datasets := make([]string, 0, len(b.Datasets))
+			for _, d := range b.Datasets {
+				datasets = append(datasets, d.ID)
+			}
+
+			entries = append(entries, entry{
+				id:    b.ID,
+				title: b.Name,
+				desc:  fmt.Sprintf("%s - %d tasks", b.Status, b.TotalTaskCount),
+				detail: renderDetail(b.Name,
+					"ID", b.ID,
+					"Status", string(b.Status),
+					"Created", ui.FormatDateTime(b.CreatedAt),
+					"Tasks", fmt.Sprint(b.TotalTaskCount),
+					"Tasks per group", fmt.Sprint(b.TasksPerGroup),
+					"Datasets", strings.Join(datasets, ", "),
+				),
+			})
+		}
+		return entries, nil
+	}
+}
@@ -0,0 +1,356 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pkg/browser"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+)
+
+// copyToClipboard and openInBrowser carry out the copy and open actions.
+// Replaced in tests via SetActionsForTesting.
+var (
+	copyToClipboard = clipboard.WriteAll
+	openInBrowser   = browser.OpenURL
+)
+
+// transitionKeys maps the keys offered after t to the study transitions.
+var transitionKeys = map[string]string{
+	"p": model.TransitionStudyPublish,
+	"s": model.TransitionStudyStart,
+	"z": model.TransitionStudyPause,
+	"x": model.TransitionStudyStop,
+}
+
+const helpText = "enter open • esc back • / search • r refresh • o open in browser • y copy ID • t transition study • q quit"
+
+var (
+	breadcrumbStyle = lipgloss.NewStyle().Bold(true).Padding(0, 1)
+	detailStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color(ui.DarkGrey)).Padding(0, 1)
+	statusStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color(ui.DarkGrey)).Padding(0, 1)
+)
+
+// level is one list in the explorer's stack, from the workspaces down.
+type level struct {
+	// id tells the level apart from any other opened at the same depth, so
+	// results for a level the user has left are not shown in its place.
+	id      int
+	title   string
+	load    loader
+	list    list.Model
+	loading bool
+	err     error
+}
+
+// Explorer is the bubbletea model for `prolific tui`, a navigable explorer of
+// workspaces, their projects, studies and submissions, hooks, participant
+// groups and AI Task Builder batches.
+type Explorer struct {
+	ctx    context.Context
+	client client.API
+	levels []level
+	// levelID is the ID of the last level opened.
+	levelID int
+	width   int
+	height  int
+	status  string
+	// transition is the study transition being chosen: "choose" after t, or
+	// the action waiting to be confirmed.
+	transition string
+}
+
+type loadedMsg struct {
+	depth   int
+	levelID int
+	entries []entry
+	err     error
+}
+
+type statusMsg string
+
+type transitionedMsg struct {
+	// levelID is the level the study was transitioned from.
+	levelID int
+	action  string
+	study   model.Study
+	err     error
+}
+
+// NewExplorer creates an Explorer starting at the list of workspaces.
+func NewExplorer(ctx context.Context, c client.API) Explorer {
+	e := Explorer{ctx: ctx, client: c}
+	e.levels = []level{e.newLevel("Workspaces", loadWorkspaces)}
+	return e
+}
+
+func (e *Explorer) newLevel(title string, load loader) level {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.Title = title
+	l.SetShowHelp(false)
+
+	e.levelID++
+	lv := level{id: e.levelID, title: title, load: load, list: l, loading: true}
+	e.sizeList(&lv.list)
+	return lv
+}
+
+func (e Explorer) load(depth int) tea.Cmd {
+	load, id := e.levels[depth].load, e.levels[depth].id
+	return func() tea.Msg {
+		entries, err := load(e.ctx, e.client)
+		return loadedMsg{depth: depth, levelID: id, entries: entries, err: err}
+	}
+}
+
+// depthOf returns the depth of the level with the ID, or false if the user
+// has since left it.
+func (e Explorer) depthOf(id int) (int, bool) {
+	for depth, lv := range e.levels {
+		if lv.id == id {
+			return depth, true
+		}
+	}
+	return 0, false
+}
+
+func (e *Explorer) current() *level {
+	return &e.levels[len(e.levels)-1]
+}
+
+func (e Explorer) selected() (entry, bool) {
+	item, ok := e.levels[len(e.levels)-1].list.SelectedItem().(entry)
+	return item, ok
+}
+
+// listWidth is the width of the lists, leaving the rest for the detail pane.
+func (e Explorer) listWidth() int {
+	return e.width * 2 / 5
+}
+
+func (e Explorer) sizeList(l *list.Model) {
+	// Leave room for the breadcrumb and status lines.
+	l.SetSize(e.listWidth(), max(e.height-2, 0))
+}
+
+// Init implements tea.Model.
+func (e Explorer) Init() tea.Cmd {
+	return e.load(0)
+}
+
+// Update implements tea.Model.
+func (e Explorer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case loadedMsg:
+		// Ignore results for a level the user has since left.
+		if msg.depth >= len(e.levels) || e.levels[msg.depth].id != msg.levelID {
+			return e, nil
+		}
+		lv := &e.levels[msg.depth]
+		lv.loading = false
+		lv.err = msg.err
+		items := make([]list.Item, len(msg.entries))
+		for i, item := range msg.entries {
+			items[i] = item
+		}
+		return e, lv.list.SetItems(items)
+
+	case statusMsg:
+		e.status = string(msg)
+		return e, nil
+
+	case transitionedMsg:
+		if msg.err != nil {
+			e.status = fmt.Sprintf("Could not %s %s: %s", strings.ToLower(msg.action), msg.study.Name, msg.err)
+			return e, nil
+		}
+		e.status = fmt.Sprintf("Sent %s to %s", strings.ToLower(msg.action), msg.study.Name)
+		depth, ok := e.depthOf(msg.levelID)
+		if !ok {
+			return e, nil
+		}
+		e.levels[depth].loading = true
+		return e, e.load(depth)
+
+	case tea.WindowSizeMsg:
+		e.width, e.height = msg.Width, msg.Height
+		for i := range e.levels {
+			e.sizeList(&e.levels[i].list)
+		}
+		return e, nil
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return e, tea.Quit
+		}
+		if e.transition != "" {
+			return e.updateTransition(msg)
+		}
+		// While typing a search, every key belongs to the list.
+		if e.current().list.FilterState() != list.Filtering {
+			if model, cmd, ok := e.updateKey(msg); ok {
+				return model, cmd
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	e.current().list, cmd = e.current().list.Update(msg)
+	return e, cmd
+}
+
+// updateKey handles the explorer's own keybindings, reporting false for keys
+// that belong to the list.
+func (e Explorer) updateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	switch msg.String() {
+	case "q":
+		return e, tea.Quit, true
+
+	case "enter":
+		item, ok := e.selected()
+		if !ok || item.open == nil {
+			return e, nil, true
+		}
+		e.status = ""
+		e.levels = append(e.levels, e.newLevel(item.title, item.open))
+		return e, e.load(len(e.levels) - 1), true
+
+	case "esc", "backspace":
+		// esc first clears a search, as the list does.
+		if msg.String() == "esc" && e.current().list.FilterState() == list.FilterApplied {
+			return e, nil, false
+		}
+		if len(e.levels) > 1 {
+			e.levels = e.levels[:len(e.levels)-1]
+			e.status = ""
+		}
+		return e, nil, true
+
+	case "r":
+		e.current().loading = true
+		e.status = "Refreshing..."
+		return e, e.load(len(e.levels) - 1), true
+
+	case "o":
+		item, ok := e.selected()
+		if !ok || item.url == "" {
+			e.status = "Nothing to open in the browser"
+			return e, nil, true
+		}
+		return e, func() tea.Msg {
+			if err := openInBrowser(item.url); err != nil {
+				return statusMsg(fmt.Sprintf("Could not open %s: %s", item.url, err))
+			}
+			return statusMsg("Opened " + item.url)
+		}, true
+
+	case "y":
+		item, ok := e.selected()
+		if !ok {
+			return e, nil, true
+		}
+		return e, func() tea.Msg {
+			if err := copyToClipboard(item.id); err != nil {
+				return statusMsg(fmt.Sprintf("Could not copy %s: %s", item.id, err))
+			}
+			return statusMsg("Copied " + item.id)
+		}, true
+
+	case "t":
+		item, ok := e.selected()
+		if !ok || item.study == nil {
+			e.status = "Only studies can be transitioned"
+			return e, nil, true
+		}
+		e.transition = "choose"
+		e.status = fmt.Sprintf("Transition %s: [p]ublish [s]tart pau[z]e [x] stop, esc to cancel", item.study.Name)
+		return e, nil, true
+	}
+
+	return e, nil, false
+}
+
+// updateTransition handles the keys pressed while choosing and confirming a
+// study transition.
+func (e Explorer) updateTransition(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	item, ok := e.selected()
+	if !ok || item.study == nil {
+		e.transition = ""
+		return e, nil
+	}
+	s := *item.study
+
+	if e.transition == "choose" {
+		action, ok := transitionKeys[msg.String()]
+		if !ok {
+			e.transition = ""
+			e.status = ""
+			return e, nil
+		}
+		e.transition = action
+		e.status = fmt.Sprintf("%s %s? [y/N]", action, s.Name)
+		return e, nil
+	}
+
+	action := e.transition
+	e.transition = ""
+	if msg.String() != "y" {
+		e.status = "Cancelled"
+		return e, nil
+	}
+
+	e.status = fmt.Sprintf("Sending %s to %s...", strings.ToLower(action), s.Name)
+	id := e.current().id
+	return e, func() tea.Msg {
+		_, err := e.client.TransitionStudy(e.ctx, s.ID, action)
+		return transitionedMsg{levelID: id, action: action, study: s, err: err}
+	}
+}
+
+// View implements tea.Model.
+func (e Explorer) View() string {
+	titles := make([]string, len(e.levels))
+	for i, lv := range e.levels {
+		titles[i] = lv.title
+	}
+	breadcrumb := breadcrumbStyle.Render(strings.Join(titles, " › "))
+
+	lv := e.levels[len(e.levels)-1]
+	var left string
+	switch {
+	case lv.err != nil:
+		left = fmt.Sprintf("Error: %s\n", lv.err)
+	case lv.loading && len(lv.list.Items()) == 0:
+		left = "Loading...\n"
+	default:
+		left = lv.list.View()
+	}
+	left = lipgloss.NewStyle().Width(e.listWidth()).Render(left)
+
+	detail := ""
+	if item, ok := e.selected(); ok {
+		detail = item.detail
+	}
+	// Account for the border and padding around the pane.
+	detailWidth := max(e.width-e.listWidth()-4, 0)
+	detailHeight := max(e.height-4, 0)
+	right := detailStyle.Width(detailWidth).Height(detailHeight).MaxHeight(detailHeight + 2).Render(detail)
+
+	status := e.status
+	if status == "" {
+		status = helpText
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		breadcrumb,
+		lipgloss.JoinHorizontal(lipgloss.Top, left, right),
+		statusStyle.Render(status),
+	)
+}
@@ -0,0 +1,222 @@
+package tui_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/golang/mock/gomock"
+	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/tui"
+	"github.com/prolific-oss/cli/mock_client"
+	"github.com/prolific-oss/cli/model"
+)
+
+// run passes msg to m, then runs the command it returns and passes on the
+// message that produces, as the bubbletea runtime would for a single step.
+func run(t *testing.T, m tea.Model, msg tea.Msg) tea.Model {
+	t.Helper()
+	m, cmd := m.Update(msg)
+	if cmd == nil {
+		return m
+	}
+	next := cmd()
+	if next == nil {
+		return m
+	}
+	m, _ = m.Update(next)
+	return m
+}
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func start(t *testing.T, c client.API) tea.Model {
+	t.Helper()
+	var m tea.Model = tui.NewExplorer(context.Background(), c)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	return run(t, m, m.Init()())
+}
+
+func TestNewTUICommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	cmd := tui.NewTUICommand(c, nil)
+
+	use := "tui"
+	short := "Explore your workspaces in a full-screen terminal UI"
+
+	if cmd.Use != use {
+		t.Fatalf("expected use: %s; got %s", use, cmd.Use)
+	}
+
+	if cmd.Short != short {
+		t.Fatalf("expected short: %s; got %s", short, cmd.Short)
+	}
+}
+
+func TestExplorerNavigatesFromWorkspacesToSubmissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().GetWorkspaces(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListWorkspacesResponse{Results: []model.Workspace{{ID: "ws-1", Title: "Research"}}}, nil)
+	c.EXPECT().GetProjects(gomock.Any(), gomock.Eq("ws-1"), gomock.Any(), gomock.Any()).
+		Return(&client.ListProjectsResponse{Results: []model.Project{{ID: "proj-1", Title: "Onboarding"}}}, nil)
+	c.EXPECT().GetStudies(gomock.Any(), gomock.Eq(model.StatusAll), gomock.Eq("proj-1"), gomock.Any(), gomock.Any()).
+		Return(&client.ListStudiesResponse{Results: []model.Study{{ID: "study-1", Name: "Pilot", Status: model.StatusActive}}}, nil)
+	c.EXPECT().GetSubmissions(gomock.Any(), gomock.Eq("study-1"), gomock.Any(), gomock.Any()).
+		Return(&client.ListSubmissionsResponse{Results: []model.Submission{{ID: "sub-1", ParticipantID: "participant-1", Status: "APPROVED"}}}, nil)
+
+	m := start(t, c)
+	if view := m.View(); !strings.Contains(view, "Research") {
+		t.Fatalf("expected the workspace to be listed, got\n%s", view)
+	}
+
+	// Workspace, then its Projects section, project and study.
+	for range 4 {
+		m = run(t, m, key("enter"))
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "Workspaces › Research › Projects › Onboarding › Pilot") {
+		t.Fatalf("expected the breadcrumb to show the path, got\n%s", view)
+	}
+	if !strings.Contains(view, "participant-1") || !strings.Contains(view, "sub-1") {
+		t.Fatalf("expected the submission and its detail, got\n%s", view)
+	}
+
+	m = run(t, m, key("esc"))
+	if view := m.View(); !strings.Contains(view, "ID:                        study-1") {
+		t.Fatalf("expected esc to go back to the study, got\n%s", view)
+	}
+}
+
+func TestExplorerTransitionsAStudyOnceConfirmed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().GetWorkspaces(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListWorkspacesResponse{Results: []model.Workspace{{ID: "ws-1", Title: "Research"}}}, nil)
+	c.EXPECT().GetProjects(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListProjectsResponse{Results: []model.Project{{ID: "proj-1", Title: "Onboarding"}}}, nil)
+	c.EXPECT().GetStudies(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListStudiesResponse{Results: []model.Study{{ID: "study-1", Name: "Pilot", Status: model.StatusActive}}}, nil).
+		Times(2)
+	c.EXPECT().TransitionStudy(gomock.Any(), gomock.Eq("study-1"), gomock.Eq(model.TransitionStudyPause)).
+		Return(&client.TransitionStudyResponse{}, nil).
+		Times(1)
+
+	m := start(t, c)
+	for range 3 {
+		m = run(t, m, key("enter"))
+	}
+
+	// Cancelling the confirmation does not transition the study.
+	m = run(t, m, key("t"))
+	m = run(t, m, key("z"))
+	m = run(t, m, key("n"))
+	if view := m.View(); !strings.Contains(view, "Cancelled") {
+		t.Fatalf("expected the transition to be cancelled, got\n%s", view)
+	}
+
+	m = run(t, m, key("t"))
+	m = run(t, m, key("z"))
+	if view := m.View(); !strings.Contains(view, "PAUSE Pilot? [y/N]") {
+		t.Fatalf("expected a confirmation prompt, got\n%s", view)
+	}
+
+	// Confirming sends the transition, then the studies are refreshed.
+	m, cmd := m.Update(key("y"))
+	m = run(t, m, cmd())
+	if view := m.View(); !strings.Contains(view, "Sent pause to Pilot") {
+		t.Fatalf("expected the transition to be reported, got\n%s", view)
+	}
+}
+
+func TestExplorerIgnoresResultsForALevelItHasLeft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().GetWorkspaces(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListWorkspacesResponse{Results: []model.Workspace{{ID: "ws-1", Title: "Research"}}}, nil)
+	c.EXPECT().GetProjects(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListProjectsResponse{Results: []model.Project{{ID: "proj-a", Title: "Alpha"}, {ID: "proj-b", Title: "Beta"}}}, nil)
+	c.EXPECT().GetStudies(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, _ string, projectID string, _, _ int) (*client.ListStudiesResponse, error) {
+			name := map[string]string{"proj-a": "Alpha study", "proj-b": "Beta study"}[projectID]
+			return &client.ListStudiesResponse{Results: []model.Study{{ID: projectID + "-study", Name: name}}}, nil
+		}).
+		Times(2)
+
+	m := start(t, c)
+	m = run(t, m, key("enter"))
+	m = run(t, m, key("enter"))
+
+	// Open Alpha and leave it before its studies load, then open Beta.
+	m, loadAlpha := m.Update(key("enter"))
+	m = run(t, m, key("esc"))
+	m = run(t, m, key("down"))
+	m, loadBeta := m.Update(key("enter"))
+
+	m, _ = m.Update(loadBeta())
+	m, _ = m.Update(loadAlpha())
+
+	view := m.View()
+	if !strings.Contains(view, "Projects › Beta") || !strings.Contains(view, "Beta study") {
+		t.Fatalf("expected Beta's studies, got\n%s", view)
+	}
+	if strings.Contains(view, "Alpha study") {
+		t.Fatalf("expected Alpha's studies to be ignored, got\n%s", view)
+	}
+}
+
+func TestExplorerCopiesAndOpensTheSelectedItem(t *testing.T) {
+	var copied, opened string
+	defer tui.SetActionsForTesting(
+		func(s string) error { copied = s; return nil },
+		func(s string) error { opened = s; return nil },
+	)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock_client.NewMockAPI(ctrl)
+
+	c.EXPECT().GetWorkspaces(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListWorkspacesResponse{Results: []model.Workspace{{ID: "ws-1", Title: "Research"}}}, nil)
+	c.EXPECT().GetProjects(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&client.ListProjectsResponse{Results: []model.Project{{ID: "proj-1", Title: "Onboarding"}}}, nil)
+
+	m := start(t, c)
+
+	m = run(t, m, key("o"))
+	if view := m.View(); !strings.Contains(view, "Nothing to open in the browser") || opened != "" {
+		t.Fatalf("expected workspaces to have nothing to open, got\n%s", view)
+	}
+
+	m = run(t, m, key("enter"))
+	m = run(t, m, key("enter"))
+
+	m = run(t, m, key("y"))
+	if copied != "proj-1" || !strings.Contains(m.View(), "Copied proj-1") {
+		t.Fatalf("expected the project ID to be copied, got %q", copied)
+	}
+
+	m = run(t, m, key("o"))
+	if !strings.HasSuffix(opened, "/researcher/workspaces/projects/proj-1/") {
+		t.Fatalf("expected the project to be opened, got %q", opened)
+	}
+}
@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/prolific-oss/cli/client"
+	"github.com/spf13/cobra"
+)
+
+// NewTUICommand creates a new `tui` command, a full-screen explorer of your
+// workspaces and everything in them.
+func NewTUICommand(client client.API, w io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tui",
+		Short: "Explore your workspaces in a full-screen terminal UI",
+		Long: `Explore your workspaces in a full-screen terminal UI
+
+Browse from your workspaces into their projects, studies and submissions, as
+well as their hooks, participant groups and AI Task Builder batches. The
+selected item is shown in the pane on the right.
+
+Keys
+  enter      Open the selected item
+  esc        Go back, or clear a search
+  /          Search the current list
+  r          Refresh the current list
+  o          Open the selected item in the web application
+  y          Copy the ID of the selected item
+  t          Publish, start, pause or stop the selected study
+  q          Quit`,
+		Example: `
+$ prolific tui`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p := tea.NewProgram(NewExplorer(cmd.Context(), client), tea.WithAltScreen(), tea.WithContext(cmd.Context()), tea.WithOutput(w))
+			if _, err := p.Run(); err != nil {
+				return fmt.Errorf("error: cannot render the explorer: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
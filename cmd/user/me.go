@@ -1,10 +1,12 @@
 package user
 
 import (
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
@@ -12,25 +14,37 @@ import (
 // NewMeCommand creates a new `user me` command to give you details about
 // your account.
 func NewMeCommand(client client.API, w io.Writer) *cobra.Command {
+	var output shared.OutputOptions
+
 	cmd := &cobra.Command{
 		Use:   "whoami",
 		Short: "View details about your account",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := RenderMe(client, w)
+			if output.IsStructured() {
+				me, err := client.GetMe(cmd.Context())
+				if err != nil {
+					return fmt.Errorf("error: %w", err)
+				}
+				return shared.RenderRecord(output, *me, w)
+			}
+
+			err := RenderMe(cmd.Context(), client, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &output)
+
 	return cmd
 }
 
 // RenderMe will provide information about the user account.
-func RenderMe(client client.API, w io.Writer) error {
-	me, err := client.GetMe()
+func RenderMe(ctx context.Context, client client.API, w io.Writer) error {
+	me, err := client.GetMe(ctx)
 	if err != nil {
 		return err
 	}
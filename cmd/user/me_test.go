@@ -64,7 +64,7 @@ Email:             baldrick@turnip.co
 
 			c.
 				EXPECT().
-				GetMe().
+				GetMe(gomock.Any()).
 				Return(&me, nil).
 				AnyTimes()
 
@@ -94,7 +94,7 @@ func TestRenderMeHandlesFailure(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetMe().
+		GetMe(gomock.Any()).
 		Return(nil, fmt.Errorf("Failure to look within")).
 		AnyTimes()
 
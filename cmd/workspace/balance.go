@@ -1,18 +1,22 @@
 package workspace
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 // NewBalanceCommand creates a new command to show the balance of a workspace.
 func NewBalanceCommand(commandName string, c client.API, w io.Writer) *cobra.Command {
+	var output shared.OutputOptions
+
 	cmd := &cobra.Command{
 		Use:   commandName + " [workspace-id]",
 		Args:  cobra.MaximumNArgs(1),
@@ -31,6 +35,9 @@ $ prolific workspace balance
 
 Set a default workspace in your config file:
 workspace: <workspace-id>
+
+Show just the available balance, formatted in the workspace's currency
+$ prolific workspace balance --go-template '{{money .AvailableBalance .CurrencyCode}}'
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			workspaceID := viper.GetString("workspace")
@@ -42,24 +49,30 @@ workspace: <workspace-id>
 				return errors.New("error: please provide a workspace ID")
 			}
 
-			err := renderWorkspaceBalance(c, workspaceID, w)
+			err := renderWorkspaceBalance(cmd.Context(), c, workspaceID, output, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err)
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
 		},
 	}
 
+	shared.AddRecordOutputFlags(cmd, &output)
+
 	return cmd
 }
 
-func renderWorkspaceBalance(c client.API, workspaceID string, w io.Writer) error {
-	balance, err := c.GetWorkspaceBalance(workspaceID)
+func renderWorkspaceBalance(ctx context.Context, c client.API, workspaceID string, output shared.OutputOptions, w io.Writer) error {
+	balance, err := c.GetWorkspaceBalance(ctx, workspaceID)
 	if err != nil {
 		return err
 	}
 
+	if output.IsStructured() {
+		return shared.RenderRecord(output, *balance, w)
+	}
+
 	toCurrency := func(amount int) float64 {
 		return float64(amount) / 100
 	}
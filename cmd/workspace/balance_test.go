@@ -52,7 +52,7 @@ func TestNewBalanceCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetWorkspaceBalance(workspaceID).
+		GetWorkspaceBalance(gomock.Any(), workspaceID).
 		Return(&response, nil).
 		Times(1)
 
@@ -93,7 +93,7 @@ func TestNewBalanceCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetWorkspaceBalance(workspaceID).
+		GetWorkspaceBalance(gomock.Any(), workspaceID).
 		Return(nil, errors.New(errorMessage)).
 		Times(1)
 
@@ -124,7 +124,7 @@ func TestNewBalanceCommandUsesViperWorkspace(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetWorkspaceBalance(workspaceID).
+		GetWorkspaceBalance(gomock.Any(), workspaceID).
 		Return(&response, nil).
 		Times(1)
 
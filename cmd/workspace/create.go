@@ -1,19 +1,22 @@
 package workspace
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/model"
 	"github.com/spf13/cobra"
 )
 
 // CreateOptions are the options to be able to create a workspace.
 type CreateOptions struct {
-	Args  []string
-	Title string
+	Args   []string
+	Title  string
+	Output shared.OutputOptions
 }
 
 // NewCreateCommand creates a new command for creating workspaces.
@@ -36,9 +39,9 @@ $ prolific workspace create -t "Research into AI"
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := createWorkspace(client, opts, w)
+			err := createWorkspace(cmd.Context(), client, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -47,12 +50,13 @@ $ prolific workspace create -t "Research into AI"
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.Title, "title", "t", "", "The title of the workspace.")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
 
 	return cmd
 }
 
 // createWorkspace will create a workspace for you
-func createWorkspace(client client.API, opts CreateOptions, w io.Writer) error {
+func createWorkspace(ctx context.Context, client client.API, opts CreateOptions, w io.Writer) error {
 	if opts.Title == "" {
 		return errors.New("title is required")
 	}
@@ -61,11 +65,15 @@ func createWorkspace(client client.API, opts CreateOptions, w io.Writer) error {
 		Title: opts.Title,
 	}
 
-	record, err := client.CreateWorkspace(workspace)
+	record, err := client.CreateWorkspace(ctx, workspace)
 	if err != nil {
 		return err
 	}
 
+	if opts.Output.IsStructured() {
+		return shared.RenderRecord(opts.Output, *record, w)
+	}
+
 	fmt.Fprintf(w, "Created workspace: %s\n", record.ID)
 
 	return nil
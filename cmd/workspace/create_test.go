@@ -41,7 +41,7 @@ func TestCreateCommandErrorsIfNoTitle(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateWorkspace(gomock.Any()).
+		CreateWorkspace(gomock.Any(), gomock.Any()).
 		MaxTimes(0)
 
 	var b bytes.Buffer
@@ -74,7 +74,7 @@ func TestCreateCommandCreatesWorkspace(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateWorkspace(gomock.Any()).
+		CreateWorkspace(gomock.Any(), gomock.Any()).
 		Return(&r, nil).
 		MaxTimes(1)
 
@@ -113,7 +113,7 @@ func TestCreateCommandHandlesFailureToCreateWorkspace(t *testing.T) {
 
 	c.
 		EXPECT().
-		CreateWorkspace(gomock.Any()).
+		CreateWorkspace(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("unable to create workspace")).
 		MaxTimes(1)
 
@@ -1,11 +1,13 @@
 package workspace
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"text/tabwriter"
 
 	"github.com/prolific-oss/cli/client"
+	"github.com/prolific-oss/cli/cmd/shared"
 	"github.com/prolific-oss/cli/ui"
 	"github.com/spf13/cobra"
 )
@@ -15,6 +17,9 @@ type WorkspaceListOptions struct {
 	Args   []string
 	Limit  int
 	Offset int
+	All    bool
+	Output shared.OutputOptions
+	Query  shared.QueryOptions
 }
 
 // NewListCommand creates a new command to deal with workspaces
@@ -39,13 +44,16 @@ $ prolific workspace list -l 1
 
 Offset records in the result set, for example by 2
 $ prolific workspace list -l 1 -o 2
+
+Fetch every workspace, however many pages that takes
+$ prolific workspace list --all
 		`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Args = args
 
-			err := renderWorkspaces(c, opts, w)
+			err := renderWorkspaces(cmd.Context(), c, opts, w)
 			if err != nil {
-				return fmt.Errorf("error: %s", err.Error())
+				return fmt.Errorf("error: %w", err)
 			}
 
 			return nil
@@ -54,18 +62,32 @@ $ prolific workspace list -l 1 -o 2
 
 	flags := cmd.Flags()
 	flags.IntVarP(&opts.Limit, "limit", "l", client.DefaultRecordLimit, "Limit the number of workspaces returned")
-	flags.IntVarP(&opts.Offset, "offset", "o", client.DefaultRecordOffset, "The number of workspaces to offset")
+	flags.IntVar(&opts.Offset, "offset", client.DefaultRecordOffset, "The number of workspaces to offset")
+	shared.AddAllFlag(cmd, &opts.All, "workspaces")
+	shared.AddRecordOutputFlags(cmd, &opts.Output)
+	shared.AddQueryFlags(cmd, &opts.Query)
 
 	return cmd
 }
 
 // renderWorkspaces will show your workspaces
-func renderWorkspaces(c client.API, opts WorkspaceListOptions, w io.Writer) error {
-	workspaces, err := c.GetWorkspaces(opts.Limit, opts.Offset)
+func renderWorkspaces(ctx context.Context, c client.API, opts WorkspaceListOptions, w io.Writer) error {
+	workspaces, err := shared.FetchList(ctx, opts.All, opts.Limit, opts.Offset, func(ctx context.Context, limit, offset int) (*client.ListWorkspacesResponse, error) {
+		return c.GetWorkspaces(ctx, limit, offset)
+	})
 	if err != nil {
 		return err
 	}
 
+	workspaces.Results, err = shared.ApplyQuery(opts.Query, workspaces.Results)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output.IsStructured() {
+		return shared.RenderList(opts.Output, workspaces.Results, "", w)
+	}
+
 	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 	fmt.Fprintf(tw, "%s\t%s\t%s\n", "ID", "Title", "Description")
 	for _, workspace := range workspaces.Results {
@@ -3,8 +3,10 @@ package workspace_test
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
@@ -63,7 +65,7 @@ func TestNewEventTypeCommandCallsAPI(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetWorkspaces(client.DefaultRecordLimit, client.DefaultRecordOffset).
+		GetWorkspaces(gomock.Any(), client.DefaultRecordLimit, client.DefaultRecordOffset).
 		Return(&response, nil).
 		AnyTimes()
 
@@ -87,6 +89,36 @@ Showing 2 records of 10
 	}
 }
 
+func TestNewListCommandReplaysRecordedResponse(t *testing.T) {
+	c := client.Client{
+		Client:  &http.Client{Transport: client.NewReplayTransport("testdata/list")},
+		BaseURL: "https://api.prolific.com",
+		Token:   "test-token",
+	}
+
+	var b bytes.Buffer
+	writer := bufio.NewWriter(&b)
+
+	cmd := workspace.NewListCommand("workspaces", &c, writer)
+	cmd.SetContext(context.Background())
+	err := cmd.RunE(cmd, nil)
+	writer.Flush()
+
+	if err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	expected := `ID  Title  Description
+444 Office The office workspace
+555 Home   The home workspace
+
+Showing 2 records of 2
+`
+	if b.String() != expected {
+		t.Fatalf("expected\n'%s'\ngot\n'%s'\n", expected, b.String())
+	}
+}
+
 func TestNewListCommandHandlesErrors(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -96,7 +128,7 @@ func TestNewListCommandHandlesErrors(t *testing.T) {
 
 	c.
 		EXPECT().
-		GetWorkspaces(client.DefaultRecordLimit, client.DefaultRecordOffset).
+		GetWorkspaces(gomock.Any(), client.DefaultRecordLimit, client.DefaultRecordOffset).
 		Return(nil, errors.New(errorMessage)).
 		AnyTimes()
 
@@ -18,6 +18,24 @@ func GetApplicationURL() string {
 	return strings.TrimRight(viper.GetString("PROLIFIC_APPLICATION_URL"), "/")
 }
 
+// GetTimezone will return the time zone to show times in, from the
+// PROLIFIC_TIMEZONE environment variable or the timezone setting.
+func GetTimezone() string {
+	return viper.GetString("PROLIFIC_TIMEZONE")
+}
+
+// GetDateFormat will return the format to show times in, from the
+// PROLIFIC_DATE_FORMAT environment variable or the date_format setting.
+func GetDateFormat() string {
+	return viper.GetString("PROLIFIC_DATE_FORMAT")
+}
+
+// GetLocale will return the locale to format amounts of money for, from the
+// PROLIFIC_LOCALE environment variable or the locale setting.
+func GetLocale() string {
+	return viper.GetString("PROLIFIC_LOCALE")
+}
+
 // GetAPIURL will return the API URL. This is the default API, but we allow
 // users to override this with an environment variable.
 func GetAPIURL() string {
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFileName is the name of the config file, without its extension.
+const DefaultFileName = "prolific"
+
+// DefaultDir returns the directory the config file is looked for in when
+// --config is not given.
+func DefaultDir() (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "prolific-oss"), nil
+}
+
+// FilePath returns the config file in use, falling back to the default
+// location when no file has been loaded yet.
+func FilePath() (string, error) {
+	if path := viper.ConfigFileUsed(); path != "" {
+		return path, nil
+	}
+
+	dir, err := DefaultDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, DefaultFileName+".yaml"), nil
+}
+
+// Setting is a single value from the config file, addressed by its dotted key.
+type Setting struct {
+	Key   string
+	Value string
+}
+
+// File is a YAML config file loaded for editing. Edits work on the parsed
+// document, so comments and key order in the file are preserved.
+type File struct {
+	path string
+	root *yaml.Node
+}
+
+// LoadFile reads the config file at path. A missing file is treated as empty,
+// so the first Save creates it.
+func LoadFile(path string) (*File, error) {
+	f := &File{path: path, root: &yaml.Node{Kind: yaml.MappingNode}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+
+	if len(doc.Content) > 0 {
+		if doc.Content[0].Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("unable to parse %s: expected a mapping at the top level", path)
+		}
+		f.root = doc.Content[0]
+	}
+
+	return f, nil
+}
+
+// Path returns where the file is read from and saved to.
+func (f *File) Path() string {
+	return f.path
+}
+
+// Get returns the value at the dotted key. Keys match case-insensitively, as
+// they do when viper reads the file.
+func (f *File) Get(key string) (string, bool) {
+	node := f.root
+	for _, part := range strings.Split(key, ".") {
+		if node.Kind != yaml.MappingNode {
+			return "", false
+		}
+		_, value := lookup(node, part)
+		if value == nil {
+			return "", false
+		}
+		node = value
+	}
+
+	if node.Kind != yaml.ScalarNode {
+		return "", false
+	}
+	return node.Value, true
+}
+
+// Set stores value at the dotted key, creating any parent mappings needed.
+// Values are always written as strings, so a token made of digits is not read
+// back as a number.
+func (f *File) Set(key, value string) error {
+	parts := strings.Split(key, ".")
+	node := f.root
+
+	for i, part := range parts[:len(parts)-1] {
+		_, child := lookup(node, part)
+		if child == nil {
+			child = &yaml.Node{Kind: yaml.MappingNode}
+			node.Content = append(node.Content, scalar(part), child)
+		}
+		if child.Kind != yaml.MappingNode {
+			return fmt.Errorf("%s is not a group of settings", strings.Join(parts[:i+1], "."))
+		}
+		node = child
+	}
+
+	last := parts[len(parts)-1]
+	if _, existing := lookup(node, last); existing != nil {
+		if existing.Kind != yaml.ScalarNode {
+			return fmt.Errorf("%s is a group of settings and cannot be set to a value", key)
+		}
+		existing.Value = value
+		existing.Tag = "!!str"
+		existing.Style = 0
+		return nil
+	}
+
+	node.Content = append(node.Content, scalar(last), &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+	return nil
+}
+
+// Unset removes the dotted key, reporting whether it was present. Parent
+// mappings left empty are removed as well.
+func (f *File) Unset(key string) bool {
+	return unset(f.root, strings.Split(key, "."))
+}
+
+// Settings returns every value in the file in the order it appears.
+func (f *File) Settings() []Setting {
+	var settings []Setting
+	flatten(f.root, "", &settings)
+	return settings
+}
+
+// Save writes the file back, creating its directory if needed. The file may
+// hold API tokens, so it is only readable by the current user.
+func (f *File) Save() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o700); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if len(f.root.Content) > 0 {
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(f.root); err != nil {
+			return err
+		}
+		if err := enc.Close(); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(f.path, buf.Bytes(), 0o600)
+}
+
+// lookup finds the entry named key in a mapping node, returning its index in
+// the node's content and its value.
+func lookup(node *yaml.Node, key string) (int, *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if strings.EqualFold(node.Content[i].Value, key) {
+			return i, node.Content[i+1]
+		}
+	}
+	return -1, nil
+}
+
+func unset(node *yaml.Node, parts []string) bool {
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+
+	i, value := lookup(node, parts[0])
+	if value == nil {
+		return false
+	}
+
+	if len(parts) > 1 {
+		if !unset(value, parts[1:]) {
+			return false
+		}
+		if len(value.Content) > 0 {
+			return true
+		}
+	}
+
+	node.Content = append(node.Content[:i], node.Content[i+2:]...)
+	return true
+}
+
+func flatten(node *yaml.Node, prefix string, settings *[]Setting) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		switch value := node.Content[i+1]; value.Kind {
+		case yaml.MappingNode:
+			flatten(value, key, settings)
+		case yaml.ScalarNode:
+			*settings = append(*settings, Setting{Key: key, Value: value.Value})
+		}
+	}
+}
+
+func scalar(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
@@ -0,0 +1,155 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prolific-oss/cli/config"
+)
+
+func TestLoadFileMissingIsEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "prolific.yaml")
+
+	f, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if len(f.Settings()) != 0 {
+		t.Fatalf("expected no settings; got %v", f.Settings())
+	}
+
+	if err := f.Set("profiles.staging.token", "abc"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if err := f.Save(); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("expected file to be created; got %v", err)
+	}
+
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected mode 0600; got %o", info.Mode().Perm())
+	}
+}
+
+func TestFileEditsPreserveComments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+	original := `# My settings
+workspace: ws-1 # the team workspace
+profiles:
+  staging:
+    url: https://staging.example.com
+`
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if v, ok := f.Get("Workspace"); !ok || v != "ws-1" {
+		t.Fatalf("expected workspace ws-1; got %q, %v", v, ok)
+	}
+
+	if err := f.Set("workspace", "ws-2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Set("profiles.staging.token", "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `# My settings
+workspace: ws-2 # the team workspace
+profiles:
+  staging:
+    url: https://staging.example.com
+    token: secret
+`
+	if string(data) != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, data)
+	}
+}
+
+func TestFileSetRejectsOverwritingAGroup(t *testing.T) {
+	f, err := config.LoadFile(filepath.Join(t.TempDir(), "prolific.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := f.Set("profiles.staging.token", "abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.Set("profiles", "abc")
+	if err == nil || !strings.Contains(err.Error(), "profiles is a group of settings") {
+		t.Fatalf("expected group error; got %v", err)
+	}
+
+	err = f.Set("profiles.staging.token.extra", "abc")
+	if err == nil || !strings.Contains(err.Error(), "profiles.staging.token is not a group of settings") {
+		t.Fatalf("expected not a group error; got %v", err)
+	}
+}
+
+func TestFileUnsetRemovesEmptyParents(t *testing.T) {
+	f, err := config.LoadFile(filepath.Join(t.TempDir(), "prolific.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = f.Set("workspace", "ws-1")
+	_ = f.Set("profiles.staging.token", "abc")
+
+	if !f.Unset("profiles.staging.token") {
+		t.Fatal("expected token to be removed")
+	}
+
+	if f.Unset("profiles.staging.token") {
+		t.Fatal("expected second unset to report nothing removed")
+	}
+
+	settings := f.Settings()
+	if len(settings) != 1 || settings[0].Key != "workspace" {
+		t.Fatalf("expected only workspace to remain; got %v", settings)
+	}
+}
+
+func TestFileSetQuotesValuesThatLookLikeNumbers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prolific.yaml")
+
+	f, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = f.Set("project", "0123")
+	if err := f.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "project: \"0123\"\n" {
+		t.Fatalf("expected a quoted value; got %q", data)
+	}
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// ProfileEnvVar selects a profile when --profile is not given.
+	ProfileEnvVar = "PROLIFIC_PROFILE"
+	// CurrentProfileKey holds the profile used when neither --profile nor
+	// PROLIFIC_PROFILE is set. `prolific config use-profile` writes it.
+	CurrentProfileKey = "current_profile"
+	// ProfilesKey groups the named profiles in the config file.
+	ProfilesKey = "profiles"
+)
+
+// applied is the profile most recently passed to ApplyProfile.
+var applied string
+
+// ProfileSetting describes a setting that can be given a default in the config
+// file, either at the top level or within a profile.
+type ProfileSetting struct {
+	// Name is the key used within a profile and by `prolific config`.
+	Name string
+	// Key is the top-level key the rest of the CLI reads the setting from.
+	// For the PROLIFIC_* settings it matches the environment variable.
+	Key string
+	// Secret settings are masked when listed.
+	Secret bool
+}
+
+// ProfileSettings lists the settings a profile can hold.
+var ProfileSettings = []ProfileSetting{
+	{Name: "token", Key: "PROLIFIC_TOKEN", Secret: true},
+	{Name: "url", Key: "PROLIFIC_URL"},
+	{Name: "application_url", Key: "PROLIFIC_APPLICATION_URL"},
+	{Name: "workspace", Key: "workspace"},
+	{Name: "project", Key: "project"},
+	{Name: "timezone", Key: "PROLIFIC_TIMEZONE"},
+	{Name: "date_format", Key: "PROLIFIC_DATE_FORMAT"},
+	{Name: "locale", Key: "PROLIFIC_LOCALE"},
+}
+
+// LookupProfileSetting finds a setting by name.
+func LookupProfileSetting(name string) (ProfileSetting, bool) {
+	for _, s := range ProfileSettings {
+		if strings.EqualFold(s.Name, name) {
+			return s, true
+		}
+	}
+	return ProfileSetting{}, false
+}
+
+// ProfileSettingNames returns the names of the settings a profile can hold.
+func ProfileSettingNames() []string {
+	names := make([]string, 0, len(ProfileSettings))
+	for _, s := range ProfileSettings {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+// ActiveProfile returns the name of the selected profile, or an empty string
+// if none is. The --profile flag wins over PROLIFIC_PROFILE, which wins over
+// the current_profile set in the config file.
+func ActiveProfile(flag string) string {
+	if flag != "" {
+		return flag
+	}
+	if env := os.Getenv(ProfileEnvVar); env != "" {
+		return env
+	}
+	return viper.GetString(CurrentProfileKey)
+}
+
+// AppliedProfile returns the profile the CLI is running with, as selected by
+// ApplyProfile, or an empty string if there is none. The name is kept even if
+// the config file doesn't define the profile, so `prolific auth login` can
+// store a token for it.
+func AppliedProfile() string {
+	return applied
+}
+
+// Profiles returns the names of the profiles in the loaded config file.
+func Profiles() []string {
+	names := []string{}
+	for name := range viper.GetStringMap(ProfilesKey) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ApplyProfile makes the named profile's settings the ones the rest of the
+// CLI reads. Environment variables still take precedence, so a one-off
+// PROLIFIC_TOKEN overrides the profile's token.
+func ApplyProfile(name string) error {
+	applied = name
+	if name == "" {
+		return nil
+	}
+
+	// viper lower cases keys as it reads the file.
+	if !slices.Contains(Profiles(), strings.ToLower(name)) {
+		return fmt.Errorf("profile %q not found in config file", name)
+	}
+
+	prefix := ProfilesKey + "." + name + "."
+	for _, s := range ProfileSettings {
+		if !viper.IsSet(prefix + s.Name) {
+			continue
+		}
+		if _, ok := os.LookupEnv(strings.ToUpper(s.Key)); ok {
+			continue
+		}
+		viper.Set(s.Key, viper.GetString(prefix+s.Name))
+	}
+
+	return nil
+}
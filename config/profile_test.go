@@ -0,0 +1,97 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prolific-oss/cli/config"
+	"github.com/spf13/viper"
+)
+
+func loadProfileConfig(t *testing.T) {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.SetConfigType("yaml")
+	err := viper.ReadConfig(strings.NewReader(`
+workspace: default-ws
+current_profile: staging
+profiles:
+  staging:
+    token: staging-token
+    url: https://api.staging.example.com
+    workspace: staging-ws
+    project: staging-project
+  production:
+    token: production-token
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestActiveProfilePrecedence(t *testing.T) {
+	loadProfileConfig(t)
+
+	if got := config.ActiveProfile(""); got != "staging" {
+		t.Fatalf("expected current_profile staging; got %q", got)
+	}
+
+	t.Setenv(config.ProfileEnvVar, "production")
+	if got := config.ActiveProfile(""); got != "production" {
+		t.Fatalf("expected PROLIFIC_PROFILE production; got %q", got)
+	}
+
+	if got := config.ActiveProfile("other"); got != "other" {
+		t.Fatalf("expected flag other; got %q", got)
+	}
+}
+
+func TestApplyProfile(t *testing.T) {
+	loadProfileConfig(t)
+
+	if err := config.ApplyProfile("staging"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	expected := map[string]string{
+		"PROLIFIC_TOKEN": "staging-token",
+		"PROLIFIC_URL":   "https://api.staging.example.com",
+		"workspace":      "staging-ws",
+		"project":        "staging-project",
+	}
+	for key, value := range expected {
+		if got := viper.GetString(key); got != value {
+			t.Fatalf("expected %s to be %q; got %q", key, value, got)
+		}
+	}
+}
+
+func TestApplyProfileLeavesEnvironmentOverrides(t *testing.T) {
+	loadProfileConfig(t)
+	t.Setenv("PROLIFIC_TOKEN", "env-token")
+	viper.AutomaticEnv()
+
+	if err := config.ApplyProfile("production"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if got := viper.GetString("PROLIFIC_TOKEN"); got != "env-token" {
+		t.Fatalf("expected env-token; got %q", got)
+	}
+
+	if got := viper.GetString("workspace"); got != "default-ws" {
+		t.Fatalf("expected the default workspace to be kept; got %q", got)
+	}
+}
+
+func TestApplyProfileUnknown(t *testing.T) {
+	loadProfileConfig(t)
+
+	err := config.ApplyProfile("missing")
+	if err == nil || err.Error() != `profile "missing" not found in config file` {
+		t.Fatalf("expected profile not found error; got %v", err)
+	}
+}
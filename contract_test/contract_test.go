@@ -101,28 +101,28 @@ type operation struct {
 // TestAPICoverage to fail.
 var operations = []operation{
 	// Workspaces
-	{operationID: "get-workspaces", call: func(c *client.Client) { c.GetWorkspaces(10, 0) }},
-	{operationID: "create-workspace", call: func(c *client.Client) { c.CreateWorkspace(model.Workspace{Title: "t"}) }},
+	{operationID: "get-workspaces", call: func(c *client.Client) { c.GetWorkspaces(context.Background(), 10, 0) }},
+	{operationID: "create-workspace", call: func(c *client.Client) { c.CreateWorkspace(context.Background(), model.Workspace{Title: "t"}) }},
 	{operationID: "get-workspace", skip: "OUTOFSCOPE: no CLI command for retrieving a single workspace by ID"},
 	{operationID: "update-workspace", skip: "OUTOFSCOPE: no CLI command for updating a workspace"},
-	{operationID: "get-workspace-balance", call: func(c *client.Client) { c.GetWorkspaceBalance("ws-id") }},
+	{operationID: "get-workspace-balance", call: func(c *client.Client) { c.GetWorkspaceBalance(context.Background(), "ws-id") }},
 
 	// Projects
-	{operationID: "get-projects", call: func(c *client.Client) { c.GetProjects("ws-id", 10, 0) }},
-	{operationID: "create-project", call: func(c *client.Client) { c.CreateProject("ws-id", model.Project{Title: "t"}) }},
-	{operationID: "get-project", call: func(c *client.Client) { c.GetProject("proj-id") }},
+	{operationID: "get-projects", call: func(c *client.Client) { c.GetProjects(context.Background(), "ws-id", 10, 0) }},
+	{operationID: "create-project", call: func(c *client.Client) { c.CreateProject(context.Background(), "ws-id", model.Project{Title: "t"}) }},
+	{operationID: "get-project", call: func(c *client.Client) { c.GetProject(context.Background(), "proj-id") }},
 	{operationID: "update-project", skip: "OUTOFSCOPE: no CLI command for updating a project"},
 	{operationID: "delete-project-study", skip: "OUTOFSCOPE: no CLI command for removing a study from a project"},
 
 	// Filters
-	{operationID: "get-filters", call: func(c *client.Client) { c.GetFilters() }},
+	{operationID: "get-filters", call: func(c *client.Client) { c.GetFilters(context.Background()) }},
 	{operationID: "get-filter-distribution", skip: "OUTOFSCOPE: filter distribution not needed in CLI"},
 	{operationID: "get-eligible-count", skip: "OUTOFSCOPE: eligibility count not needed in CLI"},
 
 	// Filter Sets
-	{operationID: "get-filter-sets", call: func(c *client.Client) { c.GetFilterSets("ws-id", 10, 0) }},
-	{operationID: "create-filter-set", call: func(c *client.Client) { c.CreateFilterSet(model.CreateFilterSet{}) }},
-	{operationID: "get-filter-set", call: func(c *client.Client) { c.GetFilterSet("fs-id") }},
+	{operationID: "get-filter-sets", call: func(c *client.Client) { c.GetFilterSets(context.Background(), "ws-id", 10, 0) }},
+	{operationID: "create-filter-set", call: func(c *client.Client) { c.CreateFilterSet(context.Background(), model.CreateFilterSet{}) }},
+	{operationID: "get-filter-set", call: func(c *client.Client) { c.GetFilterSet(context.Background(), "fs-id") }},
 	{operationID: "delete-filter-set", skip: "OUTOFSCOPE: no CLI command for deleting a filter set"},
 	{operationID: "update-filter-set", skip: "OUTOFSCOPE: no CLI command for updating a filter set"},
 	{operationID: "clone-filter-set", skip: "OUTOFSCOPE: no CLI command for cloning a filter set"},
@@ -130,14 +130,14 @@ var operations = []operation{
 	{operationID: "unlock-filter-set", skip: "OUTOFSCOPE: no CLI command for unlocking a filter set"},
 
 	// Webhooks
-	{operationID: "get-event-types", call: func(c *client.Client) { c.GetHookEventTypes() }},
-	{operationID: "get-secrets", call: func(c *client.Client) { c.GetHookSecrets("ws-id") }},
+	{operationID: "get-event-types", call: func(c *client.Client) { c.GetHookEventTypes(context.Background()) }},
+	{operationID: "get-secrets", call: func(c *client.Client) { c.GetHookSecrets(context.Background(), "ws-id") }},
 	{operationID: "create-secret", call: func(c *client.Client) {
-		c.CreateHookSecret(client.CreateSecretPayload{WorkspaceID: "ws-id"})
+		c.CreateHookSecret(context.Background(), client.CreateSecretPayload{WorkspaceID: "ws-id"})
 	}},
-	{operationID: "get-subscriptions", call: func(c *client.Client) { c.GetHooks("ws-id", true, 10, 0) }},
+	{operationID: "get-subscriptions", call: func(c *client.Client) { c.GetHooks(context.Background(), "ws-id", true, 10, 0) }},
 	{operationID: "create-subscription", call: func(c *client.Client) {
-		c.CreateHookSubscription(client.CreateHookPayload{
+		c.CreateHookSubscription(context.Background(), client.CreateHookPayload{
 			EventType:   "submission.completed",
 			TargetURL:   "https://example.com/hook",
 			WorkspaceID: "ws-id",
@@ -145,108 +145,112 @@ var operations = []operation{
 	}},
 	{operationID: "get-subscription", skip: "OUTOFSCOPE: no CLI command for retrieving a single webhook subscription"},
 	{operationID: "confirm-subscription", call: func(c *client.Client) {
-		c.ConfirmHookSubscription("sub-id", "secret-value")
+		c.ConfirmHookSubscription(context.Background(), "sub-id", "secret-value")
 	}},
-	{operationID: "delete-subscription", call: func(c *client.Client) { c.DeleteHookSubscription("sub-id") }},
+	{operationID: "delete-subscription", call: func(c *client.Client) { c.DeleteHookSubscription(context.Background(), "sub-id") }},
 	{operationID: "update-subscription", call: func(c *client.Client) {
-		c.UpdateHookSubscription("sub-id", client.UpdateHookPayload{})
+		c.UpdateHookSubscription(context.Background(), "sub-id", client.UpdateHookPayload{})
 	}},
-	{operationID: "get-events", call: func(c *client.Client) { c.GetEvents("sub-id", 10, 0) }},
+	{operationID: "get-events", call: func(c *client.Client) { c.GetEvents(context.Background(), "sub-id", 10, 0) }},
 
 	// Surveys
-	{operationID: "get-surveys", call: func(c *client.Client) { c.GetSurveys("researcher-id", 10, 0) }},
+	{operationID: "get-surveys", call: func(c *client.Client) { c.GetSurveys(context.Background(), "researcher-id", 10, 0) }},
 	{operationID: "create-survey", call: func(c *client.Client) {
-		c.CreateSurvey(model.CreateSurvey{Title: "t", ResearcherID: "researcher-id"})
+		c.CreateSurvey(context.Background(), model.CreateSurvey{Title: "t", ResearcherID: "researcher-id"})
 	}},
-	{operationID: "get-survey", call: func(c *client.Client) { c.GetSurvey("survey-id") }},
-	{operationID: "delete-survey", call: func(c *client.Client) { c.DeleteSurvey("survey-id") }},
-	{operationID: "get-responses", call: func(c *client.Client) { c.GetSurveyResponses("survey-id", 10, 0) }},
+	{operationID: "get-survey", call: func(c *client.Client) { c.GetSurvey(context.Background(), "survey-id") }},
+	{operationID: "delete-survey", call: func(c *client.Client) { c.DeleteSurvey(context.Background(), "survey-id") }},
+	{operationID: "get-responses", call: func(c *client.Client) { c.GetSurveyResponses(context.Background(), "survey-id", 10, 0) }},
 	{operationID: "create-response", call: func(c *client.Client) {
-		c.CreateSurveyResponse("survey-id", model.CreateSurveyResponseRequest{
+		c.CreateSurveyResponse(context.Background(), "survey-id", model.CreateSurveyResponseRequest{
 			ParticipantID: "participant-id",
 			SubmissionID:  "submission-id",
 		})
 	}},
-	{operationID: "delete-responses", call: func(c *client.Client) { c.DeleteAllSurveyResponses("survey-id") }},
-	{operationID: "get-summary", call: func(c *client.Client) { c.GetSurveyResponseSummary("survey-id") }},
-	{operationID: "get-response", call: func(c *client.Client) { c.GetSurveyResponse("survey-id", "response-id") }},
-	{operationID: "delete-response", call: func(c *client.Client) { c.DeleteSurveyResponse("survey-id", "response-id") }},
+	{operationID: "delete-responses", call: func(c *client.Client) { c.DeleteAllSurveyResponses(context.Background(), "survey-id") }},
+	{operationID: "get-summary", call: func(c *client.Client) { c.GetSurveyResponseSummary(context.Background(), "survey-id") }},
+	{operationID: "get-response", call: func(c *client.Client) { c.GetSurveyResponse(context.Background(), "survey-id", "response-id") }},
+	{operationID: "delete-response", call: func(c *client.Client) { c.DeleteSurveyResponse(context.Background(), "survey-id", "response-id") }},
 
 	// AI Task Builder — Batches
-	{operationID: "get-task-builder-batches", call: func(c *client.Client) { c.GetAITaskBuilderBatches("ws-id") }},
+	{operationID: "get-task-builder-batches", call: func(c *client.Client) {
+		c.GetAITaskBuilderBatches(context.Background(), "ws-id", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	}},
 	{operationID: "create-task-builder-batch", call: func(c *client.Client) {
-		c.CreateAITaskBuilderBatch(client.CreateBatchParams{
+		c.CreateAITaskBuilderBatch(context.Background(), client.CreateBatchParams{
 			Name:        "t",
 			WorkspaceID: "ws-id",
 			DatasetID:   "ds-id",
 		})
 	}},
-	{operationID: "get-task-builder-batch", call: func(c *client.Client) { c.GetAITaskBuilderBatch("batch-id") }},
+	{operationID: "get-task-builder-batch", call: func(c *client.Client) { c.GetAITaskBuilderBatch(context.Background(), "batch-id") }},
 	{operationID: "update-task-builder-batch", call: func(c *client.Client) {
-		c.UpdateAITaskBuilderBatch(client.UpdateBatchParams{BatchID: "batch-id", Name: "t"})
+		c.UpdateAITaskBuilderBatch(context.Background(), client.UpdateBatchParams{BatchID: "batch-id", Name: "t"})
 	}},
-	{operationID: "get-task-builder-batch-status", call: func(c *client.Client) { c.GetAITaskBuilderBatchStatus("batch-id") }},
+	{operationID: "get-task-builder-batch-status", call: func(c *client.Client) { c.GetAITaskBuilderBatchStatus(context.Background(), "batch-id") }},
 	{operationID: "setup-task-builder-batch", call: func(c *client.Client) {
-		c.SetupAITaskBuilderBatch("batch-id", "ds-id", 5)
+		c.SetupAITaskBuilderBatch(context.Background(), "batch-id", "ds-id", 5)
 	}},
-	{operationID: "get-task-builder-batch-task-responses", call: func(c *client.Client) { c.GetAITaskBuilderResponses("batch-id") }},
+	{operationID: "get-task-builder-batch-task-responses", call: func(c *client.Client) { c.GetAITaskBuilderResponses(context.Background(), "batch-id") }},
 	{operationID: "get-task-builder-batch-report", skip: "OUTOFSCOPE: no CLI command for batch report; GetAITaskBuilderTasks calls /tasks which is not in the spec"},
 	{operationID: "duplicate-task-builder-batch", skip: "OUTOFSCOPE: no CLI command for duplicating a batch"},
-	{operationID: "sync-task-builder-batch", call: func(c *client.Client) { c.SyncAITaskBuilderBatch("batch-id") }},
-	{operationID: "get-batch-sync-status", call: func(c *client.Client) { c.GetAITaskBuilderBatchSyncStatus("batch-id", "sync-id") }},
-	{operationID: "request-batch-export", call: func(c *client.Client) { c.InitiateBatchExport("batch-id") }},
-	{operationID: "get-batch-export-status", call: func(c *client.Client) { c.GetBatchExportStatus("batch-id", "export-id") }},
+	{operationID: "sync-task-builder-batch", call: func(c *client.Client) { c.SyncAITaskBuilderBatch(context.Background(), "batch-id") }},
+	{operationID: "get-batch-sync-status", call: func(c *client.Client) { c.GetAITaskBuilderBatchSyncStatus(context.Background(), "batch-id", "sync-id") }},
+	{operationID: "request-batch-export", call: func(c *client.Client) { c.InitiateBatchExport(context.Background(), "batch-id") }},
+	{operationID: "get-batch-export-status", call: func(c *client.Client) { c.GetBatchExportStatus(context.Background(), "batch-id", "export-id") }},
 
 	// AI Task Builder — Datasets
 	{operationID: "create-task-builder-dataset", call: func(c *client.Client) {
-		c.CreateAITaskBuilderDataset("ws-id", client.CreateAITaskBuilderDatasetPayload{Name: "t"})
+		c.CreateAITaskBuilderDataset(context.Background(), "ws-id", client.CreateAITaskBuilderDatasetPayload{Name: "t"})
 	}},
 	{operationID: "update-task-builder-dataset", skip: "OUTOFSCOPE: no CLI command for updating a dataset"},
 	{operationID: "append-dataset-datapoints", skip: "OUTOFSCOPE: no CLI command for appending datapoints to a dataset"},
-	{operationID: "get-dataset-upload-url", call: func(c *client.Client) { c.GetAITaskBuilderDatasetUploadURL("ds-id", "data.jsonl") }},
-	{operationID: "get-task-builder-dataset", call: func(c *client.Client) { c.GetAITaskBuilderDataset("ds-id") }},
-	{operationID: "get-task-builder-dataset-status", call: func(c *client.Client) { c.GetAITaskBuilderDatasetStatus("ds-id") }},
+	{operationID: "get-dataset-upload-url", call: func(c *client.Client) {
+		c.GetAITaskBuilderDatasetUploadURL(context.Background(), "ds-id", "data.jsonl")
+	}},
+	{operationID: "get-task-builder-dataset", call: func(c *client.Client) { c.GetAITaskBuilderDataset(context.Background(), "ds-id") }},
+	{operationID: "get-task-builder-dataset-status", call: func(c *client.Client) { c.GetAITaskBuilderDatasetStatus(context.Background(), "ds-id") }},
 	{operationID: "get-dataset-import-status", call: func(c *client.Client) {
-		c.GetAITaskBuilderDatasetImportStatus("ds-id", "import-id")
+		c.GetAITaskBuilderDatasetImportStatus(context.Background(), "ds-id", "import-id")
 	}},
 	{operationID: "get-schema-migration-status", skip: "OUTOFSCOPE: no CLI command for dataset schema migration status"},
 
 	// AI Task Builder — Instructions
 	{operationID: "get-task-builder-instructions", skip: "OUTOFSCOPE: no CLI command for getting task builder instructions"},
 	{operationID: "create-task-builder-instructions", call: func(c *client.Client) {
-		c.CreateAITaskBuilderInstructions("batch-id", client.CreateAITaskBuilderInstructionsPayload{
+		c.CreateAITaskBuilderInstructions(context.Background(), "batch-id", client.CreateAITaskBuilderInstructionsPayload{
 			Instructions: []client.Instruction{},
 		})
 	}},
 	{operationID: "update-task-builder-instructions", skip: "OUTOFSCOPE: no CLI command for updating task builder instructions"},
 
 	// AI Task Builder — Collections
-	{operationID: "list-collections", call: func(c *client.Client) { c.GetCollections("ws-id", 10, 0) }},
+	{operationID: "list-collections", call: func(c *client.Client) { c.GetCollections(context.Background(), "ws-id", 10, 0) }},
 	{operationID: "create-collection", call: func(c *client.Client) {
-		c.CreateAITaskBuilderCollection(model.CreateAITaskBuilderCollection{
+		c.CreateAITaskBuilderCollection(context.Background(), model.CreateAITaskBuilderCollection{
 			WorkspaceID:     "ws-id",
 			Name:            "t",
 			CollectionItems: []model.CollectionPage{},
 			TaskDetails:     &model.TaskDetails{TaskName: "t", TaskIntroduction: "t", TaskSteps: "t"},
 		})
 	}},
-	{operationID: "get-collection", call: func(c *client.Client) { c.GetCollection("coll-id") }},
+	{operationID: "get-collection", call: func(c *client.Client) { c.GetCollection(context.Background(), "coll-id") }},
 	{operationID: "update-collection", call: func(c *client.Client) {
-		c.UpdateCollection("coll-id", model.UpdateCollection{
+		c.UpdateCollection(context.Background(), "coll-id", model.UpdateCollection{
 			Name:            "t",
 			CollectionItems: []model.Page{},
 			TaskDetails:     &model.TaskDetails{TaskName: "t", TaskIntroduction: "t", TaskSteps: "t"},
 		})
 	}},
 	{operationID: "get-collection-responses", skip: "OUTOFSCOPE: no CLI command for getting collection responses"},
-	{operationID: "request-collection-export", call: func(c *client.Client) { c.InitiateCollectionExport("coll-id") }},
+	{operationID: "request-collection-export", call: func(c *client.Client) { c.InitiateCollectionExport(context.Background(), "coll-id") }},
 	{operationID: "get-collection-export-status", call: func(c *client.Client) {
-		c.GetCollectionExportStatus("coll-id", "export-id")
+		c.GetCollectionExportStatus(context.Background(), "coll-id", "export-id")
 	}},
 
 	// Invitations
 	{operationID: "create-invitation", call: func(c *client.Client) {
-		c.CreateInvitation(model.CreateInvitation{
+		c.CreateInvitation(context.Background(), model.CreateInvitation{
 			Association: "ws-id",
 			Emails:      []string{"user@example.com"},
 			Role:        "WORKSPACE_COLLABORATOR",
@@ -256,21 +260,23 @@ var operations = []operation{
 	// Messages
 	{operationID: "get-messages", call: func(c *client.Client) {
 		uid := "user-id"
-		c.GetMessages(&uid, nil)
+		c.GetMessages(context.Background(), &uid, nil)
 	}},
-	{operationID: "send-message", call: func(c *client.Client) { c.SendMessage("hello", "recipient-id", "study-id") }},
+	{operationID: "send-message", call: func(c *client.Client) { c.SendMessage(context.Background(), "hello", "recipient-id", "study-id") }},
 	{operationID: "bulk-message-participants", call: func(c *client.Client) {
-		c.BulkSendMessage([]string{"p1", "p2"}, "hello", "study-id")
+		c.BulkSendMessage(context.Background(), []string{"p1", "p2"}, "hello", "study-id")
 	}},
 	{operationID: "send-message-to-participant-group", call: func(c *client.Client) {
-		c.SendGroupMessage("group-id", "hello", nil)
+		c.SendGroupMessage(context.Background(), "group-id", "hello", nil)
 	}},
-	{operationID: "get-unread-messages", call: func(c *client.Client) { c.GetUnreadMessages() }},
+	{operationID: "get-unread-messages", call: func(c *client.Client) { c.GetUnreadMessages(context.Background()) }},
 
 	// Studies
-	{operationID: "get-studies", call: func(c *client.Client) { c.GetStudies("", "") }},
+	{operationID: "get-studies", call: func(c *client.Client) {
+		c.GetStudies(context.Background(), "", "", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	}},
 	{operationID: "create-study", call: func(c *client.Client) {
-		c.CreateStudy(model.CreateStudy{
+		c.CreateStudy(context.Background(), model.CreateStudy{
 			Name:                    "t",
 			ExternalStudyURL:        "https://example.com/study?p={{%PROLIFIC_PID%}}",
 			ProlificIDOption:        "url_parameters",
@@ -280,40 +286,42 @@ var operations = []operation{
 			DeviceCompatibility:     []string{"desktop"},
 		})
 	}},
-	{operationID: "get-project-studies", call: func(c *client.Client) { c.GetStudies("", "proj-id") }},
+	{operationID: "get-project-studies", call: func(c *client.Client) {
+		c.GetStudies(context.Background(), "", "proj-id", client.DefaultRecordLimit, client.DefaultRecordOffset)
+	}},
 	{operationID: "delete-project-study", skip: "OUTOFSCOPE: no CLI command for deleting a study"},
-	{operationID: "get-study", call: func(c *client.Client) { c.GetStudy("study-id") }},
+	{operationID: "get-study", call: func(c *client.Client) { c.GetStudy(context.Background(), "study-id") }},
 	{operationID: "delete-study", skip: "OUTOFSCOPE: no CLI command for deleting a study"},
 	{operationID: "update-study", call: func(c *client.Client) {
-		c.UpdateStudy("study-id", map[string]any{"name": "updated"})
+		c.UpdateStudy(context.Background(), "study-id", map[string]any{"name": "updated"})
 	}},
-	{operationID: "publish-study", call: func(c *client.Client) { c.TransitionStudy("study-id", "PUBLISH") }},
-	{operationID: "create-test-study", call: func(c *client.Client) { c.TestStudy("study-id") }},
+	{operationID: "publish-study", call: func(c *client.Client) { c.TransitionStudy(context.Background(), "study-id", "PUBLISH") }},
+	{operationID: "create-test-study", call: func(c *client.Client) { c.TestStudy(context.Background(), "study-id") }},
 	{operationID: "get-study-access-details-progress", skip: "OUTOFSCOPE: no CLI command for access details progress"},
 	{operationID: "get-study-cost", skip: "OUTOFSCOPE: no CLI command for getting study cost"},
-	{operationID: "get-study-submissions", call: func(c *client.Client) { c.GetSubmissions("study-id", 10, 0) }},
-	{operationID: "count-study-submissions-by-status", call: func(c *client.Client) { c.GetStudySubmissionCounts("study-id") }},
+	{operationID: "get-study-submissions", call: func(c *client.Client) { c.GetSubmissions(context.Background(), "study-id", 10, 0) }},
+	{operationID: "count-study-submissions-by-status", call: func(c *client.Client) { c.GetStudySubmissionCounts(context.Background(), "study-id") }},
 	{operationID: "download-study-credential-report", call: func(c *client.Client) {
-		c.GetStudyCredentialsUsageReportCSV("study-id")
+		c.GetStudyCredentialsUsageReportCSV(context.Background(), "study-id")
 	}},
 	{operationID: "export-study", skip: "OUTOFSCOPE: no CLI command for exporting a study as a whole"},
-	{operationID: "export-demographic-data", call: func(c *client.Client) { c.ExportDemographics("study-id") }},
+	{operationID: "export-demographic-data", call: func(c *client.Client) { c.ExportDemographics(context.Background(), "study-id") }},
 	{operationID: "get-demographic-export-history", skip: "OUTOFSCOPE: no CLI command for demographic export history"},
-	{operationID: "duplicate-study", call: func(c *client.Client) { c.DuplicateStudy("study-id") }},
+	{operationID: "duplicate-study", call: func(c *client.Client) { c.DuplicateStudy(context.Background(), "study-id") }},
 	{operationID: "calculate-study-cost", skip: "OUTOFSCOPE: no CLI command for calculating study cost"},
 
 	// Credentials
-	{operationID: "list-credential-pools", call: func(c *client.Client) { c.ListCredentialPools("ws-id") }},
+	{operationID: "list-credential-pools", call: func(c *client.Client) { c.ListCredentialPools(context.Background(), "ws-id") }},
 	{operationID: "create-credential-pool", call: func(c *client.Client) {
-		c.CreateCredentialPool("user,pass\nuser2,pass2", "ws-id")
+		c.CreateCredentialPool(context.Background(), "user,pass\nuser2,pass2", "ws-id")
 	}},
 	{operationID: "update-credential-pool", call: func(c *client.Client) {
-		c.UpdateCredentialPool("pool-id", "user,pass\nuser2,pass2")
+		c.UpdateCredentialPool(context.Background(), "pool-id", "user,pass\nuser2,pass2")
 	}},
 
 	// Reward Recommendations
 	{operationID: "calculate-reward-recommendations", call: func(c *client.Client) {
-		c.GetRewardRecommendations("ws-id", "GBP", []string{"mandarin", "spanish"})
+		c.GetRewardRecommendations(context.Background(), "ws-id", "GBP", []string{"mandarin", "spanish"})
 	}},
 
 	// Well-known endpoints
@@ -323,45 +331,45 @@ var operations = []operation{
 	{operationID: "get-submissions", skip: "OUTOFSCOPE: global submissions list not exposed in CLI; use study-scoped get-study-submissions"},
 	{operationID: "get-submission", skip: "OUTOFSCOPE: single submission retrieval not exposed in CLI"},
 	{operationID: "transition-submission", call: func(c *client.Client) {
-		c.TransitionSubmission("sub-id", client.TransitionSubmissionPayload{Action: "APPROVE"})
+		c.TransitionSubmission(context.Background(), "sub-id", client.TransitionSubmissionPayload{Action: "APPROVE"})
 	}},
 	{operationID: "request-submission-return", call: func(c *client.Client) {
-		c.RequestSubmissionReturn("sub-id", []string{"no longer needed"})
+		c.RequestSubmissionReturn(context.Background(), "sub-id", []string{"no longer needed"})
 	}},
 	{operationID: "get-submission-feedback-upload-url", skip: "OUTOFSCOPE: no CLI command for submission feedback upload URL"},
 	{operationID: "bulk-approve-submissions", call: func(c *client.Client) {
-		c.BulkApproveSubmissions(client.BulkApproveSubmissionsPayload{
+		c.BulkApproveSubmissions(context.Background(), client.BulkApproveSubmissionsPayload{
 			SubmissionIDs: []string{"sub-id"},
 		})
 	}},
 
 	// Bonuses
 	{operationID: "create-bonus-payments", call: func(c *client.Client) {
-		c.CreateBonusPayments(client.CreateBonusPaymentsPayload{
+		c.CreateBonusPayments(context.Background(), client.CreateBonusPaymentsPayload{
 			StudyID:    "study-id",
 			CSVBonuses: "participant-id,1.50",
 		})
 	}},
-	{operationID: "pay-bonus-payments", call: func(c *client.Client) { c.PayBonusPayments("bonus-id") }},
+	{operationID: "pay-bonus-payments", call: func(c *client.Client) { c.PayBonusPayments(context.Background(), "bonus-id") }},
 
 	// Users
-	{operationID: "get-user", call: func(c *client.Client) { c.GetMe() }},
+	{operationID: "get-user", call: func(c *client.Client) { c.GetMe(context.Background()) }},
 	{operationID: "create-test-participant-for-researcher", call: func(c *client.Client) {
-		c.CreateTestParticipant("test@example.com")
+		c.CreateTestParticipant(context.Background(), "test@example.com")
 	}},
 
 	// Participant Groups
 	{operationID: "get-participant-groups", skip: "HARNESSGAP: kin-openapi v0.146.0 mis-decodes a top-level oneOf-of-objects query param under the default form/explode=true style — it always resolves to the last oneOf branch (project_id), discarding an earlier correct match (workspace_id), so oneOf validation fails regardless of how the client sends it. Client's flat workspace_id=X is correct per spec defaults and per the real API (see commit 8c7aae6 / DCP-2272)."},
 	{operationID: "create-participant-group", call: func(c *client.Client) {
-		c.CreateParticipantGroup(model.CreateParticipantGroup{Name: "t", WorkspaceID: "ws-id"})
+		c.CreateParticipantGroup(context.Background(), model.CreateParticipantGroup{Name: "t", WorkspaceID: "ws-id"})
 	}},
 	{operationID: "get-participant-group", skip: "OUTOFSCOPE: no CLI command for retrieving a single participant group by ID"},
 	{operationID: "delete-participant-group", skip: "OUTOFSCOPE: no CLI command for deleting a participant group"},
 	{operationID: "update-participant-group", skip: "OUTOFSCOPE: no CLI command for updating a participant group"},
-	{operationID: "get-participant-group-participants", call: func(c *client.Client) { c.GetParticipantGroup("group-id") }},
+	{operationID: "get-participant-group-participants", call: func(c *client.Client) { c.GetParticipantGroup(context.Background(), "group-id") }},
 	{operationID: "add-to-participant-group", skip: "OUTOFSCOPE: adding participants not exposed in CLI"},
 	{operationID: "remove-from-participant-group", call: func(c *client.Client) {
-		c.RemoveParticipantGroupMembers("group-id", []string{"participant-id"})
+		c.RemoveParticipantGroupMembers(context.Background(), "group-id", []string{"participant-id"})
 	}},
 }
 
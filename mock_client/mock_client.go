@@ -5,6 +5,7 @@
 package mock_client
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,172 +37,172 @@ func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
 }
 
 // BulkApproveSubmissions mocks base method.
-func (m *MockAPI) BulkApproveSubmissions(payload client.BulkApproveSubmissionsPayload) error {
+func (m *MockAPI) BulkApproveSubmissions(ctx context.Context, payload client.BulkApproveSubmissionsPayload) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BulkApproveSubmissions", payload)
+	ret := m.ctrl.Call(m, "BulkApproveSubmissions", ctx, payload)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // BulkApproveSubmissions indicates an expected call of BulkApproveSubmissions.
-func (mr *MockAPIMockRecorder) BulkApproveSubmissions(payload interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) BulkApproveSubmissions(ctx, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkApproveSubmissions", reflect.TypeOf((*MockAPI)(nil).BulkApproveSubmissions), payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkApproveSubmissions", reflect.TypeOf((*MockAPI)(nil).BulkApproveSubmissions), ctx, payload)
 }
 
 // BulkSendMessage mocks base method.
-func (m *MockAPI) BulkSendMessage(ids []string, body, studyID string) error {
+func (m *MockAPI) BulkSendMessage(ctx context.Context, ids []string, body, studyID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BulkSendMessage", ids, body, studyID)
+	ret := m.ctrl.Call(m, "BulkSendMessage", ctx, ids, body, studyID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // BulkSendMessage indicates an expected call of BulkSendMessage.
-func (mr *MockAPIMockRecorder) BulkSendMessage(ids, body, studyID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) BulkSendMessage(ctx, ids, body, studyID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkSendMessage", reflect.TypeOf((*MockAPI)(nil).BulkSendMessage), ids, body, studyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkSendMessage", reflect.TypeOf((*MockAPI)(nil).BulkSendMessage), ctx, ids, body, studyID)
 }
 
 // ConfirmHookSubscription mocks base method.
-func (m *MockAPI) ConfirmHookSubscription(subscriptionID, secret string) (*model.Hook, error) {
+func (m *MockAPI) ConfirmHookSubscription(ctx context.Context, subscriptionID, secret string) (*model.Hook, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfirmHookSubscription", subscriptionID, secret)
+	ret := m.ctrl.Call(m, "ConfirmHookSubscription", ctx, subscriptionID, secret)
 	ret0, _ := ret[0].(*model.Hook)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ConfirmHookSubscription indicates an expected call of ConfirmHookSubscription.
-func (mr *MockAPIMockRecorder) ConfirmHookSubscription(subscriptionID, secret interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) ConfirmHookSubscription(ctx, subscriptionID, secret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmHookSubscription", reflect.TypeOf((*MockAPI)(nil).ConfirmHookSubscription), subscriptionID, secret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmHookSubscription", reflect.TypeOf((*MockAPI)(nil).ConfirmHookSubscription), ctx, subscriptionID, secret)
 }
 
 // CreateAITaskBuilderBatch mocks base method.
-func (m *MockAPI) CreateAITaskBuilderBatch(params client.CreateBatchParams) (*client.CreateAITaskBuilderBatchResponse, error) {
+func (m *MockAPI) CreateAITaskBuilderBatch(ctx context.Context, params client.CreateBatchParams) (*client.CreateAITaskBuilderBatchResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAITaskBuilderBatch", params)
+	ret := m.ctrl.Call(m, "CreateAITaskBuilderBatch", ctx, params)
 	ret0, _ := ret[0].(*client.CreateAITaskBuilderBatchResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAITaskBuilderBatch indicates an expected call of CreateAITaskBuilderBatch.
-func (mr *MockAPIMockRecorder) CreateAITaskBuilderBatch(params interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateAITaskBuilderBatch(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).CreateAITaskBuilderBatch), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).CreateAITaskBuilderBatch), ctx, params)
 }
 
 // CreateAITaskBuilderCollection mocks base method.
-func (m *MockAPI) CreateAITaskBuilderCollection(payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
+func (m *MockAPI) CreateAITaskBuilderCollection(ctx context.Context, payload model.CreateAITaskBuilderCollection) (*client.CreateAITaskBuilderCollectionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAITaskBuilderCollection", payload)
+	ret := m.ctrl.Call(m, "CreateAITaskBuilderCollection", ctx, payload)
 	ret0, _ := ret[0].(*client.CreateAITaskBuilderCollectionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAITaskBuilderCollection indicates an expected call of CreateAITaskBuilderCollection.
-func (mr *MockAPIMockRecorder) CreateAITaskBuilderCollection(payload interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateAITaskBuilderCollection(ctx, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAITaskBuilderCollection", reflect.TypeOf((*MockAPI)(nil).CreateAITaskBuilderCollection), payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAITaskBuilderCollection", reflect.TypeOf((*MockAPI)(nil).CreateAITaskBuilderCollection), ctx, payload)
 }
 
 // CreateAITaskBuilderDataset mocks base method.
-func (m *MockAPI) CreateAITaskBuilderDataset(workspaceID string, payload client.CreateAITaskBuilderDatasetPayload) (*client.CreateAITaskBuilderDatasetResponse, error) {
+func (m *MockAPI) CreateAITaskBuilderDataset(ctx context.Context, workspaceID string, payload client.CreateAITaskBuilderDatasetPayload) (*client.CreateAITaskBuilderDatasetResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAITaskBuilderDataset", workspaceID, payload)
+	ret := m.ctrl.Call(m, "CreateAITaskBuilderDataset", ctx, workspaceID, payload)
 	ret0, _ := ret[0].(*client.CreateAITaskBuilderDatasetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAITaskBuilderDataset indicates an expected call of CreateAITaskBuilderDataset.
-func (mr *MockAPIMockRecorder) CreateAITaskBuilderDataset(workspaceID, payload interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateAITaskBuilderDataset(ctx, workspaceID, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAITaskBuilderDataset", reflect.TypeOf((*MockAPI)(nil).CreateAITaskBuilderDataset), workspaceID, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAITaskBuilderDataset", reflect.TypeOf((*MockAPI)(nil).CreateAITaskBuilderDataset), ctx, workspaceID, payload)
 }
 
 // CreateAITaskBuilderInstructions mocks base method.
-func (m *MockAPI) CreateAITaskBuilderInstructions(batchID string, instructions client.CreateAITaskBuilderInstructionsPayload) (*client.CreateAITaskBuilderInstructionsResponse, error) {
+func (m *MockAPI) CreateAITaskBuilderInstructions(ctx context.Context, batchID string, instructions client.CreateAITaskBuilderInstructionsPayload) (*client.CreateAITaskBuilderInstructionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAITaskBuilderInstructions", batchID, instructions)
+	ret := m.ctrl.Call(m, "CreateAITaskBuilderInstructions", ctx, batchID, instructions)
 	ret0, _ := ret[0].(*client.CreateAITaskBuilderInstructionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAITaskBuilderInstructions indicates an expected call of CreateAITaskBuilderInstructions.
-func (mr *MockAPIMockRecorder) CreateAITaskBuilderInstructions(batchID, instructions interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateAITaskBuilderInstructions(ctx, batchID, instructions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAITaskBuilderInstructions", reflect.TypeOf((*MockAPI)(nil).CreateAITaskBuilderInstructions), batchID, instructions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAITaskBuilderInstructions", reflect.TypeOf((*MockAPI)(nil).CreateAITaskBuilderInstructions), ctx, batchID, instructions)
 }
 
 // CreateBonusPayments mocks base method.
-func (m *MockAPI) CreateBonusPayments(payload client.CreateBonusPaymentsPayload) (*client.CreateBonusPaymentsResponse, error) {
+func (m *MockAPI) CreateBonusPayments(ctx context.Context, payload client.CreateBonusPaymentsPayload) (*client.CreateBonusPaymentsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBonusPayments", payload)
+	ret := m.ctrl.Call(m, "CreateBonusPayments", ctx, payload)
 	ret0, _ := ret[0].(*client.CreateBonusPaymentsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBonusPayments indicates an expected call of CreateBonusPayments.
-func (mr *MockAPIMockRecorder) CreateBonusPayments(payload interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateBonusPayments(ctx, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBonusPayments", reflect.TypeOf((*MockAPI)(nil).CreateBonusPayments), payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBonusPayments", reflect.TypeOf((*MockAPI)(nil).CreateBonusPayments), ctx, payload)
 }
 
 // CreateCredentialPool mocks base method.
-func (m *MockAPI) CreateCredentialPool(credentials, workspaceID string) (*client.CredentialPoolResponse, error) {
+func (m *MockAPI) CreateCredentialPool(ctx context.Context, credentials, workspaceID string) (*client.CredentialPoolResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCredentialPool", credentials, workspaceID)
+	ret := m.ctrl.Call(m, "CreateCredentialPool", ctx, credentials, workspaceID)
 	ret0, _ := ret[0].(*client.CredentialPoolResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateCredentialPool indicates an expected call of CreateCredentialPool.
-func (mr *MockAPIMockRecorder) CreateCredentialPool(credentials, workspaceID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateCredentialPool(ctx, credentials, workspaceID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCredentialPool", reflect.TypeOf((*MockAPI)(nil).CreateCredentialPool), credentials, workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCredentialPool", reflect.TypeOf((*MockAPI)(nil).CreateCredentialPool), ctx, credentials, workspaceID)
 }
 
 // CreateFilterSet mocks base method.
-func (m *MockAPI) CreateFilterSet(filterSet model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
+func (m *MockAPI) CreateFilterSet(ctx context.Context, filterSet model.CreateFilterSet) (*client.CreateFilterSetResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFilterSet", filterSet)
+	ret := m.ctrl.Call(m, "CreateFilterSet", ctx, filterSet)
 	ret0, _ := ret[0].(*client.CreateFilterSetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateFilterSet indicates an expected call of CreateFilterSet.
-func (mr *MockAPIMockRecorder) CreateFilterSet(filterSet interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateFilterSet(ctx, filterSet interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFilterSet", reflect.TypeOf((*MockAPI)(nil).CreateFilterSet), filterSet)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFilterSet", reflect.TypeOf((*MockAPI)(nil).CreateFilterSet), ctx, filterSet)
 }
 
 // CreateHookSecret mocks base method.
-func (m *MockAPI) CreateHookSecret(payload client.CreateSecretPayload) (*model.Secret, error) {
+func (m *MockAPI) CreateHookSecret(ctx context.Context, payload client.CreateSecretPayload) (*model.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateHookSecret", payload)
+	ret := m.ctrl.Call(m, "CreateHookSecret", ctx, payload)
 	ret0, _ := ret[0].(*model.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateHookSecret indicates an expected call of CreateHookSecret.
-func (mr *MockAPIMockRecorder) CreateHookSecret(payload interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateHookSecret(ctx, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHookSecret", reflect.TypeOf((*MockAPI)(nil).CreateHookSecret), payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHookSecret", reflect.TypeOf((*MockAPI)(nil).CreateHookSecret), ctx, payload)
 }
 
 // CreateHookSubscription mocks base method.
-func (m *MockAPI) CreateHookSubscription(payload client.CreateHookPayload) (*model.Hook, string, error) {
+func (m *MockAPI) CreateHookSubscription(ctx context.Context, payload client.CreateHookPayload) (*model.Hook, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateHookSubscription", payload)
+	ret := m.ctrl.Call(m, "CreateHookSubscription", ctx, payload)
 	ret0, _ := ret[0].(*model.Hook)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -209,1125 +210,1125 @@ func (m *MockAPI) CreateHookSubscription(payload client.CreateHookPayload) (*mod
 }
 
 // CreateHookSubscription indicates an expected call of CreateHookSubscription.
-func (mr *MockAPIMockRecorder) CreateHookSubscription(payload interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateHookSubscription(ctx, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHookSubscription", reflect.TypeOf((*MockAPI)(nil).CreateHookSubscription), payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHookSubscription", reflect.TypeOf((*MockAPI)(nil).CreateHookSubscription), ctx, payload)
 }
 
 // CreateInvitation mocks base method.
-func (m *MockAPI) CreateInvitation(invitation model.CreateInvitation) (*client.CreateInvitationResponse, error) {
+func (m *MockAPI) CreateInvitation(ctx context.Context, invitation model.CreateInvitation) (*client.CreateInvitationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInvitation", invitation)
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, invitation)
 	ret0, _ := ret[0].(*client.CreateInvitationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateInvitation indicates an expected call of CreateInvitation.
-func (mr *MockAPIMockRecorder) CreateInvitation(invitation interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateInvitation(ctx, invitation interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockAPI)(nil).CreateInvitation), invitation)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockAPI)(nil).CreateInvitation), ctx, invitation)
 }
 
 // CreateParticipantGroup mocks base method.
-func (m *MockAPI) CreateParticipantGroup(group model.CreateParticipantGroup) (*client.CreateParticipantGroupResponse, error) {
+func (m *MockAPI) CreateParticipantGroup(ctx context.Context, group model.CreateParticipantGroup) (*client.CreateParticipantGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateParticipantGroup", group)
+	ret := m.ctrl.Call(m, "CreateParticipantGroup", ctx, group)
 	ret0, _ := ret[0].(*client.CreateParticipantGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateParticipantGroup indicates an expected call of CreateParticipantGroup.
-func (mr *MockAPIMockRecorder) CreateParticipantGroup(group interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateParticipantGroup(ctx, group interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateParticipantGroup", reflect.TypeOf((*MockAPI)(nil).CreateParticipantGroup), group)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateParticipantGroup", reflect.TypeOf((*MockAPI)(nil).CreateParticipantGroup), ctx, group)
 }
 
 // CreateProject mocks base method.
-func (m *MockAPI) CreateProject(workspaceID string, project model.Project) (*client.CreateProjectResponse, error) {
+func (m *MockAPI) CreateProject(ctx context.Context, workspaceID string, project model.Project) (*client.CreateProjectResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateProject", workspaceID, project)
+	ret := m.ctrl.Call(m, "CreateProject", ctx, workspaceID, project)
 	ret0, _ := ret[0].(*client.CreateProjectResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateProject indicates an expected call of CreateProject.
-func (mr *MockAPIMockRecorder) CreateProject(workspaceID, project interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateProject(ctx, workspaceID, project interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockAPI)(nil).CreateProject), workspaceID, project)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockAPI)(nil).CreateProject), ctx, workspaceID, project)
 }
 
 // CreateStudy mocks base method.
-func (m *MockAPI) CreateStudy(arg0 model.CreateStudy) (*model.Study, error) {
+func (m *MockAPI) CreateStudy(ctx context.Context, study model.CreateStudy) (*model.Study, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateStudy", arg0)
+	ret := m.ctrl.Call(m, "CreateStudy", ctx, study)
 	ret0, _ := ret[0].(*model.Study)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateStudy indicates an expected call of CreateStudy.
-func (mr *MockAPIMockRecorder) CreateStudy(arg0 interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateStudy(ctx, study interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStudy", reflect.TypeOf((*MockAPI)(nil).CreateStudy), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStudy", reflect.TypeOf((*MockAPI)(nil).CreateStudy), ctx, study)
 }
 
 // CreateSurvey mocks base method.
-func (m *MockAPI) CreateSurvey(survey model.CreateSurvey) (*client.CreateSurveyResponse, error) {
+func (m *MockAPI) CreateSurvey(ctx context.Context, survey model.CreateSurvey) (*client.CreateSurveyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSurvey", survey)
+	ret := m.ctrl.Call(m, "CreateSurvey", ctx, survey)
 	ret0, _ := ret[0].(*client.CreateSurveyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSurvey indicates an expected call of CreateSurvey.
-func (mr *MockAPIMockRecorder) CreateSurvey(survey interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateSurvey(ctx, survey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSurvey", reflect.TypeOf((*MockAPI)(nil).CreateSurvey), survey)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSurvey", reflect.TypeOf((*MockAPI)(nil).CreateSurvey), ctx, survey)
 }
 
 // CreateSurveyResponse mocks base method.
-func (m *MockAPI) CreateSurveyResponse(surveyID string, response model.CreateSurveyResponseRequest) (*client.CreateSurveyResponseResponse, error) {
+func (m *MockAPI) CreateSurveyResponse(ctx context.Context, surveyID string, response model.CreateSurveyResponseRequest) (*client.CreateSurveyResponseResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSurveyResponse", surveyID, response)
+	ret := m.ctrl.Call(m, "CreateSurveyResponse", ctx, surveyID, response)
 	ret0, _ := ret[0].(*client.CreateSurveyResponseResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSurveyResponse indicates an expected call of CreateSurveyResponse.
-func (mr *MockAPIMockRecorder) CreateSurveyResponse(surveyID, response interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateSurveyResponse(ctx, surveyID, response interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSurveyResponse", reflect.TypeOf((*MockAPI)(nil).CreateSurveyResponse), surveyID, response)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSurveyResponse", reflect.TypeOf((*MockAPI)(nil).CreateSurveyResponse), ctx, surveyID, response)
 }
 
 // CreateTestParticipant mocks base method.
-func (m *MockAPI) CreateTestParticipant(email string) (*client.CreateTestParticipantResponse, error) {
+func (m *MockAPI) CreateTestParticipant(ctx context.Context, email string) (*client.CreateTestParticipantResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTestParticipant", email)
+	ret := m.ctrl.Call(m, "CreateTestParticipant", ctx, email)
 	ret0, _ := ret[0].(*client.CreateTestParticipantResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTestParticipant indicates an expected call of CreateTestParticipant.
-func (mr *MockAPIMockRecorder) CreateTestParticipant(email interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateTestParticipant(ctx, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTestParticipant", reflect.TypeOf((*MockAPI)(nil).CreateTestParticipant), email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTestParticipant", reflect.TypeOf((*MockAPI)(nil).CreateTestParticipant), ctx, email)
 }
 
 // CreateWorkspace mocks base method.
-func (m *MockAPI) CreateWorkspace(workspace model.Workspace) (*client.CreateWorkspacesResponse, error) {
+func (m *MockAPI) CreateWorkspace(ctx context.Context, workspace model.Workspace) (*client.CreateWorkspacesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWorkspace", workspace)
+	ret := m.ctrl.Call(m, "CreateWorkspace", ctx, workspace)
 	ret0, _ := ret[0].(*client.CreateWorkspacesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateWorkspace indicates an expected call of CreateWorkspace.
-func (mr *MockAPIMockRecorder) CreateWorkspace(workspace interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) CreateWorkspace(ctx, workspace interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockAPI)(nil).CreateWorkspace), workspace)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockAPI)(nil).CreateWorkspace), ctx, workspace)
 }
 
 // DeleteAllSurveyResponses mocks base method.
-func (m *MockAPI) DeleteAllSurveyResponses(surveyID string) error {
+func (m *MockAPI) DeleteAllSurveyResponses(ctx context.Context, surveyID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAllSurveyResponses", surveyID)
+	ret := m.ctrl.Call(m, "DeleteAllSurveyResponses", ctx, surveyID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteAllSurveyResponses indicates an expected call of DeleteAllSurveyResponses.
-func (mr *MockAPIMockRecorder) DeleteAllSurveyResponses(surveyID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) DeleteAllSurveyResponses(ctx, surveyID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllSurveyResponses", reflect.TypeOf((*MockAPI)(nil).DeleteAllSurveyResponses), surveyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllSurveyResponses", reflect.TypeOf((*MockAPI)(nil).DeleteAllSurveyResponses), ctx, surveyID)
 }
 
 // DeleteHookSubscription mocks base method.
-func (m *MockAPI) DeleteHookSubscription(subscriptionID string) error {
+func (m *MockAPI) DeleteHookSubscription(ctx context.Context, subscriptionID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteHookSubscription", subscriptionID)
+	ret := m.ctrl.Call(m, "DeleteHookSubscription", ctx, subscriptionID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteHookSubscription indicates an expected call of DeleteHookSubscription.
-func (mr *MockAPIMockRecorder) DeleteHookSubscription(subscriptionID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) DeleteHookSubscription(ctx, subscriptionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHookSubscription", reflect.TypeOf((*MockAPI)(nil).DeleteHookSubscription), subscriptionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHookSubscription", reflect.TypeOf((*MockAPI)(nil).DeleteHookSubscription), ctx, subscriptionID)
 }
 
 // DeleteSurvey mocks base method.
-func (m *MockAPI) DeleteSurvey(ID string) error {
+func (m *MockAPI) DeleteSurvey(ctx context.Context, ID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSurvey", ID)
+	ret := m.ctrl.Call(m, "DeleteSurvey", ctx, ID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteSurvey indicates an expected call of DeleteSurvey.
-func (mr *MockAPIMockRecorder) DeleteSurvey(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) DeleteSurvey(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSurvey", reflect.TypeOf((*MockAPI)(nil).DeleteSurvey), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSurvey", reflect.TypeOf((*MockAPI)(nil).DeleteSurvey), ctx, ID)
 }
 
 // DeleteSurveyResponse mocks base method.
-func (m *MockAPI) DeleteSurveyResponse(surveyID, responseID string) error {
+func (m *MockAPI) DeleteSurveyResponse(ctx context.Context, surveyID, responseID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSurveyResponse", surveyID, responseID)
+	ret := m.ctrl.Call(m, "DeleteSurveyResponse", ctx, surveyID, responseID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteSurveyResponse indicates an expected call of DeleteSurveyResponse.
-func (mr *MockAPIMockRecorder) DeleteSurveyResponse(surveyID, responseID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) DeleteSurveyResponse(ctx, surveyID, responseID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSurveyResponse", reflect.TypeOf((*MockAPI)(nil).DeleteSurveyResponse), surveyID, responseID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSurveyResponse", reflect.TypeOf((*MockAPI)(nil).DeleteSurveyResponse), ctx, surveyID, responseID)
 }
 
 // DuplicateStudy mocks base method.
-func (m *MockAPI) DuplicateStudy(ID string) (*model.Study, error) {
+func (m *MockAPI) DuplicateStudy(ctx context.Context, ID string) (*model.Study, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DuplicateStudy", ID)
+	ret := m.ctrl.Call(m, "DuplicateStudy", ctx, ID)
 	ret0, _ := ret[0].(*model.Study)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DuplicateStudy indicates an expected call of DuplicateStudy.
-func (mr *MockAPIMockRecorder) DuplicateStudy(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) DuplicateStudy(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateStudy", reflect.TypeOf((*MockAPI)(nil).DuplicateStudy), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateStudy", reflect.TypeOf((*MockAPI)(nil).DuplicateStudy), ctx, ID)
 }
 
 // ExportDemographics mocks base method.
-func (m *MockAPI) ExportDemographics(ID string) (string, error) {
+func (m *MockAPI) ExportDemographics(ctx context.Context, ID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExportDemographics", ID)
+	ret := m.ctrl.Call(m, "ExportDemographics", ctx, ID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExportDemographics indicates an expected call of ExportDemographics.
-func (mr *MockAPIMockRecorder) ExportDemographics(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) ExportDemographics(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportDemographics", reflect.TypeOf((*MockAPI)(nil).ExportDemographics), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportDemographics", reflect.TypeOf((*MockAPI)(nil).ExportDemographics), ctx, ID)
 }
 
 // GetAITaskBuilderBatch mocks base method.
-func (m *MockAPI) GetAITaskBuilderBatch(batchID string) (*client.GetAITaskBuilderBatchResponse, error) {
+func (m *MockAPI) GetAITaskBuilderBatch(ctx context.Context, batchID string) (*client.GetAITaskBuilderBatchResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderBatch", batchID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderBatch", ctx, batchID)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderBatchResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderBatch indicates an expected call of GetAITaskBuilderBatch.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderBatch(batchID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderBatch(ctx, batchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderBatch), batchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderBatch), ctx, batchID)
 }
 
 // GetAITaskBuilderBatchStatus mocks base method.
-func (m *MockAPI) GetAITaskBuilderBatchStatus(batchID string) (*client.GetAITaskBuilderBatchStatusResponse, error) {
+func (m *MockAPI) GetAITaskBuilderBatchStatus(ctx context.Context, batchID string) (*client.GetAITaskBuilderBatchStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderBatchStatus", batchID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderBatchStatus", ctx, batchID)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderBatchStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderBatchStatus indicates an expected call of GetAITaskBuilderBatchStatus.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderBatchStatus(batchID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderBatchStatus(ctx, batchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderBatchStatus", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderBatchStatus), batchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderBatchStatus", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderBatchStatus), ctx, batchID)
 }
 
 // GetAITaskBuilderBatchSyncStatus mocks base method.
-func (m *MockAPI) GetAITaskBuilderBatchSyncStatus(batchID, syncID string) (*client.AITaskBuilderBatchSyncResponse, error) {
+func (m *MockAPI) GetAITaskBuilderBatchSyncStatus(ctx context.Context, batchID, syncID string) (*client.AITaskBuilderBatchSyncResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderBatchSyncStatus", batchID, syncID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderBatchSyncStatus", ctx, batchID, syncID)
 	ret0, _ := ret[0].(*client.AITaskBuilderBatchSyncResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderBatchSyncStatus indicates an expected call of GetAITaskBuilderBatchSyncStatus.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderBatchSyncStatus(batchID, syncID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderBatchSyncStatus(ctx, batchID, syncID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderBatchSyncStatus", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderBatchSyncStatus), batchID, syncID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderBatchSyncStatus", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderBatchSyncStatus), ctx, batchID, syncID)
 }
 
 // GetAITaskBuilderBatches mocks base method.
-func (m *MockAPI) GetAITaskBuilderBatches(workspaceID string) (*client.GetAITaskBuilderBatchesResponse, error) {
+func (m *MockAPI) GetAITaskBuilderBatches(ctx context.Context, workspaceID string, limit, offset int) (*client.GetAITaskBuilderBatchesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderBatches", workspaceID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderBatches", ctx, workspaceID, limit, offset)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderBatchesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderBatches indicates an expected call of GetAITaskBuilderBatches.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderBatches(workspaceID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderBatches(ctx, workspaceID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderBatches", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderBatches), workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderBatches", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderBatches), ctx, workspaceID, limit, offset)
 }
 
 // GetAITaskBuilderDataset mocks base method.
-func (m *MockAPI) GetAITaskBuilderDataset(datasetID string) (*client.GetAITaskBuilderDatasetResponse, error) {
+func (m *MockAPI) GetAITaskBuilderDataset(ctx context.Context, datasetID string) (*client.GetAITaskBuilderDatasetResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderDataset", datasetID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderDataset", ctx, datasetID)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderDatasetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderDataset indicates an expected call of GetAITaskBuilderDataset.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderDataset(datasetID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderDataset(ctx, datasetID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderDataset", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderDataset), datasetID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderDataset", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderDataset), ctx, datasetID)
 }
 
 // GetAITaskBuilderDatasetImportStatus mocks base method.
-func (m *MockAPI) GetAITaskBuilderDatasetImportStatus(datasetID, importID string) (*client.GetAITaskBuilderDatasetImportStatusResponse, error) {
+func (m *MockAPI) GetAITaskBuilderDatasetImportStatus(ctx context.Context, datasetID, importID string) (*client.GetAITaskBuilderDatasetImportStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderDatasetImportStatus", datasetID, importID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderDatasetImportStatus", ctx, datasetID, importID)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderDatasetImportStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderDatasetImportStatus indicates an expected call of GetAITaskBuilderDatasetImportStatus.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderDatasetImportStatus(datasetID, importID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderDatasetImportStatus(ctx, datasetID, importID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderDatasetImportStatus", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderDatasetImportStatus), datasetID, importID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderDatasetImportStatus", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderDatasetImportStatus), ctx, datasetID, importID)
 }
 
 // GetAITaskBuilderDatasetStatus mocks base method.
-func (m *MockAPI) GetAITaskBuilderDatasetStatus(datasetID string) (*client.GetAITaskBuilderDatasetStatusResponse, error) {
+func (m *MockAPI) GetAITaskBuilderDatasetStatus(ctx context.Context, datasetID string) (*client.GetAITaskBuilderDatasetStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderDatasetStatus", datasetID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderDatasetStatus", ctx, datasetID)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderDatasetStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderDatasetStatus indicates an expected call of GetAITaskBuilderDatasetStatus.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderDatasetStatus(datasetID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderDatasetStatus(ctx, datasetID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderDatasetStatus", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderDatasetStatus), datasetID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderDatasetStatus", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderDatasetStatus), ctx, datasetID)
 }
 
 // GetAITaskBuilderDatasetUploadURL mocks base method.
-func (m *MockAPI) GetAITaskBuilderDatasetUploadURL(datasetID, fileName string) (*client.GetAITaskBuilderDatasetUploadURLResponse, error) {
+func (m *MockAPI) GetAITaskBuilderDatasetUploadURL(ctx context.Context, datasetID, fileName string) (*client.GetAITaskBuilderDatasetUploadURLResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderDatasetUploadURL", datasetID, fileName)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderDatasetUploadURL", ctx, datasetID, fileName)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderDatasetUploadURLResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderDatasetUploadURL indicates an expected call of GetAITaskBuilderDatasetUploadURL.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderDatasetUploadURL(datasetID, fileName interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderDatasetUploadURL(ctx, datasetID, fileName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderDatasetUploadURL", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderDatasetUploadURL), datasetID, fileName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderDatasetUploadURL", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderDatasetUploadURL), ctx, datasetID, fileName)
 }
 
 // GetAITaskBuilderResponses mocks base method.
-func (m *MockAPI) GetAITaskBuilderResponses(batchID string) (*client.GetAITaskBuilderResponsesResponse, error) {
+func (m *MockAPI) GetAITaskBuilderResponses(ctx context.Context, batchID string) (*client.GetAITaskBuilderResponsesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderResponses", batchID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderResponses", ctx, batchID)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderResponsesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderResponses indicates an expected call of GetAITaskBuilderResponses.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderResponses(batchID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderResponses(ctx, batchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderResponses", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderResponses), batchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderResponses", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderResponses), ctx, batchID)
 }
 
 // GetAITaskBuilderTaskGroups mocks base method.
-func (m *MockAPI) GetAITaskBuilderTaskGroups(batchID string) (*client.GetAITaskBuilderTaskGroupsResponse, error) {
+func (m *MockAPI) GetAITaskBuilderTaskGroups(ctx context.Context, batchID string) (*client.GetAITaskBuilderTaskGroupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderTaskGroups", batchID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderTaskGroups", ctx, batchID)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderTaskGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderTaskGroups indicates an expected call of GetAITaskBuilderTaskGroups.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderTaskGroups(batchID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderTaskGroups(ctx, batchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderTaskGroups", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderTaskGroups), batchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderTaskGroups", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderTaskGroups), ctx, batchID)
 }
 
 // GetAITaskBuilderTasks mocks base method.
-func (m *MockAPI) GetAITaskBuilderTasks(batchID string) (*client.GetAITaskBuilderTasksResponse, error) {
+func (m *MockAPI) GetAITaskBuilderTasks(ctx context.Context, batchID string) (*client.GetAITaskBuilderTasksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAITaskBuilderTasks", batchID)
+	ret := m.ctrl.Call(m, "GetAITaskBuilderTasks", ctx, batchID)
 	ret0, _ := ret[0].(*client.GetAITaskBuilderTasksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAITaskBuilderTasks indicates an expected call of GetAITaskBuilderTasks.
-func (mr *MockAPIMockRecorder) GetAITaskBuilderTasks(batchID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetAITaskBuilderTasks(ctx, batchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderTasks", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderTasks), batchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAITaskBuilderTasks", reflect.TypeOf((*MockAPI)(nil).GetAITaskBuilderTasks), ctx, batchID)
 }
 
 // GetBatchExportStatus mocks base method.
-func (m *MockAPI) GetBatchExportStatus(batchID, exportID string) (*client.BatchExportResponse, error) {
+func (m *MockAPI) GetBatchExportStatus(ctx context.Context, batchID, exportID string) (*client.BatchExportResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBatchExportStatus", batchID, exportID)
+	ret := m.ctrl.Call(m, "GetBatchExportStatus", ctx, batchID, exportID)
 	ret0, _ := ret[0].(*client.BatchExportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetBatchExportStatus indicates an expected call of GetBatchExportStatus.
-func (mr *MockAPIMockRecorder) GetBatchExportStatus(batchID, exportID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetBatchExportStatus(ctx, batchID, exportID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchExportStatus", reflect.TypeOf((*MockAPI)(nil).GetBatchExportStatus), batchID, exportID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBatchExportStatus", reflect.TypeOf((*MockAPI)(nil).GetBatchExportStatus), ctx, batchID, exportID)
 }
 
 // GetCampaigns mocks base method.
-func (m *MockAPI) GetCampaigns(workspaceID string, limit, offset int) (*client.ListCampaignsResponse, error) {
+func (m *MockAPI) GetCampaigns(ctx context.Context, workspaceID string, limit, offset int) (*client.ListCampaignsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCampaigns", workspaceID, limit, offset)
+	ret := m.ctrl.Call(m, "GetCampaigns", ctx, workspaceID, limit, offset)
 	ret0, _ := ret[0].(*client.ListCampaignsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCampaigns indicates an expected call of GetCampaigns.
-func (mr *MockAPIMockRecorder) GetCampaigns(workspaceID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetCampaigns(ctx, workspaceID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaigns", reflect.TypeOf((*MockAPI)(nil).GetCampaigns), workspaceID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaigns", reflect.TypeOf((*MockAPI)(nil).GetCampaigns), ctx, workspaceID, limit, offset)
 }
 
 // GetCollection mocks base method.
-func (m *MockAPI) GetCollection(ID string) (*model.Collection, error) {
+func (m *MockAPI) GetCollection(ctx context.Context, ID string) (*model.Collection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCollection", ID)
+	ret := m.ctrl.Call(m, "GetCollection", ctx, ID)
 	ret0, _ := ret[0].(*model.Collection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCollection indicates an expected call of GetCollection.
-func (mr *MockAPIMockRecorder) GetCollection(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetCollection(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockAPI)(nil).GetCollection), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockAPI)(nil).GetCollection), ctx, ID)
 }
 
 // GetCollectionExportStatus mocks base method.
-func (m *MockAPI) GetCollectionExportStatus(collectionID, exportID string) (*client.CollectionExportResponse, error) {
+func (m *MockAPI) GetCollectionExportStatus(ctx context.Context, collectionID, exportID string) (*client.CollectionExportResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCollectionExportStatus", collectionID, exportID)
+	ret := m.ctrl.Call(m, "GetCollectionExportStatus", ctx, collectionID, exportID)
 	ret0, _ := ret[0].(*client.CollectionExportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCollectionExportStatus indicates an expected call of GetCollectionExportStatus.
-func (mr *MockAPIMockRecorder) GetCollectionExportStatus(collectionID, exportID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetCollectionExportStatus(ctx, collectionID, exportID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionExportStatus", reflect.TypeOf((*MockAPI)(nil).GetCollectionExportStatus), collectionID, exportID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollectionExportStatus", reflect.TypeOf((*MockAPI)(nil).GetCollectionExportStatus), ctx, collectionID, exportID)
 }
 
 // GetCollections mocks base method.
-func (m *MockAPI) GetCollections(workspaceID string, limit, offset int) (*client.ListCollectionsResponse, error) {
+func (m *MockAPI) GetCollections(ctx context.Context, workspaceID string, limit, offset int) (*client.ListCollectionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCollections", workspaceID, limit, offset)
+	ret := m.ctrl.Call(m, "GetCollections", ctx, workspaceID, limit, offset)
 	ret0, _ := ret[0].(*client.ListCollectionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCollections indicates an expected call of GetCollections.
-func (mr *MockAPIMockRecorder) GetCollections(workspaceID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetCollections(ctx, workspaceID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollections", reflect.TypeOf((*MockAPI)(nil).GetCollections), workspaceID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollections", reflect.TypeOf((*MockAPI)(nil).GetCollections), ctx, workspaceID, limit, offset)
 }
 
 // GetEvents mocks base method.
-func (m *MockAPI) GetEvents(subscriptionID string, limit, offset int) (*client.ListHookEventsResponse, error) {
+func (m *MockAPI) GetEvents(ctx context.Context, subscriptionID string, limit, offset int) (*client.ListHookEventsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEvents", subscriptionID, limit, offset)
+	ret := m.ctrl.Call(m, "GetEvents", ctx, subscriptionID, limit, offset)
 	ret0, _ := ret[0].(*client.ListHookEventsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEvents indicates an expected call of GetEvents.
-func (mr *MockAPIMockRecorder) GetEvents(subscriptionID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetEvents(ctx, subscriptionID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockAPI)(nil).GetEvents), subscriptionID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockAPI)(nil).GetEvents), ctx, subscriptionID, limit, offset)
 }
 
 // GetFilterSet mocks base method.
-func (m *MockAPI) GetFilterSet(ID string) (*model.FilterSet, error) {
+func (m *MockAPI) GetFilterSet(ctx context.Context, ID string) (*model.FilterSet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilterSet", ID)
+	ret := m.ctrl.Call(m, "GetFilterSet", ctx, ID)
 	ret0, _ := ret[0].(*model.FilterSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFilterSet indicates an expected call of GetFilterSet.
-func (mr *MockAPIMockRecorder) GetFilterSet(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetFilterSet(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilterSet", reflect.TypeOf((*MockAPI)(nil).GetFilterSet), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilterSet", reflect.TypeOf((*MockAPI)(nil).GetFilterSet), ctx, ID)
 }
 
 // GetFilterSets mocks base method.
-func (m *MockAPI) GetFilterSets(workspaceID string, limit, offset int) (*client.ListFilterSetsResponse, error) {
+func (m *MockAPI) GetFilterSets(ctx context.Context, workspaceID string, limit, offset int) (*client.ListFilterSetsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilterSets", workspaceID, limit, offset)
+	ret := m.ctrl.Call(m, "GetFilterSets", ctx, workspaceID, limit, offset)
 	ret0, _ := ret[0].(*client.ListFilterSetsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFilterSets indicates an expected call of GetFilterSets.
-func (mr *MockAPIMockRecorder) GetFilterSets(workspaceID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetFilterSets(ctx, workspaceID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilterSets", reflect.TypeOf((*MockAPI)(nil).GetFilterSets), workspaceID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilterSets", reflect.TypeOf((*MockAPI)(nil).GetFilterSets), ctx, workspaceID, limit, offset)
 }
 
 // GetFilters mocks base method.
-func (m *MockAPI) GetFilters() (*client.ListFiltersResponse, error) {
+func (m *MockAPI) GetFilters(ctx context.Context) (*client.ListFiltersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilters")
+	ret := m.ctrl.Call(m, "GetFilters", ctx)
 	ret0, _ := ret[0].(*client.ListFiltersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFilters indicates an expected call of GetFilters.
-func (mr *MockAPIMockRecorder) GetFilters() *gomock.Call {
+func (mr *MockAPIMockRecorder) GetFilters(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilters", reflect.TypeOf((*MockAPI)(nil).GetFilters))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilters", reflect.TypeOf((*MockAPI)(nil).GetFilters), ctx)
 }
 
 // GetHookEventTypes mocks base method.
-func (m *MockAPI) GetHookEventTypes() (*client.ListHookEventTypesResponse, error) {
+func (m *MockAPI) GetHookEventTypes(ctx context.Context) (*client.ListHookEventTypesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHookEventTypes")
+	ret := m.ctrl.Call(m, "GetHookEventTypes", ctx)
 	ret0, _ := ret[0].(*client.ListHookEventTypesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHookEventTypes indicates an expected call of GetHookEventTypes.
-func (mr *MockAPIMockRecorder) GetHookEventTypes() *gomock.Call {
+func (mr *MockAPIMockRecorder) GetHookEventTypes(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHookEventTypes", reflect.TypeOf((*MockAPI)(nil).GetHookEventTypes))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHookEventTypes", reflect.TypeOf((*MockAPI)(nil).GetHookEventTypes), ctx)
 }
 
 // GetHookSecrets mocks base method.
-func (m *MockAPI) GetHookSecrets(workspaceID string) (*client.ListSecretsResponse, error) {
+func (m *MockAPI) GetHookSecrets(ctx context.Context, workspaceID string) (*client.ListSecretsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHookSecrets", workspaceID)
+	ret := m.ctrl.Call(m, "GetHookSecrets", ctx, workspaceID)
 	ret0, _ := ret[0].(*client.ListSecretsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHookSecrets indicates an expected call of GetHookSecrets.
-func (mr *MockAPIMockRecorder) GetHookSecrets(workspaceID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetHookSecrets(ctx, workspaceID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHookSecrets", reflect.TypeOf((*MockAPI)(nil).GetHookSecrets), workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHookSecrets", reflect.TypeOf((*MockAPI)(nil).GetHookSecrets), ctx, workspaceID)
 }
 
 // GetHooks mocks base method.
-func (m *MockAPI) GetHooks(workspaceID string, enabled bool, limit, offset int) (*client.ListHooksResponse, error) {
+func (m *MockAPI) GetHooks(ctx context.Context, workspaceID string, enabled bool, limit, offset int) (*client.ListHooksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHooks", workspaceID, enabled, limit, offset)
+	ret := m.ctrl.Call(m, "GetHooks", ctx, workspaceID, enabled, limit, offset)
 	ret0, _ := ret[0].(*client.ListHooksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHooks indicates an expected call of GetHooks.
-func (mr *MockAPIMockRecorder) GetHooks(workspaceID, enabled, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetHooks(ctx, workspaceID, enabled, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHooks", reflect.TypeOf((*MockAPI)(nil).GetHooks), workspaceID, enabled, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHooks", reflect.TypeOf((*MockAPI)(nil).GetHooks), ctx, workspaceID, enabled, limit, offset)
 }
 
 // GetMe mocks base method.
-func (m *MockAPI) GetMe() (*client.MeResponse, error) {
+func (m *MockAPI) GetMe(ctx context.Context) (*client.MeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMe")
+	ret := m.ctrl.Call(m, "GetMe", ctx)
 	ret0, _ := ret[0].(*client.MeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMe indicates an expected call of GetMe.
-func (mr *MockAPIMockRecorder) GetMe() *gomock.Call {
+func (mr *MockAPIMockRecorder) GetMe(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMe", reflect.TypeOf((*MockAPI)(nil).GetMe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMe", reflect.TypeOf((*MockAPI)(nil).GetMe), ctx)
 }
 
 // GetMessages mocks base method.
-func (m *MockAPI) GetMessages(userID, createdAfter *string) (*client.ListMessagesResponse, error) {
+func (m *MockAPI) GetMessages(ctx context.Context, userID, createdAfter *string) (*client.ListMessagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMessages", userID, createdAfter)
+	ret := m.ctrl.Call(m, "GetMessages", ctx, userID, createdAfter)
 	ret0, _ := ret[0].(*client.ListMessagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMessages indicates an expected call of GetMessages.
-func (mr *MockAPIMockRecorder) GetMessages(userID, createdAfter interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetMessages(ctx, userID, createdAfter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockAPI)(nil).GetMessages), userID, createdAfter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockAPI)(nil).GetMessages), ctx, userID, createdAfter)
 }
 
 // GetParticipantGroup mocks base method.
-func (m *MockAPI) GetParticipantGroup(groupID string) (*client.ViewParticipantGroupResponse, error) {
+func (m *MockAPI) GetParticipantGroup(ctx context.Context, groupID string) (*client.ViewParticipantGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetParticipantGroup", groupID)
+	ret := m.ctrl.Call(m, "GetParticipantGroup", ctx, groupID)
 	ret0, _ := ret[0].(*client.ViewParticipantGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetParticipantGroup indicates an expected call of GetParticipantGroup.
-func (mr *MockAPIMockRecorder) GetParticipantGroup(groupID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetParticipantGroup(ctx, groupID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipantGroup", reflect.TypeOf((*MockAPI)(nil).GetParticipantGroup), groupID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipantGroup", reflect.TypeOf((*MockAPI)(nil).GetParticipantGroup), ctx, groupID)
 }
 
 // GetParticipantGroups mocks base method.
-func (m *MockAPI) GetParticipantGroups(workspaceID string, limit, offset int) (*client.ListParticipantGroupsResponse, error) {
+func (m *MockAPI) GetParticipantGroups(ctx context.Context, workspaceID string, limit, offset int) (*client.ListParticipantGroupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetParticipantGroups", workspaceID, limit, offset)
+	ret := m.ctrl.Call(m, "GetParticipantGroups", ctx, workspaceID, limit, offset)
 	ret0, _ := ret[0].(*client.ListParticipantGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetParticipantGroups indicates an expected call of GetParticipantGroups.
-func (mr *MockAPIMockRecorder) GetParticipantGroups(workspaceID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetParticipantGroups(ctx, workspaceID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipantGroups", reflect.TypeOf((*MockAPI)(nil).GetParticipantGroups), workspaceID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipantGroups", reflect.TypeOf((*MockAPI)(nil).GetParticipantGroups), ctx, workspaceID, limit, offset)
 }
 
 // GetProject mocks base method.
-func (m *MockAPI) GetProject(ID string) (*model.Project, error) {
+func (m *MockAPI) GetProject(ctx context.Context, ID string) (*model.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProject", ID)
+	ret := m.ctrl.Call(m, "GetProject", ctx, ID)
 	ret0, _ := ret[0].(*model.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProject indicates an expected call of GetProject.
-func (mr *MockAPIMockRecorder) GetProject(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetProject(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockAPI)(nil).GetProject), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockAPI)(nil).GetProject), ctx, ID)
 }
 
 // GetProjects mocks base method.
-func (m *MockAPI) GetProjects(workspaceID string, limit, offset int) (*client.ListProjectsResponse, error) {
+func (m *MockAPI) GetProjects(ctx context.Context, workspaceID string, limit, offset int) (*client.ListProjectsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjects", workspaceID, limit, offset)
+	ret := m.ctrl.Call(m, "GetProjects", ctx, workspaceID, limit, offset)
 	ret0, _ := ret[0].(*client.ListProjectsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProjects indicates an expected call of GetProjects.
-func (mr *MockAPIMockRecorder) GetProjects(workspaceID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetProjects(ctx, workspaceID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockAPI)(nil).GetProjects), workspaceID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjects", reflect.TypeOf((*MockAPI)(nil).GetProjects), ctx, workspaceID, limit, offset)
 }
 
 // GetRewardRecommendations mocks base method.
-func (m *MockAPI) GetRewardRecommendations(workspaceID, currency string, screenerIDs []string) (*client.RewardRecommendationsResponse, error) {
+func (m *MockAPI) GetRewardRecommendations(ctx context.Context, workspaceID, currency string, screenerIDs []string) (*client.RewardRecommendationsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRewardRecommendations", workspaceID, currency, screenerIDs)
+	ret := m.ctrl.Call(m, "GetRewardRecommendations", ctx, workspaceID, currency, screenerIDs)
 	ret0, _ := ret[0].(*client.RewardRecommendationsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRewardRecommendations indicates an expected call of GetRewardRecommendations.
-func (mr *MockAPIMockRecorder) GetRewardRecommendations(workspaceID, currency, screenerIDs interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetRewardRecommendations(ctx, workspaceID, currency, screenerIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardRecommendations", reflect.TypeOf((*MockAPI)(nil).GetRewardRecommendations), workspaceID, currency, screenerIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardRecommendations", reflect.TypeOf((*MockAPI)(nil).GetRewardRecommendations), ctx, workspaceID, currency, screenerIDs)
 }
 
 // GetStudies mocks base method.
-func (m *MockAPI) GetStudies(status, projectID string) (*client.ListStudiesResponse, error) {
+func (m *MockAPI) GetStudies(ctx context.Context, status, projectID string, limit, offset int) (*client.ListStudiesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStudies", status, projectID)
+	ret := m.ctrl.Call(m, "GetStudies", ctx, status, projectID, limit, offset)
 	ret0, _ := ret[0].(*client.ListStudiesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStudies indicates an expected call of GetStudies.
-func (mr *MockAPIMockRecorder) GetStudies(status, projectID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetStudies(ctx, status, projectID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudies", reflect.TypeOf((*MockAPI)(nil).GetStudies), status, projectID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudies", reflect.TypeOf((*MockAPI)(nil).GetStudies), ctx, status, projectID, limit, offset)
 }
 
 // GetStudy mocks base method.
-func (m *MockAPI) GetStudy(ID string) (*model.Study, error) {
+func (m *MockAPI) GetStudy(ctx context.Context, ID string) (*model.Study, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStudy", ID)
+	ret := m.ctrl.Call(m, "GetStudy", ctx, ID)
 	ret0, _ := ret[0].(*model.Study)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStudy indicates an expected call of GetStudy.
-func (mr *MockAPIMockRecorder) GetStudy(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetStudy(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudy", reflect.TypeOf((*MockAPI)(nil).GetStudy), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudy", reflect.TypeOf((*MockAPI)(nil).GetStudy), ctx, ID)
 }
 
 // GetStudyCredentialsUsageReportCSV mocks base method.
-func (m *MockAPI) GetStudyCredentialsUsageReportCSV(ID string) (string, error) {
+func (m *MockAPI) GetStudyCredentialsUsageReportCSV(ctx context.Context, ID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStudyCredentialsUsageReportCSV", ID)
+	ret := m.ctrl.Call(m, "GetStudyCredentialsUsageReportCSV", ctx, ID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStudyCredentialsUsageReportCSV indicates an expected call of GetStudyCredentialsUsageReportCSV.
-func (mr *MockAPIMockRecorder) GetStudyCredentialsUsageReportCSV(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetStudyCredentialsUsageReportCSV(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudyCredentialsUsageReportCSV", reflect.TypeOf((*MockAPI)(nil).GetStudyCredentialsUsageReportCSV), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudyCredentialsUsageReportCSV", reflect.TypeOf((*MockAPI)(nil).GetStudyCredentialsUsageReportCSV), ctx, ID)
 }
 
 // GetStudySubmissionCounts mocks base method.
-func (m *MockAPI) GetStudySubmissionCounts(ID string) (*model.SubmissionCounts, error) {
+func (m *MockAPI) GetStudySubmissionCounts(ctx context.Context, ID string) (*model.SubmissionCounts, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStudySubmissionCounts", ID)
+	ret := m.ctrl.Call(m, "GetStudySubmissionCounts", ctx, ID)
 	ret0, _ := ret[0].(*model.SubmissionCounts)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStudySubmissionCounts indicates an expected call of GetStudySubmissionCounts.
-func (mr *MockAPIMockRecorder) GetStudySubmissionCounts(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetStudySubmissionCounts(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudySubmissionCounts", reflect.TypeOf((*MockAPI)(nil).GetStudySubmissionCounts), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudySubmissionCounts", reflect.TypeOf((*MockAPI)(nil).GetStudySubmissionCounts), ctx, ID)
 }
 
 // GetSubmissions mocks base method.
-func (m *MockAPI) GetSubmissions(ID string, limit, offset int) (*client.ListSubmissionsResponse, error) {
+func (m *MockAPI) GetSubmissions(ctx context.Context, ID string, limit, offset int) (*client.ListSubmissionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubmissions", ID, limit, offset)
+	ret := m.ctrl.Call(m, "GetSubmissions", ctx, ID, limit, offset)
 	ret0, _ := ret[0].(*client.ListSubmissionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubmissions indicates an expected call of GetSubmissions.
-func (mr *MockAPIMockRecorder) GetSubmissions(ID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetSubmissions(ctx, ID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissions", reflect.TypeOf((*MockAPI)(nil).GetSubmissions), ID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubmissions", reflect.TypeOf((*MockAPI)(nil).GetSubmissions), ctx, ID, limit, offset)
 }
 
 // GetSurvey mocks base method.
-func (m *MockAPI) GetSurvey(ID string) (*model.Survey, error) {
+func (m *MockAPI) GetSurvey(ctx context.Context, ID string) (*model.Survey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSurvey", ID)
+	ret := m.ctrl.Call(m, "GetSurvey", ctx, ID)
 	ret0, _ := ret[0].(*model.Survey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSurvey indicates an expected call of GetSurvey.
-func (mr *MockAPIMockRecorder) GetSurvey(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetSurvey(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurvey", reflect.TypeOf((*MockAPI)(nil).GetSurvey), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurvey", reflect.TypeOf((*MockAPI)(nil).GetSurvey), ctx, ID)
 }
 
 // GetSurveyResponse mocks base method.
-func (m *MockAPI) GetSurveyResponse(surveyID, responseID string) (*model.SurveyResponse, error) {
+func (m *MockAPI) GetSurveyResponse(ctx context.Context, surveyID, responseID string) (*model.SurveyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSurveyResponse", surveyID, responseID)
+	ret := m.ctrl.Call(m, "GetSurveyResponse", ctx, surveyID, responseID)
 	ret0, _ := ret[0].(*model.SurveyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSurveyResponse indicates an expected call of GetSurveyResponse.
-func (mr *MockAPIMockRecorder) GetSurveyResponse(surveyID, responseID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetSurveyResponse(ctx, surveyID, responseID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveyResponse", reflect.TypeOf((*MockAPI)(nil).GetSurveyResponse), surveyID, responseID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveyResponse", reflect.TypeOf((*MockAPI)(nil).GetSurveyResponse), ctx, surveyID, responseID)
 }
 
 // GetSurveyResponseSummary mocks base method.
-func (m *MockAPI) GetSurveyResponseSummary(surveyID string) (*model.SurveySummary, error) {
+func (m *MockAPI) GetSurveyResponseSummary(ctx context.Context, surveyID string) (*model.SurveySummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSurveyResponseSummary", surveyID)
+	ret := m.ctrl.Call(m, "GetSurveyResponseSummary", ctx, surveyID)
 	ret0, _ := ret[0].(*model.SurveySummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSurveyResponseSummary indicates an expected call of GetSurveyResponseSummary.
-func (mr *MockAPIMockRecorder) GetSurveyResponseSummary(surveyID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetSurveyResponseSummary(ctx, surveyID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveyResponseSummary", reflect.TypeOf((*MockAPI)(nil).GetSurveyResponseSummary), surveyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveyResponseSummary", reflect.TypeOf((*MockAPI)(nil).GetSurveyResponseSummary), ctx, surveyID)
 }
 
 // GetSurveyResponses mocks base method.
-func (m *MockAPI) GetSurveyResponses(surveyID string, limit, offset int) (*client.ListSurveyResponsesResponse, error) {
+func (m *MockAPI) GetSurveyResponses(ctx context.Context, surveyID string, limit, offset int) (*client.ListSurveyResponsesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSurveyResponses", surveyID, limit, offset)
+	ret := m.ctrl.Call(m, "GetSurveyResponses", ctx, surveyID, limit, offset)
 	ret0, _ := ret[0].(*client.ListSurveyResponsesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSurveyResponses indicates an expected call of GetSurveyResponses.
-func (mr *MockAPIMockRecorder) GetSurveyResponses(surveyID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetSurveyResponses(ctx, surveyID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveyResponses", reflect.TypeOf((*MockAPI)(nil).GetSurveyResponses), surveyID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveyResponses", reflect.TypeOf((*MockAPI)(nil).GetSurveyResponses), ctx, surveyID, limit, offset)
 }
 
 // GetSurveys mocks base method.
-func (m *MockAPI) GetSurveys(researcherID string, limit, offset int) (*client.ListSurveysResponse, error) {
+func (m *MockAPI) GetSurveys(ctx context.Context, researcherID string, limit, offset int) (*client.ListSurveysResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSurveys", researcherID, limit, offset)
+	ret := m.ctrl.Call(m, "GetSurveys", ctx, researcherID, limit, offset)
 	ret0, _ := ret[0].(*client.ListSurveysResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSurveys indicates an expected call of GetSurveys.
-func (mr *MockAPIMockRecorder) GetSurveys(researcherID, limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetSurveys(ctx, researcherID, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveys", reflect.TypeOf((*MockAPI)(nil).GetSurveys), researcherID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveys", reflect.TypeOf((*MockAPI)(nil).GetSurveys), ctx, researcherID, limit, offset)
 }
 
 // GetUnreadMessages mocks base method.
-func (m *MockAPI) GetUnreadMessages() (*client.ListUnreadMessagesResponse, error) {
+func (m *MockAPI) GetUnreadMessages(ctx context.Context) (*client.ListUnreadMessagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUnreadMessages")
+	ret := m.ctrl.Call(m, "GetUnreadMessages", ctx)
 	ret0, _ := ret[0].(*client.ListUnreadMessagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUnreadMessages indicates an expected call of GetUnreadMessages.
-func (mr *MockAPIMockRecorder) GetUnreadMessages() *gomock.Call {
+func (mr *MockAPIMockRecorder) GetUnreadMessages(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadMessages", reflect.TypeOf((*MockAPI)(nil).GetUnreadMessages))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadMessages", reflect.TypeOf((*MockAPI)(nil).GetUnreadMessages), ctx)
 }
 
 // GetWorkspaceBalance mocks base method.
-func (m *MockAPI) GetWorkspaceBalance(workspaceID string) (*client.WorkspaceBalanceResponse, error) {
+func (m *MockAPI) GetWorkspaceBalance(ctx context.Context, workspaceID string) (*client.WorkspaceBalanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWorkspaceBalance", workspaceID)
+	ret := m.ctrl.Call(m, "GetWorkspaceBalance", ctx, workspaceID)
 	ret0, _ := ret[0].(*client.WorkspaceBalanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetWorkspaceBalance indicates an expected call of GetWorkspaceBalance.
-func (mr *MockAPIMockRecorder) GetWorkspaceBalance(workspaceID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetWorkspaceBalance(ctx, workspaceID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBalance", reflect.TypeOf((*MockAPI)(nil).GetWorkspaceBalance), workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceBalance", reflect.TypeOf((*MockAPI)(nil).GetWorkspaceBalance), ctx, workspaceID)
 }
 
 // GetWorkspaces mocks base method.
-func (m *MockAPI) GetWorkspaces(limit, offset int) (*client.ListWorkspacesResponse, error) {
+func (m *MockAPI) GetWorkspaces(ctx context.Context, limit, offset int) (*client.ListWorkspacesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWorkspaces", limit, offset)
+	ret := m.ctrl.Call(m, "GetWorkspaces", ctx, limit, offset)
 	ret0, _ := ret[0].(*client.ListWorkspacesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetWorkspaces indicates an expected call of GetWorkspaces.
-func (mr *MockAPIMockRecorder) GetWorkspaces(limit, offset interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetWorkspaces(ctx, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaces", reflect.TypeOf((*MockAPI)(nil).GetWorkspaces), limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaces", reflect.TypeOf((*MockAPI)(nil).GetWorkspaces), ctx, limit, offset)
 }
 
 // InitiateBatchExport mocks base method.
-func (m *MockAPI) InitiateBatchExport(batchID string) (*client.BatchExportResponse, error) {
+func (m *MockAPI) InitiateBatchExport(ctx context.Context, batchID string) (*client.BatchExportResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitiateBatchExport", batchID)
+	ret := m.ctrl.Call(m, "InitiateBatchExport", ctx, batchID)
 	ret0, _ := ret[0].(*client.BatchExportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // InitiateBatchExport indicates an expected call of InitiateBatchExport.
-func (mr *MockAPIMockRecorder) InitiateBatchExport(batchID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) InitiateBatchExport(ctx, batchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateBatchExport", reflect.TypeOf((*MockAPI)(nil).InitiateBatchExport), batchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateBatchExport", reflect.TypeOf((*MockAPI)(nil).InitiateBatchExport), ctx, batchID)
 }
 
 // InitiateCollectionExport mocks base method.
-func (m *MockAPI) InitiateCollectionExport(collectionID string) (*client.CollectionExportResponse, error) {
+func (m *MockAPI) InitiateCollectionExport(ctx context.Context, collectionID string) (*client.CollectionExportResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitiateCollectionExport", collectionID)
+	ret := m.ctrl.Call(m, "InitiateCollectionExport", ctx, collectionID)
 	ret0, _ := ret[0].(*client.CollectionExportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // InitiateCollectionExport indicates an expected call of InitiateCollectionExport.
-func (mr *MockAPIMockRecorder) InitiateCollectionExport(collectionID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) InitiateCollectionExport(ctx, collectionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateCollectionExport", reflect.TypeOf((*MockAPI)(nil).InitiateCollectionExport), collectionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateCollectionExport", reflect.TypeOf((*MockAPI)(nil).InitiateCollectionExport), ctx, collectionID)
 }
 
 // ListCredentialPools mocks base method.
-func (m *MockAPI) ListCredentialPools(workspaceID string) (*client.ListCredentialPoolsResponse, error) {
+func (m *MockAPI) ListCredentialPools(ctx context.Context, workspaceID string) (*client.ListCredentialPoolsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCredentialPools", workspaceID)
+	ret := m.ctrl.Call(m, "ListCredentialPools", ctx, workspaceID)
 	ret0, _ := ret[0].(*client.ListCredentialPoolsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListCredentialPools indicates an expected call of ListCredentialPools.
-func (mr *MockAPIMockRecorder) ListCredentialPools(workspaceID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) ListCredentialPools(ctx, workspaceID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCredentialPools", reflect.TypeOf((*MockAPI)(nil).ListCredentialPools), workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCredentialPools", reflect.TypeOf((*MockAPI)(nil).ListCredentialPools), ctx, workspaceID)
 }
 
 // PayBonusPayments mocks base method.
-func (m *MockAPI) PayBonusPayments(id string) error {
+func (m *MockAPI) PayBonusPayments(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PayBonusPayments", id)
+	ret := m.ctrl.Call(m, "PayBonusPayments", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PayBonusPayments indicates an expected call of PayBonusPayments.
-func (mr *MockAPIMockRecorder) PayBonusPayments(id interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) PayBonusPayments(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayBonusPayments", reflect.TypeOf((*MockAPI)(nil).PayBonusPayments), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayBonusPayments", reflect.TypeOf((*MockAPI)(nil).PayBonusPayments), ctx, id)
 }
 
 // RemoveParticipantGroupMembers mocks base method.
-func (m *MockAPI) RemoveParticipantGroupMembers(groupID string, participantIDs []string) (*client.ViewParticipantGroupResponse, error) {
+func (m *MockAPI) RemoveParticipantGroupMembers(ctx context.Context, groupID string, participantIDs []string) (*client.ViewParticipantGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveParticipantGroupMembers", groupID, participantIDs)
+	ret := m.ctrl.Call(m, "RemoveParticipantGroupMembers", ctx, groupID, participantIDs)
 	ret0, _ := ret[0].(*client.ViewParticipantGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveParticipantGroupMembers indicates an expected call of RemoveParticipantGroupMembers.
-func (mr *MockAPIMockRecorder) RemoveParticipantGroupMembers(groupID, participantIDs interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) RemoveParticipantGroupMembers(ctx, groupID, participantIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveParticipantGroupMembers", reflect.TypeOf((*MockAPI)(nil).RemoveParticipantGroupMembers), groupID, participantIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveParticipantGroupMembers", reflect.TypeOf((*MockAPI)(nil).RemoveParticipantGroupMembers), ctx, groupID, participantIDs)
 }
 
 // RequestSubmissionReturn mocks base method.
-func (m *MockAPI) RequestSubmissionReturn(ID string, reasons []string) (*client.RequestSubmissionReturnResponse, error) {
+func (m *MockAPI) RequestSubmissionReturn(ctx context.Context, ID string, reasons []string) (*client.RequestSubmissionReturnResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RequestSubmissionReturn", ID, reasons)
+	ret := m.ctrl.Call(m, "RequestSubmissionReturn", ctx, ID, reasons)
 	ret0, _ := ret[0].(*client.RequestSubmissionReturnResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RequestSubmissionReturn indicates an expected call of RequestSubmissionReturn.
-func (mr *MockAPIMockRecorder) RequestSubmissionReturn(ID, reasons interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) RequestSubmissionReturn(ctx, ID, reasons interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSubmissionReturn", reflect.TypeOf((*MockAPI)(nil).RequestSubmissionReturn), ID, reasons)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSubmissionReturn", reflect.TypeOf((*MockAPI)(nil).RequestSubmissionReturn), ctx, ID, reasons)
 }
 
 // SendGroupMessage mocks base method.
-func (m *MockAPI) SendGroupMessage(participantGroupID, body string, studyID *string) error {
+func (m *MockAPI) SendGroupMessage(ctx context.Context, participantGroupID, body string, studyID *string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendGroupMessage", participantGroupID, body, studyID)
+	ret := m.ctrl.Call(m, "SendGroupMessage", ctx, participantGroupID, body, studyID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendGroupMessage indicates an expected call of SendGroupMessage.
-func (mr *MockAPIMockRecorder) SendGroupMessage(participantGroupID, body, studyID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) SendGroupMessage(ctx, participantGroupID, body, studyID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendGroupMessage", reflect.TypeOf((*MockAPI)(nil).SendGroupMessage), participantGroupID, body, studyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendGroupMessage", reflect.TypeOf((*MockAPI)(nil).SendGroupMessage), ctx, participantGroupID, body, studyID)
 }
 
 // SendMessage mocks base method.
-func (m *MockAPI) SendMessage(body, recipientID, studyID string) error {
+func (m *MockAPI) SendMessage(ctx context.Context, body, recipientID, studyID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendMessage", body, recipientID, studyID)
+	ret := m.ctrl.Call(m, "SendMessage", ctx, body, recipientID, studyID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendMessage indicates an expected call of SendMessage.
-func (mr *MockAPIMockRecorder) SendMessage(body, recipientID, studyID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) SendMessage(ctx, body, recipientID, studyID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockAPI)(nil).SendMessage), body, recipientID, studyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockAPI)(nil).SendMessage), ctx, body, recipientID, studyID)
 }
 
 // SetupAITaskBuilderBatch mocks base method.
-func (m *MockAPI) SetupAITaskBuilderBatch(batchID, datasetID string, tasksPerGroup int) (*client.SetupAITaskBuilderBatchResponse, error) {
+func (m *MockAPI) SetupAITaskBuilderBatch(ctx context.Context, batchID, datasetID string, tasksPerGroup int) (*client.SetupAITaskBuilderBatchResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetupAITaskBuilderBatch", batchID, datasetID, tasksPerGroup)
+	ret := m.ctrl.Call(m, "SetupAITaskBuilderBatch", ctx, batchID, datasetID, tasksPerGroup)
 	ret0, _ := ret[0].(*client.SetupAITaskBuilderBatchResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetupAITaskBuilderBatch indicates an expected call of SetupAITaskBuilderBatch.
-func (mr *MockAPIMockRecorder) SetupAITaskBuilderBatch(batchID, datasetID, tasksPerGroup interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) SetupAITaskBuilderBatch(ctx, batchID, datasetID, tasksPerGroup interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).SetupAITaskBuilderBatch), batchID, datasetID, tasksPerGroup)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).SetupAITaskBuilderBatch), ctx, batchID, datasetID, tasksPerGroup)
 }
 
 // SyncAITaskBuilderBatch mocks base method.
-func (m *MockAPI) SyncAITaskBuilderBatch(batchID string) (*client.AITaskBuilderBatchSyncResponse, error) {
+func (m *MockAPI) SyncAITaskBuilderBatch(ctx context.Context, batchID string) (*client.AITaskBuilderBatchSyncResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SyncAITaskBuilderBatch", batchID)
+	ret := m.ctrl.Call(m, "SyncAITaskBuilderBatch", ctx, batchID)
 	ret0, _ := ret[0].(*client.AITaskBuilderBatchSyncResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SyncAITaskBuilderBatch indicates an expected call of SyncAITaskBuilderBatch.
-func (mr *MockAPIMockRecorder) SyncAITaskBuilderBatch(batchID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) SyncAITaskBuilderBatch(ctx, batchID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).SyncAITaskBuilderBatch), batchID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).SyncAITaskBuilderBatch), ctx, batchID)
 }
 
 // TestStudy mocks base method.
-func (m *MockAPI) TestStudy(ID string) (*client.TestStudyResponse, error) {
+func (m *MockAPI) TestStudy(ctx context.Context, ID string) (*client.TestStudyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TestStudy", ID)
+	ret := m.ctrl.Call(m, "TestStudy", ctx, ID)
 	ret0, _ := ret[0].(*client.TestStudyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TestStudy indicates an expected call of TestStudy.
-func (mr *MockAPIMockRecorder) TestStudy(ID interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) TestStudy(ctx, ID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestStudy", reflect.TypeOf((*MockAPI)(nil).TestStudy), ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestStudy", reflect.TypeOf((*MockAPI)(nil).TestStudy), ctx, ID)
 }
 
 // TransitionStudy mocks base method.
-func (m *MockAPI) TransitionStudy(ID, action string) (*client.TransitionStudyResponse, error) {
+func (m *MockAPI) TransitionStudy(ctx context.Context, ID, action string) (*client.TransitionStudyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TransitionStudy", ID, action)
+	ret := m.ctrl.Call(m, "TransitionStudy", ctx, ID, action)
 	ret0, _ := ret[0].(*client.TransitionStudyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TransitionStudy indicates an expected call of TransitionStudy.
-func (mr *MockAPIMockRecorder) TransitionStudy(ID, action interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) TransitionStudy(ctx, ID, action interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionStudy", reflect.TypeOf((*MockAPI)(nil).TransitionStudy), ID, action)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionStudy", reflect.TypeOf((*MockAPI)(nil).TransitionStudy), ctx, ID, action)
 }
 
 // TransitionSubmission mocks base method.
-func (m *MockAPI) TransitionSubmission(ID string, payload client.TransitionSubmissionPayload) (*client.TransitionSubmissionResponse, error) {
+func (m *MockAPI) TransitionSubmission(ctx context.Context, ID string, payload client.TransitionSubmissionPayload) (*client.TransitionSubmissionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TransitionSubmission", ID, payload)
+	ret := m.ctrl.Call(m, "TransitionSubmission", ctx, ID, payload)
 	ret0, _ := ret[0].(*client.TransitionSubmissionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TransitionSubmission indicates an expected call of TransitionSubmission.
-func (mr *MockAPIMockRecorder) TransitionSubmission(ID, payload interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) TransitionSubmission(ctx, ID, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionSubmission", reflect.TypeOf((*MockAPI)(nil).TransitionSubmission), ID, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionSubmission", reflect.TypeOf((*MockAPI)(nil).TransitionSubmission), ctx, ID, payload)
 }
 
 // UpdateAITaskBuilderBatch mocks base method.
-func (m *MockAPI) UpdateAITaskBuilderBatch(params client.UpdateBatchParams) (*client.UpdateAITaskBuilderBatchResponse, error) {
+func (m *MockAPI) UpdateAITaskBuilderBatch(ctx context.Context, params client.UpdateBatchParams) (*client.UpdateAITaskBuilderBatchResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAITaskBuilderBatch", params)
+	ret := m.ctrl.Call(m, "UpdateAITaskBuilderBatch", ctx, params)
 	ret0, _ := ret[0].(*client.UpdateAITaskBuilderBatchResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAITaskBuilderBatch indicates an expected call of UpdateAITaskBuilderBatch.
-func (mr *MockAPIMockRecorder) UpdateAITaskBuilderBatch(params interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) UpdateAITaskBuilderBatch(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).UpdateAITaskBuilderBatch), params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAITaskBuilderBatch", reflect.TypeOf((*MockAPI)(nil).UpdateAITaskBuilderBatch), ctx, params)
 }
 
 // UpdateCollection mocks base method.
-func (m *MockAPI) UpdateCollection(ID string, collection model.UpdateCollection) (*model.Collection, error) {
+func (m *MockAPI) UpdateCollection(ctx context.Context, ID string, collection model.UpdateCollection) (*model.Collection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCollection", ID, collection)
+	ret := m.ctrl.Call(m, "UpdateCollection", ctx, ID, collection)
 	ret0, _ := ret[0].(*model.Collection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCollection indicates an expected call of UpdateCollection.
-func (mr *MockAPIMockRecorder) UpdateCollection(ID, collection interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) UpdateCollection(ctx, ID, collection interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockAPI)(nil).UpdateCollection), ID, collection)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockAPI)(nil).UpdateCollection), ctx, ID, collection)
 }
 
 // UpdateCredentialPool mocks base method.
-func (m *MockAPI) UpdateCredentialPool(credentialPoolID, credentials string) (*client.CredentialPoolResponse, error) {
+func (m *MockAPI) UpdateCredentialPool(ctx context.Context, credentialPoolID, credentials string) (*client.CredentialPoolResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCredentialPool", credentialPoolID, credentials)
+	ret := m.ctrl.Call(m, "UpdateCredentialPool", ctx, credentialPoolID, credentials)
 	ret0, _ := ret[0].(*client.CredentialPoolResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateCredentialPool indicates an expected call of UpdateCredentialPool.
-func (mr *MockAPIMockRecorder) UpdateCredentialPool(credentialPoolID, credentials interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) UpdateCredentialPool(ctx, credentialPoolID, credentials interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCredentialPool", reflect.TypeOf((*MockAPI)(nil).UpdateCredentialPool), credentialPoolID, credentials)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCredentialPool", reflect.TypeOf((*MockAPI)(nil).UpdateCredentialPool), ctx, credentialPoolID, credentials)
 }
 
 // UpdateHookSubscription mocks base method.
-func (m *MockAPI) UpdateHookSubscription(subscriptionID string, payload client.UpdateHookPayload) (*model.Hook, error) {
+func (m *MockAPI) UpdateHookSubscription(ctx context.Context, subscriptionID string, payload client.UpdateHookPayload) (*model.Hook, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateHookSubscription", subscriptionID, payload)
+	ret := m.ctrl.Call(m, "UpdateHookSubscription", ctx, subscriptionID, payload)
 	ret0, _ := ret[0].(*model.Hook)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateHookSubscription indicates an expected call of UpdateHookSubscription.
-func (mr *MockAPIMockRecorder) UpdateHookSubscription(subscriptionID, payload interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) UpdateHookSubscription(ctx, subscriptionID, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHookSubscription", reflect.TypeOf((*MockAPI)(nil).UpdateHookSubscription), subscriptionID, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHookSubscription", reflect.TypeOf((*MockAPI)(nil).UpdateHookSubscription), ctx, subscriptionID, payload)
 }
 
 // UpdateStudy mocks base method.
-func (m *MockAPI) UpdateStudy(ID string, study any) (*model.Study, error) {
+func (m *MockAPI) UpdateStudy(ctx context.Context, ID string, study any) (*model.Study, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStudy", ID, study)
+	ret := m.ctrl.Call(m, "UpdateStudy", ctx, ID, study)
 	ret0, _ := ret[0].(*model.Study)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateStudy indicates an expected call of UpdateStudy.
-func (mr *MockAPIMockRecorder) UpdateStudy(ID, study interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) UpdateStudy(ctx, ID, study interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStudy", reflect.TypeOf((*MockAPI)(nil).UpdateStudy), ID, study)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStudy", reflect.TypeOf((*MockAPI)(nil).UpdateStudy), ctx, ID, study)
 }
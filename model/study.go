@@ -48,6 +48,70 @@ const (
 	TransitionStudyStop = "STOP"
 )
 
+// The values the API accepts for the enum fields of CreateStudy.
+var (
+	// ProlificIDOptions are the values for CreateStudy.ProlificIDOption.
+	ProlificIDOptions = []string{"question", "url_parameters", "not_required"}
+	// CompletionOptions are the values for CreateStudy.CompletionOption.
+	CompletionOptions = []string{"url", "code"}
+	// DeviceCompatibilities are the values for CreateStudy.DeviceCompatibility.
+	DeviceCompatibilities = []string{"desktop", "tablet", "mobile"}
+	// PeripheralRequirements are the values for
+	// CreateStudy.PeripheralRequirements.
+	PeripheralRequirements = []string{"audio", "camera", "download", "microphone"}
+	// StudyTypes are the values for CreateStudy.StudyType. Weighted filters
+	// need StudyTypeQuota.
+	StudyTypes = []string{StudyTypeSingle, StudyTypeQuota}
+)
+
+const (
+	// StudyTypeSingle is a study with one sample.
+	StudyTypeSingle = "SINGLE"
+	// StudyTypeQuota is a study whose places are split by weighted filters.
+	StudyTypeQuota = "QUOTA"
+)
+
+// CompletionCodeTypes are the completion code types the CLI knows about.
+var CompletionCodeTypes = []string{
+	"COMPLETED",
+	"FAILED_ATTENTION_CHECK",
+	"FOLLOW_UP_STUDY",
+	"GIVE_BONUS",
+	"INCOMPATIBLE_DEVICE",
+	"NO_CONSENT",
+	"OTHER",
+	"FIXED_SCREENOUT",
+}
+
+// CompletionCodeActions are the completion code actions the CLI knows about.
+var CompletionCodeActions = []string{
+	CompletionActionAutomaticallyApprove,
+	CompletionActionManuallyReview,
+	CompletionActionAddToParticipantGroup,
+	CompletionActionRemoveFromParticipantGroup,
+	CompletionActionRequestReturn,
+	CompletionActionDynamicPayment,
+}
+
+const (
+	// CompletionActionAutomaticallyApprove approves the submission.
+	CompletionActionAutomaticallyApprove = "AUTOMATICALLY_APPROVE"
+	// CompletionActionManuallyReview leaves the submission for review.
+	CompletionActionManuallyReview = "MANUALLY_REVIEW"
+	// CompletionActionAddToParticipantGroup adds the participant to the
+	// action's participant_group.
+	CompletionActionAddToParticipantGroup = "ADD_TO_PARTICIPANT_GROUP"
+	// CompletionActionRemoveFromParticipantGroup removes the participant from
+	// the action's participant_group.
+	CompletionActionRemoveFromParticipantGroup = "REMOVE_FROM_PARTICIPANT_GROUP"
+	// CompletionActionRequestReturn asks the participant to return the
+	// submission.
+	CompletionActionRequestReturn = "REQUEST_RETURN"
+	// CompletionActionDynamicPayment pays a percentage of the reward, given
+	// when the submission is transitioned.
+	CompletionActionDynamicPayment = "DYNAMIC_PAYMENT"
+)
+
 const (
 	// DataCollectionMethodAITBCollection is the data collection method for AI Task Builder Collections
 	DataCollectionMethodAITBCollection = "AI_TASK_BUILDER_COLLECTION"
@@ -76,19 +140,27 @@ type Study struct {
 			ID    string `json:"id"`
 			Title string `json:"title"`
 		} `json:"question"`
-		DisplayDetails string `json:"details_display"`
+		DisplayDetails string           `json:"details_display"`
+		Attributes     []map[string]any `json:"attributes,omitempty"`
+		Query          struct {
+			ID string `json:"id"`
+		} `json:"query"`
+		Cls string `json:"_cls,omitempty"`
 	} `json:"eligibility_requirements"`
-	Filters                 []Filter `json:"filters"`
-	Desc                    string   `json:"description"`
-	EstimatedCompletionTime int      `json:"estimated_completion_time"`
-	MaximumAllowedTime      int      `json:"maximum_allowed_time"`
-	CompletionURL           string   `json:"completion_url"`
-	ExternalStudyURL        string   `json:"external_study_url"`
-	PublishedAt             any      `json:"published_at"`
-	StartedPublishingAt     any      `json:"started_publishing_at"`
-	AwardPoints             int      `json:"award_points"`
-	PresentmentCurrencyCode string   `json:"presentment_currency_code"`
-	CurrencyCode            string   `json:"currency_code"`
+	Filters                 []Filter         `json:"filters"`
+	Desc                    string           `json:"description"`
+	EstimatedCompletionTime int              `json:"estimated_completion_time"`
+	MaximumAllowedTime      int              `json:"maximum_allowed_time"`
+	CompletionURL           string           `json:"completion_url"`
+	CompletionOption        string           `json:"completion_option,omitempty"`
+	CompletionCodes         []CompletionCode `json:"completion_codes,omitempty"`
+	ProlificIDOption        string           `json:"prolific_id_option,omitempty"`
+	ExternalStudyURL        string           `json:"external_study_url"`
+	PublishedAt             any              `json:"published_at"`
+	StartedPublishingAt     any              `json:"started_publishing_at"`
+	AwardPoints             int              `json:"award_points"`
+	PresentmentCurrencyCode string           `json:"presentment_currency_code"`
+	CurrencyCode            string           `json:"currency_code"`
 	Researcher              struct {
 		ID          string `json:"id"`
 		Name        string `json:"name"`
@@ -114,6 +186,9 @@ type Study struct {
 	IsUnderpaying          any               `json:"is_underpaying"`
 	SubmissionsConfig      SubmissionsConfig `json:"submissions_config"`
 	CredentialPoolID       string            `json:"credential_pool_id"`
+	StudyLabels            []string          `json:"study_labels,omitempty"`
+	FilterSetID            string            `json:"filter_set_id,omitempty"`
+	Project                string            `json:"project,omitempty"`
 }
 
 // CreateStudy is responsible for capturing what fields we need to send
@@ -196,8 +271,9 @@ type UpdateStudy struct {
 
 // SubmissionsConfig represents configuration around submission gathering
 type SubmissionsConfig struct {
-	MaxSubmissionsPerParticipant int `json:"max_submissions_per_participant"`
-	MaxConcurrentSubmissions     int `json:"max_concurrent_submissions"`
+	MaxSubmissionsPerParticipant int      `json:"max_submissions_per_participant"`
+	MaxConcurrentSubmissions     int      `json:"max_concurrent_submissions"`
+	AutoRejectionCategories      []string `json:"auto_rejection_categories,omitempty"`
 }
 
 // FilterValue will help the bubbletea views run
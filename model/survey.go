@@ -1,9 +1,6 @@
 package model
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 // Survey represents a survey resource from the Prolific API.
 type Survey struct {
@@ -16,23 +13,6 @@ type Survey struct {
 	Questions    []SurveyQuestion `json:"questions,omitempty"`
 }
 
-// SurveyListItem wraps a Survey to satisfy the bubbletea list.DefaultItem interface
-// without conflicting with the Survey.Title field.
-type SurveyListItem struct {
-	Survey
-}
-
-// FilterValue implements the bubbletea list.Item interface.
-func (s SurveyListItem) FilterValue() string { return s.Survey.Title }
-
-// Title implements the bubbletea list.DefaultItem interface.
-func (s SurveyListItem) Title() string { return s.Survey.Title }
-
-// Description implements the bubbletea list.DefaultItem interface.
-func (s SurveyListItem) Description() string {
-	return fmt.Sprintf("ID: %s - created %s", s.ID, s.DateCreated.Format("2006-01-02"))
-}
-
 // SurveySection represents a section within a survey.
 type SurveySection struct {
 	ID        string           `json:"id,omitempty" mapstructure:"id"`
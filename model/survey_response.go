@@ -1,9 +1,6 @@
 package model
 
-import (
-	"fmt"
-	"time"
-)
+import "time"
 
 // SurveyResponse represents a participant's response to a survey.
 type SurveyResponse struct {
@@ -16,22 +13,6 @@ type SurveyResponse struct {
 	Questions     []SurveyQuestionResponse `json:"questions,omitempty"`
 }
 
-// SurveyResponseListItem wraps a SurveyResponse to satisfy the bubbletea list.DefaultItem interface.
-type SurveyResponseListItem struct {
-	SurveyResponse
-}
-
-// FilterValue implements the bubbletea list.Item interface.
-func (s SurveyResponseListItem) FilterValue() string { return s.ID }
-
-// Title implements the bubbletea list.DefaultItem interface.
-func (s SurveyResponseListItem) Title() string { return s.ID }
-
-// Description implements the bubbletea list.DefaultItem interface.
-func (s SurveyResponseListItem) Description() string {
-	return fmt.Sprintf("Participant: %s - submitted %s", s.ParticipantID, s.DateCreated.Format("2006-01-02"))
-}
-
 // SurveyResponseSection represents a section within a survey response.
 type SurveyResponseSection struct {
 	SectionID string                   `json:"section_id" mapstructure:"section_id"`
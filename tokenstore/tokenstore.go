@@ -0,0 +1,313 @@
+// Package tokenstore keeps API tokens saved by `prolific auth login`,
+// encrypted on disk and keyed by profile, so they don't have to live in an
+// environment variable.
+//
+// Tokens are sealed with AES-256-GCM. The key is either derived from a
+// passphrase, or is a random machine key kept in a separate file readable
+// only by the current user. The machine key keeps tokens out of shell
+// history, CI logs and copies of the config file, but anyone who can read
+// your home directory can read both files, so use a passphrase where that
+// matters.
+package tokenstore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/prolific-oss/cli/config"
+)
+
+const (
+	// PassphraseEnvVar supplies the passphrase for tokens stored with one,
+	// for non-interactive use.
+	PassphraseEnvVar = "PROLIFIC_TOKEN_PASSPHRASE"
+	// DefaultProfile is the entry used when no profile is active.
+	DefaultProfile = "default"
+
+	tokensFile     = "tokens.json"
+	machineKeyFile = "machine.key"
+
+	methodMachine    = "machine"
+	methodPassphrase = "passphrase"
+
+	keyLength = 32
+	saltBytes = 16
+	// kdfIterations follows OWASP's guidance for PBKDF2-HMAC-SHA256.
+	kdfIterations = 600_000
+)
+
+var (
+	// ErrNotFound is returned when no token is stored for the profile.
+	ErrNotFound = errors.New("no token stored")
+	// ErrPassphraseRequired is returned when the token was stored with a
+	// passphrase and none was given.
+	ErrPassphraseRequired = errors.New("the stored token is protected by a passphrase; set " + PassphraseEnvVar)
+	// ErrWrongPassphrase is returned when the token cannot be decrypted with
+	// the passphrase or machine key given.
+	ErrWrongPassphrase = errors.New("unable to decrypt the stored token: wrong passphrase or machine key")
+)
+
+// Entry is an encrypted token as held in the store's file.
+type Entry struct {
+	Method     string `json:"method"`
+	Salt       []byte `json:"salt,omitempty"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// UsesPassphrase reports whether the entry needs a passphrase to decrypt.
+func (e Entry) UsesPassphrase() bool {
+	return e.Method == methodPassphrase
+}
+
+type document struct {
+	Tokens map[string]Entry `json:"tokens"`
+}
+
+// Store reads and writes tokens in a directory.
+type Store struct {
+	dir string
+}
+
+// New returns a store kept in dir.
+func New(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Default returns the store kept alongside the default config file.
+func Default() (*Store, error) {
+	dir, err := config.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	return New(dir), nil
+}
+
+// Path returns the file the encrypted tokens are kept in.
+func (s *Store) Path() string {
+	return filepath.Join(s.dir, tokensFile)
+}
+
+// Save encrypts token and stores it for profile, replacing any token already
+// there. An empty passphrase encrypts it with the machine key instead.
+func (s *Store) Save(profile, token, passphrase string) error {
+	profile = entryName(profile)
+
+	entry := Entry{Method: methodMachine}
+	var key []byte
+	var err error
+	if passphrase != "" {
+		entry.Method = methodPassphrase
+		entry.Salt = make([]byte, saltBytes)
+		if _, err := rand.Read(entry.Salt); err != nil {
+			return err
+		}
+		key, err = passphraseKey(passphrase, entry.Salt)
+	} else {
+		key, err = s.machineKey(true)
+	}
+	if err != nil {
+		return err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	entry.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(entry.Nonce); err != nil {
+		return err
+	}
+	// Binding the profile name stops an entry being copied to another profile.
+	entry.Ciphertext = aead.Seal(nil, entry.Nonce, []byte(token), []byte(profile))
+
+	doc, err := s.read()
+	if err != nil {
+		return err
+	}
+	doc.Tokens[profile] = entry
+
+	return s.write(doc)
+}
+
+// Load decrypts the token stored for profile. passphrase is only used for
+// tokens that were stored with one.
+func (s *Store) Load(profile, passphrase string) (string, error) {
+	profile = entryName(profile)
+
+	entry, ok, err := s.Lookup(profile)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	var key []byte
+	if entry.UsesPassphrase() {
+		if passphrase == "" {
+			return "", ErrPassphraseRequired
+		}
+		key, err = passphraseKey(passphrase, entry.Salt)
+	} else {
+		key, err = s.machineKey(false)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	if len(entry.Nonce) != aead.NonceSize() {
+		return "", fmt.Errorf("unable to decrypt the stored token: malformed entry in %s", s.Path())
+	}
+
+	token, err := aead.Open(nil, entry.Nonce, entry.Ciphertext, []byte(profile))
+	if err != nil {
+		return "", ErrWrongPassphrase
+	}
+
+	return string(token), nil
+}
+
+// Lookup returns the encrypted entry for profile without decrypting it.
+func (s *Store) Lookup(profile string) (Entry, bool, error) {
+	doc, err := s.read()
+	if err != nil {
+		return Entry{}, false, err
+	}
+
+	entry, ok := doc.Tokens[entryName(profile)]
+	return entry, ok, nil
+}
+
+// Profiles returns the profiles that have a token stored.
+func (s *Store) Profiles() ([]string, error) {
+	doc, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(doc.Tokens))
+	for name := range doc.Tokens {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Delete removes the token stored for profile, reporting whether there was
+// one.
+func (s *Store) Delete(profile string) (bool, error) {
+	doc, err := s.read()
+	if err != nil {
+		return false, err
+	}
+
+	profile = entryName(profile)
+	if _, ok := doc.Tokens[profile]; !ok {
+		return false, nil
+	}
+	delete(doc.Tokens, profile)
+
+	return true, s.write(doc)
+}
+
+func (s *Store) read() (*document, error) {
+	doc := &document{Tokens: map[string]Entry{}}
+
+	data, err := os.ReadFile(s.Path())
+	if errors.Is(err, os.ErrNotExist) {
+		return doc, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", s.Path(), err)
+	}
+	if doc.Tokens == nil {
+		doc.Tokens = map[string]Entry{}
+	}
+
+	return doc, nil
+}
+
+func (s *Store) write(doc *document) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.Path(), append(data, '\n'), 0o600)
+}
+
+// machineKey reads the machine key, generating it first if create is set and
+// there isn't one yet.
+func (s *Store) machineKey(create bool) ([]byte, error) {
+	path := filepath.Join(s.dir, machineKeyFile)
+
+	key, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && create {
+		key = make([]byte, keyLength)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(s.dir, 0o700); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, key, 0o600); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("unable to decrypt the stored token: machine key %s is missing", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(key) != keyLength {
+		return nil, fmt.Errorf("unable to decrypt the stored token: machine key %s is malformed", path)
+	}
+
+	return key, nil
+}
+
+func passphraseKey(passphrase string, salt []byte) ([]byte, error) {
+	return pbkdf2.Key(sha256.New, passphrase, salt, kdfIterations, keyLength)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func entryName(profile string) string {
+	if profile == "" {
+		return DefaultProfile
+	}
+	return profile
+}
@@ -0,0 +1,123 @@
+package tokenstore_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prolific-oss/cli/tokenstore"
+)
+
+func TestSaveAndLoadWithMachineKey(t *testing.T) {
+	dir := t.TempDir()
+	store := tokenstore.New(dir)
+
+	if err := store.Save("", "my-token", ""); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	token, err := store.Load(tokenstore.DefaultProfile, "")
+	if err != nil || token != "my-token" {
+		t.Fatalf("expected my-token; got %q, %v", token, err)
+	}
+
+	data, err := os.ReadFile(store.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "my-token") {
+		t.Fatal("expected the token to be encrypted on disk")
+	}
+
+	for _, name := range []string{"tokens.json", "machine.key"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0o600 {
+			t.Fatalf("expected %s to have mode 0600; got %o", name, info.Mode().Perm())
+		}
+	}
+}
+
+func TestSaveAndLoadWithPassphrase(t *testing.T) {
+	store := tokenstore.New(t.TempDir())
+
+	if err := store.Save("staging", "staging-token", "correct horse"); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if _, err := store.Load("staging", ""); !errors.Is(err, tokenstore.ErrPassphraseRequired) {
+		t.Fatalf("expected ErrPassphraseRequired; got %v", err)
+	}
+
+	if _, err := store.Load("staging", "wrong"); !errors.Is(err, tokenstore.ErrWrongPassphrase) {
+		t.Fatalf("expected ErrWrongPassphrase; got %v", err)
+	}
+
+	token, err := store.Load("staging", "correct horse")
+	if err != nil || token != "staging-token" {
+		t.Fatalf("expected staging-token; got %q, %v", token, err)
+	}
+
+	entry, ok, err := store.Lookup("staging")
+	if err != nil || !ok || !entry.UsesPassphrase() {
+		t.Fatalf("expected a passphrase entry; got %+v, %v, %v", entry, ok, err)
+	}
+}
+
+func TestTokensAreKeptPerProfile(t *testing.T) {
+	store := tokenstore.New(t.TempDir())
+
+	_ = store.Save("staging", "staging-token", "")
+	_ = store.Save("production", "production-token", "")
+
+	if _, err := store.Load("", ""); !errors.Is(err, tokenstore.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for the default profile; got %v", err)
+	}
+
+	token, err := store.Load("production", "")
+	if err != nil || token != "production-token" {
+		t.Fatalf("expected production-token; got %q, %v", token, err)
+	}
+
+	profiles, err := store.Profiles()
+	if err != nil || strings.Join(profiles, ",") != "production,staging" {
+		t.Fatalf("expected production,staging; got %v, %v", profiles, err)
+	}
+}
+
+func TestEntriesCannotBeMovedBetweenProfiles(t *testing.T) {
+	store := tokenstore.New(t.TempDir())
+	_ = store.Save("staging", "staging-token", "")
+
+	data, err := os.ReadFile(store.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := strings.Replace(string(data), `"staging"`, `"production"`, 1)
+	if err := os.WriteFile(store.Path(), []byte(tampered), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Load("production", ""); !errors.Is(err, tokenstore.ErrWrongPassphrase) {
+		t.Fatalf("expected the moved entry to fail to decrypt; got %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	store := tokenstore.New(t.TempDir())
+	_ = store.Save("", "my-token", "")
+
+	removed, err := store.Delete("")
+	if err != nil || !removed {
+		t.Fatalf("expected the token to be removed; got %v, %v", removed, err)
+	}
+
+	removed, err = store.Delete("")
+	if err != nil || removed {
+		t.Fatalf("expected nothing to remove; got %v, %v", removed, err)
+	}
+}
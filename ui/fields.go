@@ -0,0 +1,242 @@
+package ui
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fieldStep is one dot separated part of a field path, such as "Filters[0]".
+type fieldStep struct {
+	name  string
+	index int // -1 when the step is not indexed
+}
+
+// fieldPath is a parsed --fields entry. Each step names a struct field by its
+// Go name or JSON key, ignoring case, or a map key. A step through a list
+// without an index resolves the rest of the path for every item.
+type fieldPath []fieldStep
+
+func parseFieldPath(field string) (fieldPath, error) {
+	var path fieldPath
+
+	for part := range strings.SplitSeq(field, ".") {
+		step := fieldStep{name: part, index: -1}
+
+		if name, rest, ok := strings.Cut(part, "["); ok {
+			index, err := strconv.Atoi(strings.TrimSuffix(rest, "]"))
+			if !strings.HasSuffix(rest, "]") || err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid field %q: %q is not a valid index", field, "["+rest)
+			}
+			step = fieldStep{name: name, index: index}
+		}
+
+		if step.name == "" {
+			return nil, fmt.Errorf("invalid field %q", field)
+		}
+		path = append(path, step)
+	}
+
+	return path, nil
+}
+
+// parseFields parses and checks each field against the item type T, so an
+// unknown field is reported before anything is rendered.
+func parseFields[T any](fields []string) ([]fieldPath, error) {
+	t := reflect.TypeFor[T]()
+	paths := make([]fieldPath, len(fields))
+
+	for i, field := range fields {
+		path, err := parseFieldPath(field)
+		if err != nil {
+			return nil, err
+		}
+		if err := path.check(t, field); err != nil {
+			return nil, err
+		}
+		paths[i] = path
+	}
+
+	return paths, nil
+}
+
+// check walks the path through t, reporting the fields available wherever it
+// stops matching. Anything below an interface or a map is only known at
+// render time, so it is not checked.
+func (p fieldPath) check(t reflect.Type, field string) error {
+	for i, step := range p {
+		t = listElem(t)
+
+		switch t.Kind() {
+		case reflect.Interface, reflect.Map:
+			return nil
+		case reflect.Struct:
+			f, ok := lookupField(t, step.name)
+			if !ok {
+				return unknownField(field, p[:i], t)
+			}
+			t = f.Type
+		default:
+			return fmt.Errorf("unknown field %q: %s has no fields", field, p[:i])
+		}
+
+		if step.index >= 0 {
+			t = indirectType(t)
+			switch t.Kind() {
+			case reflect.Interface:
+				return nil
+			case reflect.Slice, reflect.Array:
+				t = t.Elem()
+			default:
+				return fmt.Errorf("invalid field %q: %s is not a list", field, p[:i+1])
+			}
+		}
+	}
+
+	return nil
+}
+
+// value resolves the path against v. It reports false when something along
+// the way is missing, such as a nil pointer or an index past the end of a
+// list.
+func (p fieldPath) value(v reflect.Value) (any, bool) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil, false
+	}
+	if len(p) == 0 {
+		return v.Interface(), true
+	}
+
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		values := make([]any, 0, v.Len())
+		for i := range v.Len() {
+			if value, ok := p.value(v.Index(i)); ok {
+				values = append(values, value)
+			}
+		}
+		return values, true
+	}
+
+	step := p[0]
+	var next reflect.Value
+
+	switch v.Kind() {
+	case reflect.Struct:
+		f, ok := lookupField(v.Type(), step.name)
+		if !ok {
+			return nil, false
+		}
+		field, err := v.FieldByIndexErr(f.Index)
+		if err != nil {
+			return nil, false
+		}
+		next = field
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		next = v.MapIndex(reflect.ValueOf(step.name).Convert(v.Type().Key()))
+	default:
+		return nil, false
+	}
+
+	if step.index >= 0 {
+		next = indirect(next)
+		if next.Kind() != reflect.Slice && next.Kind() != reflect.Array || step.index >= next.Len() {
+			return nil, false
+		}
+		next = next.Index(step.index)
+	}
+
+	return p[1:].value(next)
+}
+
+// format renders the value of the path for item, or an empty string when it
+// has no value. Times are rendered with formatTime.
+func (p fieldPath) format(item any, formatTime func(time.Time) string) string {
+	value, ok := p.value(reflect.ValueOf(item))
+	if !ok {
+		return ""
+	}
+	return formatCell(value, formatTime)
+}
+
+func (p fieldPath) String() string {
+	parts := make([]string, len(p))
+	for i, step := range p {
+		parts[i] = step.name
+		if step.index >= 0 {
+			parts[i] += fmt.Sprintf("[%d]", step.index)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// lookupField finds the exported field of t with the given Go name or JSON
+// key, ignoring case.
+func lookupField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		if strings.EqualFold(f.Name, name) || strings.EqualFold(jsonName(f), name) {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// jsonName returns the key f is encoded as in JSON, or an empty string when it
+// has no JSON tag.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+func unknownField(field string, parent fieldPath, t reflect.Type) error {
+	var names []string
+	for _, f := range reflect.VisibleFields(t) {
+		if f.IsExported() && !f.Anonymous {
+			names = append(names, f.Name)
+		}
+	}
+
+	if len(parent) == 0 {
+		return fmt.Errorf("unknown field %q, valid fields are: %s", field, strings.Join(names, ", "))
+	}
+	return fmt.Errorf("unknown field %q, valid fields of %s are: %s", field, parent, strings.Join(names, ", "))
+}
+
+// listElem returns the type of the items t holds, looking through pointers
+// and lists.
+func listElem(t reflect.Type) reflect.Type {
+	for t = indirectType(t); t.Kind() == reflect.Slice || t.Kind() == reflect.Array; {
+		t = indirectType(t.Elem())
+	}
+	return t
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// indirect follows pointers and interfaces, returning an invalid value when
+// one of them is nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
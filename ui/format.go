@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// OutputSettings control how times and amounts of money are shown.
+type OutputSettings struct {
+	// Location is the time zone times are shown in. When nil, times are left
+	// in the zone the API gave them in, which is UTC.
+	Location *time.Location
+	// DateTimeFormat is the Go layout for times in output meant for people.
+	// Machine readable output always uses RFC 3339.
+	DateTimeFormat string
+	// Locale sets the decimal and grouping separators for amounts of money.
+	// When undetermined, amounts are shown as plain numbers, e.g. 1234.50.
+	Locale language.Tag
+}
+
+// dateFormatPresets are the names accepted in place of a Go layout.
+var dateFormatPresets = map[string]string{
+	"default": AppDateTimeFormat,
+	"iso":     time.RFC3339,
+	"rfc3339": time.RFC3339,
+}
+
+var outputSettings = OutputSettings{DateTimeFormat: AppDateTimeFormat}
+
+// ParseOutputSettings builds OutputSettings from the settings as they are
+// given in the config file or by flag: an IANA time zone such as
+// America/New_York, Local or UTC; a Go layout such as "2006-01-02 15:04", or
+// iso for RFC 3339; and a BCP 47 locale such as en-US or ja-JP. Empty values
+// keep the defaults.
+func ParseOutputSettings(timezone, dateFormat, locale string) (OutputSettings, error) {
+	settings := OutputSettings{DateTimeFormat: AppDateTimeFormat}
+
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return settings, fmt.Errorf("unknown time zone %q, expected a name such as Europe/London, America/New_York, Local or UTC", timezone)
+		}
+		settings.Location = loc
+	}
+
+	if dateFormat != "" {
+		layout, ok := dateFormatPresets[strings.ToLower(dateFormat)]
+		if !ok {
+			layout = dateFormat
+			// A layout turns a time into something other than itself.
+			sample := time.Date(2001, time.February, 3, 4, 5, 6, 0, time.UTC)
+			if sample.Format(layout) == layout {
+				return settings, fmt.Errorf("date format %q is not a Go layout, expected one such as \"2006-01-02 15:04\" or iso", dateFormat)
+			}
+		}
+		settings.DateTimeFormat = layout
+	}
+
+	if locale != "" {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return settings, fmt.Errorf("unknown locale %q, expected a language tag such as en-GB, en-US or ja-JP", locale)
+		}
+		settings.Locale = tag
+	}
+
+	return settings, nil
+}
+
+// SetOutputSettings changes how times and money are shown from now on.
+func SetOutputSettings(settings OutputSettings) {
+	outputSettings = settings
+}
+
+// InTimezone returns t in the time zone times are shown in.
+func InTimezone(t time.Time) time.Time {
+	if outputSettings.Location == nil {
+		return t
+	}
+	return t.In(outputSettings.Location)
+}
+
+// FormatDateTime formats t for people, in the configured time zone and date
+// format.
+func FormatDateTime(t time.Time) string {
+	return InTimezone(t).Format(outputSettings.DateTimeFormat)
+}
+
+// FormatDateTimeString formats a time the API returns as a string, as
+// FormatDateTime does. Text that is not an RFC 3339 time is returned as it is.
+func FormatDateTimeString(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return FormatDateTime(t)
+}
+
+// FormatMachineDateTime formats t as RFC 3339 in the configured time zone, for
+// output read by other programs such as CSV.
+func FormatMachineDateTime(t time.Time) string {
+	return InTimezone(t).Format(time.RFC3339)
+}
+
+// formatCell formats a value for a table or CSV cell. Times are formatted with
+// format and left blank when unset, and the items of a list are joined with
+// ", ".
+func formatCell(value any, format func(time.Time) string) string {
+	switch v := value.(type) {
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return format(v)
+	case *time.Time:
+		if v == nil || v.IsZero() {
+			return ""
+		}
+		return format(*v)
+	}
+
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = formatCell(v.Index(i).Interface(), format)
+		}
+		return strings.Join(items, ", ")
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,161 @@
+package ui_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+)
+
+// useOutputSettings applies the settings for the rest of the test.
+func useOutputSettings(t *testing.T, timezone, dateFormat, locale string) {
+	t.Helper()
+
+	settings, err := ui.ParseOutputSettings(timezone, dateFormat, locale)
+	if err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+	ui.SetOutputSettings(settings)
+	t.Cleanup(func() {
+		ui.SetOutputSettings(ui.OutputSettings{DateTimeFormat: ui.AppDateTimeFormat})
+	})
+}
+
+func TestFormatDateTime(t *testing.T) {
+	at := time.Date(2026, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	tt := []struct {
+		name       string
+		timezone   string
+		dateFormat string
+		expected   string
+	}{{
+		name:     "Defaults to the application format in UTC",
+		expected: "14-03-2026 15:09",
+	}, {
+		name:     "Converts to the time zone",
+		timezone: "Asia/Tokyo",
+		expected: "15-03-2026 00:09",
+	}, {
+		name:       "Uses a Go layout",
+		timezone:   "America/New_York",
+		dateFormat: "01/02/2006 3:04 PM MST",
+		expected:   "03/14/2026 11:09 AM EDT",
+	}, {
+		name:       "Uses RFC 3339 for iso",
+		timezone:   "Asia/Tokyo",
+		dateFormat: "iso",
+		expected:   "2026-03-15T00:09:26+09:00",
+	}}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			useOutputSettings(t, tc.timezone, tc.dateFormat, "")
+
+			actual := ui.FormatDateTime(at)
+			if actual != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFormatDateTimeString(t *testing.T) {
+	useOutputSettings(t, "Asia/Tokyo", "", "")
+
+	if actual := ui.FormatDateTimeString("2024-09-18T07:50:15.055Z"); actual != "18-09-2024 16:50" {
+		t.Fatalf("expected the time in the time zone, got %q", actual)
+	}
+	if actual := ui.FormatDateTimeString("yesterday"); actual != "yesterday" {
+		t.Fatalf("expected other text to be kept, got %q", actual)
+	}
+}
+
+func TestParseOutputSettingsRejectsInvalidSettings(t *testing.T) {
+	tt := []struct {
+		name       string
+		timezone   string
+		dateFormat string
+		locale     string
+		expected   string
+	}{{
+		name:     "Unknown time zone",
+		timezone: "Mars/Olympus_Mons",
+		expected: `unknown time zone "Mars/Olympus_Mons"`,
+	}, {
+		name:       "Date format without a layout",
+		dateFormat: "DD/MM/YYYY",
+		expected:   `date format "DD/MM/YYYY" is not a Go layout`,
+	}, {
+		name:     "Unknown locale",
+		locale:   "not a locale",
+		expected: `unknown locale "not a locale"`,
+	}}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ui.ParseOutputSettings(tc.timezone, tc.dateFormat, tc.locale)
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Fatalf("expected error containing %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestRenderMoneyUsesTheLocale(t *testing.T) {
+	tt := []struct {
+		locale   string
+		currency string
+		expected string
+	}{
+		{locale: "en-GB", currency: "GBP", expected: "£1,234.50"},
+		{locale: "en-US", currency: "USD", expected: "$1,234.50"},
+		{locale: "de-DE", currency: "GBP", expected: "£1.234,50"},
+		{locale: "ja-JP", currency: "USD", expected: "$1,234.50"},
+		{locale: "ja-JP", currency: "JPY", expected: "￥1,235"},
+		{locale: "de-DE", currency: "BHD", expected: "BHD1.234,500"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.locale, func(t *testing.T) {
+			useOutputSettings(t, "", "", tc.locale)
+
+			actual := ui.RenderMoney(1234.5, tc.currency)
+			if actual != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRenderersFormatTimes(t *testing.T) {
+	useOutputSettings(t, "America/New_York", "2006-01-02 15:04", "")
+
+	submissions := []model.Submission{
+		{ID: "1", StartedAt: time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)},
+		{ID: "2"},
+	}
+
+	var b bytes.Buffer
+	if err := (ui.CsvRenderer[model.Submission]{}).Render(submissions, "ID,StartedAt", &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	expected := "ID,StartedAt\n1,2026-01-15T05:30:00-05:00\n2,\n"
+	if b.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, b.String())
+	}
+
+	b.Reset()
+	if err := (ui.TableRenderer[model.Submission]{}).Render(submissions, "ID,StartedAt", &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	expected = "ID StartedAt        \n1  2026-01-15 05:30 \n2                   \n"
+	if b.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, b.String())
+	}
+}
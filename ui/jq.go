@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/itchyny/gojq"
+)
+
+// ParseJQ parses and compiles a --jq expression.
+func ParseJQ(expr string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return gojq.Compile(query)
+}
+
+// RenderJQ runs a jq expression over the JSON encoding of value and writes
+// each result on its own line. Strings are written without quotes, as jq -r
+// does, so they can be used directly in scripts; everything else is written
+// as indented JSON.
+func RenderJQ(expr string, value any, w io.Writer) error {
+	code, err := ParseJQ(expr)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	// Decode numbers as json.Number so large IDs and amounts keep their
+	// precision.
+	var input any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&input); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	iter := code.Run(input)
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+
+		switch result := result.(type) {
+		case error:
+			var halt *gojq.HaltError
+			if errors.As(result, &halt) && halt.Value() == nil {
+				return nil
+			}
+			return fmt.Errorf("jq: %w", result)
+		case string:
+			if _, err := fmt.Fprintln(w, result); err != nil {
+				return err
+			}
+		default:
+			if err := encoder.Encode(result); err != nil {
+				return err
+			}
+		}
+	}
+}
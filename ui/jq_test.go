@@ -0,0 +1,84 @@
+package ui_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+)
+
+func TestRenderJQ(t *testing.T) {
+	submissions := []model.Submission{
+		{ID: "1", ParticipantID: "p1", Status: "AWAITING REVIEW"},
+		{ID: "2", ParticipantID: "p2", Status: "APPROVED"},
+		{ID: "3", ParticipantID: "p3", Status: "AWAITING REVIEW"},
+	}
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected string
+	}{
+		{
+			name:     "strings are printed raw",
+			expr:     `.[] | select(.status=="AWAITING REVIEW") | .participant_id`,
+			expected: "p1\np3\n",
+		},
+		{
+			name:     "other values are printed as JSON",
+			expr:     `length`,
+			expected: "3\n",
+		},
+		{
+			name:     "objects are indented",
+			expr:     `.[0] | {id, status}`,
+			expected: "{\n  \"id\": \"1\",\n  \"status\": \"AWAITING REVIEW\"\n}\n",
+		},
+		{
+			name:     "empty filters print nothing",
+			expr:     `.[] | select(.status=="REJECTED")`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := ui.RenderJQ(tt.expr, submissions, &b); err != nil {
+				t.Fatalf("did not expect error, got %v", err)
+			}
+			if b.String() != tt.expected {
+				t.Fatalf("expected\n%q\ngot\n%q", tt.expected, b.String())
+			}
+		})
+	}
+}
+
+func TestRenderJQKeepsLargeNumbers(t *testing.T) {
+	var b bytes.Buffer
+	if err := ui.RenderJQ(".id", map[string]int64{"id": 9007199254740993}, &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+	if b.String() != "9007199254740993\n" {
+		t.Fatalf("expected the number to keep its precision, got %q", b.String())
+	}
+}
+
+func TestRenderJQReturnsRuntimeErrors(t *testing.T) {
+	var b bytes.Buffer
+	err := ui.RenderJQ(".[0]", map[string]string{"id": "1"}, &b)
+	if err == nil || !strings.HasPrefix(err.Error(), "jq: ") {
+		t.Fatalf("expected a jq error, got %v", err)
+	}
+}
+
+func TestParseJQRejectsInvalidExpressions(t *testing.T) {
+	if _, err := ui.ParseJQ(".[] | select("); err == nil {
+		t.Fatal("expected an error for an unfinished expression")
+	}
+	if _, err := ui.ParseJQ("nope(1)"); err == nil {
+		t.Fatal("expected an error for an unknown function")
+	}
+}
@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// IsTerminal reports whether r is an interactive terminal.
+func IsTerminal(r io.Reader) bool {
+	f, ok := r.(*os.File)
+	return ok && term.IsTerminal(f.Fd())
+}
+
+// ReadSecret prompts on w and reads a line from in without echoing it when in
+// is a terminal. Otherwise the line is read as-is, so secrets can be piped in;
+// pass the same *bufio.Reader to read several lines.
+func ReadSecret(in io.Reader, w io.Writer, prompt string) (string, error) {
+	if IsTerminal(in) {
+		fmt.Fprint(w, prompt)
+		secret, err := term.ReadPassword(in.(*os.File).Fd())
+		fmt.Fprintln(w)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(secret)), nil
+	}
+
+	r, ok := in.(*bufio.Reader)
+	if !ok {
+		r = bufio.NewReader(in)
+	}
+
+	line, err := r.ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
@@ -0,0 +1,607 @@
+package ui
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Filter is a parsed --filter expression, such as
+// `Reward >= 500 && StudyType == "SINGLE"`.
+//
+// Operands are field paths, resolved in the same way as --fields, or
+// literals: "strings" or 'strings', numbers, true, false and null. The
+// comparisons are ==, !=, <, <=, >, >= and =~, which matches a regular
+// expression. They combine with &&, || and !, and group with parentheses. A
+// field on its own is true when it is set, so `IsUnderpaying` keeps the
+// underpaying studies.
+//
+// Strings compare ignoring case, and a string compared with a number or a
+// date is read as one. A comparison against a list is true when any of its
+// items match.
+type Filter struct {
+	root filterNode
+}
+
+// ParseFilter parses expr and checks the fields it names against the item type
+// T, so a mistake is reported before anything is fetched or rendered.
+func ParseFilter[T any](expr string) (*Filter, error) {
+	tokens, err := lexFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := filterParser{tokens: tokens, itemType: reflect.TypeFor[T]()}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("invalid filter: unexpected %s", p.peek())
+	}
+
+	return &Filter{root: root}, nil
+}
+
+// Match reports whether item satisfies the filter.
+func (f *Filter) Match(item any) (bool, error) {
+	return f.root.eval(reflect.ValueOf(item))
+}
+
+// FilterItems returns the items matching expr, in their original order.
+func FilterItems[T any](items []T, expr string) ([]T, error) {
+	filter, err := ParseFilter[T](expr)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]T, 0, len(items))
+	for _, item := range items {
+		ok, err := filter.Match(item)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, item)
+		}
+	}
+
+	return matched, nil
+}
+
+// sortKey is one comma separated part of a --sort value.
+type sortKey struct {
+	path       fieldPath
+	descending bool
+}
+
+// ParseSort parses a --sort value: a comma separated list of fields, each
+// sorted in ascending order unless it starts with a "-". The fields are
+// checked against the item type T.
+func ParseSort[T any](keys string) ([]sortKey, error) {
+	var parsed []sortKey
+
+	for _, key := range splitFields(keys) {
+		descending := strings.HasPrefix(key, "-")
+		field := strings.TrimPrefix(key, "-")
+
+		path, err := parseFieldPath(field)
+		if err != nil {
+			return nil, err
+		}
+		if err := path.check(reflect.TypeFor[T](), field); err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, sortKey{path: path, descending: descending})
+	}
+
+	if len(parsed) == 0 {
+		return nil, errors.New("invalid sort: no fields given")
+	}
+
+	return parsed, nil
+}
+
+// SortItems sorts items in place by keys, as parsed by ParseSort. Items with
+// equal keys keep their order, and values that are not set sort last.
+func SortItems[T any](items []T, keys string) error {
+	parsed, err := ParseSort[T](keys)
+	if err != nil {
+		return err
+	}
+
+	slices.SortStableFunc(items, func(a, b T) int {
+		for _, key := range parsed {
+			av, aok := key.path.value(reflect.ValueOf(a))
+			bv, bok := key.path.value(reflect.ValueOf(b))
+
+			switch {
+			case !aok && !bok:
+				continue
+			case !aok:
+				return 1
+			case !bok:
+				return -1
+			}
+
+			c := compareSortValues(scalar(av), scalar(bv))
+			if key.descending {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	return nil
+}
+
+func compareSortValues(a, b any) int {
+	switch a := a.(type) {
+	case float64:
+		if b, ok := b.(float64); ok {
+			return cmp.Compare(a, b)
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b)
+		}
+	case bool:
+		if b, ok := b.(bool); ok && a != b {
+			if a {
+				return 1
+			}
+			return -1
+		}
+	}
+	return strings.Compare(strings.ToLower(fmt.Sprint(a)), strings.ToLower(fmt.Sprint(b)))
+}
+
+// scalar converts a field value into one of the types filters and sorts
+// compare: float64, string, bool, time.Time, []any, or nil.
+func scalar(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		return v
+	case []any:
+		return v
+	}
+
+	v := indirect(reflect.ValueOf(value))
+	if !v.IsValid() {
+		return nil
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t
+	}
+
+	switch {
+	case v.CanFloat():
+		return v.Float()
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Slice, reflect.Array:
+		items := make([]any, v.Len())
+		for i := range v.Len() {
+			items[i] = v.Index(i).Interface()
+		}
+		return items
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+type filterNode interface {
+	eval(item reflect.Value) (bool, error)
+}
+
+type andNode struct{ left, right filterNode }
+
+func (n andNode) eval(item reflect.Value) (bool, error) {
+	ok, err := n.left.eval(item)
+	if err != nil || !ok {
+		return false, err
+	}
+	return n.right.eval(item)
+}
+
+type orNode struct{ left, right filterNode }
+
+func (n orNode) eval(item reflect.Value) (bool, error) {
+	ok, err := n.left.eval(item)
+	if err != nil || ok {
+		return ok, err
+	}
+	return n.right.eval(item)
+}
+
+type notNode struct{ node filterNode }
+
+func (n notNode) eval(item reflect.Value) (bool, error) {
+	ok, err := n.node.eval(item)
+	return !ok, err
+}
+
+// truthyNode is a field on its own, which is true when it is set.
+type truthyNode struct{ path fieldPath }
+
+func (n truthyNode) eval(item reflect.Value) (bool, error) {
+	value, ok := n.path.value(item)
+	if !ok {
+		return false, nil
+	}
+
+	switch v := scalar(value).(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case float64:
+		return v != 0, nil
+	case string:
+		return v != "", nil
+	case time.Time:
+		return !v.IsZero(), nil
+	case []any:
+		return len(v) > 0, nil
+	}
+	return true, nil
+}
+
+type compareNode struct {
+	path    fieldPath
+	op      string
+	literal any // string, float64, bool or nil
+	pattern *regexp.Regexp
+}
+
+func (n compareNode) eval(item reflect.Value) (bool, error) {
+	value, ok := n.path.value(item)
+	if !ok {
+		value = nil
+	}
+
+	if items, ok := scalar(value).([]any); ok {
+		if n.op == "!=" {
+			matched, err := n.any(items, "==")
+			return !matched, err
+		}
+		return n.any(items, n.op)
+	}
+
+	return n.compare(scalar(value), n.op)
+}
+
+// any reports whether any of items satisfy op.
+func (n compareNode) any(items []any, op string) (bool, error) {
+	for _, item := range items {
+		ok, err := n.compare(scalar(item), op)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+func (n compareNode) compare(value any, op string) (bool, error) {
+	if op == "=~" {
+		if value == nil {
+			return false, nil
+		}
+		return n.pattern.MatchString(fmt.Sprint(value)), nil
+	}
+
+	if value == nil || n.literal == nil {
+		switch op {
+		case "==":
+			return value == nil && n.literal == nil, nil
+		case "!=":
+			return (value == nil) != (n.literal == nil), nil
+		}
+		return false, nil
+	}
+
+	c, err := n.order(value)
+	if err != nil {
+		return false, err
+	}
+
+	switch op {
+	case "==":
+		return c == 0, nil
+	case "!=":
+		return c != 0, nil
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	case ">=":
+		return c >= 0, nil
+	}
+	return false, fmt.Errorf("invalid filter: unknown operator %q", op)
+}
+
+// order compares value with the literal, reading the literal as the type of
+// value where it can.
+func (n compareNode) order(value any) (int, error) {
+	switch v := value.(type) {
+	case float64:
+		switch l := n.literal.(type) {
+		case float64:
+			return cmp.Compare(v, l), nil
+		case string:
+			if f, err := strconv.ParseFloat(l, 64); err == nil {
+				return cmp.Compare(v, f), nil
+			}
+		}
+	case time.Time:
+		if l, ok := n.literal.(string); ok {
+			if t, err := parseFilterTime(l); err == nil {
+				return v.Compare(t), nil
+			}
+			return 0, fmt.Errorf("invalid filter: %s is a date, and %q is not a date such as 2024-01-31 or 2024-01-31T09:00:00Z", n.path, l)
+		}
+	case bool:
+		if l, ok := n.literal.(bool); ok {
+			if n.op != "==" && n.op != "!=" {
+				return 0, fmt.Errorf("invalid filter: %s is true or false, so can only be compared with == or !=", n.path)
+			}
+			if v == l {
+				return 0, nil
+			}
+			return 1, nil
+		}
+	case string:
+		return strings.Compare(strings.ToLower(v), strings.ToLower(literalString(n.literal))), nil
+	}
+
+	return 0, fmt.Errorf("invalid filter: cannot compare %s with %s", n.path, literalString(n.literal))
+}
+
+func literalString(literal any) string {
+	if f, ok := literal.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(literal)
+}
+
+func parseFilterTime(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date", s)
+}
+
+// filterToken is a lexed part of a filter expression.
+type filterToken struct {
+	kind  string // "field", "string", "number", "op" or "word" (true, false, null)
+	text  string
+	value any
+}
+
+func (t filterToken) String() string {
+	if t.kind == "string" {
+		return strconv.Quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+var filterOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "=~", "<", ">", "!", "(", ")"}
+
+func lexFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	rest := expr
+
+	for {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			return tokens, nil
+		}
+
+		if op, ok := matchOperator(rest); ok {
+			tokens = append(tokens, filterToken{kind: "op", text: op})
+			rest = rest[len(op):]
+			continue
+		}
+
+		switch c := rest[0]; {
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(rest[1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("invalid filter %q: unterminated string %s", expr, rest)
+			}
+			text := rest[1 : end+1]
+			tokens = append(tokens, filterToken{kind: "string", text: text, value: text})
+			rest = rest[end+2:]
+		case c == '-' || c == '.' || (c >= '0' && c <= '9'):
+			end := strings.IndexFunc(rest[1:], func(r rune) bool {
+				return !unicode.IsDigit(r) && r != '.'
+			})
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			text := rest[:end+1]
+			number, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter %q: %q is not a number", expr, text)
+			}
+			tokens = append(tokens, filterToken{kind: "number", text: text, value: number})
+			rest = rest[end+1:]
+		case c == '_' || unicode.IsLetter(rune(c)):
+			end := strings.IndexFunc(rest, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_.[]", r)
+			})
+			if end < 0 {
+				end = len(rest)
+			}
+			text := rest[:end]
+			switch text {
+			case "true", "false":
+				tokens = append(tokens, filterToken{kind: "word", text: text, value: text == "true"})
+			case "null":
+				tokens = append(tokens, filterToken{kind: "word", text: text})
+			default:
+				tokens = append(tokens, filterToken{kind: "field", text: text})
+			}
+			rest = rest[end:]
+		default:
+			return nil, fmt.Errorf("invalid filter %q: unexpected %q", expr, rest[:1])
+		}
+	}
+}
+
+func matchOperator(s string) (string, bool) {
+	for _, op := range filterOperators {
+		if strings.HasPrefix(s, op) {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+// filterParser is a recursive descent parser over the grammar
+//
+//	or         = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | "(" or ")" | comparison
+//	comparison = field [ operator literal ]
+type filterParser struct {
+	tokens   []filterToken
+	pos      int
+	itemType reflect.Type
+}
+
+func (p *filterParser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *filterParser) peek() filterToken {
+	if p.done() {
+		return filterToken{kind: "end", text: "end of filter"}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) acceptOp(ops ...string) (string, bool) {
+	if t := p.peek(); t.kind == "op" && slices.Contains(ops, t.text) {
+		p.pos++
+		return t.text, true
+	}
+	return "", false
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.acceptOp("||"); !ok {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.acceptOp("&&"); !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	if _, ok := p.acceptOp("!"); ok {
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node: node}, nil
+	}
+
+	if _, ok := p.acceptOp("("); ok {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := p.acceptOp(")"); !ok {
+			return nil, fmt.Errorf("invalid filter: expected \")\", got %s", p.peek())
+		}
+		return node, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (filterNode, error) {
+	t := p.peek()
+	if t.kind != "field" {
+		return nil, fmt.Errorf("invalid filter: expected a field, got %s", t)
+	}
+	p.pos++
+
+	path, err := parseFieldPath(t.text)
+	if err != nil {
+		return nil, err
+	}
+	if err := path.check(p.itemType, t.text); err != nil {
+		return nil, err
+	}
+
+	op, ok := p.acceptOp("==", "!=", "<", "<=", ">", ">=", "=~")
+	if !ok {
+		return truthyNode{path: path}, nil
+	}
+
+	literal := p.peek()
+	if literal.kind != "string" && literal.kind != "number" && literal.kind != "word" {
+		return nil, fmt.Errorf("invalid filter: expected a value after %s %s, got %s", t.text, op, literal)
+	}
+	p.pos++
+
+	node := compareNode{path: path, op: op, literal: literal.value}
+	if op == "=~" {
+		node.pattern, err = regexp.Compile(literalString(literal.value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
+	return node, nil
+}
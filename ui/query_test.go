@@ -0,0 +1,117 @@
+package ui_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+)
+
+func queryStudies() []model.Study {
+	return []model.Study{
+		{ID: "1", Name: "Beta", Reward: 450, StudyType: "SINGLE", Status: model.StatusActive, DateCreated: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), IsUnderpaying: true},
+		{ID: "2", Name: "alpha", Reward: 900, StudyType: "QUOTA", Status: model.StatusActive, DateCreated: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "3", Name: "Gamma", Reward: 900, StudyType: "SINGLE", Status: model.StatusCompleted, DateCreated: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), DeviceCompatibility: []string{"desktop", "mobile"}},
+	}
+}
+
+func ids(studies []model.Study) string {
+	result := make([]string, len(studies))
+	for i, s := range studies {
+		result[i] = s.ID
+	}
+	return strings.Join(result, ",")
+}
+
+func TestFilterItems(t *testing.T) {
+	tests := []struct {
+		filter   string
+		expected string
+	}{
+		{filter: `Reward >= 500 && StudyType == "SINGLE"`, expected: "3"},
+		{filter: `status == 'active'`, expected: "1,2"},
+		{filter: `Reward < 500 || Name =~ "^G"`, expected: "1,3"},
+		{filter: `!(Status == "ACTIVE")`, expected: "3"},
+		{filter: `IsUnderpaying`, expected: "1"},
+		{filter: `!IsUnderpaying`, expected: "2,3"},
+		{filter: `DateCreated > "2024-01-15"`, expected: "1,3"},
+		{filter: `device_compatibility == "mobile"`, expected: "3"},
+		{filter: `DeviceCompatibility != "mobile"`, expected: "1,2"},
+		{filter: `Reward == "900"`, expected: "2,3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			filtered, err := ui.FilterItems(queryStudies(), tt.filter)
+			if err != nil {
+				t.Fatalf("did not expect error, got %v", err)
+			}
+			if got := ids(filtered); got != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseFilterRejectsInvalidFilters(t *testing.T) {
+	tests := []struct {
+		filter   string
+		expected string
+	}{
+		{filter: `Nope == 1`, expected: `unknown field "Nope"`},
+		{filter: `Reward >=`, expected: "expected a value after Reward >="},
+		{filter: `(Reward > 1`, expected: `expected ")"`},
+		{filter: `Name == "open`, expected: "unterminated string"},
+		{filter: `Reward > 1 Name`, expected: `unexpected "Name"`},
+		{filter: `Name =~ "("`, expected: "error parsing regexp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			_, err := ui.ParseFilter[model.Study](tt.filter)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Fatalf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestFilterItemsReturnsComparisonErrors(t *testing.T) {
+	_, err := ui.FilterItems(queryStudies(), `DateCreated > "last week"`)
+	if err == nil || !strings.Contains(err.Error(), "is not a date") {
+		t.Fatalf("expected a date error, got %v", err)
+	}
+}
+
+func TestSortItems(t *testing.T) {
+	tests := []struct {
+		sort     string
+		expected string
+	}{
+		{sort: "Name", expected: "2,1,3"},
+		{sort: "-DateCreated", expected: "1,3,2"},
+		{sort: "-Reward,Name", expected: "2,3,1"},
+		{sort: "reward, -name", expected: "1,3,2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			studies := queryStudies()
+			if err := ui.SortItems(studies, tt.sort); err != nil {
+				t.Fatalf("did not expect error, got %v", err)
+			}
+			if got := ids(studies); got != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSortItemsRejectsUnknownFields(t *testing.T) {
+	err := ui.SortItems(queryStudies(), "-Nope")
+	if err == nil || !strings.Contains(err.Error(), `unknown field "Nope"`) {
+		t.Fatalf("expected an unknown field error, got %v", err)
+	}
+}
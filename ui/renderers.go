@@ -5,33 +5,34 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"reflect"
 	"strings"
 	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
 )
 
 // TableRenderer renders a slice of items as a tab-aligned table using reflection for field access.
+// Fields are paths such as "Researcher.Name" or "filters[0].title"; see fieldPath.
 type TableRenderer[T any] struct{}
 
 // Render writes items as a tab-aligned table to w.
 func (r TableRenderer[T]) Render(items []T, fields string, w io.Writer) error {
-	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
-
 	fieldList := splitFields(fields)
+	paths, err := parseFields[T](fieldList)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 1, 1, ' ', 0)
 
 	for _, field := range fieldList {
-		fmt.Fprintf(tw, "%s\t", strings.Trim(field, " "))
+		fmt.Fprintf(tw, "%s\t", field)
 	}
 	fmt.Fprint(tw, "\n")
 
 	for _, item := range items {
-		for _, field := range fieldList {
-			v := reflect.ValueOf(item).FieldByName(strings.Trim(field, " "))
-			if !v.IsValid() {
-				fmt.Fprintf(tw, "\t")
-				continue
-			}
-			fmt.Fprintf(tw, "%v\t", v)
+		for _, path := range paths {
+			fmt.Fprintf(tw, "%s\t", path.format(item, FormatDateTime))
 		}
 		fmt.Fprint(tw, "\n")
 	}
@@ -40,11 +41,17 @@ func (r TableRenderer[T]) Render(items []T, fields string, w io.Writer) error {
 }
 
 // CsvRenderer renders a slice of items as CSV using reflection for field access.
+// Fields are resolved in the same way as for TableRenderer, and times are
+// written as RFC 3339.
 type CsvRenderer[T any] struct{}
 
 // Render writes items as CSV to w.
 func (r CsvRenderer[T]) Render(items []T, fields string, w io.Writer) error {
 	fieldList := splitFields(fields)
+	paths, err := parseFields[T](fieldList)
+	if err != nil {
+		return err
+	}
 
 	cw := csv.NewWriter(w)
 
@@ -53,12 +60,9 @@ func (r CsvRenderer[T]) Render(items []T, fields string, w io.Writer) error {
 	}
 
 	for _, item := range items {
-		row := make([]string, len(fieldList))
-		for i, field := range fieldList {
-			v := reflect.ValueOf(item).FieldByName(field)
-			if v.IsValid() {
-				row[i] = fmt.Sprintf("%v", v)
-			}
+		row := make([]string, len(paths))
+		for i, path := range paths {
+			row[i] = path.format(item, FormatMachineDateTime)
 		}
 		if err := cw.Write(row); err != nil {
 			return err
@@ -89,3 +93,104 @@ func splitFields(fields string) []string {
 	}
 	return result
 }
+
+// NDJSONRenderer renders a slice of items as newline-delimited JSON, one
+// compact object per line, for streaming into tools such as jq.
+type NDJSONRenderer[T any] struct{}
+
+// Render writes each item as a line of JSON to w.
+func (r NDJSONRenderer[T]) Render(items []T, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	for _, item := range items {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// YAMLRenderer renders a slice of items as YAML. Items are converted through
+// JSON first, so keys match the JSON output and keep their order.
+type YAMLRenderer[T any] struct{}
+
+// Render writes items as YAML to w.
+func (r YAMLRenderer[T]) Render(items []T, w io.Writer) error {
+	if items == nil {
+		items = []T{}
+	}
+	return RenderYAML(items, w)
+}
+
+// RenderYAML writes value as YAML to w, converting it through JSON in the same
+// way as YAMLRenderer.
+func RenderYAML(value any, w io.Writer) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	// YAML is a superset of JSON, so this keeps the order of the keys.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	blockStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// blockStyle clears the styles the JSON input gave each node, so collections
+// are written as indented YAML blocks and strings are only quoted when they
+// need to be. Empty collections stay as [] or {}.
+func blockStyle(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode || len(node.Content) > 0 {
+		node.Style = 0
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// MarkdownRenderer renders a slice of items as a Markdown table, for pasting
+// into reports. Fields are resolved in the same way as for TableRenderer.
+type MarkdownRenderer[T any] struct{}
+
+// Render writes items as a Markdown table to w.
+func (r MarkdownRenderer[T]) Render(items []T, fields string, w io.Writer) error {
+	fieldList := splitFields(fields)
+	paths, err := parseFields[T](fieldList)
+	if err != nil {
+		return err
+	}
+
+	cells := make([]string, len(fieldList))
+
+	for i, field := range fieldList {
+		cells[i] = markdownCell(field)
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+
+	for i := range cells {
+		cells[i] = "---"
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+
+	for _, item := range items {
+		for i, path := range paths {
+			cells[i] = markdownCell(path.format(item, FormatDateTime))
+		}
+		if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markdownCell escapes the characters that would break a table row.
+var markdownCell = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>").Replace
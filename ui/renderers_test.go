@@ -158,3 +158,210 @@ func TestTableRendererRendersCredentialPoolID(t *testing.T) {
 		t.Fatalf("expected study data in output, got '%v'", output)
 	}
 }
+
+func TestCsvRendererResolvesFieldPaths(t *testing.T) {
+	study := model.Study{
+		ID:                  "1234",
+		DeviceCompatibility: []string{"desktop", "mobile"},
+		Filters: []model.Filter{
+			{FilterID: "age", FilterTitle: "Age"},
+			{FilterID: "sex", FilterTitle: "Sex"},
+		},
+		SubmissionsConfig: model.SubmissionsConfig{MaxConcurrentSubmissions: 3},
+	}
+	study.Researcher.Name = "Ada"
+
+	tt := []struct {
+		name     string
+		fields   string
+		expected string
+	}{
+		{
+			name:     "Nested Go field names",
+			fields:   "Researcher.Name,SubmissionsConfig.MaxConcurrentSubmissions",
+			expected: "Researcher.Name,SubmissionsConfig.MaxConcurrentSubmissions\nAda,3\n",
+		},
+		{
+			name:     "JSON keys",
+			fields:   "id,researcher.name,submissions_config.max_concurrent_submissions",
+			expected: "id,researcher.name,submissions_config.max_concurrent_submissions\n1234,Ada,3\n",
+		},
+		{
+			name:     "Indexed list",
+			fields:   "Filters[0].title,Filters[2].title,DeviceCompatibility[1]",
+			expected: "Filters[0].title,Filters[2].title,DeviceCompatibility[1]\nAge,,mobile\n",
+		},
+		{
+			name:     "Every item of a list",
+			fields:   "Filters.FilterID,DeviceCompatibility",
+			expected: "Filters.FilterID,DeviceCompatibility\n\"age, sex\",\"desktop, mobile\"\n",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+
+			r := ui.CsvRenderer[model.Study]{}
+			if err := r.Render([]model.Study{study}, tc.fields, &b); err != nil {
+				t.Fatalf("did not expect error, got %v", err)
+			}
+
+			if b.String() != tc.expected {
+				t.Fatalf("expected '%v', got '%v'", tc.expected, b.String())
+			}
+		})
+	}
+}
+
+func TestTableRendererJoinsListFields(t *testing.T) {
+	study := model.Study{ID: "1234", DeviceCompatibility: []string{"desktop", "mobile"}}
+
+	var b bytes.Buffer
+
+	r := ui.TableRenderer[model.Study]{}
+	if err := r.Render([]model.Study{study}, "ID,DeviceCompatibility", &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	if !strings.Contains(b.String(), "1234 desktop, mobile") {
+		t.Fatalf("expected the devices to be joined, got '%v'", b.String())
+	}
+}
+
+func TestTableRendererResolvesSubmissionStrata(t *testing.T) {
+	submission := model.Submission{ParticipantID: "p1"}
+	submission.Strata.Sex = "Female"
+
+	var b bytes.Buffer
+
+	r := ui.TableRenderer[model.Submission]{}
+	if err := r.Render([]model.Submission{submission}, "ParticipantID,Strata.Sex", &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	if !strings.Contains(b.String(), "Strata.Sex") || !strings.Contains(b.String(), "Female") {
+		t.Fatalf("expected the strata sex in output, got '%v'", b.String())
+	}
+}
+
+func TestRenderersRejectUnknownFields(t *testing.T) {
+	tt := []struct {
+		name     string
+		fields   string
+		expected string
+	}{
+		{
+			name:     "Unknown top level field",
+			fields:   "ID,Nmae",
+			expected: `unknown field "Nmae", valid fields are: ID, Name, InternalName`,
+		},
+		{
+			name:     "Unknown nested field",
+			fields:   "Researcher.Nmae",
+			expected: `unknown field "Researcher.Nmae", valid fields of Researcher are: ID, Name, Email, Country, Institution`,
+		},
+		{
+			name:     "Path into a value without fields",
+			fields:   "Name.First",
+			expected: `unknown field "Name.First": Name has no fields`,
+		},
+		{
+			name:     "Index into a value that is not a list",
+			fields:   "Name[0]",
+			expected: `invalid field "Name[0]": Name[0] is not a list`,
+		},
+		{
+			name:     "Malformed index",
+			fields:   "Filters[x]",
+			expected: `invalid field "Filters[x]": "[x]" is not a valid index`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+
+			err := ui.TableRenderer[model.Study]{}.Render([]model.Study{{ID: "1234"}}, tc.fields, &b)
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Fatalf("expected error containing '%v', got %v", tc.expected, err)
+			}
+
+			err = ui.CsvRenderer[model.Study]{}.Render([]model.Study{{ID: "1234"}}, tc.fields, &b)
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Fatalf("expected error containing '%v', got %v", tc.expected, err)
+			}
+
+			if b.Len() != 0 {
+				t.Fatalf("expected nothing to be rendered, got '%v'", b.String())
+			}
+		})
+	}
+}
+
+func TestNDJSONRendererWritesAnObjectPerLine(t *testing.T) {
+	var b bytes.Buffer
+
+	r := ui.NDJSONRenderer[model.Workspace]{}
+	if err := r.Render([]model.Workspace{{ID: "1", Title: "Lab"}, {ID: "2", Title: "Home"}}, &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], `{"id":"1","title":"Lab"`) || !strings.HasPrefix(lines[1], `{"id":"2","title":"Home"`) {
+		t.Fatalf("expected one object per line, got '%v'", b.String())
+	}
+}
+
+func TestYAMLRendererUsesJSONKeysInOrder(t *testing.T) {
+	study := model.Study{ID: "1234", Name: "Eggs", DeviceCompatibility: []string{"desktop", "mobile"}, Filters: []model.Filter{}}
+
+	var b bytes.Buffer
+
+	r := ui.YAMLRenderer[model.Study]{}
+	if err := r.Render([]model.Study{study}, &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	output := b.String()
+	if !strings.HasPrefix(output, "- id: \"1234\"\n  name: Eggs\n") {
+		t.Fatalf("expected the study's JSON keys in order, got '%v'", output)
+	}
+	if !strings.Contains(output, "  device_compatibility:\n    - desktop\n    - mobile\n") {
+		t.Fatalf("expected lists in block style, got '%v'", output)
+	}
+	if !strings.Contains(output, "  filters: []\n") {
+		t.Fatalf("expected empty lists to stay inline, got '%v'", output)
+	}
+}
+
+func TestYAMLRendererRendersNoItemsAsAnEmptyList(t *testing.T) {
+	var b bytes.Buffer
+
+	if err := (ui.YAMLRenderer[model.Study]{}).Render(nil, &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	if b.String() != "[]\n" {
+		t.Fatalf("expected '[]', got '%v'", b.String())
+	}
+}
+
+func TestMarkdownRendererRendersATable(t *testing.T) {
+	studies := []model.Study{
+		{ID: "1234", Name: "Eggs | bacon", Desc: "Line one\nLine two"},
+	}
+
+	var b bytes.Buffer
+
+	r := ui.MarkdownRenderer[model.Study]{}
+	if err := r.Render(studies, "ID,Name,Desc", &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	expected := "| ID | Name | Desc |\n| --- | --- | --- |\n| 1234 | Eggs \\| bacon | Line one<br>Line two |\n"
+
+	if b.String() != expected {
+		t.Fatalf("expected '%v', got '%v'", expected, b.String())
+	}
+}
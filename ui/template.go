@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// TemplateFuncs are the functions available to --go-template, on top of the
+// text/template builtins.
+var TemplateFuncs = template.FuncMap{
+	// money formats an amount in the minor unit of currency, as the API
+	// returns them, e.g. {{money .Reward .CurrencyCode}} gives £4.00 for 400.
+	"money": func(amount any, currency string) (string, error) {
+		value, err := toFloat(amount)
+		if err != nil {
+			return "", err
+		}
+		return RenderMoney(value/100, currency), nil
+	},
+	// date formats a time, or an RFC 3339 string, in the configured time zone
+	// with the configured format or the Go layout given, e.g.
+	// {{date .DateCreated "2006-01-02"}}.
+	"date": func(value any, layout ...string) (string, error) {
+		t, err := toTime(value)
+		if err != nil || t.IsZero() {
+			return "", err
+		}
+		if len(layout) > 0 {
+			return InTimezone(t).Format(layout[0]), nil
+		}
+		return FormatDateTime(t), nil
+	},
+	// join joins the items of a list with sep, e.g. {{join .DeviceCompatibility ", "}}.
+	"join": func(list any, sep string) (string, error) {
+		v := indirect(reflect.ValueOf(list))
+		if !v.IsValid() {
+			return "", nil
+		}
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return "", fmt.Errorf("join: %s is not a list", v.Type())
+		}
+		items := make([]string, v.Len())
+		for i := range v.Len() {
+			items[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		return strings.Join(items, sep), nil
+	},
+	// json encodes a value as compact JSON, e.g. {{json .Filters}}.
+	"json": func(value any) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// ParseTemplate parses text as a --go-template, with TemplateFuncs available.
+func ParseTemplate(text string) (*template.Template, error) {
+	return template.New("go-template").Funcs(TemplateFuncs).Option("missingkey=zero").Parse(text)
+}
+
+// TemplateRenderer renders each item through a Go template, one per line.
+type TemplateRenderer[T any] struct {
+	Template string
+}
+
+// Render executes the template for each item, writing a newline after each.
+func (r TemplateRenderer[T]) Render(items []T, w io.Writer) error {
+	tmpl, err := ParseTemplate(r.Template)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := tmpl.Execute(w, item); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RenderTemplate renders a single item through a Go template, followed by a
+// newline.
+func RenderTemplate[T any](text string, item T, w io.Writer) error {
+	return TemplateRenderer[T]{Template: text}.Render([]T{item}, w)
+}
+
+// RenderTemplateList renders items through a Go template, one per line. It
+// saves naming the item type, as TemplateRenderer needs.
+func RenderTemplateList[T any](text string, items []T, w io.Writer) error {
+	return TemplateRenderer[T]{Template: text}.Render(items, w)
+}
+
+func toFloat(value any) (float64, error) {
+	v := indirect(reflect.ValueOf(value))
+	switch {
+	case !v.IsValid():
+		return 0, nil
+	case v.CanFloat():
+		return v.Float(), nil
+	case v.CanInt():
+		return float64(v.Int()), nil
+	case v.CanUint():
+		return float64(v.Uint()), nil
+	}
+	return 0, fmt.Errorf("money: %v is not a number", value)
+}
+
+func toTime(value any) (time.Time, error) {
+	switch t := value.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return t, nil
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, nil
+		}
+		return *t, nil
+	case string:
+		if t == "" {
+			return time.Time{}, nil
+		}
+		parsed, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("date: %w", err)
+		}
+		return parsed, nil
+	}
+	return time.Time{}, fmt.Errorf("date: %v is not a time", value)
+}
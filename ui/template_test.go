@@ -0,0 +1,79 @@
+package ui_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prolific-oss/cli/model"
+	"github.com/prolific-oss/cli/ui"
+)
+
+func TestTemplateRendererRendersEachItemOnALine(t *testing.T) {
+	studies := []model.Study{
+		{ID: "1234", Name: "First", Status: model.StatusActive},
+		{ID: "5678", Name: "Second", Status: model.StatusCompleted},
+	}
+
+	var b bytes.Buffer
+	if err := (ui.TemplateRenderer[model.Study]{Template: "{{.ID}} {{upper .Status}}"}).Render(studies, &b); err != nil {
+		t.Fatalf("did not expect error, got %v", err)
+	}
+
+	expected := "1234 ACTIVE\n5678 COMPLETED\n"
+	if b.String() != expected {
+		t.Fatalf("expected\n%q\ngot\n%q", expected, b.String())
+	}
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	study := model.Study{
+		ID:                  "1234",
+		Reward:              450,
+		CurrencyCode:        "GBP",
+		DateCreated:         time.Date(2023, time.March, 4, 10, 30, 0, 0, time.UTC),
+		DeviceCompatibility: []string{"desktop", "mobile"},
+	}
+
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{template: "{{money .Reward .CurrencyCode}}", expected: "£4.50"},
+		{template: `{{date .DateCreated "2006-01-02"}}`, expected: "2023-03-04"},
+		{template: `{{date "2023-03-04T10:30:00Z" "15:04"}}`, expected: "10:30"},
+		{template: `{{join .DeviceCompatibility ", "}}`, expected: "desktop, mobile"},
+		{template: "{{json .DeviceCompatibility}}", expected: `["desktop","mobile"]`},
+		{template: "{{lower .CurrencyCode}}", expected: "gbp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := ui.RenderTemplate(tt.template, study, &b); err != nil {
+				t.Fatalf("did not expect error, got %v", err)
+			}
+			if got := strings.TrimSuffix(b.String(), "\n"); got != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTemplateRendererReturnsExecutionErrors(t *testing.T) {
+	var b bytes.Buffer
+	err := ui.RenderTemplate("{{join .Name \",\"}}", model.Study{Name: "Study"}, &b)
+	if err == nil || !strings.Contains(err.Error(), "join: string is not a list") {
+		t.Fatalf("expected a join error, got %v", err)
+	}
+}
+
+func TestParseTemplateRejectsInvalidTemplates(t *testing.T) {
+	if _, err := ui.ParseTemplate("{{.ID"); err == nil {
+		t.Fatal("expected an error for an unclosed action")
+	}
+	if _, err := ui.ParseTemplate("{{nope .ID}}"); err == nil {
+		t.Fatal("expected an error for an unknown function")
+	}
+}
@@ -5,6 +5,7 @@ package ui
 
 import (
 	"fmt"
+	"math"
 	"os"
 
 	"github.com/charmbracelet/lipgloss"
@@ -20,7 +21,8 @@ const (
 	DarkGrey = "#989898"
 )
 
-// AppDateTimeFormat The format for date/times in the application.
+// AppDateTimeFormat The default format for date/times in the application. See
+// OutputSettings to change it.
 const AppDateTimeFormat string = "02-01-2006 15:04"
 
 // RenderSectionMarker will render a section marker in the output.
@@ -33,18 +35,36 @@ func RenderHeading(heading string) string {
 	return lipgloss.NewStyle().Bold(true).Render(heading)
 }
 
-// RenderMoney will return a symbolised string of money, e.g. £10.00
+// RenderMoney will return a symbolised string of money, e.g. £10.00, with as
+// many decimal places as the currency has minor units, e.g. ¥1235 for JPY.
+// With a locale set in the OutputSettings, the symbol and separators follow
+// it, e.g. £1.234,50 for de-DE.
 func RenderMoney(amount float64, currencyCode string) string {
 	if currencyCode == "" {
 		currencyCode = model.DefaultCurrency
 	}
 
 	cur, err := currency.ParseISO(currencyCode)
+	scale := 2
+	if err == nil {
+		scale, _ = currency.Standard.Rounding(cur)
+	}
+	// Round halves away from zero, as money is, rather than to even.
+	unit := math.Pow10(scale)
+	amount = math.Round(amount*unit) / unit
+
+	number := fmt.Sprintf("%.*f", scale, amount)
+	locale := language.English
+	if outputSettings.Locale != language.Und {
+		locale = outputSettings.Locale
+		number = message.NewPrinter(locale).Sprintf("%.*f", scale, amount)
+	}
+
 	if err != nil {
-		return fmt.Sprintf("%.2f", amount)
+		return number
 	}
-	p := message.NewPrinter(language.English)
-	return fmt.Sprintf("%s%.2f", p.Sprint(currency.Symbol(cur)), amount)
+	p := message.NewPrinter(locale)
+	return fmt.Sprintf("%s%s", p.Sprint(currency.Symbol(cur)), number)
 }
 
 // RenderRecordCounter will render a common string to explain how many records